@@ -0,0 +1,135 @@
+// This file and its contents are licensed under the Apache License 2.0.
+// Please see the included NOTICE for copyright information and
+// LICENSE for a copy of the license.
+
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/NYTimes/gziphandler"
+	"github.com/timescale/promscale/pkg/log"
+	pgmodelErrs "github.com/timescale/promscale/pkg/pgmodel/common/errors"
+	"github.com/timescale/promscale/pkg/pgmodel/ingestor"
+)
+
+// backfillStatus reports the progress of the backfill.
+type backfillStatus struct {
+	BufferedSamples int        `json:"bufferedSamples"`
+	BufferedMetrics int        `json:"bufferedMetrics"`
+	Flushing        bool       `json:"flushing"`
+	Flushes         uint64     `json:"flushes"`
+	FlushedMetrics  uint64     `json:"flushedMetrics"`
+	InsertedSamples uint64     `json:"insertedSamples"`
+	FailedSamples   uint64     `json:"failedSamples"`
+	LastFlush       *time.Time `json:"lastFlush,omitempty"`
+	LastError       string     `json:"lastError,omitempty"`
+}
+
+// Backfill accepts write requests like Write, but buffers their samples until
+// the backfill is flushed, so that the compressed chunks they fall into are
+// decompressed and recompressed once. Requests are rejected with 429 while the
+// buffer is full, and with 501 if the spool is disabled.
+func Backfill(backfiller ingestor.DBBackfiller, metrics *Metrics) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !validateWriteHeaders(w, r) {
+			metrics.InvalidWriteReqs.Inc()
+			return
+		}
+
+		req, err, logMsg := loadWriteRequest(r)
+		if err != nil {
+			metrics.InvalidWriteReqs.Inc()
+			log.Error("msg", logMsg, "err", err.Error())
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		timeseries := req.GetTimeseries()
+		if len(timeseries) == 0 {
+			return
+		}
+
+		numSamples, err := backfiller.Backfill(timeseries, req)
+		if isIngestSaturatedError(err) {
+			log.DebugRateLimited("msg", "Backfill request rejected, the ingestion is saturated", "err", err)
+			w.Header().Set("Retry-After", strconv.Itoa(int(writeRetryAfter.Seconds())))
+			http.Error(w, err.Error(), http.StatusTooManyRequests)
+			return
+		}
+		if errors.Is(err, pgmodelErrs.ErrBackfillWithoutSpool) {
+			log.Error("msg", "Backfill request rejected, the spool is disabled", "err", err)
+			http.Error(w, err.Error(), http.StatusNotImplemented)
+			return
+		}
+		if err != nil {
+			log.Warn("msg", "Error backfilling samples", "err", err, "num_samples", numSamples)
+			status := http.StatusInternalServerError
+			if _, ok := pgmodelErrs.NonRetryableReason(err); ok {
+				status = http.StatusBadRequest
+			}
+			http.Error(w, err.Error(), status)
+		}
+	})
+}
+
+// BackfillFlush inserts the buffered backfilled samples, responding with the
+// progress of the backfill once they are inserted.
+func BackfillFlush(conf *Config, backfiller ingestor.DBBackfiller) http.Handler {
+	hf := corsWrapper(conf, backfillFlushHandler(conf, backfiller))
+	return gziphandler.GzipHandler(hf)
+}
+
+func backfillFlushHandler(conf *Config, backfiller ingestor.DBBackfiller) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if conf.ReadOnly {
+			respondError(w, http.StatusForbidden, fmt.Errorf("read-only connector cannot backfill samples"), "operation_not_permitted")
+			return
+		}
+		if err := backfiller.FlushBackfill(); err != nil {
+			log.Warn("msg", "Error flushing the backfilled samples", "err", err)
+			respondError(w, http.StatusInternalServerError, err, "backfill")
+			return
+		}
+		respondBackfillStatus(w, backfiller.BackfillProgress())
+	}
+}
+
+// BackfillStatus responds with the progress of the backfill.
+func BackfillStatus(conf *Config, backfiller ingestor.DBBackfiller) http.Handler {
+	hf := corsWrapper(conf, backfillStatusHandler(backfiller))
+	return gziphandler.GzipHandler(hf)
+}
+
+func backfillStatusHandler(backfiller ingestor.DBBackfiller) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		respondBackfillStatus(w, backfiller.BackfillProgress())
+	}
+}
+
+func respondBackfillStatus(w http.ResponseWriter, p ingestor.BackfillProgress) {
+	status := backfillStatus{
+		BufferedSamples: p.BufferedSamples,
+		BufferedMetrics: p.BufferedMetrics,
+		Flushing:        p.Flushing,
+		Flushes:         p.Flushes,
+		FlushedMetrics:  p.FlushedMetrics,
+		InsertedSamples: p.InsertedSamples,
+		FailedSamples:   p.FailedSamples,
+		LastError:       p.LastError,
+	}
+	if !p.LastFlush.IsZero() {
+		status.LastFlush = &p.LastFlush
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	_ = json.NewEncoder(w).Encode(&response{
+		Status: "success",
+		Data:   status,
+	})
+}
@@ -0,0 +1,217 @@
+// This file and its contents are licensed under the Apache License 2.0.
+// Please see the included NOTICE for copyright information and
+// LICENSE for a copy of the license.
+
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+
+	pgmodelErrs "github.com/timescale/promscale/pkg/pgmodel/common/errors"
+	"github.com/timescale/promscale/pkg/pgmodel/ingestor"
+	"github.com/timescale/promscale/pkg/prompb"
+)
+
+type mockBackfiller struct {
+	ts       []prompb.TimeSeries
+	err      error
+	flushErr error
+	flushed  bool
+	progress ingestor.BackfillProgress
+}
+
+func (m *mockBackfiller) Backfill(series []prompb.TimeSeries, _ *prompb.WriteRequest) (uint64, error) {
+	m.ts = series
+	return uint64(len(series)), m.err
+}
+
+func (m *mockBackfiller) FlushBackfill() error {
+	m.flushed = true
+	return m.flushErr
+}
+
+func (m *mockBackfiller) BackfillProgress() ingestor.BackfillProgress {
+	return m.progress
+}
+
+func TestBackfill(t *testing.T) {
+	protobufHeaders := map[string]string{
+		"Content-Encoding":                  "snappy",
+		"Content-Type":                      "application/x-protobuf",
+		"X-Prometheus-Remote-Write-Version": "0.1.0",
+	}
+	testCases := []struct {
+		name         string
+		method       string
+		requestBody  string
+		err          error
+		responseCode int
+		backfilled   int
+	}{
+		{
+			name:         "write request method not POST",
+			method:       "GET",
+			responseCode: http.StatusBadRequest,
+		},
+		{
+			name:         "malformed compression data",
+			method:       "POST",
+			requestBody:  "123",
+			responseCode: http.StatusBadRequest,
+		},
+		{
+			name:   "happy path",
+			method: "POST",
+			requestBody: writeRequestToString(
+				&prompb.WriteRequest{
+					Timeseries: []prompb.TimeSeries{{}},
+				},
+			),
+			responseCode: http.StatusOK,
+			backfilled:   1,
+		},
+		{
+			name:   "backfill error",
+			method: "POST",
+			requestBody: writeRequestToString(
+				&prompb.WriteRequest{
+					Timeseries: []prompb.TimeSeries{{}},
+				},
+			),
+			err:          fmt.Errorf("some error"),
+			responseCode: http.StatusInternalServerError,
+			backfilled:   1,
+		},
+		{
+			name:   "non-retryable backfill error",
+			method: "POST",
+			requestBody: writeRequestToString(
+				&prompb.WriteRequest{
+					Timeseries: []prompb.TimeSeries{{}},
+				},
+			),
+			err:          pgmodelErrs.NewNonRetryableError(pgmodelErrs.ReasonNoMetricName, pgmodelErrs.ErrNoMetricName),
+			responseCode: http.StatusBadRequest,
+			backfilled:   1,
+		},
+		{
+			name:   "spool disabled",
+			method: "POST",
+			requestBody: writeRequestToString(
+				&prompb.WriteRequest{
+					Timeseries: []prompb.TimeSeries{{}},
+				},
+			),
+			err:          pgmodelErrs.ErrBackfillWithoutSpool,
+			responseCode: http.StatusNotImplemented,
+			backfilled:   1,
+		},
+	}
+
+	for _, c := range testCases {
+		t.Run(c.name, func(t *testing.T) {
+			mock := &mockBackfiller{err: c.err}
+			handler := Backfill(mock, &Metrics{InvalidWriteReqs: &mockMetric{}})
+
+			w := GenerateWriteHandleTester(t, handler, protobufHeaders)(c.method, getReader(c.requestBody))
+
+			if w.Code != c.responseCode {
+				t.Errorf("Unexpected HTTP status code received: got %d wanted %d", w.Code, c.responseCode)
+			}
+			if len(mock.ts) != c.backfilled {
+				t.Errorf("Unexpected number of backfilled series: got %d wanted %d", len(mock.ts), c.backfilled)
+			}
+		})
+	}
+}
+
+func TestBackfillFlush(t *testing.T) {
+	lastFlush := time.Date(2021, 3, 1, 12, 0, 0, 0, time.UTC)
+	testCases := []struct {
+		name         string
+		readOnly     bool
+		backfiller   *mockBackfiller
+		responseCode int
+		flushed      bool
+		expectData   string
+	}{
+		{
+			name:         "read-only",
+			readOnly:     true,
+			backfiller:   &mockBackfiller{},
+			responseCode: http.StatusForbidden,
+		},
+		{
+			name:         "flush error",
+			backfiller:   &mockBackfiller{flushErr: fmt.Errorf("some error")},
+			responseCode: http.StatusInternalServerError,
+			flushed:      true,
+		},
+		{
+			name: "all good",
+			backfiller: &mockBackfiller{progress: ingestor.BackfillProgress{
+				Flushes:         1,
+				FlushedMetrics:  2,
+				InsertedSamples: 100,
+				LastFlush:       lastFlush,
+			}},
+			responseCode: http.StatusOK,
+			flushed:      true,
+			expectData: `{
+				"bufferedSamples": 0,
+				"bufferedMetrics": 0,
+				"flushing": false,
+				"flushes": 1,
+				"flushedMetrics": 2,
+				"insertedSamples": 100,
+				"failedSamples": 0,
+				"lastFlush": "2021-03-01T12:00:00Z"
+			}`,
+		},
+	}
+
+	for _, c := range testCases {
+		t.Run(c.name, func(t *testing.T) {
+			req := httptest.NewRequest("POST", "http://localhost:9201/backfill/flush", nil)
+			w := httptest.NewRecorder()
+			backfillFlushHandler(&Config{ReadOnly: c.readOnly}, c.backfiller).ServeHTTP(w, req)
+
+			if w.Code != c.responseCode {
+				t.Fatalf("unexpected HTTP status code: got %d wanted %d", w.Code, c.responseCode)
+			}
+			if c.backfiller.flushed != c.flushed {
+				t.Errorf("unexpected flush: got %v wanted %v", c.backfiller.flushed, c.flushed)
+			}
+			if c.expectData == "" {
+				return
+			}
+
+			var resp struct {
+				Status string          `json:"status"`
+				Data   json.RawMessage `json:"data"`
+			}
+			if err := json.NewDecoder(w.Body).Decode(&resp); err != nil {
+				t.Fatalf("unexpected error decoding the response: %v", err)
+			}
+			if resp.Status != "success" {
+				t.Errorf("unexpected status: got %s wanted success", resp.Status)
+			}
+			var got, expected interface{}
+			if err := json.Unmarshal(resp.Data, &got); err != nil {
+				t.Fatal(err)
+			}
+			if err := json.Unmarshal([]byte(c.expectData), &expected); err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, expected) {
+				t.Errorf("unexpected data:\ngot\n%v\nwanted\n%v", got, expected)
+			}
+		})
+	}
+}
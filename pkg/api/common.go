@@ -262,6 +262,21 @@ func parseTimeParam(r *http.Request, paramName string, defaultValue time.Time) (
 	return result, nil
 }
 
+// parsePartialResponse parses the partial_response parameter, which allows the
+// queries across multiple metrics to return the series of the metrics that
+// were queried successfully, with warnings about the others.
+func parsePartialResponse(r *http.Request) (bool, error) {
+	val := r.FormValue("partial_response")
+	if val == "" {
+		return false, nil
+	}
+	result, err := strconv.ParseBool(val)
+	if err != nil {
+		return false, fmt.Errorf("Invalid value for 'partial_response': %w", err)
+	}
+	return result, nil
+}
+
 func parseTime(s string) (time.Time, error) {
 	if t, err := strconv.ParseFloat(s, 64); err == nil {
 		s, ns := math.Modf(t)
@@ -7,13 +7,19 @@ package api
 import (
 	"context"
 	"encoding/json"
-	"math"
 	"net/http"
+	"sort"
 	"strings"
+	"time"
 
 	"github.com/NYTimes/gziphandler"
+	"github.com/pkg/errors"
+	"github.com/prometheus/prometheus/pkg/labels"
+	"github.com/prometheus/prometheus/pkg/timestamp"
 	"github.com/prometheus/prometheus/promql/parser"
 	"github.com/prometheus/prometheus/storage"
+	pgmodelErrs "github.com/timescale/promscale/pkg/pgmodel/common/errors"
+	"github.com/timescale/promscale/pkg/pgmodel/model"
 	"github.com/timescale/promscale/pkg/promql"
 )
 
@@ -34,15 +40,22 @@ func Labels(conf *Config, queryable promql.Queryable) http.Handler {
 
 func labelsHandler(queryable promql.Queryable) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		querier, err := queryable.Querier(context.Background(), math.MinInt64, math.MaxInt64)
+		start, end, matcherSets, err := parseLabelsScope(r)
+		if err != nil {
+			respondError(w, http.StatusBadRequest, err, "bad_data")
+			return
+		}
+		querier, err := queryable.Querier(context.Background(), timestamp.FromTime(start), timestamp.FromTime(end))
 		if err != nil {
 			respondError(w, http.StatusInternalServerError, err, "internal")
 			return
 		}
 		var names labelsValue
-		names, warnings, err := querier.LabelNames()
+		names, warnings, err := mergeLabelSets(matcherSets, func(matchers ...*labels.Matcher) ([]string, storage.Warnings, error) {
+			return querier.LabelNames(matchers...)
+		})
 		if err != nil {
-			respondError(w, http.StatusInternalServerError, err, "internal")
+			respondLabelsError(w, err)
 			return
 		}
 		respondLabels(w, &promql.Result{
@@ -51,6 +64,72 @@ func labelsHandler(queryable promql.Queryable) http.HandlerFunc {
 	}
 }
 
+// respondLabelsError responds with the error of a label names or values
+// query. Matchers selecting registered views are bad requests, since views are
+// not listed by the label endpoints.
+func respondLabelsError(w http.ResponseWriter, err error) {
+	if errors.Is(err, pgmodelErrs.ErrViewNotSelectable) {
+		respondError(w, http.StatusBadRequest, err, "bad_data")
+		return
+	}
+	respondError(w, http.StatusInternalServerError, err, "internal")
+}
+
+// parseLabelsScope parses the optional time range and match[] parameters
+// scoping the label names and values endpoints.
+func parseLabelsScope(r *http.Request) (start, end time.Time, matcherSets [][]*labels.Matcher, err error) {
+	if err = r.ParseForm(); err != nil {
+		return start, end, nil, errors.Wrap(err, "error parsing form values")
+	}
+	if start, err = parseTimeParam(r, "start", model.MinTime); err != nil {
+		return start, end, nil, err
+	}
+	if end, err = parseTimeParam(r, "end", model.MaxTime); err != nil {
+		return start, end, nil, err
+	}
+	if end.Before(start) {
+		return start, end, nil, errors.New("end timestamp must not be before start time")
+	}
+	for _, s := range r.Form["match[]"] {
+		matchers, err := parser.ParseMetricSelector(s)
+		if err != nil {
+			return start, end, nil, err
+		}
+		matcherSets = append(matcherSets, matchers)
+	}
+	return start, end, matcherSets, nil
+}
+
+// mergeLabelSets returns the sorted union of the label names or values
+// fetched for each of the matcher sets.
+func mergeLabelSets(matcherSets [][]*labels.Matcher, fetch func(...*labels.Matcher) ([]string, storage.Warnings, error)) ([]string, storage.Warnings, error) {
+	if len(matcherSets) == 0 {
+		return fetch()
+	}
+	if len(matcherSets) == 1 {
+		return fetch(matcherSets[0]...)
+	}
+
+	var warnings storage.Warnings
+	seen := make(map[string]struct{})
+	for _, matchers := range matcherSets {
+		vals, ws, err := fetch(matchers...)
+		warnings = append(warnings, ws...)
+		if err != nil {
+			return nil, warnings, err
+		}
+		for _, v := range vals {
+			seen[v] = struct{}{}
+		}
+	}
+	merged := make([]string, 0, len(seen))
+	for v := range seen {
+		merged = append(merged, v)
+	}
+	sort.Strings(merged)
+	return merged, warnings, nil
+}
+
 func respondLabels(w http.ResponseWriter, res *promql.Result, warnings storage.Warnings) {
 	setResponseHeaders(w, res, warnings)
 	resp := &response{
@@ -15,6 +15,7 @@ import (
 	"testing"
 
 	"github.com/timescale/promscale/pkg/log"
+	pgmodelErrs "github.com/timescale/promscale/pkg/pgmodel/common/errors"
 	"github.com/timescale/promscale/pkg/query"
 )
 
@@ -23,29 +24,65 @@ func TestLabels(t *testing.T) {
 		Level: "debug",
 	})
 	testCases := []struct {
-		name         string
-		querier      *mockQuerier
-		labelsReader *mockLabelsReader
-		expectCode   int
-		expectError  string
+		name        string
+		querier     *mockQuerier
+		params      string
+		expectCode  int
+		expectError string
+		expected    []string
 	}{
 		{
-			name:         "Error on get label names",
-			expectCode:   http.StatusInternalServerError,
-			expectError:  "internal",
-			querier:      &mockQuerier{},
-			labelsReader: &mockLabelsReader{labelNamesErr: fmt.Errorf("error on label names")},
+			name:        "Error on get label names",
+			expectCode:  http.StatusInternalServerError,
+			expectError: "internal",
+			querier:     &mockQuerier{selectErr: fmt.Errorf("error on label names")},
 		}, {
-			name:         "All good",
-			expectCode:   http.StatusOK,
-			querier:      &mockQuerier{},
-			labelsReader: &mockLabelsReader{labelNames: []string{"a"}},
+			name:       "All good",
+			expectCode: http.StatusOK,
+			querier:    &mockQuerier{labelNames: []string{"a"}},
+			expected:   []string{"a"},
+		}, {
+			name:        "Matcher is unparsable",
+			params:      "?match[]=wrong_matcher{",
+			expectCode:  http.StatusBadRequest,
+			expectError: "bad_data",
+			querier:     &mockQuerier{},
+		}, {
+			name:        "End is before start",
+			params:      "?start=2&end=1",
+			expectCode:  http.StatusBadRequest,
+			expectError: "bad_data",
+			querier:     &mockQuerier{},
+		}, {
+			name:        "Error on scoped label names",
+			params:      "?match[]=m",
+			expectCode:  http.StatusInternalServerError,
+			expectError: "internal",
+			querier:     &mockQuerier{selectErr: fmt.Errorf("some error")},
+		}, {
+			name:        "Scoped by a registered view",
+			params:      "?match[]=view",
+			expectCode:  http.StatusBadRequest,
+			expectError: "bad_data",
+			querier:     &mockQuerier{selectErr: fmt.Errorf("%w: view", pgmodelErrs.ErrViewNotSelectable)},
+		}, {
+			name:       "Scoped by time range",
+			params:     "?start=1&end=2",
+			expectCode: http.StatusOK,
+			querier:    &mockQuerier{labelNames: []string{"b"}},
+			expected:   []string{"b"},
+		}, {
+			name:       "Scoped by several matcher sets",
+			params:     "?match[]=m&match[]=n",
+			expectCode: http.StatusOK,
+			querier:    &mockQuerier{labelNames: []string{"b", "c"}},
+			expected:   []string{"b", "c"},
 		},
 	}
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
-			handler := labelsHandler(query.NewQueryable(nil, tc.labelsReader))
-			w := doLabels(t, handler)
+			handler := labelsHandler(query.NewQueryable(tc.querier))
+			w := doLabels(t, handler, tc.params)
 
 			if w.Code != tc.expectCode {
 				t.Errorf("Unexpected HTTP status code received: got %d wanted %d", w.Code, tc.expectCode)
@@ -68,8 +105,8 @@ func TestLabels(t *testing.T) {
 			for _, s := range res.Data.([]interface{}) {
 				resStr = append(resStr, s.(string))
 			}
-			if !reflect.DeepEqual(resStr, tc.labelsReader.labelNames) {
-				t.Errorf("expected: %v, got: %v", tc.labelsReader.labelNames, res.Data)
+			if !reflect.DeepEqual(resStr, tc.expected) {
+				t.Errorf("expected: %v, got: %v", tc.expected, res.Data)
 			}
 		})
 
@@ -77,8 +114,8 @@ func TestLabels(t *testing.T) {
 
 }
 
-func doLabels(t *testing.T, queryHandler http.Handler) *httptest.ResponseRecorder {
-	req, err := http.NewRequestWithContext(context.Background(), "GET", "http://localhost:9090/labels", nil)
+func doLabels(t *testing.T, queryHandler http.Handler, params string) *httptest.ResponseRecorder {
+	req, err := http.NewRequestWithContext(context.Background(), "GET", "http://localhost:9090/labels"+params, nil)
 	if err != nil {
 		t.Errorf("%v", err)
 	}
@@ -32,6 +32,7 @@ type Metrics struct {
 	InvalidReadReqs     prometheus.Counter
 	InvalidWriteReqs    prometheus.Counter
 	InvalidQueryReqs    prometheus.Counter
+	QueryLimitHits      *prometheus.CounterVec
 	HTTPRequestDuration *prometheus.HistogramVec
 }
 
@@ -55,6 +56,7 @@ func InitMetrics(writeMetricInterval int) *Metrics {
 		metrics.SentBatchDuration,
 		metrics.QueryBatchDuration,
 		metrics.QueryDuration,
+		metrics.QueryLimitHits,
 		metrics.HTTPRequestDuration,
 	)
 	metrics.WriteThroughput.Start()
@@ -157,6 +159,14 @@ func createMetrics(writeMetricInterval int) *Metrics {
 				Help:      "Total number of received queries.",
 			},
 		),
+		QueryLimitHits: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: util.PromNamespace,
+				Name:      "query_limit_hits_total",
+				Help:      "Total number of queries aborted because they exceeded a query limit.",
+			},
+			[]string{"limit"},
+		),
 		HTTPRequestDuration: prometheus.NewHistogramVec(
 			prometheus.HistogramOpts{
 				Namespace: util.PromNamespace,
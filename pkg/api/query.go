@@ -6,11 +6,14 @@ package api
 
 import (
 	"context"
+	"errors"
 	"net/http"
 	"time"
 
 	"github.com/NYTimes/gziphandler"
 	"github.com/timescale/promscale/pkg/log"
+	pgmodelErrs "github.com/timescale/promscale/pkg/pgmodel/common/errors"
+	"github.com/timescale/promscale/pkg/pgmodel/querier"
 	"github.com/timescale/promscale/pkg/promql"
 )
 
@@ -46,6 +49,17 @@ func queryHandler(queryEngine *promql.Engine, queryable promql.Queryable, metric
 			defer cancel()
 		}
 
+		partialResponse, err := parsePartialResponse(r)
+		if err != nil {
+			log.Error("msg", "Query error", "err", err.Error())
+			respondError(w, http.StatusBadRequest, err, "bad_data")
+			metrics.InvalidQueryReqs.Add(1)
+			return
+		}
+		if partialResponse {
+			ctx = querier.WithPartialResponse(ctx)
+		}
+
 		metrics.ReceivedQueries.Add(1)
 		begin := time.Now()
 		qry, err := queryEngine.NewInstantQuery(queryable, r.FormValue("query"), ts)
@@ -72,6 +86,12 @@ func queryHandler(queryEngine *promql.Engine, queryable promql.Queryable, metric
 				respondError(w, http.StatusInternalServerError, res.Err, "internal")
 				return
 			}
+			if isReadQueueError(res.Err) {
+				respondError(w, http.StatusServiceUnavailable, res.Err, "unavailable")
+				metrics.FailedQueries.Add(1)
+				return
+			}
+			countQueryLimitHit(metrics, res.Err)
 			respondError(w, http.StatusUnprocessableEntity, res.Err, "execution")
 			metrics.FailedQueries.Add(1)
 			return
@@ -80,3 +100,27 @@ func queryHandler(queryEngine *promql.Engine, queryable promql.Queryable, metric
 		respondQuery(w, res, res.Warnings)
 	}
 }
+
+// countQueryLimitHit increments the query limit hits metric if the error was
+// caused by the query exceeding one of the configured query limits.
+func countQueryLimitHit(metrics *Metrics, err error) bool {
+	var limit string
+	switch {
+	case errors.Is(err, pgmodelErrs.ErrQueryMaxSeries):
+		limit = "series"
+	case errors.Is(err, pgmodelErrs.ErrQueryMaxSamples):
+		limit = "samples"
+	case errors.Is(err, pgmodelErrs.ErrQueryMaxRange):
+		limit = "range"
+	default:
+		return false
+	}
+	metrics.QueryLimitHits.WithLabelValues(limit).Inc()
+	return true
+}
+
+// isReadQueueError returns true if the error was caused by the query not
+// being admitted to the database read queue.
+func isReadQueueError(err error) bool {
+	return errors.Is(err, pgmodelErrs.ErrReadQueueFull) || errors.Is(err, pgmodelErrs.ErrReadQueueTimeout)
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/NYTimes/gziphandler"
 	"github.com/pkg/errors"
 	"github.com/timescale/promscale/pkg/log"
+	"github.com/timescale/promscale/pkg/pgmodel/querier"
 	"github.com/timescale/promscale/pkg/promql"
 )
 
@@ -85,6 +86,17 @@ func queryRange(queryEngine *promql.Engine, queryable promql.Queryable, metrics
 			defer cancel()
 		}
 
+		partialResponse, err := parsePartialResponse(r)
+		if err != nil {
+			log.Info("msg", "Query bad request"+err.Error())
+			respondError(w, http.StatusBadRequest, err, "bad_data")
+			metrics.InvalidQueryReqs.Add(1)
+			return
+		}
+		if partialResponse {
+			ctx = querier.WithPartialResponse(ctx)
+		}
+
 		metrics.ReceivedQueries.Add(1)
 		begin := time.Now()
 		qry, err := queryEngine.NewRangeQuery(
@@ -117,6 +129,12 @@ func queryRange(queryEngine *promql.Engine, queryable promql.Queryable, metrics
 				respondError(w, http.StatusInternalServerError, res.Err, "internal")
 				return
 			}
+			if isReadQueueError(res.Err) {
+				respondError(w, http.StatusServiceUnavailable, res.Err, "unavailable")
+				metrics.FailedQueries.Add(1)
+				return
+			}
+			countQueryLimitHit(metrics, res.Err)
 			respondError(w, http.StatusUnprocessableEntity, res.Err, "execution")
 			metrics.FailedQueries.Add(1)
 			return
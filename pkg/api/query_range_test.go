@@ -26,17 +26,16 @@ func TestRangedQuery(t *testing.T) {
 		Level: "debug",
 	})
 	testCases := []struct {
-		name         string
-		timeout      string
-		querier      *mockQuerier
-		labelsReader *mockLabelsReader
-		metric       string
-		start        string
-		end          string
-		step         string
-		expectCode   int
-		expectError  string
-		canceled     bool
+		name        string
+		timeout     string
+		querier     *mockQuerier
+		metric      string
+		start       string
+		end         string
+		step        string
+		expectCode  int
+		expectError string
+		canceled    bool
 	}{
 		{
 			name:        "Start is unparsable",
@@ -171,7 +170,7 @@ func TestRangedQuery(t *testing.T) {
 				InvalidQueryReqs: invalidQueryReqs,
 				QueryDuration:    queryDuration,
 			}
-			handler := queryRange(engine, query.NewQueryable(tc.querier, nil), metrics)
+			handler := queryRange(engine, query.NewQueryable(tc.querier), metrics)
 			queryUrl := constructRangedQuery(tc.metric, tc.start, tc.end, tc.step, tc.timeout)
 			w := doRangedQuery(t, handler, queryUrl, tc.canceled)
 
@@ -16,10 +16,12 @@ import (
 	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/prometheus/prometheus/pkg/labels"
 	"github.com/prometheus/prometheus/promql/parser"
 	"github.com/prometheus/prometheus/storage"
 	"github.com/timescale/promscale/pkg/log"
+	pgmodelErrs "github.com/timescale/promscale/pkg/pgmodel/common/errors"
 	"github.com/timescale/promscale/pkg/pgmodel/querier"
 	"github.com/timescale/promscale/pkg/prompb"
 	"github.com/timescale/promscale/pkg/promql"
@@ -49,6 +51,9 @@ func (m mockSeriesSet) Warnings() storage.Warnings {
 type mockQuerier struct {
 	timeToSleepOnSelect time.Duration
 	selectErr           error
+	seriesPage          querier.SeriesPage
+	labelNames          []string
+	labelValues         []string
 }
 
 var _ querier.Querier = (*mockQuerier)(nil)
@@ -57,30 +62,24 @@ func (m mockQuerier) Query(*prompb.Query) ([]*prompb.TimeSeries, error) {
 	panic("implement me")
 }
 
-func (m mockQuerier) Select(int64, int64, bool, *storage.SelectHints, []parser.Node, ...*labels.Matcher) (storage.SeriesSet, parser.Node) {
+func (m mockQuerier) Select(context.Context, int64, int64, bool, *storage.SelectHints, []parser.Node, ...*labels.Matcher) (storage.SeriesSet, parser.Node) {
 	time.Sleep(m.timeToSleepOnSelect)
 	return &mockSeriesSet{err: m.selectErr}, nil
 }
 
-type mockLabelsReader struct {
-	labelNames    []string
-	labelNamesErr error
-}
-
-func (m mockLabelsReader) PrompbLabelsForIds(ids []int64) (lls []prompb.Label, err error) {
-	return nil, nil
-}
-
-func (m mockLabelsReader) LabelsForIds(ids []int64) (lls labels.Labels, err error) {
-	return nil, nil
+func (m mockQuerier) SelectSeries(int64, int64, int, string, ...[]*labels.Matcher) (*querier.SeriesPage, error) {
+	if m.selectErr != nil {
+		return nil, m.selectErr
+	}
+	return &m.seriesPage, nil
 }
 
-func (m mockLabelsReader) LabelNames() ([]string, error) {
-	return m.labelNames, m.labelNamesErr
+func (m mockQuerier) LabelNames(int64, int64, ...*labels.Matcher) ([]string, error) {
+	return m.labelNames, m.selectErr
 }
 
-func (m mockLabelsReader) LabelValues(string) ([]string, error) {
-	return nil, nil
+func (m mockQuerier) LabelValues(int64, int64, string, ...*labels.Matcher) ([]string, error) {
+	return m.labelValues, m.selectErr
 }
 
 func TestParseDuration(t *testing.T) {
@@ -131,15 +130,16 @@ func TestQuery(t *testing.T) {
 		Level: "debug",
 	})
 	testCases := []struct {
-		name         string
-		timeout      string
-		querier      *mockQuerier
-		labelsReader *mockLabelsReader
-		metric       string
-		time         string
-		expectCode   int
-		expectError  string
-		canceled     bool
+		name           string
+		timeout        string
+		querier        *mockQuerier
+		metric         string
+		time           string
+		expectCode     int
+		expectError    string
+		expectLimitHit bool
+		canceled       bool
+		partial        string
 	}{
 		{
 			name:        "Time is unparsable",
@@ -156,6 +156,14 @@ func TestQuery(t *testing.T) {
 			timeout:     "unparsable",
 			expectError: "bad_data",
 			querier:     &mockQuerier{},
+		}, {
+			name:        "Partial response is unparsable",
+			expectCode:  http.StatusBadRequest,
+			metric:      "m",
+			time:        "1s",
+			partial:     "unparsable",
+			expectError: "bad_data",
+			querier:     &mockQuerier{},
 		}, {
 			name:        "No query given",
 			expectCode:  http.StatusBadRequest,
@@ -187,12 +195,27 @@ func TestQuery(t *testing.T) {
 			metric:      "m",
 			querier:     &mockQuerier{selectErr: fmt.Errorf("some error")},
 			timeout:     "30s",
+		}, {
+			name:           "Query limit exceeded",
+			expectCode:     http.StatusUnprocessableEntity,
+			expectError:    "execution",
+			expectLimitHit: true,
+			metric:         "m",
+			querier:        &mockQuerier{selectErr: fmt.Errorf("%w (limit: 1)", pgmodelErrs.ErrQueryMaxSeries)},
+			timeout:        "30s",
 		}, {
 			name:       "All good",
 			expectCode: http.StatusOK,
 			metric:     "m",
 			querier:    &mockQuerier{},
 			timeout:    "30s",
+		}, {
+			name:       "Partial response",
+			expectCode: http.StatusOK,
+			metric:     "m",
+			querier:    &mockQuerier{},
+			timeout:    "30s",
+			partial:    "true",
 		},
 	}
 	for _, tc := range testCases {
@@ -210,14 +233,19 @@ func TestQuery(t *testing.T) {
 			failedQueriesCounter := &mockMetric{}
 			invalidQueryReqs := &mockMetric{}
 			queryDuration := &mockMetric{}
+			queryLimitHits := prometheus.NewCounterVec(prometheus.CounterOpts{Name: "query_limit_hits"}, []string{"limit"})
 			metrics := &Metrics{
 				FailedQueries:    failedQueriesCounter,
 				ReceivedQueries:  receivedQueriesCounter,
 				InvalidQueryReqs: invalidQueryReqs,
 				QueryDuration:    queryDuration,
+				QueryLimitHits:   queryLimitHits,
 			}
-			handler := queryHandler(engine, query.NewQueryable(tc.querier, tc.labelsReader), metrics)
+			handler := queryHandler(engine, query.NewQueryable(tc.querier), metrics)
 			queryURL := constructQuery(tc.metric, tc.time, tc.timeout)
+			if tc.partial != "" {
+				queryURL += "&partial_response=" + tc.partial
+			}
 			w := doQuery(t, handler, queryURL, tc.canceled)
 
 			if w.Code != tc.expectCode {
@@ -232,6 +260,10 @@ func TestQuery(t *testing.T) {
 					return
 				}
 			}
+			limitHits := testutil.ToFloat64(queryLimitHits.WithLabelValues("series"))
+			if tc.expectLimitHit != (limitHits == 1) {
+				t.Errorf("unexpected number of query limit hits: got %v", limitHits)
+			}
 		})
 
 	}
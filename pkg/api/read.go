@@ -54,7 +54,13 @@ func Read(reader querier.Reader, metrics *Metrics) http.Handler {
 		resp, err = reader.Read(&req)
 		if err != nil {
 			log.Warn("msg", "Error executing query", "query", req, "storage", "PostgreSQL", "err", err)
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+			status := http.StatusInternalServerError
+			if isReadQueueError(err) {
+				status = http.StatusServiceUnavailable
+			} else if countQueryLimitHit(metrics, err) {
+				status = http.StatusBadRequest
+			}
+			http.Error(w, err.Error(), status)
 			metrics.FailedQueries.Add(queryCount)
 			return
 		}
@@ -13,6 +13,8 @@ import (
 
 	"github.com/gogo/protobuf/proto"
 	"github.com/golang/snappy"
+	"github.com/prometheus/client_golang/prometheus"
+	pgmodelErrs "github.com/timescale/promscale/pkg/pgmodel/common/errors"
 	"github.com/timescale/promscale/pkg/prompb"
 )
 
@@ -53,6 +55,15 @@ func TestRead(t *testing.T) {
 			),
 			expReceivedQueries: 1,
 		},
+		{
+			name:         "query limit exceeded",
+			responseCode: http.StatusBadRequest,
+			readerErr:    fmt.Errorf("%w (limit: 1)", pgmodelErrs.ErrQueryMaxSamples),
+			requestBody: readRequestToString(
+				&prompb.ReadRequest{Queries: []*prompb.Query{{}}},
+			),
+			expReceivedQueries: 1,
+		},
 		{
 			name:           "happy path",
 			responseCode:   http.StatusOK,
@@ -86,6 +97,7 @@ func TestRead(t *testing.T) {
 				FailedQueries:      failedQueriesCounter,
 				ReceivedQueries:    receivedQueriesCounter,
 				InvalidReadReqs:    invalidReadReqs,
+				QueryLimitHits:     prometheus.NewCounterVec(prometheus.CounterOpts{Name: "query_limit_hits"}, []string{"limit"}),
 			}
 			handler := Read(mockReader, metrics)
 
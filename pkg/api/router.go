@@ -36,6 +36,14 @@ func GenerateRouter(apiConf *Config, metrics *Metrics, client *pgclient.Client,
 
 	router.Post("/write", writeHandler)
 
+	backfillHandler := timeHandler(metrics.HTTPRequestDuration, "backfill", Backfill(client, metrics))
+	if apiConf.ReadOnly {
+		backfillHandler = withWarnLog("trying to backfill metrics while connector is in read-only mode", http.NotFoundHandler())
+	}
+	router.Post("/backfill", backfillHandler)
+	router.Post("/backfill/flush", timeHandler(metrics.HTTPRequestDuration, "backfill/flush", BackfillFlush(apiConf, client)))
+	router.Get("/backfill/status", timeHandler(metrics.HTTPRequestDuration, "backfill/status", BackfillStatus(apiConf, client)))
+
 	readHandler := timeHandler(metrics.HTTPRequestDuration, "read", Read(client, metrics))
 	router.Get("/read", readHandler)
 	router.Post("/read", readHandler)
@@ -68,6 +76,9 @@ func GenerateRouter(apiConf *Config, metrics *Metrics, client *pgclient.Client,
 	labelValuesHandler := timeHandler(metrics.HTTPRequestDuration, "label/:name/values", LabelValues(apiConf, queryable))
 	router.Get("/api/v1/label/:name/values", labelValuesHandler)
 
+	tsdbStatusHandler := timeHandler(metrics.HTTPRequestDuration, "status/tsdb", TSDBStatus(apiConf, client.CardinalityReader()))
+	router.Get("/api/v1/status/tsdb", tsdbStatusHandler)
+
 	healthChecker := func() error { return client.HealthCheck() }
 	router.Get("/healthz", Health(healthChecker))
 
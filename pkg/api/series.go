@@ -6,8 +6,10 @@ package api
 
 import (
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/NYTimes/gziphandler"
@@ -17,10 +19,14 @@ import (
 	"github.com/prometheus/prometheus/promql/parser"
 	"github.com/prometheus/prometheus/storage"
 	"github.com/timescale/promscale/pkg/log"
+	pgmodelErrs "github.com/timescale/promscale/pkg/pgmodel/common/errors"
 	"github.com/timescale/promscale/pkg/pgmodel/model"
+	"github.com/timescale/promscale/pkg/pgmodel/querier"
 	"github.com/timescale/promscale/pkg/promql"
 )
 
+const seriesTruncatedWarning = "results truncated due to limit, use nextToken to fetch the next page"
+
 func Series(conf *Config, queryable promql.Queryable) http.Handler {
 	seriesHandler := corsWrapper(conf, series(queryable))
 	return gziphandler.GzipHandler(seriesHandler)
@@ -66,6 +72,12 @@ func series(queryable promql.Queryable) http.HandlerFunc {
 			}
 			matcherSets = append(matcherSets, matchers)
 		}
+		limit, err := parseSeriesLimit(r)
+		if err != nil {
+			respondError(w, http.StatusBadRequest, err, "bad_data")
+			return
+		}
+		token := r.FormValue("next_token")
 		ctx := r.Context()
 
 		q, err := queryable.Querier(ctx, timestamp.FromTime(start), timestamp.FromTime(end))
@@ -73,10 +85,36 @@ func series(queryable promql.Queryable) http.HandlerFunc {
 			respondError(w, http.StatusUnprocessableEntity, err, "execution")
 			return
 		}
+		defer q.Close()
+
+		if limit > 0 || token != "" {
+			selector, ok := q.(seriesSelector)
+			if !ok {
+				respondError(w, http.StatusBadRequest, errors.New("series pagination is not supported"), "bad_data")
+				return
+			}
+			page, err := selector.SelectSeries(limit, token, matcherSets...)
+			if err != nil {
+				if errors.Is(err, pgmodelErrs.ErrInvalidSeriesToken) || errors.Is(err, pgmodelErrs.ErrViewNotSelectable) {
+					respondError(w, http.StatusBadRequest, err, "bad_data")
+					return
+				}
+				if isReadQueueError(err) {
+					respondError(w, http.StatusServiceUnavailable, err, "unavailable")
+					return
+				}
+				respondError(w, http.StatusUnprocessableEntity, err, "execution")
+				return
+			}
+			respondSeriesPage(w, page)
+			return
+		}
+
 		var sets []storage.SeriesSet
 		var warnings storage.Warnings
 		for _, mset := range matcherSets {
-			s, _ := q.Select(false, nil, nil, mset...)
+			// The merged series sets have to be sorted.
+			s, _ := q.Select(true, nil, nil, mset...)
 			warnings = append(warnings, s.Warnings()...)
 			if s.Err() != nil {
 				respondError(w, http.StatusUnprocessableEntity, s.Err(), "execution")
@@ -102,6 +140,49 @@ func series(queryable promql.Queryable) http.HandlerFunc {
 		}, warnings)
 	}
 }
+
+// seriesSelector is implemented by queriers supporting paginated series
+// queries.
+type seriesSelector interface {
+	SelectSeries(limit int, token string, matcherSets ...[]*labels.Matcher) (*querier.SeriesPage, error)
+}
+
+func parseSeriesLimit(r *http.Request) (int, error) {
+	val := r.FormValue("limit")
+	if val == "" {
+		return 0, nil
+	}
+	limit, err := strconv.Atoi(val)
+	if err != nil || limit < 0 {
+		return 0, fmt.Errorf("cannot parse %q to a non-negative limit", val)
+	}
+	return limit, nil
+}
+
+type seriesPageResponse struct {
+	Status    string          `json:"status"`
+	Data      []labels.Labels `json:"data"`
+	Warnings  []string        `json:"warnings,omitempty"`
+	NextToken string          `json:"nextToken,omitempty"`
+}
+
+func respondSeriesPage(w http.ResponseWriter, page *querier.SeriesPage) {
+	var warnings storage.Warnings
+	if page.NextToken != "" {
+		warnings = append(warnings, errors.New(seriesTruncatedWarning))
+	}
+	setResponseHeaders(w, &promql.Result{Value: seriesType(page.Series)}, warnings)
+	resp := &seriesPageResponse{
+		Status:    "success",
+		Data:      page.Series,
+		NextToken: page.NextToken,
+	}
+	for _, warn := range warnings {
+		resp.Warnings = append(resp.Warnings, warn.Error())
+	}
+	_ = json.NewEncoder(w).Encode(resp)
+}
+
 func respondSeries(w http.ResponseWriter, res *promql.Result, warnings storage.Warnings) {
 	setResponseHeaders(w, res, warnings)
 	resp := &response{
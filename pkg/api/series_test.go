@@ -11,10 +11,14 @@ import (
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"reflect"
 	"strings"
 	"testing"
 
+	"github.com/prometheus/prometheus/pkg/labels"
 	"github.com/timescale/promscale/pkg/log"
+	pgmodelErrs "github.com/timescale/promscale/pkg/pgmodel/common/errors"
+	"github.com/timescale/promscale/pkg/pgmodel/querier"
 	"github.com/timescale/promscale/pkg/query"
 )
 
@@ -29,8 +33,10 @@ func TestSeries(t *testing.T) {
 		matchers    []string
 		start       string
 		end         string
+		params      string
 		expectCode  int
 		expectError string
+		expectPage  *seriesPageResponse
 	}{
 		{
 			name:        "match[] is not sent",
@@ -86,12 +92,70 @@ func TestSeries(t *testing.T) {
 			expectCode: http.StatusOK,
 			matchers:   []string{"m", `m{a="1"}`},
 			querier:    &mockQuerier{},
+		}, {
+			name:        "Limit is unparsable",
+			start:       "1",
+			end:         "2",
+			params:      "&limit=-1",
+			expectCode:  http.StatusBadRequest,
+			expectError: "bad_data",
+			matchers:    []string{"m"},
+			querier:     &mockQuerier{},
+		}, {
+			name:        "Invalid continuation token",
+			start:       "1",
+			end:         "2",
+			params:      "&next_token=abc",
+			expectCode:  http.StatusBadRequest,
+			expectError: "bad_data",
+			matchers:    []string{"m"},
+			querier:     &mockQuerier{selectErr: pgmodelErrs.ErrInvalidSeriesToken},
+		}, {
+			name:        "Paginated select error",
+			start:       "1",
+			end:         "2",
+			params:      "&limit=1",
+			expectCode:  http.StatusUnprocessableEntity,
+			expectError: "execution",
+			matchers:    []string{"m"},
+			querier:     &mockQuerier{selectErr: fmt.Errorf("some error")},
+		}, {
+			name:       "Truncated page",
+			start:      "1",
+			end:        "2",
+			params:     "&limit=1",
+			expectCode: http.StatusOK,
+			matchers:   []string{"m"},
+			querier: &mockQuerier{seriesPage: querier.SeriesPage{
+				Series:    []labels.Labels{labels.FromStrings("__name__", "m", "a", "1")},
+				NextToken: "5",
+			}},
+			expectPage: &seriesPageResponse{
+				Status:    "success",
+				Data:      []labels.Labels{labels.FromStrings("__name__", "m", "a", "1")},
+				Warnings:  []string{seriesTruncatedWarning},
+				NextToken: "5",
+			},
+		}, {
+			name:       "Last page",
+			start:      "1",
+			end:        "2",
+			params:     "&limit=1&next_token=5",
+			expectCode: http.StatusOK,
+			matchers:   []string{"m"},
+			querier: &mockQuerier{seriesPage: querier.SeriesPage{
+				Series: []labels.Labels{labels.FromStrings("__name__", "m", "a", "2")},
+			}},
+			expectPage: &seriesPageResponse{
+				Status: "success",
+				Data:   []labels.Labels{labels.FromStrings("__name__", "m", "a", "2")},
+			},
 		},
 	}
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
-			handler := series(query.NewQueryable(tc.querier, nil))
-			queryUrl := constructSeriesRequest(tc.start, tc.end, tc.matchers)
+			handler := series(query.NewQueryable(tc.querier))
+			queryUrl := constructSeriesRequest(tc.start, tc.end, tc.matchers) + tc.params
 			w := doSeriesRequest(t, handler, queryUrl)
 
 			if w.Code != tc.expectCode {
@@ -105,6 +169,15 @@ func TestSeries(t *testing.T) {
 					t.Errorf("expected error of type %s, got %s", tc.expectError, er.ErrorType)
 				}
 			}
+			if tc.expectPage != nil {
+				var page seriesPageResponse
+				if err := json.NewDecoder(bytes.NewReader(w.Body.Bytes())).Decode(&page); err != nil {
+					t.Fatalf("unexpected error decoding response: %v", err)
+				}
+				if !reflect.DeepEqual(page, *tc.expectPage) {
+					t.Errorf("unexpected response: got %+v, wanted %+v", page, *tc.expectPage)
+				}
+			}
 		})
 
 	}
@@ -0,0 +1,89 @@
+// This file and its contents are licensed under the Apache License 2.0.
+// Please see the included NOTICE for copyright information and
+// LICENSE for a copy of the license.
+
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/NYTimes/gziphandler"
+	"github.com/timescale/promscale/pkg/pgmodel/cardinality"
+)
+
+const defaultTSDBStatusLimit = 10
+
+// tsdbStatus has the same shape as the Prometheus TSDB status, so existing
+// dashboards can be pointed at the connector.
+type tsdbStatus struct {
+	HeadStats                   headStats `json:"headStats"`
+	SeriesCountByMetricName     []stat    `json:"seriesCountByMetricName"`
+	LabelValueCountByLabelName  []stat    `json:"labelValueCountByLabelName"`
+	MemoryInBytesByLabelName    []stat    `json:"memoryInBytesByLabelName"`
+	SeriesCountByLabelValuePair []stat    `json:"seriesCountByLabelValuePair"`
+}
+
+type headStats struct {
+	NumSeries     int64 `json:"numSeries"`
+	NumLabelPairs int64 `json:"numLabelPairs"`
+}
+
+type stat struct {
+	Name  string `json:"name"`
+	Value int64  `json:"value"`
+}
+
+func TSDBStatus(conf *Config, reader cardinality.Reader) http.Handler {
+	hf := corsWrapper(conf, tsdbStatusHandler(reader))
+	return gziphandler.GzipHandler(hf)
+}
+
+func tsdbStatusHandler(reader cardinality.Reader) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		limit := defaultTSDBStatusLimit
+		if val := r.FormValue("limit"); val != "" {
+			var err error
+			limit, err = strconv.Atoi(val)
+			if err != nil || limit <= 0 {
+				respondError(w, http.StatusBadRequest, fmt.Errorf("limit must be a positive number, got %q", val), "bad_data")
+				return
+			}
+		}
+
+		stats, err := reader.Stats(limit)
+		if err != nil {
+			if isReadQueueError(err) {
+				respondError(w, http.StatusServiceUnavailable, err, "unavailable")
+				return
+			}
+			respondError(w, http.StatusInternalServerError, err, "internal")
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(&response{
+			Status: "success",
+			Data: tsdbStatus{
+				HeadStats: headStats{
+					NumSeries:     stats.NumSeries,
+					NumLabelPairs: stats.NumLabelPairs,
+				},
+				SeriesCountByMetricName:     toStats(stats.SeriesCountByMetricName),
+				LabelValueCountByLabelName:  toStats(stats.LabelValueCountByLabelName),
+				MemoryInBytesByLabelName:    toStats(stats.MemoryInBytesByLabelName),
+				SeriesCountByLabelValuePair: toStats(stats.SeriesCountByLabelValuePair),
+			},
+		})
+	}
+}
+
+func toStats(in []cardinality.Stat) []stat {
+	out := make([]stat, len(in))
+	for i, s := range in {
+		out[i] = stat{Name: s.Name, Value: s.Value}
+	}
+	return out
+}
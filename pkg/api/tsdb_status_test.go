@@ -0,0 +1,103 @@
+// This file and its contents are licensed under the Apache License 2.0.
+// Please see the included NOTICE for copyright information and
+// LICENSE for a copy of the license.
+
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/timescale/promscale/pkg/pgmodel/cardinality"
+)
+
+type mockCardinalityReader struct {
+	stats *cardinality.Stats
+	err   error
+	limit int
+}
+
+func (m *mockCardinalityReader) Stats(limit int) (*cardinality.Stats, error) {
+	m.limit = limit
+	return m.stats, m.err
+}
+
+func TestTSDBStatus(t *testing.T) {
+	testCases := []struct {
+		name        string
+		params      string
+		reader      *mockCardinalityReader
+		expectCode  int
+		expectLimit int
+		expectData  string
+	}{
+		{
+			name:       "invalid limit",
+			params:     "?limit=0",
+			reader:     &mockCardinalityReader{},
+			expectCode: http.StatusBadRequest,
+		},
+		{
+			name:        "reader error",
+			reader:      &mockCardinalityReader{err: fmt.Errorf("some error")},
+			expectCode:  http.StatusInternalServerError,
+			expectLimit: defaultTSDBStatusLimit,
+		},
+		{
+			name:   "all good",
+			params: "?limit=1",
+			reader: &mockCardinalityReader{stats: &cardinality.Stats{
+				NumSeries:                   3,
+				NumLabelPairs:               4,
+				SeriesCountByMetricName:     []cardinality.Stat{{Name: "up", Value: 3}},
+				LabelValueCountByLabelName:  []cardinality.Stat{{Name: "instance", Value: 2}},
+				SeriesCountByLabelValuePair: []cardinality.Stat{{Name: "job=node", Value: 3}},
+			}},
+			expectCode:  http.StatusOK,
+			expectLimit: 1,
+			expectData: `{
+				"headStats": {"numSeries": 3, "numLabelPairs": 4},
+				"seriesCountByMetricName": [{"name": "up", "value": 3}],
+				"labelValueCountByLabelName": [{"name": "instance", "value": 2}],
+				"memoryInBytesByLabelName": [],
+				"seriesCountByLabelValuePair": [{"name": "job=node", "value": 3}]
+			}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest("GET", "http://localhost:9090/api/v1/status/tsdb"+tc.params, nil)
+			w := httptest.NewRecorder()
+			tsdbStatusHandler(tc.reader).ServeHTTP(w, req)
+
+			if w.Code != tc.expectCode {
+				t.Fatalf("unexpected HTTP status code: got %d wanted %d", w.Code, tc.expectCode)
+			}
+			if tc.reader.limit != tc.expectLimit {
+				t.Errorf("unexpected limit: got %d wanted %d", tc.reader.limit, tc.expectLimit)
+			}
+			if tc.expectData == "" {
+				return
+			}
+
+			var (
+				res      response
+				expected interface{}
+			)
+			if err := json.Unmarshal(w.Body.Bytes(), &res); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if err := json.Unmarshal([]byte(tc.expectData), &expected); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(res.Data, expected) {
+				t.Errorf("unexpected data:\ngot\n%v\nwanted\n%v", res.Data, expected)
+			}
+		})
+	}
+}
@@ -7,10 +7,12 @@ package api
 import (
 	"bytes"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"io/ioutil"
 	"net/http"
+	"strconv"
 	"strings"
 	"sync/atomic"
 	"time"
@@ -20,11 +22,16 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 	io_prometheus_client "github.com/prometheus/client_model/go"
 	"github.com/timescale/promscale/pkg/log"
+	pgmodelErrs "github.com/timescale/promscale/pkg/pgmodel/common/errors"
 	"github.com/timescale/promscale/pkg/pgmodel/ingestor"
 	"github.com/timescale/promscale/pkg/prompb"
 	"github.com/timescale/promscale/pkg/util"
 )
 
+// writeRetryAfter is how long clients are asked to wait before retrying write
+// requests rejected because the ingestion is saturated.
+const writeRetryAfter = 5 * time.Second
+
 func Write(writer ingestor.DBInserter, elector *util.Elector, metrics *Metrics) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 
@@ -77,9 +84,21 @@ func Write(writer ingestor.DBInserter, elector *util.Elector, metrics *Metrics)
 		begin := time.Now()
 
 		numSamples, err := writer.Ingest(timeseries, req)
+		if isIngestSaturatedError(err) {
+			log.DebugRateLimited("msg", "Write request rejected, the ingestion is saturated", "err", err)
+			w.Header().Set("Retry-After", strconv.Itoa(int(writeRetryAfter.Seconds())))
+			http.Error(w, err.Error(), http.StatusTooManyRequests)
+			return
+		}
 		if err != nil {
 			log.Warn("msg", "Error sending samples to remote storage", "err", err, "num_samples", numSamples)
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+			// Non-retryable errors are reported as client errors, so that
+			// Prometheus drops the write request instead of retrying it.
+			status := http.StatusInternalServerError
+			if _, ok := pgmodelErrs.NonRetryableReason(err); ok {
+				status = http.StatusBadRequest
+			}
+			http.Error(w, err.Error(), status)
 			metrics.FailedSamples.Add(float64(receivedBatchCount - numSamples))
 			return
 		}
@@ -100,6 +119,12 @@ func Write(writer ingestor.DBInserter, elector *util.Elector, metrics *Metrics)
 	})
 }
 
+// isIngestSaturatedError returns true if the write request was rejected
+// because too many samples are being ingested, or the spool is full.
+func isIngestSaturatedError(err error) bool {
+	return errors.Is(err, pgmodelErrs.ErrIngestBudgetExceeded) || errors.Is(err, pgmodelErrs.ErrSpoolFull)
+}
+
 func loadWriteRequest(r *http.Request) (*prompb.WriteRequest, error, string) {
 	var req *prompb.WriteRequest
 
@@ -24,6 +24,7 @@ import (
 	io_prometheus_client "github.com/prometheus/client_model/go"
 	"github.com/stretchr/testify/require"
 	"github.com/timescale/promscale/pkg/log"
+	pgmodelErrs "github.com/timescale/promscale/pkg/pgmodel/common/errors"
 
 	"github.com/timescale/promscale/pkg/prompb"
 	"github.com/timescale/promscale/pkg/util"
@@ -388,6 +389,7 @@ func TestWrite(t *testing.T) {
 		isLeader         bool
 		electionErr      error
 		customHeaders    map[string]string
+		retryAfter       string
 	}{
 		{
 			name:         "write request body error",
@@ -428,6 +430,47 @@ func TestWrite(t *testing.T) {
 				},
 			),
 		},
+		{
+			name:         "ingestion saturated",
+			isLeader:     true,
+			responseCode: http.StatusTooManyRequests,
+			retryAfter:   "5",
+			inserterErr:  fmt.Errorf("%w (limit: 1 samples)", pgmodelErrs.ErrIngestBudgetExceeded),
+			requestBody: writeRequestToString(
+				&prompb.WriteRequest{
+					Timeseries: []prompb.TimeSeries{
+						{},
+					},
+				},
+			),
+		},
+		{
+			name:         "spool full",
+			isLeader:     true,
+			responseCode: http.StatusTooManyRequests,
+			retryAfter:   "5",
+			inserterErr:  pgmodelErrs.ErrSpoolFull,
+			requestBody: writeRequestToString(
+				&prompb.WriteRequest{
+					Timeseries: []prompb.TimeSeries{
+						{},
+					},
+				},
+			),
+		},
+		{
+			name:         "non-retryable write error",
+			isLeader:     true,
+			responseCode: http.StatusBadRequest,
+			inserterErr:  pgmodelErrs.NewNonRetryableError(pgmodelErrs.ReasonNoMetricName, pgmodelErrs.ErrNoMetricName),
+			requestBody: writeRequestToString(
+				&prompb.WriteRequest{
+					Timeseries: []prompb.TimeSeries{
+						{},
+					},
+				},
+			),
+		},
 		{
 			name:         "elector error",
 			electionErr:  fmt.Errorf("some error"),
@@ -560,6 +603,9 @@ func TestWrite(t *testing.T) {
 			if w.Code != c.responseCode {
 				t.Errorf("Unexpected HTTP status code received: got %d wanted %d", w.Code, c.responseCode)
 			}
+			if retryAfter := w.Header().Get("Retry-After"); retryAfter != c.retryAfter {
+				t.Errorf("Unexpected Retry-After header: got %q wanted %q", retryAfter, c.retryAfter)
+			}
 
 			if c.electionErr != nil && leaderGauge.value != 0 {
 				t.Errorf("leader gauge metric not set correctly: got %f when election returns an error", leaderGauge.value)
@@ -11,6 +11,7 @@ import (
 	"github.com/timescale/promscale/pkg/pgmodel/cache"
 	"github.com/timescale/promscale/pkg/pgmodel/common/errors"
 	"github.com/timescale/promscale/pkg/pgmodel/model"
+	"github.com/timescale/promscale/pkg/pgmodel/relabel"
 	"github.com/timescale/promscale/pkg/prompb"
 )
 
@@ -20,12 +21,16 @@ const ClusterNameLabel = "cluster"
 type haParser struct {
 	service *Service
 	scache  cache.SeriesCache
+	relabel *relabel.Rules
 }
 
-func NewHAParser(service *Service, scache cache.SeriesCache) *haParser {
+// NewHAParser returns a parser of the samples of the leader replicas, applying
+// the relabeling rules, if any, to the series.
+func NewHAParser(service *Service, scache cache.SeriesCache, relabelRules *relabel.Rules) *haParser {
 	return &haParser{
 		service: service,
 		scache:  scache,
+		relabel: relabelRules,
 	}
 }
 
@@ -41,7 +46,7 @@ func (h *haParser) ParseData(tts []prompb.TimeSeries) (map[string][]model.Sample
 	clusterName, replicaName := haLabels(tts[0].Labels)
 
 	if err := validateClusterLabels(clusterName, replicaName); err != nil {
-		return nil, 0, err
+		return nil, 0, errors.NewNonRetryableError(errors.ReasonHAValidation, err)
 	}
 
 	// find samples time range
@@ -82,6 +87,10 @@ func (h *haParser) ParseData(tts []prompb.TimeSeries) (map[string][]model.Sample
 			}
 		}
 
+		if !h.relabel.Process(t) {
+			continue
+		}
+
 		// Normalize and canonicalize t.Labels.
 		// After this point t.Labels should never be used again.
 		seriesLabels, metricName, err := h.scache.GetSeriesFromProtos(t.Labels)
@@ -89,7 +98,7 @@ func (h *haParser) ParseData(tts []prompb.TimeSeries) (map[string][]model.Sample
 			return nil, 0, err
 		}
 		if metricName == "" {
-			return nil, 0, errors.ErrNoMetricName
+			return nil, 0, errors.NewNonRetryableError(errors.ReasonNoMetricName, errors.ErrNoMetricName)
 		}
 
 		sample := model.NewPromSample(seriesLabels, t.Samples)
@@ -28,23 +28,44 @@ var MigrationFiles = func() http.FileSystem {
 		"/idempotent/base.sql": &vfsgen۰CompressedFileInfo{
 			name:             "base.sql",
 			modTime:          time.Time{},
-			uncompressedSize: 86078,
+			uncompressedSize: 98679,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xec\xbd\xfb\x77\xe2\x46\xd6\x28\xfa\x3b\x7f\x45\x9d\xb9\xee\x03\x64\x80\xb4\x3b\xf3\x3a\x76\xe8\x75\x89\x4d\x77\x38\xe3\x86\xfe\x00\xe7\xf1\xe5\x66\x71\x0a\xa9\x00\xc5\x42\x22\xaa\x92\x31\x73\xe7\xfe\xef\x77\xd5\xae\x87\xaa\xa4\x92\x10\xd8\x4e\xe6\x3b\x67\x7a\xad\x99\x74\xa3\x7a\xee\xda\xb5\x5f\xb5\x1f\xdd\xee\x78\x32\x1f\xce\x1a\xdd\xee\x7c\x13\x50\xe4\xc5\x3e\x41\x98\xd2\x74\x4b\x28\x62\x1b\xcc\x10\xc3\xcb\x90\xa0\x08\xf3\x1f\x3c\x1c\xa1\x38\x0a\x0f\x68\x49\xd0\x5f\xbe\x42\xde\x06\x27\x14\x85\x71\xb4\x6e\x34\x1a\x37\xd3\xe1\x60\x3e\x44\x93\x29\x9a\x0e\x3f\xdf\x0d\x6e\x86\xe8\xc3\xfd\xf8\x66\x3e\x9a\x8c\xd1\xec\xe6\xdb\xe1\xa7\xc1\xe2\x66\x30\x1f\xdc\x4d\x3e\xf6\xd6\x84\x2d\x7c\xb2\xc2\x69\xc8\x16\xde\x26\x8d\x1e\x16\x41\xc4\x48\xf2\x88\xc3\x56\xbb\x81\x10\x42\xd3\xe1\xfc\x7e\x3a\x9e\xa1\xd1\x78\x3e\x9c\x7e\x37\xb8\x6b\x0c\x66\xe8\x62\x95\x46\xde\x05\x7c\x9e\x0d\xef\x86\x37\x73\xf4\x88\xc3\x94\x5c\x5d\xa9\x46\xe8\xc3\x74\xf2\x29\x3f\x95\x9c\x06\x7d\xff\xed\x70\x3a\x44\x0f\xe4\xd0\x6f\xda\x33\x36\xaf\x1b\x72\xe4\xbb\xc1\xf8\xe3\xfd\xe0\xe3\x10\xcd\xfe\xe3\x0e\xcd\xe6\x83\x6f\xee\x86\xe8\xf3\x60\x3a\xb8\xbb\x1b\xde\xa1\xd9\xe0\xc3\xf0\xba\xf1\x71\x3a\x18\xcf\xd1\xf0\x87\xe1\xcd\x3d\xdf\xe9\xf8\xac\x1d\xa2\xf9\x04\xed\x92\x78\xbb\x48\x08\xf6\x49\x72\x7d\x2a\xe4\x58\xb0\x25\xd4\xc3\x21\x59\x6c\xf1\x2f\x71\xb2\x78\x24\x09\x0d\xe2\xa8\x08\x3a\x37\xd4\xe8\x2e\x0c\xd8\x62\x87\x13\xd6\x22\x4f\x4c\x76\xee\xa0\x66\xaf\xd9\x41\x97\x6d\x00\xa7\x80\xe4\x6e\xbd\xf0\x30\xc3\x61\xbc\xee\xed\xd6\x0b\xf2\xc4\x48\xc4\x9b\x4a\x50\x92\x27\xc6\x51\xa2\xdf\xd4\xcb\xf1\x97\x4d\x74\x37\xfa\x34\x9a\xa3\xcb\x57\x83\x69\xe9\xde\x9f\x0b\x54\x75\x58\x09\x61\x24\x62\x41\x1c\x2d\x76\x24\x09\x62\xff\xb7\x40\xc8\xfc\x9c\xaf\x8f\x92\xc5\x5d\x3e\x07\x7e\x01\x5d\x18\x48\xb0\x08\x22\xca\x70\x18\x92\x3c\xec\xbe\x99\x4c\xee\x86\x83\xb1\x1b\x74\x5e\x9c\x46\xac\xf5\x45\x1b\xbd\x47\x6f\x35\xfa\xd5\xc2\xb9\x2a\x60\x9d\x00\x9e\xf2\x4d\x3c\x13\x34\xdb\x34\x64\x41\x14\xfb\xe4\x28\x38\x6e\x87\x37\x77\x83\xe9\x10\x5a\x05\x74\xe1\x07\x94\x25\xc1\x32\x65\xc4\x57\x8d\x51\x1f\xad\x70\x48\xc9\x75\xe3\x9b\xe1\xc7\xd1\x18\x5a\x8e\x3e\x9c\x76\x51\xde\xf7\xd1\x3b\x34\xff\x76\x28\x7a\x57\x1e\x81\x0d\x90\x55\x9c\x6c\x31\x47\x9a\x9e\x8f\x19\x5e\xf0\x2d\x51\x3d\x06\xac\x64\x3c\x9f\xe4\x16\x7e\x0d\x0d\x86\xe3\x5b\x34\xfa\x70\x6d\x6c\xbf\xd0\x6c\xf8\xc3\xcd\xf0\x33\x40\xf0\xfb\x6f\x87\x63\x7e\x84\xb3\x39\x87\x71\xf3\x4f\xef\x3e\xbf\xbd\x6c\xc2\x82\x51\xb7\x8b\xe6\x6a\x49\xe8\xb2\xf7\xd4\x41\x11\x79\x24\x09\x32\x46\x32\xe7\x90\xa0\x1a\x8e\x6f\x0b\x28\xf2\xf9\xee\xf3\xc7\x73\xd1\xc4\x38\xd0\x97\xa2\x3a\x5e\xbc\xdd\x25\x84\xf2\x13\x5a\x50\xc2\x58\x10\xad\x4f\xb9\x3c\x92\xee\x28\x24\xa9\x49\x76\xb6\x84\x25\x81\x67\xce\xfd\x1b\xf0\x42\xd7\x46\x8b\x50\xec\x76\x07\xbe\x8f\x2e\xdf\xa0\x78\x85\x12\x1c\xf9\xf1\x36\x22\x94\x22\x16\x23\xb6\x21\x48\xb1\x52\x44\x63\x21\xa1\x00\x87\xa5\x08\x27\x04\x45\x31\x43\x38\x0c\xd6\x11\xf1\x5d\x9f\x29\xc3\xeb\x35\x49\x88\x8f\x56\x71\x82\x8c\xd5\xa0\x5f\xe2\x25\xed\x9d\x78\x7c\x7a\xb4\x3c\x8f\xb7\xff\xa9\xb9\x46\xbb\x51\x8f\x8f\xe4\xba\x7f\x81\x5a\x97\xbd\xb7\x7f\x6c\xb5\x04\x28\x5a\xed\x2f\xde\xf6\xde\x5e\xb6\xbb\x6f\x7b\x6f\xdf\xfe\xb9\xdd\x76\x1f\xda\x77\x93\xbb\xc1\x7c\xc4\x71\xfb\x84\x4d\x85\xb1\xf7\xb0\x90\x78\xb1\x8a\x93\xc5\x16\xf3\x45\x44\x38\xf2\x48\x4b\xfe\x1c\xf8\x1c\xfe\x1d\xb4\xc7\x01\x43\xcb\x38\x0e\x09\x8e\x50\x1f\xb1\x24\x25\x75\xe9\x9b\x45\xbb\xc6\x93\xb9\x18\xcb\x22\x49\x9f\x87\xd3\x0f\x93\xe9\x27\xb4\xed\x7d\xa1\x7f\x73\xa1\xb5\x58\x14\xda\xea\x46\x02\xbf\xb7\xbd\xc0\x47\x7d\xa4\x97\x9c\x8d\x31\x99\xa2\xf1\x04\xfd\x7d\xf8\x23\xba\xff\x7c\xcb\xa1\x32\xfb\xfb\xe8\x33\xba\x9b\xdc\xfc\x7d\x78\x7b\xdd\xd0\xed\x24\x05\xf9\x30\xb9\x1f\xdf\x4a\x1a\x76\x37\x1b\xfe\xf6\xcb\xab\x5e\x92\x24\xab\x55\x04\x2e\x43\x83\xda\xf7\xb5\x0a\x09\xe0\xe8\xe5\xa9\x67\xf7\x76\x9f\x04\x8c\xdf\xdb\x6e\xf7\x06\x47\x71\x14\x78\x38\x44\x7c\x14\x14\x27\x3e\x49\x82\x68\x7d\xd5\xe8\x76\xc5\x88\xb4\xd1\xed\x72\xf6\x21\xb4\x8a\x46\xb7\x1b\xe2\x25\x09\xf9\xaf\x94\x24\x01\xa1\x68\x87\x13\x12\x31\xeb\xdf\x2c\xe0\x5c\x87\x53\x05\x2f\x8e\x28\x4b\xf8\x7a\x28\x1f\xb2\x8b\xe6\x1b\x22\x96\x20\x21\xfd\x18\x90\x3d\x62\xf8\x81\x50\x58\x00\x45\x41\x04\x24\x03\x16\x72\x85\xb2\x99\x3b\x28\x3f\x7e\xaf\xd1\x50\x3a\xd0\x2e\x89\x3d\xe2\xa7\x09\x41\xab\x20\xc2\x61\xf0\x0f\x50\x85\x08\xf2\x12\x02\x0c\x90\x93\x25\x2c\x8f\xaf\x07\x6b\x58\x05\x09\x65\x30\x16\x8a\x57\x7a\xb3\x59\x87\x0d\xde\xed\x48\x04\xcb\xd9\xe2\x07\xa2\xc0\x2b\x54\x2b\x1c\xf9\x30\x3c\x4c\x26\x06\x51\xed\x37\x24\x21\xbd\x46\xb7\xfb\x3d\x11\x72\x3b\xca\x0f\x1c\x44\x9c\x28\xee\x63\xe8\x06\x14\x72\x1b\x44\xc1\x36\xf8\x07\x41\x21\x66\x24\xf2\x0e\xc8\x4f\xf9\x11\xa0\x20\xa2\x24\x01\x40\x76\xbb\xad\xfd\x26\xf0\x36\xe6\xaa\xf8\xfc\xc5\x95\xed\x30\xdb\xb4\x7b\x68\x48\x77\xc4\x0b\x70\x18\x1e\x38\x7d\x25\xfb\x38\x61\x9b\x03\x0a\x84\x7e\xd8\xe8\x76\x31\x63\xd8\xdb\xf0\x49\xf8\x30\x1a\xa2\x8a\x5e\x4b\x48\x8b\x21\xcd\x9d\xa1\x25\xf1\x70\x4a\x09\x0a\x18\x4a\xc8\xaf\x69\x90\x10\x8e\x09\x38\x42\xe4\xc9\x0b\x53\x1a\x3c\x12\x38\xc6\x0e\x12\xeb\x0d\x28\xc2\x68\x13\xac\x37\x5d\xb5\xb7\x78\x47\x12\x21\x93\xc0\x31\xc4\x6c\x43\x12\x84\x3d\xfe\x0b\x5f\x5d\xc0\x87\xe3\x37\x03\x96\xe3\xc7\xc4\x60\x12\x14\x79\x49\xc0\x04\xae\x8a\xd1\xba\xfb\x80\x12\xb4\x4c\x19\x34\xc2\x21\x8d\xa1\x65\x44\x3c\x42\x29\x4e\x0e\x8d\x6e\x97\xc5\x68\x47\x12\x2e\x09\x71\xa0\x01\x56\xf1\x5d\x0a\xd8\x0a\xf4\x12\xa7\x99\x8a\x99\x76\x29\xd3\x67\xd8\xe8\x76\xc7\x31\x23\x57\x82\x29\x61\xc4\x91\x99\xfc\x9a\x92\xc8\x23\x1c\xa1\xf8\x6a\x91\x4f\x68\xb0\x8e\x14\x68\x4d\xe8\x65\x50\xe5\x50\x00\x80\x13\x5f\xac\xc8\x6e\x45\x22\x86\xf0\x8a\x91\x44\x1c\x6b\x40\x11\x65\x64\xc7\xe1\xc3\xd7\xa4\x10\x68\x1b\xac\x37\x0c\xb6\xb7\xe4\x9d\x09\xc7\x24\x44\xe3\x2d\xbf\x92\x5e\x12\x53\xaa\x50\xf8\xd7\x54\x8c\x9c\x40\x07\xbc\xc7\x07\x3e\x54\x4c\x89\xfe\xc2\xa7\x6c\x32\xce\x4c\xb7\x1c\xd3\xe3\x3d\xc8\x64\x0a\xa9\x7d\x12\x62\x0e\xb9\x80\xa3\x19\xdf\x5c\xb0\x0a\x3c\x1c\x31\x3e\xdf\x2e\xe1\x47\xe5\x29\xe8\xf0\xa3\xee\xca\x9b\x2a\x67\x97\x77\x15\x04\xce\xc2\xbd\x25\x9c\x18\x15\xc8\x44\x91\xdb\x7d\x9e\x4e\x6e\x86\xb7\xf7\xd3\x61\x9e\xd2\xa9\xdb\xad\x90\x5e\xdd\xaa\x56\x1b\xb8\x16\x27\x03\xb6\x54\x9e\xa0\xe9\xf0\x66\x32\x95\xf4\x17\x9a\x13\x5f\xd1\x43\x53\x28\xe7\x84\x3c\x41\xa3\x82\x8c\x5d\x87\x5d\xe4\x98\x05\x67\x90\x6a\x61\x20\x3f\x85\x44\xc9\xb9\xfc\xcf\x64\x7a\x3b\x9c\xa2\x6f\x7e\x44\x4a\x38\x80\x2f\x77\x93\xc9\xe7\x82\x7c\x5f\x3e\x08\x48\xee\x72\x3b\xcf\x60\x68\x49\x2f\xc7\xcb\x0a\x4c\x6c\xf4\x41\x43\xcd\xe2\xf7\xfc\x4f\xb7\x9b\x90\x90\x60\x4a\x50\x12\xef\xe1\xde\x5b\x9f\x6f\x26\x9f\x3e\x8d\xe6\xd7\xb9\xdf\xc6\xf3\xd1\xf8\x7e\x98\xfd\xaa\x78\xa2\x39\x63\x7d\x4d\x6f\x30\xbe\x3d\x43\x7a\xcd\x6f\x44\x49\x07\x72\xa4\xcf\xd3\xc9\xa7\x1e\x25\x76\xf7\x38\xb2\x28\x6d\x2b\xe9\xc1\x7f\x17\x5c\xbf\xed\xa0\xf9\xf4\x7e\xd8\xae\xd8\x54\xb7\xeb\xc7\xe2\x6e\x2f\xc9\x2a\x4e\x08\x67\x79\x9c\xfc\xda\x64\xd3\xe2\x06\xfb\x38\x79\x90\x74\x41\x36\xb6\x20\xac\xa4\x21\xe7\x71\xcf\x86\x2e\xec\x41\x7d\x58\xa7\x44\x01\x8d\x00\xd6\x32\xf7\x04\xed\x83\x30\x44\x11\x21\xbe\x58\x30\x2c\x8c\x0b\xdf\x65\x4c\x83\x4b\xed\xf8\x01\x78\x42\x14\xef\x8d\xb1\x58\x8c\xf0\x63\x1c\xf8\x62\x88\x74\xb7\x4e\xb0\x4f\x7a\x68\xc4\x0c\x4a\x5e\xd8\xb1\x1f\x47\x84\x73\x8f\x90\x08\x76\x90\x0d\x07\xa3\x70\x42\x8b\x1f\x48\xd4\xd3\x1f\xb8\x28\x88\x84\xc2\x33\x19\xdf\xfd\x98\x87\x88\x24\x37\xa3\x31\x1a\xdc\xdc\x0c\x67\x33\x34\xfc\xe1\xe6\xee\x7e\x36\xfa\x6e\x88\xb6\xb1\x4f\x8c\xcd\x2b\x49\x4b\xa8\xcd\xad\x8b\x0b\x13\x47\x06\x77\xf3\xe1\x54\x4e\xe3\x9e\x61\x30\x9f\x0f\x6e\xbe\xe5\x4a\xd7\x7c\x64\x4a\x69\xb7\x83\xf9\x60\x31\x1b\x4e\x47\xc3\x59\xef\xcd\xe5\xc5\x08\xee\xd9\x77\x83\xbb\xfb\x21\xd7\x2a\x50\xeb\xcd\xbb\x8b\xbb\xb6\x9e\xea\xe2\xa2\x83\x6c\xd4\xe2\x47\x64\xa0\x96\x79\xab\x38\x9a\x71\xc2\x01\x12\xe5\x75\x43\xd0\x3f\x94\x17\x29\xaf\x1b\xbc\xcf\x70\x3c\xe7\x32\xe4\x39\xa4\x75\x34\x43\xcd\x0f\x5a\xae\xca\x09\x34\x9c\xb9\x58\x12\x18\xdd\xc4\x69\xe8\x73\x2e\x95\xa4\x11\x5a\x1e\x84\x20\x16\x47\x11\xf1\x18\xc7\xa2\x94\xc5\x5b\x0c\x87\x1f\x1e\x9a\x0e\x29\xf7\x8c\x15\x16\xe4\x5a\x25\x17\x6a\x49\x22\xa0\xc8\x03\x9a\xc1\x17\x84\x11\x4b\x02\xae\x07\xa2\xfd\x86\x44\x08\xa3\x88\xec\xd5\xb6\x78\x43\x41\xef\x38\xa2\x82\x54\xcb\x28\x4a\x77\x42\xde\x12\x6d\x7e\x49\x29\x43\x24\x8a\xd3\xf5\x26\x2f\x4b\x80\x74\x17\xb0\x1e\xfa\x64\x43\x49\xf0\xd3\xec\x26\x06\x11\xaa\xd8\x0e\x5e\xc6\x8f\xa4\x87\x66\x84\x48\xe0\x6d\xb7\x24\x62\x5c\x34\xe2\x32\x1a\x97\x33\xf4\xc6\xf8\xc5\xe4\x6d\x12\x82\x69\x1c\xf1\xcb\x29\x7e\xe1\x52\x04\xc8\x9f\x42\x40\xb1\xc4\x19\x25\x3d\x51\x12\xd1\x80\x71\xe2\xa3\x86\xeb\xa1\x99\x38\x3d\x78\x32\xf0\xe2\x88\xe1\x20\xb2\xf6\x1b\xc6\xeb\xc0\x13\x52\x0c\x4d\x77\xbb\x38\x61\x72\xff\x54\x2f\x45\x8a\xd9\x39\xf9\xc0\x94\xe4\x85\x0a\xe1\x92\xe8\xeb\x6b\xbe\x05\xd9\x37\x67\x7f\x91\x47\x0c\xbf\xb9\x2c\x76\xb0\x06\xae\x1c\x8f\xc6\x73\x43\x10\xc8\x11\x81\xa6\x5c\x90\x75\xf1\xf9\x8d\xee\xbd\x19\xb5\x38\x53\x42\xf3\xd1\xa7\xe1\x6c\x3e\xf8\xf4\x79\xfe\x9f\xc0\xf9\xc7\xf7\x77\x77\x1d\x61\xe0\x41\xb7\x93\x7b\xb0\xc3\x4c\x87\x37\xa3\x19\xdf\x43\xd6\x40\x6c\x9d\xcf\xff\xcd\xe8\xe3\x68\x3c\xd7\x9f\xda\xe8\xfb\xd1\xfc\x5b\xd4\xe2\xf7\xe4\x11\x7b\x69\xba\x5d\xc8\xff\xb0\x4d\x42\xe8\x26\x0e\x39\xdd\xfe\xf3\xdb\xb7\x6f\xdf\x76\x90\xd1\x08\x47\x38\x3c\xfc\x83\x14\x5b\xb5\x9b\x1d\x8b\xd9\xa9\x3f\xe3\xe1\xf7\x06\x9d\x11\x14\xa6\x64\xf7\xf7\xe3\xd1\x7f\xdc\x0f\xd1\x68\x7c\x3b\xfc\x41\x88\x76\x7a\xf9\xc0\x99\x17\x6f\x28\xb2\x09\x5e\xef\xcd\x08\xb5\x74\xa3\x0e\x18\x26\xdb\x68\x34\xbe\xb9\xbb\xbf\x1d\xa2\x16\x80\xa7\x6a\x61\xbc\x4f\x61\x81\x8d\x93\xc5\x03\x8b\xd3\x3b\x7b\x5a\xb6\xc1\xa2\x80\x23\x2e\xcc\x5e\x98\xb0\xc0\x00\x0f\x4a\x95\x2f\x14\x8d\x8c\x07\x2e\x0f\xc6\x89\x82\xfe\x00\xea\x0d\x3c\xcb\xed\x24\x05\xca\x0d\x0d\xf7\x78\x4f\x9a\x61\x88\x36\xf8\x91\xa0\x2d\x17\x0b\xfe\xb0\x21\xf8\xf1\x20\xaf\x10\xfd\x03\xbf\xec\x11\x02\xc3\x6d\xa6\xa6\xe8\x59\xf9\x6d\xff\x32\x88\xfc\xe0\x31\xf0\x53\x1c\x7e\x99\x9b\x40\x0e\x82\xf6\x31\x97\xf6\xd7\xfc\x26\xa7\x14\x6d\x53\x6f\x03\x57\x55\x5d\x5b\x3e\xee\x5e\x91\x6c\x9f\xf7\xe1\xc4\x06\x87\xd0\x68\x8b\xa3\x83\xd2\x1b\x7a\x4e\x99\x49\x50\x4b\xd3\x36\xbc\xd8\x1c\x76\x24\x11\x77\xb2\x70\xc0\x0a\xb3\x6c\x5c\x69\x16\x4e\xbb\x88\x1a\xf0\x86\xe0\x40\x19\x61\x7b\x03\x34\x54\x06\xb8\xfe\xfb\x53\x6c\x7f\x27\xbc\x04\x3a\x96\xa5\xf6\x2f\x7b\x04\x91\x4f\x9e\x08\xed\xbf\x07\x5b\xb6\xd5\xda\x94\x0f\x4d\xdb\x94\x03\x9a\x06\x04\x6b\x03\xcc\x09\xa0\xdf\x19\x38\xf5\x21\xe5\x10\x9e\x0b\x82\xb4\x54\x8b\x1c\x4b\x8a\x93\x85\x1c\x5d\x91\xf5\x56\x73\x01\x70\x59\x2c\x24\xa8\x24\xab\x00\x58\x35\xb4\x0a\x35\x9b\x4f\x47\x37\x73\xcd\x0c\xc4\xa4\xdd\x6e\x14\x33\x22\x18\xad\x32\x78\x08\x96\xf5\xd3\xe5\xcf\x5c\x42\x48\xa3\xe0\xd7\x94\x20\x0c\x7a\x77\x76\x1f\xc5\x5d\x12\xc4\xb2\x25\x3a\xb4\x41\x87\xf6\x0d\x71\x59\x71\x3f\xb0\x36\xac\x53\x9c\xe0\x88\x71\x59\x7b\x1d\xc6\x4b\xa0\x2d\x62\xf0\x46\xb5\x44\x5a\xc6\x96\x2c\x41\xd3\xbe\x7d\x81\x8f\x96\xc1\x3a\x88\x58\xc6\x85\xac\xef\x96\xb9\xb8\xa4\x8d\x5c\xba\xa9\x27\x09\xd0\xe1\x24\xc1\x87\x92\x4e\x3e\xe1\x32\xcf\x82\xec\x62\x6f\xa3\xb9\xdd\xfd\xdd\x1d\xba\x1d\x7e\x18\xdc\xdf\xb9\xba\xdc\x7c\x3b\xbc\xf9\x7b\x2b\x83\x79\x1f\x71\x29\x19\xb4\xbd\xec\xc7\xd1\x2c\x63\x9a\xae\xee\xd9\x86\xfa\xe8\xcd\x57\x17\x85\x46\x93\xf1\x6c\x3e\x1d\xf0\xd5\x48\xd2\x2d\x86\xe6\x4c\xed\xcd\x57\x17\x34\x7f\x90\x9a\x79\x05\xfe\xd1\x91\x76\x0f\xe4\x20\x06\xf9\x3c\x1d\x7d\x1a\x4c\x7f\x44\x7f\x1f\xfe\xc8\x3b\xea\x7e\xf5\xd8\xfc\x65\x0d\x26\x7f\xf9\xf6\xad\x18\x96\xab\x0e\x36\x51\xe8\x68\xc4\xee\x48\xae\x2a\xb9\xa8\x34\x4d\x8f\x87\xdf\xbf\xb8\x31\xda\x21\x97\x15\xc5\xf3\xdb\xe9\xe4\x33\x9a\x4f\x47\x1f\x3f\x0e\xa7\x9c\x2f\x0f\x7f\x18\xcd\xe6\xb3\xa2\x3d\x73\xa1\x04\x75\xc7\x3c\x42\xc0\xbe\x19\xcc\x6e\x06\xb7\xc3\x6b\x25\x39\xaa\x41\x4b\x87\x12\x02\xe1\x07\xae\xcd\x8d\xc6\xb3\xe1\x74\x5e\x3a\xb6\xb6\x0b\x0d\xb9\x5e\x37\x9d\x7c\x6f\xdd\xc9\x52\x35\xc5\x01\x80\x6b\xb0\x54\xbb\xff\x34\xba\x5d\x34\xe2\x34\x34\xe2\x2c\x57\xca\xe1\x14\xc1\x87\x92\x1e\xbc\xcb\x94\xb0\x34\xe1\xba\x4b\xe6\xec\x83\x96\x69\x10\x32\xb4\x4a\xe2\x2d\xc2\x68\x95\x86\x21\x20\x01\x10\x25\x8c\x68\xba\x5a\x05\x4f\x5c\x2a\x17\xf6\xef\x94\x2b\xf9\xfc\x33\xd7\xa8\x93\x34\xf2\xc0\xc6\xa3\x5e\xe0\xc0\x42\x09\x3d\x90\x07\xb2\xc1\x2a\x00\x03\x20\xef\x06\x63\x40\x57\x0a\x5a\x7b\x10\x86\x08\x87\x7b\x7c\xa0\x5c\x82\x20\x4f\xd8\x63\xe1\x01\xfd\xe5\x9d\x70\x36\x3a\x45\xa6\xdf\xad\x05\xcd\xde\x07\x6c\xb3\x10\xd3\x67\x34\x2c\xdb\x10\x23\x4f\xac\xa3\x96\xc7\xff\x61\x4b\xfe\xbc\x8d\xfb\x99\xae\x45\xd3\x25\x17\x53\xa2\x75\x2b\x1b\x8d\x8b\x39\x7f\x79\xd7\x6d\xf1\xd5\x2e\x42\x12\xad\xd9\xa6\x25\xc6\x6e\xff\xf1\xb2\xdd\x46\xff\xfc\x27\x6a\x2e\x9a\xfc\x3f\xf2\xd7\xab\x2b\x98\xc1\xf5\x86\x37\xfa\xf4\xe9\xfe\x79\x6f\xaf\x2e\x10\x88\xfd\xc2\x46\x5d\x2f\xaf\x19\x2e\x70\x3d\x56\xf2\x26\xb1\x35\x81\x0a\x1a\x0b\x02\x5f\x9e\x3f\x9c\x39\x98\xf8\x63\xc4\xb9\x1b\x93\x18\x21\x20\xa2\xce\x19\x7d\x93\x32\x14\xac\xf8\x57\xde\x2d\x43\x19\x3f\x26\x94\xcb\x94\xab\x80\x75\xd0\x9a\x44\x24\xc1\x8c\x4b\xa8\xf9\x05\xc0\x6c\x63\xcd\x4b\x19\x3c\x21\x78\x38\x92\x56\x6c\x8c\xbc\x38\x0c\x03\x78\xcd\x5d\x12\xb6\x27\x04\xb4\xf1\x94\x92\x84\x77\xf4\xc9\x2a\x88\x88\x8f\x0c\x24\x86\xbf\x72\xd0\x68\x84\xd6\x0c\xda\xd5\x8b\xa2\x78\x85\xc4\x91\x72\x7c\x94\x48\xba\x26\x2c\xeb\x8e\x23\x9f\xf7\xf2\xe2\xe8\x91\x24\x94\x84\x87\x0e\xc2\x72\x9b\x34\x37\x13\x67\xd8\x7a\xb0\x1e\x40\xfe\x7b\x98\x17\x61\xb4\xc5\x4f\x62\x71\xb2\x41\xbc\xe2\x13\xf2\x7d\xfe\xe5\x2b\xbd\x44\x71\x55\xf5\x4b\x90\x90\x58\xb8\xd0\xc0\x87\x12\x1c\x94\x1d\x76\x02\x74\x3e\xfa\x5f\x82\x7a\xf0\x7f\xfc\xaf\x1e\x9f\x49\x98\xe4\x62\x44\x22\x9a\x26\x1a\xa4\x01\x55\xd7\x98\x8f\xa2\x24\x13\x8a\xf6\x24\x0c\x3b\xfc\x3e\x83\x72\xc1\x62\x94\x10\x4a\x92\x47\xbe\x58\xba\xc3\x1e\xd1\xea\x7a\x1a\xf9\x24\xa1\x5e\x9c\x90\x73\xae\xaa\x98\xd0\x71\x4b\x17\x38\x59\x9f\x7f\x53\x6f\x06\x86\x80\x0c\x0e\x26\xe6\xf5\xb4\x26\x69\xa3\xaf\x39\xac\x0b\xca\x9b\xd5\x48\xde\xd9\x52\xf9\xfb\x14\x42\xe4\x9c\x40\xed\xd2\x96\xf8\x4d\x99\xf6\x95\x09\x86\x3c\x88\x23\xb4\xe2\x46\xe1\x1c\x5c\x55\x81\x90\x60\xdb\x45\xeb\xe0\x91\x44\xca\xc2\xa5\x2e\x2f\x50\x8a\x94\x12\xb0\x80\xd1\x98\x93\x7c\xf9\x00\x46\x39\x6a\x51\xc3\x58\xb4\x24\xd2\xc2\xd6\xe8\x76\x47\x40\x33\xe4\xf0\xf0\x88\xc7\x6f\xc2\x81\x30\x44\x9e\x02\xca\xc4\xc8\xc4\xb0\xce\x49\x55\x54\xbc\x8d\x66\x86\x36\xe9\xcd\x28\xcd\x46\x1c\xbf\xe5\xbb\x22\xdc\x27\x5a\xf2\x06\xaa\x64\x06\x16\xa3\x55\x90\x58\xfd\xb0\xc7\x52\x10\xb2\xd5\xdd\xd3\xcb\xe4\x8d\xe0\x01\x5a\xbd\x64\x75\x8a\x23\xff\x54\xc7\x86\xf5\xf3\x09\x97\x48\xea\x2c\x96\xb0\xd0\xc8\xc9\xe3\xb9\xbb\x34\xb9\x9f\x23\xe5\xd1\xc1\xff\x9e\x09\x7b\x48\xa8\x36\x2e\x5b\x57\x44\xf6\x52\xae\x57\x96\x2e\xf9\x4b\x1f\x45\xe4\x89\x71\xed\x6d\xb7\x5e\x80\x1e\x48\x92\x00\x87\x0b\x75\xca\x5a\xed\xb4\x25\xa3\x66\xa7\x19\xf8\xcd\x76\xfb\xea\x0a\x86\xd4\x6f\x57\x52\xa0\x12\x9a\x95\x53\x5c\x6b\x71\x51\xd4\xd8\x59\xc7\xd8\x40\x3b\xff\xfe\x25\xd7\x5d\x54\x2b\x73\xa0\x29\x36\xa8\xbe\x23\xf9\xee\x72\x9e\xab\xab\x8c\x42\x4d\xc6\x5c\xaa\xff\x70\xc7\x95\xc3\xdb\x09\xd7\x33\xbe\x1d\x8d\x3f\x1a\xc4\x6b\x34\xfe\xe8\xde\x22\x98\xae\xdc\x5f\xb2\xad\x66\x0a\x28\x18\xc7\xf4\xef\x4a\xff\x14\x44\x19\x5e\xce\x39\x6b\xf2\xd2\x24\x81\xd7\x73\xe1\x4c\x05\xe6\xe6\x2d\x86\xb7\x7d\x94\x48\xe6\x1f\x1d\xd8\x26\x88\xd6\x40\xf2\x59\x72\xe0\x64\x9e\x84\xc4\x63\xc0\x39\xc3\x38\xde\xa9\xa1\x37\x8c\xed\xe8\xd5\x97\x5f\x52\x86\xbd\x87\xf8\x91\x24\xab\x30\xde\xf7\xbc\x78\xfb\x25\xfe\xf2\xf2\xcf\xff\xe3\xcf\x6f\xbf\x7a\xf7\x27\x29\xe9\x8e\xe6\x82\xf6\x4a\x17\x16\x93\x40\x6f\x61\x9f\xdb\x1a\x7b\x6a\xd4\x7a\x9a\x94\xcf\x92\xd9\xc9\x64\xee\x36\xea\x9c\xe4\x02\x0a\xcb\xb2\x5e\x41\x8e\xaa\x32\xe8\x04\xda\xea\xba\x9f\x36\x69\x35\x1e\x1c\x6c\xd2\x2a\x14\xaf\x07\x72\x80\xb7\x51\x93\xc4\x3e\x90\xc3\x6b\x92\xd6\x93\xa9\x8f\x5e\x69\x46\x7a\xf8\x7d\xe0\x4b\x9f\x0f\x7f\x98\x6b\x92\x33\x1a\xcb\xbf\x83\xf1\x76\xe1\xc5\x61\xba\x8d\xc4\x51\x8d\x07\x9f\x86\xaa\x5d\xe1\x43\xe3\xb5\x69\x92\xde\xc0\x19\x64\x29\x3b\x26\xa0\x4c\x0f\xe4\xd0\x29\xee\xaf\x93\xdb\x56\x7d\x42\x25\x01\x79\x2a\x81\x52\xdd\x6c\xc2\x74\xe6\x28\x42\x81\x09\xfc\x66\x47\x1b\x5f\xdf\x50\xf1\x6f\x31\x7c\xfb\x7c\x92\xa7\xc1\xe7\xa2\x7a\xd9\x47\x07\x44\x2b\x06\x32\x1b\xda\x44\xe5\xe8\xc9\xfc\xd7\xa1\x9f\xe1\x03\x80\x2c\x7c\x70\x01\x07\x3e\x3e\x03\x0c\xa5\x24\x37\x43\xf7\xf0\xc1\x20\xbb\xfc\x87\xbe\x42\xd6\x97\x21\xb3\xa7\x53\xd9\x8c\x0e\x71\xb2\xe3\x24\xb1\x1f\x41\x73\x83\x86\x48\x91\xd6\x60\x85\xe2\x28\x53\x49\xcf\xa2\x84\x2e\x13\xb2\x45\x10\x5f\x8c\x18\xb6\x6d\x75\x47\x22\x43\xed\x43\xad\x73\xa6\xe2\x48\xc3\x87\x9e\x38\xd5\x92\xbd\xf1\xaf\xbc\xf5\xfd\x98\xc3\x63\x70\x77\xd7\xc8\xf9\x3c\xb9\xa6\x2a\x00\xa8\x62\x70\x20\x2a\x32\xba\xe8\x88\xbf\xf3\x49\x8e\xe9\xae\x73\x12\x08\xc3\xe2\x02\xc2\x20\x81\x31\x9a\x21\x4b\x2d\x7b\x17\xd3\x40\xbf\x9e\x1b\x08\xd5\x43\x1f\xf8\x0f\x91\x7a\x80\x03\xd5\x61\x4f\xd0\x1e\x47\xc2\x24\xa6\x3a\x82\xe1\x64\x09\x7a\xb6\x17\x6f\x77\xd8\x03\xf7\xc4\x5d\x4c\x69\xb0\x0c\x49\x66\x64\x01\xfe\x0e\xcc\x7d\x97\x10\xc6\x0e\x48\x3c\xef\x09\x4f\x57\x2a\x6c\x2f\x74\x87\x93\x20\x5a\x87\x20\x15\x28\x1d\x44\xef\x6d\xa1\xa6\xec\x54\xfa\xc2\xa2\x56\x10\x09\x5f\x5a\x65\x5e\x68\x77\x4e\xbc\x00\xfc\xfa\xef\x62\x0a\x1e\xc4\x16\xf2\x9b\x42\x99\x50\x42\xf8\xba\xf4\x3f\x6d\x95\x3e\x88\x58\x49\x80\x8c\x06\x3a\x30\x67\xc1\x1d\x9f\xd8\xa2\xf8\xb3\xa5\xcc\xf1\x4b\x63\xfa\xe9\x75\xbb\x1c\x66\x7e\x9c\x82\x29\x65\x43\xbc\x07\x00\x59\x10\xad\xe1\xd9\x55\xb6\x59\x05\x94\xa1\x78\xc7\x82\x6d\x40\x19\x57\x24\x79\xc3\x2b\x83\xfe\xea\xcd\xed\x62\xaa\xa9\x65\xa3\x84\xaf\x16\x0f\x03\x85\x0f\xbb\x8c\x7e\xea\x7e\xe1\xc3\xae\x67\x8b\xb0\x0e\xc0\x9a\x2d\x74\x4f\x78\xd9\x78\xd8\x19\x77\x36\xdf\x4b\xc1\x3c\x63\x05\x6a\x31\xd9\xc3\x38\x50\x6a\xdb\x12\x22\x2d\xfd\x59\xdb\xb2\x57\xb5\x1a\x02\xbb\x7d\xfd\x2c\xe3\x3a\xef\xd7\x3a\xb2\x59\xe3\xd9\xcd\xec\xab\x78\x36\xb8\x77\x61\xe1\x46\x62\x7a\x5b\x29\xeb\xd9\x9e\x80\x05\x2e\x88\x10\x59\xad\x38\x63\xf6\x36\x38\x5a\x2b\x77\x34\xea\x6d\xc8\x16\x9b\x38\x00\xee\xc0\x5b\xf0\x2c\x97\xf6\x32\x92\xc3\xb8\x25\x09\x39\x03\xe1\x77\x38\x49\xf8\x88\x41\x84\x18\x49\xb6\x60\x36\x34\xc4\x06\xd7\x5b\x5c\xd3\x70\x3b\xcb\xf9\x3d\x8c\xc6\x68\xf6\xed\x60\x3a\x54\x2e\x7a\x99\xc3\xd9\xa7\xc9\xed\xb0\xd9\xb1\x76\xdf\x56\xdb\xa7\xc4\x8b\x23\x5f\xa2\xb4\x70\xfb\xd3\xfe\x7e\xff\x15\x70\xb6\x12\x69\x5f\x14\x61\x47\x1f\x32\x02\xd4\x47\xd9\x3b\xaf\x35\x8e\x7d\xd2\x57\x7d\x74\x79\xcd\x85\xb7\xcb\xae\x78\x76\xf6\x05\x27\xa0\x1d\xa4\xba\x03\xea\x41\x50\x00\x09\xc9\x96\x44\xac\x18\x44\x92\x3b\x06\xa0\x55\xf8\xa9\xb5\x8b\x69\x1b\xfd\x11\x5d\x5a\x7e\xb8\x55\xd6\xc5\x8a\xb3\x29\x9e\xcf\x59\x67\x24\xe0\x6d\xc1\xc0\xf6\xb0\xb5\xc1\x33\x9a\x89\x07\xd9\x82\x0d\xb5\x00\xc5\x77\x00\x45\x09\x21\x74\xa9\x8c\xca\x22\x3a\x4b\x81\xf2\xf8\x4b\x7e\xce\xe1\xf6\x18\x7f\x57\xc7\xad\x7d\x80\x6a\x28\x74\x7a\xd9\x7a\x35\xd2\xe7\xb2\x65\x99\x9f\xd4\xd0\x1d\x7b\xaf\x05\x95\x48\x8f\x52\xa6\x1a\x99\xb7\xb3\x0c\xdd\xc7\x93\xb9\x13\xe5\x07\xa3\xd9\x10\x35\x6f\x40\xe3\xe7\x3a\xc9\x2a\x10\xaf\x1d\x64\xaf\x07\x69\xd6\x87\xa2\x04\x9f\x7c\x8a\xe6\x42\x81\xb9\x65\x49\x70\xaa\xfb\xca\xf6\x8e\xbe\x0d\xe7\x1d\x7d\x61\x8d\xc0\x25\x8e\xb8\x0c\xdb\x86\xa4\xe7\xb4\x97\x48\x3a\x8a\x25\x55\x95\x2f\x26\xf2\x79\x53\x48\x7d\x4a\x6f\x00\x9d\xe1\x0c\x89\x49\xfb\x9b\x58\x32\x91\x12\xe7\x8d\x1f\x32\xc5\xc1\xd4\x01\x84\x60\xe3\xb2\x54\x54\x12\xf6\x56\x66\xa8\x10\x98\x2a\x70\x5b\xf7\xd1\xab\xe9\x64\xeb\x78\xa6\x96\xaf\x22\x05\xa4\x16\x5a\xa6\x25\xba\xf8\x55\xbe\x6f\xb5\x7a\x8a\x42\x07\x97\x12\x3c\x46\xc3\x78\x30\xbe\xd5\x9f\x84\xab\x65\xdf\x80\xf8\x6f\xae\xc1\x16\x90\xc1\x44\x56\x87\x5a\xb2\x4f\xf0\x6e\xc7\x11\x33\x89\xd3\xc8\x47\xbf\xd0\x38\x5a\x2e\x08\xf6\x36\x0b\xde\x85\xf7\x58\x07\xf0\x52\xb7\x24\x8c\x23\x70\x12\xef\x17\x84\xb2\x60\x8b\x19\x69\x74\xbb\x9c\xd6\x4a\x4f\x9c\xd6\xe5\x5b\xa0\x18\xe0\x04\x72\x42\xd8\x28\x2c\x34\x37\x6f\xeb\x17\x2a\x96\x22\x90\x95\x83\x3c\x43\x5d\x01\x65\x29\xef\x2b\x61\x7f\x36\x9c\x4f\x3e\xa0\x84\x78\x71\xe2\x37\x90\xa9\xdd\x35\xca\x5e\xb6\x94\xc7\xd5\x74\xf2\xfd\x0c\x5d\xbe\xd5\x57\x81\xd3\x91\x0b\xfd\x4e\x5f\x5c\x59\xbb\xdd\xfb\xc2\x68\x79\x4a\x74\x64\xc9\x5e\xe3\x68\x99\x1d\x8e\xf1\x44\x96\x3b\x9c\x34\x8a\x08\xcd\xce\x24\x3b\x11\xa4\x4e\xe4\x79\x87\x20\xc6\x6f\x99\x6e\x54\x38\x3a\xc0\x5f\x0a\x90\xc6\xd1\x41\x0b\x27\x2f\x07\xed\xe2\x0a\xda\xcf\x81\xb4\x1c\x4e\x6f\xc2\x05\x63\x44\xf1\x8a\x2c\xf0\x6e\x97\xc4\x4f\x00\xc3\x05\x47\x71\xc8\x67\x20\x0d\x72\xe2\x69\xce\x68\x01\x20\x17\x2d\x20\x98\x33\x73\x91\x04\x17\x85\xcc\x01\x18\x89\xc0\x35\xa6\x2c\xe6\x88\x84\x94\xd4\x18\x55\xc6\x54\x46\x5c\xbe\x0f\x85\x3a\xa4\x63\x1b\xc8\x23\x89\x18\x45\x24\x49\xe2\x04\xa2\x2f\xcd\x21\x44\x77\x0f\x87\x5e\x1a\x2a\x67\x7f\xc7\x9a\x38\x86\xe8\x75\x19\x01\x92\xe0\xe1\x8f\x29\x68\x36\xbb\x10\xf3\xff\x8f\x29\x5b\x27\xa0\xb7\x80\x87\xfd\x29\xa6\xac\x72\xc0\xb6\x32\x4d\x6d\x11\x44\xbb\x94\xa1\xe9\xf0\xe3\xcd\xdd\x60\x36\x6b\x67\x21\xe0\xe0\x9d\x27\x02\xd2\x72\x74\xb1\x31\x98\x35\x2e\x2e\x5e\x34\x8d\x85\x14\x23\x5a\xca\xec\x24\x78\x42\xbd\xc5\xb7\xdb\x8e\x28\xef\x53\x7c\xc3\x2d\x39\x97\xab\x32\x2d\x47\x56\x8d\x82\xc5\x1d\x56\x68\x0d\xa9\x32\xee\x64\xf8\x58\xe8\x24\x2c\x72\xda\xf8\x3e\x12\xfe\xbb\x42\x63\x2d\xbe\x82\x5e\x5d\x25\x64\xed\x85\x98\xd2\x7e\x61\xd3\x7a\xe8\x82\xa4\xee\x80\xa7\xc9\x35\xc4\xc2\xb3\x35\x2e\x4e\x83\x72\x5e\x98\x77\xcd\x46\x42\x96\xee\x42\x42\xaf\xae\xa4\x8f\xa7\xce\x49\xc4\xf7\x22\x81\x10\x07\x7e\x71\x57\x85\xe0\xf8\xeb\xc6\xc5\xc5\x49\x69\x10\xa4\x8b\xa9\x14\x79\xe5\x91\xf0\x7d\xb5\x8a\x16\x25\x00\x38\xfc\xac\x3d\xf6\xa9\xf4\x8c\xfd\xe9\xe7\x46\x76\x17\xbe\x9b\x8c\x6e\x51\x1e\xe9\x15\x15\xe4\xb2\xf3\x60\x9e\xd9\xc8\x9a\x76\x38\x5e\xc1\x15\x77\x36\x9c\xdb\x7e\xb0\x7d\x24\xac\x0b\x4c\xfc\xfb\x8f\x97\x4e\x81\x28\xf0\xa9\x6c\x2f\xc0\x67\x0d\xa1\xb4\x36\x8e\xbc\xf0\x6e\x36\x18\xff\xd8\xba\xb8\x34\xc3\x2a\xcc\x8d\x37\x84\xdb\xe9\xfd\x8c\x4b\x78\xd9\xd6\xcd\x24\x2f\x1a\xf8\x8d\x62\x0c\x59\xa9\x3b\x62\xc5\x1f\x57\x1f\xf4\x39\x5d\x86\x81\x87\x06\x9f\x47\x14\xd5\xec\x73\x74\x9e\x13\x05\xf7\x82\xe9\x6a\x11\xac\x16\xa0\x01\xd0\x72\xb3\xa7\x6d\xe7\x14\xcc\xb6\xa5\x5c\x31\x2a\xdc\x30\x6c\x33\x7f\xd6\x30\x73\x49\x3a\xf6\x38\xae\x42\x76\x8b\x26\x80\x8a\x8d\x58\x26\x9b\x57\x4a\x12\x53\x05\x47\x5b\xf8\x35\x79\xbf\x44\x00\xed\xfd\xc3\x45\x2b\x22\x74\x32\xd8\x5a\x6c\x3e\x71\x17\x9d\x93\xb4\x71\x1d\x1c\x4f\x85\xc2\x6a\x3a\x0d\x69\x99\x20\x60\xcf\x7c\x20\x3f\x66\xef\xac\xb0\x90\x1f\x71\xd3\x11\x3f\xca\xf7\x82\x03\xd7\x1d\x54\xf6\x95\xfa\x98\xd3\x41\x3a\xc4\xe4\x7c\x04\xaa\xd8\x5e\xde\xe6\xe7\x7c\x29\xea\x40\x1e\x99\x23\xef\x45\x96\xff\xc4\x09\xb3\xbe\xfe\x13\x52\xf1\x4c\x4b\x75\xb6\x5d\x39\xd6\x56\x3f\x2a\x3d\xff\x1d\x72\xb1\x8b\xe9\x91\xe7\x18\x07\x85\x52\xf9\x04\x2f\xa4\x81\x19\x4c\x23\xe4\x89\x78\xa9\x72\x7c\x83\x88\x33\xf2\xb4\x23\x11\x44\xa0\x2b\x05\x38\x73\xcb\x5b\x95\x1a\x4a\x7e\x1f\xab\x74\x09\x6c\x6a\xbe\xa8\x94\xf5\x96\x2f\xa1\x36\x82\xe7\x77\x57\xc3\x42\x55\x73\x85\x9d\x63\x8b\x91\x09\x21\x14\xde\xbf\xda\xb3\x29\xa0\xd5\x11\x4b\x85\x7c\x88\xf4\x70\xe2\x43\xb8\x32\x3b\x58\x9a\x94\xf9\x3b\x68\x65\xa2\xf9\x0e\x07\x89\x20\x7f\x85\x74\x32\x3d\x11\xef\x80\x68\xb0\xdd\x85\x44\x3c\xb7\x74\x10\xe4\xc9\xc1\x72\xd0\x28\xdd\x2e\x49\x02\x6c\x80\xcb\xd9\xd6\xa8\x5f\x8a\xbf\x6e\x31\xf3\x36\x24\x41\xe2\x89\x15\xb4\x3c\x19\x8c\x85\xc3\xd0\x98\xb3\x0e\xb5\x37\xa2\x98\x8c\xed\xb4\xcc\xf8\xe0\xe2\xc5\xb2\x34\xa4\x4c\x3b\x72\x24\xe7\x33\xf2\x73\xba\xf3\x06\x28\xd1\x98\xf6\xa4\x4d\xe7\xff\x7e\x2f\x28\xca\x4f\x6a\x09\x3f\x73\x91\xac\x84\x5f\x3f\x87\x32\x49\x26\x29\x18\x76\x03\x5e\x56\x57\x69\xc8\x4f\x0d\xf4\xd1\x94\x92\x84\xca\xb7\xef\x18\xad\x93\x38\xdd\x89\xe8\x79\x48\x2e\xb4\x0a\xbc\x93\x68\x9c\x01\x66\xf3\xfe\x3f\x97\xae\xfd\xb6\x44\xa8\xd8\xb5\x06\xed\x71\x74\x52\x24\xa7\xec\x92\x9f\x29\x9b\x95\xc1\xd8\x75\xc9\x4d\x89\xcc\x4f\xe2\x9d\x4a\xc8\x25\x54\x0c\x23\xf1\x10\x8e\x7c\xae\xdc\x89\xf0\x20\x81\xb2\x86\xed\x05\x42\x4c\x20\x6d\x10\x66\x78\xc9\xd1\x06\x87\x71\xb4\x16\xa1\x13\x22\x31\x81\xd9\xb5\x03\x4e\x0a\x22\x50\x32\x8d\x12\xb2\x22\x09\x89\x3c\xe2\x4b\x7b\x66\xed\xfb\x6a\xac\xd8\x72\xe7\x65\xf1\x62\x49\x16\xfc\xeb\x8e\xf8\x72\xc7\xa6\x42\x67\xdc\x53\xd3\x37\x81\xff\x31\x94\x63\x38\xed\xf9\xf0\x07\x23\x79\x0d\x80\x05\x3e\x66\x61\x85\xa3\xf1\x7c\xf8\x71\x38\x15\x8d\x32\x1d\x51\x5a\x22\x94\x62\x3c\x9e\xcc\xb9\x16\xcc\x92\xc3\x02\xfb\x8f\x01\x8d\x93\xc3\xe2\x09\x7b\x6c\x11\xc6\xde\x83\x8a\xaf\xbd\x9b\xdc\xfc\x7d\x31\xba\x6d\x3b\x82\xd0\xc5\xeb\xd0\x78\x32\x1f\xdd\x0c\x51\xd3\x3c\x2a\x0f\x47\x90\x63\x03\x38\x3b\xa4\xb2\x88\x62\xf4\x39\x89\xb7\x22\xdf\xa6\xce\xb9\x21\x62\x4d\x93\x34\x8a\x82\x68\xdd\x43\x9f\x45\xce\x1e\xba\x49\x99\x1f\xef\x05\x89\x76\xf5\x6a\x5e\x3b\x43\x94\x77\xeb\x1a\xfb\x28\x37\x1b\x14\xdc\x0d\x3a\xf2\x59\x64\x92\x3f\x81\x8e\x13\xe8\x15\xb2\x6e\xc1\x87\xb8\x5f\x8a\x1a\xd7\x8d\x12\xf0\xf2\x19\x83\x68\x8d\xfe\xf0\xe6\x0f\xea\x02\x00\x2a\x67\x0b\xc0\x14\x3e\x42\x38\x7e\x86\x2d\xe2\xd7\xa6\xe5\xdc\x6e\x4f\xe9\xdc\x4e\x27\xbf\xe9\xeb\x42\x3a\x1a\x69\x6a\x68\x42\xcc\xe4\x77\xa3\xe1\xf7\x6a\xf7\x86\x7d\xe1\xba\x59\x18\xa8\x7d\xc2\x48\x9f\x86\xf3\xe9\xe8\xe6\xdc\x91\x2a\x83\x90\x5f\x62\xbc\x1a\x03\xdd\x0e\xef\x86\x7c\x9c\x63\xc8\x11\xf8\x7d\xc7\x29\x5c\x1b\x59\x86\x90\x07\x09\x32\xd3\x9d\x8b\x3e\x75\x32\x62\x2e\x68\x58\xc0\xa8\x66\xaf\xbd\x3a\xab\x71\x30\x9f\xb3\xd0\xb6\xce\x14\x86\x77\x27\x27\x42\xa3\x31\x6a\x49\x9f\x56\xfe\x13\x50\xee\xa3\xab\xcb\x8c\x73\xb6\x5d\x68\x17\xee\xd6\xf4\xd7\x50\xbb\x65\x6a\x45\x81\x5f\x11\x21\x67\x64\x6f\x94\x88\x8b\x6e\x10\x35\x1a\x8b\x28\x36\xa9\xd5\x13\x23\x91\x0d\x10\x31\x4c\x95\xd4\x01\x39\xa8\x44\x98\x60\x4a\x21\x1b\xa2\x4f\x91\x1f\x24\xc4\x63\xe1\x73\x55\xaa\xc0\xb7\x3c\x3b\x2b\x9e\x6d\xab\x35\x2a\xe1\x2e\x22\x41\x0a\x7c\x15\xde\x09\xb4\x23\xbf\x00\xf1\x92\xf0\xe5\x73\x31\x15\xa5\xca\x89\x38\x8d\x54\x8e\xc2\x20\x3c\xb8\xe4\x98\x63\x8f\xa4\xcf\x7d\x22\x3d\x5b\xe1\x29\xbc\x77\x9b\x30\xfb\x4d\x34\x97\xe3\xcf\xab\x60\x1d\x32\xc3\x52\x33\x8f\x2f\x4c\x95\xeb\x4f\x26\xb9\xc0\x53\x60\xa3\xdb\x7d\x4b\x51\x42\x76\x09\xa1\xfc\x0c\xe1\x86\x8b\xbc\x8f\x32\xff\x24\x25\x0c\xb5\xf6\x04\xf9\x90\x4e\x25\xa5\x04\xac\xaf\x8d\x6e\x97\x06\xfc\xac\x83\x88\x89\x71\xb5\xcd\x49\xe7\x47\x62\x6d\x1d\xf0\x11\xe8\x4f\x24\x51\x89\x29\x31\xef\xae\x13\xa2\x89\xd1\x64\x26\xcc\x80\x8a\x7b\x01\xd8\x13\x47\xa6\xff\xba\x17\x06\x90\x38\x92\x13\x21\x8a\x3c\xc8\x2e\x29\x22\x6c\xf9\x64\x53\x82\x7d\x9d\xef\x91\xcb\x09\x2a\xca\x97\xfc\x6a\x5c\xb9\x44\xe4\xdf\xa4\x99\xb4\x06\xb0\x10\x91\x73\x91\x8f\xc8\xaf\x29\x28\x43\xcf\xbc\x6f\x00\x17\xfd\xba\x9c\xe5\x54\x2e\x4b\x23\x91\xdd\x31\xc8\x91\x10\xf8\x4f\x8b\x47\x1c\xf2\x9f\x5b\x55\xbe\x58\xdd\xae\x4c\x5b\xa5\x74\xc0\x2c\x9a\x9e\xc5\xca\x50\x18\x91\x3d\xdc\x14\xed\xc9\x9b\x1f\x02\xdc\xfd\x41\xaf\xe1\x14\x47\x98\x40\x0e\xf2\xd0\x41\x53\x42\x2d\x4e\xbd\xcc\xb3\x13\xb9\xb7\xa8\xfd\xa4\xe4\xc5\x38\x24\xd4\x23\x2d\xae\x08\xec\x62\x9a\x8f\xde\x38\x41\x47\xff\x85\x76\xdf\xbf\x37\xf3\x99\x10\x30\x13\xb4\x39\x64\x3a\x25\x93\xf6\x8a\xe1\x28\xf5\xee\x16\x8c\xcd\xa7\x10\xde\x25\x6d\x7e\xbd\xed\x0c\x93\x65\x0f\xea\x6d\x94\x7b\xfa\xba\x1b\x7e\x98\xa3\xff\x39\x19\x8d\xab\xfc\x3c\x8c\x3f\x93\x31\x6a\x85\x52\x69\x82\x65\x08\x45\xaa\xa7\xc8\x97\x5a\x53\xa3\xfe\x24\xe5\x5e\x76\x7a\xce\xfc\x2f\x2d\x47\x16\x9b\xa2\x26\x98\x3b\x13\x8b\xdc\xda\xfd\x8c\xfd\xe4\x5b\xb4\x0d\xb9\x83\xf3\x45\x40\x54\x91\xa3\x76\x79\x10\xea\x6f\xc6\x55\x7c\x82\x7d\x99\x22\x79\x85\xdc\x87\xa7\xb3\xd7\x41\xb6\x48\x91\xa7\xb9\x90\x76\x34\xd4\x2b\x69\x9b\xf6\x8a\xc1\x74\x3a\xf8\xb1\x55\x2c\x31\x20\x11\x4a\x5e\x42\x7e\x02\x1d\xf4\xb6\x5d\xee\xeb\xa8\xe8\xae\x7c\x8c\x6b\x39\x73\x02\x5d\xba\x53\x05\x29\x95\x69\x8b\x9f\xf8\x84\x6d\x81\x6f\x72\x6a\xfb\xd8\xdb\x68\x5d\x82\x06\x8a\x5c\x70\x6c\x52\xab\x0e\xfc\x27\xd4\x47\x6b\x31\x44\xfb\xea\xaa\x84\xf2\x54\xb0\x2c\x23\x85\xe2\x39\xa4\x0f\xe8\xde\x68\x86\x9a\x22\xd1\x00\xe3\x5c\x49\xd3\x5a\x6c\x06\x27\x34\x9f\xc9\x1e\xcd\x19\x8b\x8e\x72\x2f\x41\xc8\xcd\x8b\x20\x82\x62\x0c\xa1\x98\xd3\x82\x9f\x7e\x56\x3f\xc1\x7d\x55\x3f\xfe\x9b\xf0\x9f\x4a\xf8\x4b\xcf\xc0\xb6\x27\x3f\x3c\xbe\x22\x3f\x10\x83\xc3\x24\xa5\x1c\x01\xdc\x8b\xf8\xdf\x5a\x96\x2f\x11\x47\x88\x76\x07\xdd\x8f\xc7\xc3\xd9\xbc\x65\x62\x44\xbb\xcd\x0f\xf5\xe1\xb1\xe0\xc7\xf8\x12\xac\x43\xac\x38\xc7\x3b\xf4\xf2\xff\x15\x98\x47\xad\x73\x3d\xca\x52\xc4\x3e\xcb\x79\x8a\xa6\xf8\x46\xc3\x7f\x93\xfc\xdf\x88\xe4\x67\x2a\x0a\xa7\x86\x8a\x00\xe6\x38\x80\x91\x6d\xa3\x23\xb5\x92\x78\x05\xaa\x47\x47\x24\xbc\x51\x3f\x29\x3a\xfa\x2a\xbc\x42\xd0\xf0\xdc\x52\x5f\x9a\x75\x04\x3e\x7d\x01\xc6\x01\xa6\xa1\xd6\x2e\xa6\xe2\x65\x5d\xbd\xb0\x67\x16\x0f\x01\x76\x63\x0c\xa9\x25\x66\x9c\xe5\xdf\x3c\x04\x0e\xe3\x77\xe7\x20\xf9\xb1\x64\xab\xe2\xaf\xd0\xe7\xdf\xfc\xe6\xa5\xf9\x4d\x0e\x07\x9e\xcd\x6d\xba\x5d\x95\x68\x4a\x2b\x30\x41\x04\x14\x95\xdf\x9f\x38\x62\x49\x1c\x66\x95\x5d\x20\x31\x17\x80\x56\xa7\xc3\x8a\x62\xb4\xc5\xe0\x5c\x0d\x86\x88\x38\x88\xca\x38\x59\x86\x4a\x2f\x4f\xbd\x39\x9d\x7a\x45\xda\x0d\x71\xa9\xab\x8c\x46\x34\x9f\x6d\x0c\xa3\x75\xe9\x77\x96\x29\x8e\xf2\x99\x3b\x48\x1b\xb1\xe5\x0a\x8d\xd7\x61\xc9\x1b\xbb\x5d\xe3\xc4\x54\xc2\x84\xa5\x30\x24\x51\xf9\xea\x21\x1a\x04\x6b\x28\x85\xa3\x6b\x03\x21\x3b\xfd\xba\x56\x6e\x97\x44\x86\xe5\xfe\x43\x5a\x81\x0d\x52\x78\xd2\x23\x32\x85\xfa\x76\xad\xd1\x78\x72\x3f\x57\xc1\x10\xa3\xf1\x9c\x43\x40\x06\x2f\x64\x2c\x45\xc6\x2f\x64\xec\xa4\xf4\xf9\x18\xb6\xbd\xc0\xeb\x35\x90\xbb\x76\xc7\xfa\x81\x53\x48\xfb\x17\xe3\x86\x1b\x42\x51\xd1\xaf\x9f\xb6\xb5\x6d\xbc\xa1\xea\x77\x8c\x87\xd3\x2a\x82\x23\x29\x0c\xf8\x75\xaa\xbe\xed\x9a\xef\xc4\x15\xa8\xef\x00\xe0\xbc\x88\xdc\x51\x86\xbd\x19\x37\x83\xd4\x5c\x09\x91\x4e\x05\xf4\x0a\xd2\x2e\x40\x22\x69\x8e\x4c\xea\x1f\x1a\xa9\x70\x24\x6a\xfb\xf0\x1f\x05\x82\x35\x4f\x7a\xc1\xb6\xd6\x77\x4e\xd9\x3e\x18\x8a\x53\x54\x61\x9f\x16\xb2\x4e\x75\x02\xdb\x73\x70\x47\x5e\x79\x68\x63\x9a\xeb\x0b\x3b\x91\x98\xf0\x42\x67\x98\xdf\x58\xc9\x8e\x0a\x64\x4b\x27\x1f\x86\x6c\x83\xa2\x12\x55\xfe\x40\x5f\xe2\x0c\xeb\xae\xcf\x95\xa4\x6e\x6a\x78\x18\x09\x23\x89\x20\x4d\xd2\xfb\x40\x65\x78\x04\x5f\x14\x93\x5c\xd5\xc4\x09\x18\xf2\x08\x26\x64\x22\xa7\x58\x40\x29\xc5\x10\x66\xd0\x78\xf9\x0b\xf1\x58\x2b\x43\x85\x02\x51\x38\x8e\x94\x2f\x85\x19\xf5\xb6\x77\x04\x2d\x30\xfa\x9f\xb3\xc9\xf8\x1b\x24\x36\x56\xfb\xd4\xc5\xdc\xe7\x9e\xb5\x69\x0a\x12\x1e\xbf\x38\x73\x54\x3f\x8d\x3b\xb4\xf2\xf5\x15\x4e\x31\x3e\xe5\xc3\x17\x33\x43\x6a\x95\x5b\x91\x98\xd1\xc8\xaa\x03\xb4\x3b\x5b\xff\x4b\xd2\x6e\xc7\xf6\xf8\x81\xae\x08\xf3\x36\x84\xda\xa7\xa9\xf2\x7c\x0a\x88\xca\x3b\x14\xf8\x27\x52\xe3\xe2\x8c\xae\xd3\xbc\x15\x75\x11\x40\x89\x92\x85\x8e\x20\xf4\x56\x64\x69\xb0\xeb\xa3\x15\x7d\xb3\x4f\xcf\x5d\x96\x37\x38\xd8\x25\x2f\x9d\x61\x10\x46\x92\x1e\xfb\x84\x25\x1a\x94\xb1\x86\x4c\x18\xbd\x9f\xa3\x22\xf8\x9d\x39\x4f\x22\xb2\xcf\x2a\x65\xc8\x18\x93\x2c\x99\x89\xfd\x35\x4b\x7b\xd6\x74\x22\xd6\x22\xf0\x9b\x6d\x23\xa9\x99\x9d\x8f\x02\x99\xc9\xe1\x1d\xe1\xf1\x96\x5b\xc6\xc8\xcc\xc2\x08\x59\xa8\x24\x01\xca\x59\x82\x2e\x2e\x3b\xe8\xe2\x5d\x07\x5d\x7c\xd5\x30\xf4\x9e\xb2\xf0\x61\x3b\x84\x58\x4a\x3a\x17\x17\x9d\x22\xf4\x8d\x44\x20\xd9\xf5\x80\x07\x69\x88\x4d\xb1\xe0\x52\x5c\xa7\x38\x8f\x42\x8c\x6f\x06\x49\xf9\xc6\x1a\xa5\x61\xa8\x5b\x95\xe5\xd1\xd7\xfe\xf5\xce\x22\x6a\x36\xd4\x72\x25\xd4\xe4\x25\xeb\xa3\x8b\xcb\xb3\xb7\x7a\xc6\x86\x5e\x3b\x0d\x97\xbc\x52\x60\x57\xb1\x52\xb5\x95\x93\x73\xd3\x5f\x78\x0e\x39\x9e\x45\x34\x7e\xd4\x94\x69\x9e\x55\xe6\x62\xd0\x10\x31\xe2\x04\x43\x58\x5b\x64\x74\xa0\xae\xa6\x08\x79\x8b\x9b\x61\x88\x52\x4a\x94\xf6\x41\x7e\xd5\x0f\xd5\x48\xf9\xfd\x5a\xc6\x19\x78\xaa\xd6\x74\x8d\xa2\x30\x78\x10\x0f\xe8\x3d\xf4\xad\xa8\x6d\xd8\x91\x63\x25\x22\x85\x8c\xca\xd3\x04\x8f\xe2\x1e\x16\x99\x64\x31\x53\x35\xcf\x48\x36\x98\xf6\x38\x29\xe8\x2a\x30\x20\x14\x70\x91\x95\x19\x95\x9f\x2c\x25\x10\x7c\xb2\xcf\xf2\x35\x4b\x3f\x80\x0e\xd7\x87\x55\xfa\x5a\x4a\x10\xe6\x63\x14\x41\x21\x46\x03\xb7\x17\xe5\x8d\xbb\x4a\x59\xea\xce\xce\x5c\x53\x57\xd4\xa8\x24\xc4\x82\xfc\x3b\xbc\x8c\xd7\x13\x0c\x30\x23\x5f\x45\xca\x85\xf2\x71\x2c\xfc\x27\x8b\xe6\x1a\xa9\x9a\xba\xdd\x19\x21\xa8\x64\x21\xc2\x69\xfe\x71\x91\xb1\xa8\x28\x06\x5f\x8d\x65\x9c\x32\x95\xd1\xc9\x08\x34\xd9\xb2\x48\x24\x1c\x65\x91\x91\x72\xf4\xac\x2c\x45\x00\x02\xeb\xf1\xb6\xcd\x87\x6d\xe4\x72\x13\xe5\x13\xb3\x36\x6a\x97\x9a\x0b\x22\x55\x6a\x4e\xa4\x01\xca\xca\xcc\xe5\xe9\xd0\xaf\x29\x49\x0e\xc6\x83\xd7\xcd\x7c\xe8\x7a\xec\xaa\x6f\xca\xbd\xb8\x6c\x17\xcd\xfc\x0e\x5f\xa2\x42\x7c\x22\xa6\xa8\x20\xbf\x68\x02\x97\x0b\xd0\xf5\x98\x24\x6b\x2e\xff\xa1\x6a\xaa\xf2\xe6\xdd\xc5\x5d\x07\xbd\xb9\xe4\xff\xef\x18\xd5\xf6\x1f\xe2\x34\x55\x40\xa8\x63\xb9\x8b\xea\x03\x82\xe6\x06\x21\x6d\x14\x48\xad\x55\xed\xc2\xf8\x15\x48\x67\x25\xd9\x3c\xd9\x7c\x94\xdd\x31\xe3\xb5\xd7\x8c\x95\xc8\x88\x0a\x10\x0e\x55\x27\x41\x90\x34\x9a\x49\xdc\x52\xe7\x7e\x86\x69\x28\xbf\x94\x97\xb3\xe5\xbb\xef\xef\xd9\x86\xfd\x42\x6c\x5c\x96\x3b\xb1\x9e\x84\x55\x4e\x7b\x14\xf1\xdd\xe2\x07\x92\xe5\xff\x2a\xa4\xcd\x93\xb1\x0f\xaf\x42\x68\xcc\x48\xb6\xba\x14\x46\xf2\xbc\x2c\xd5\x9f\x2c\xbf\xb1\x14\x05\x42\x89\xaf\x8a\x43\x67\x41\x1c\xba\xc4\x60\xf6\x04\xb1\x4d\x29\x33\xba\xa8\x92\xa3\xc5\xaa\xc3\x9e\x17\x27\x3e\x08\xe7\xb1\x5d\xff\xfb\x08\xb5\xaa\xa8\xbb\xa9\x1d\x76\x8d\x92\x9b\x82\x0e\x7e\x9a\xdc\x0a\x6f\x45\xc7\xad\x6e\xd7\x23\x90\x1e\x23\xcf\x25\x90\x4a\xa4\x95\x84\xb2\x23\x50\x80\xc3\xc0\x35\x70\xa0\xdc\xb6\x65\xd0\xf5\x71\x31\xb1\x48\x4d\x4f\xa0\xa8\xed\x0e\x4a\x77\x3e\x78\x2d\x5a\xab\x39\x3d\xba\x1c\x7c\x13\xed\xd9\xc1\xcd\x5e\x4f\xad\x5c\xe9\xf5\xf6\x4b\x22\xcc\x55\x85\x25\x17\x5f\xb1\x47\xf8\x57\xe3\x09\xd6\x0b\x57\x46\x90\x6c\x4a\x54\xcd\x33\x5e\x25\x53\xd0\x51\x72\x5a\xd7\xa0\xff\x42\x64\xfc\x98\x6f\x4f\xa5\x5a\xfc\x6f\x0a\xfe\x6f\x0a\x7e\x0a\x05\xff\x3d\xa8\xad\x54\xd0\xff\x4f\x27\xae\x4a\x39\x38\x4f\xbe\x06\x0b\xfb\x7f\x5d\x62\x29\x0a\xad\x7d\xc6\x09\xde\x12\x06\x96\x84\x28\xd8\xc9\x7c\x4d\x99\x39\xa1\x71\x5a\x2e\x11\xa8\x11\x6f\x55\xc1\x2c\x94\x89\x2f\x12\x54\x48\xba\x2f\x9b\x43\x08\xe1\xf4\xbb\xc1\x5d\xa6\x8c\x3f\xc6\x81\x5f\x48\x10\x68\xe4\x8f\x3c\xb3\xf8\xad\x08\x73\x1b\xfe\x70\x33\xfc\x0c\x3b\x69\xca\x32\x5c\x94\x30\x51\x24\x14\x82\xad\x91\x5e\xd8\x3e\x60\x1b\xae\x8a\x5b\x49\xac\xd4\xe0\xb9\x64\x94\x2a\x81\x2d\x33\xba\x43\x01\x77\xec\x03\x69\xba\x7c\xc3\xb5\x9a\x04\x47\x7e\xbc\x8d\x08\x95\x4f\x89\xc6\x64\xaa\xea\x1c\x2c\x84\xea\x88\x0b\x1c\x06\xeb\x28\x2b\x4a\x27\xe7\x31\x1a\xe9\xaa\xa5\x60\x41\x30\xaa\xf5\xa3\x5f\xe2\xa5\xac\x57\xab\xf0\x2c\x3b\x2b\xab\x1a\xaa\x51\xb9\xaa\xa4\xd0\x6a\xab\x10\xb1\xf8\x6c\x5e\xd2\x36\x92\x3c\x19\x1e\x2f\xa7\xd4\x65\x35\xb1\xa8\xdd\xae\x7b\xf5\xea\x3e\xa2\xd0\xf2\x32\xaf\xf6\x3f\x1d\x08\x2c\x13\x99\x18\x0f\xa5\x15\xe9\x5a\xe5\x24\xa6\x5f\x8e\xcc\x65\xd9\x6a\xda\x33\x35\x3b\xc8\xfe\xa1\xac\x5c\x0f\x1f\xab\x8d\x6e\x27\x9a\xae\x0f\xe7\x3a\x00\x0a\x52\x31\xdf\x0e\x6f\xc5\xcb\x7d\x65\x59\xd9\xd3\xee\x76\x7e\x71\xed\x23\x55\x6f\x0c\x33\x8b\x1b\xce\xb9\xe4\xdc\x09\x5f\xed\x59\xce\x2e\xc7\xce\x33\x3b\xc0\xd1\x0c\x35\xa9\x0c\xe5\x13\x24\x41\x9f\xf2\xca\x4a\x8b\x4f\x51\x4b\x33\x36\x2e\xac\x44\x64\xdf\xd6\x04\x03\x73\x91\x6c\x17\x06\x5e\xc0\x50\xfc\x48\x92\x24\xf0\x49\xf3\x34\xcc\x53\x05\xf1\xed\x85\x16\x29\xe9\x49\xa8\x98\x95\x98\x13\x29\xe4\x8f\xdc\x57\x33\xed\xb8\x32\xed\x2e\x09\x12\x05\xc6\x62\x20\x9b\x5f\x0a\xa9\xec\x4b\x80\x8c\xa8\xfe\x4f\x51\x10\xad\x09\x65\xc4\x6f\xe4\xa2\x3a\x92\x34\x52\x52\x9c\x10\x42\x38\x55\x83\x2c\x92\x20\xbf\xda\xdf\x7a\xb5\xcb\x1d\x17\xe9\x4c\x29\x00\x7b\x8e\x44\xbe\xb6\xe8\x93\x8b\x98\x15\x82\x8f\x0b\x69\x50\x3f\xcb\x3d\x54\x29\xff\x9c\x98\x33\xaa\xde\xda\xdb\xaf\x79\x6f\x9d\xf7\xae\x32\xf5\x50\x9d\xbb\xe7\xc6\x68\x81\xc5\xc5\x0b\x88\x9d\xd7\x2f\x4b\xbb\xa1\x6a\xa9\xc1\x93\x89\xba\x63\xc2\xc8\x28\xcf\xab\x7d\xc2\x8d\x4b\x48\xfd\x3b\x77\xec\x6a\x9d\x8f\x4f\x2a\x8d\x94\xf9\x76\xfe\x4c\x6c\x7a\x35\x9c\xa9\x8a\x91\x2d\x2d\x8e\xfe\xf2\x88\x55\x75\x70\xe2\xb0\x84\x09\x9a\x12\x46\x4b\x89\x7a\x01\xab\xa0\x22\xac\xaa\xaa\x20\x77\xd3\xbc\x3e\x33\xc3\x5e\x42\x18\x89\xb8\x64\xbd\xd8\x91\x24\x88\xfd\x0a\x84\x52\xd7\xa0\xe8\x60\x75\x33\x19\xdc\x0d\x67\x37\xc3\xd6\xb6\x97\x1f\xaf\x53\x75\x04\x85\xc9\xdb\xed\x53\x6a\xd1\xbd\x08\x45\xab\x80\x85\x4d\xd3\x6a\xeb\x77\xd5\x3b\x7c\x8d\xac\x32\x75\xce\xd5\x2e\xd9\x74\xaa\x97\x1e\xad\xda\x53\xfe\x87\xd7\x14\x39\xf3\x73\x35\x3b\x28\xff\xd3\x4b\x88\x9d\xaf\x24\xd9\x15\x40\xe7\x96\xed\x74\x33\x24\x01\xfa\xbb\x48\x77\x47\x49\x83\xd0\x94\x4f\x3c\xfd\xff\x03\xa5\xbc\x4a\xba\x52\x57\xce\x2b\x80\xb9\xef\x84\xfe\x2b\x0a\x7c\xd5\xe4\xf1\x55\xc5\x32\x27\x35\x73\x0b\x66\xee\xbb\xf3\x9b\x88\x66\x27\xf0\xd2\x33\x85\x33\x07\x12\x68\x4b\xe7\xcb\x89\x65\x95\x9b\xca\x9f\xfa\x6b\x8a\x4c\x6e\x26\x96\x17\x9a\x6a\x9e\xf8\x8b\x8a\x4d\x86\x25\x6b\x41\x09\xe3\xa4\xb8\xe6\x69\xdb\x25\xd7\x3c\x1c\xe9\xb1\xd0\x32\x8e\x43\x82\x65\x41\xa5\x84\xd0\x34\x64\xf6\x6f\x45\xea\xc8\x39\x9d\x59\x7c\x4d\x9e\x84\xbe\xbc\x90\xf8\xe6\x0b\x91\x49\x65\xb7\x5e\xec\x92\xd8\x43\x7b\x70\xb3\xda\x25\xb1\xaa\xcf\xa4\x16\x20\x44\xd4\xa6\xe1\x11\x27\x8b\x13\x98\xab\xb4\x6b\xe5\x58\xeb\x77\xa5\x8e\xff\x30\xb8\x9b\x0d\x6b\xd7\x34\x33\x27\x2d\x6c\xf6\xec\xaa\x67\x0e\x72\x7b\x56\x6a\x7c\x7b\x83\x3a\x18\x37\xc3\x04\x12\xf1\x49\x73\x9e\x8a\xb6\xf1\x57\xd8\x30\x83\x38\x32\xd2\xd1\xe7\x9f\x45\x8c\x24\x5a\xb2\x68\x5a\xdf\xb4\x79\x36\x1b\x76\x5a\xfc\x7c\x5a\xbc\x7e\x09\xe8\xf2\x00\x16\x18\x76\x5d\x56\x45\x0b\x0d\x7f\x18\xcd\xe6\xb3\x5c\x00\x9b\xfc\x56\x27\xcb\x3e\xda\x34\x8a\x39\xf6\x37\x3d\x23\xab\x3e\x47\xbe\x92\x8d\xc9\xad\xf5\x6a\xee\x2b\xeb\xa0\xce\x83\xf8\x0b\x03\x30\x9c\x17\xe6\x5f\x73\x34\x3c\x2c\x40\x18\x16\x73\x89\xc2\xea\x73\x99\x4b\xd2\xcb\x09\xed\x2e\xaa\xf2\x72\x72\xbb\x6b\x74\xc7\x6f\x59\x9e\xeb\x13\xc9\x97\x6c\x76\x6d\xbf\x88\xb8\x66\xe8\x57\xea\xe5\x8e\x65\xb6\x9d\xb4\x65\x3e\xbd\xaf\x20\x2d\xbf\x0f\x0d\xe4\x48\xe8\xda\x72\xf5\x53\xcf\x8d\x78\xea\x11\xf4\x43\x4b\xf9\xc6\x38\x1d\xb4\x22\x98\xa5\xf2\xd9\x65\x15\xa7\x91\xef\xaa\x37\x76\xa6\x52\x55\x44\xbf\x66\xc7\xb5\x8b\x17\x33\xe8\xe7\x6a\x9b\x69\x54\x35\xe7\xcc\xdb\x77\xcc\x07\x50\xc7\xda\xce\xb1\xe7\x67\xa3\x58\x17\x5e\xc8\x31\xcf\xf2\x40\xac\x75\xf9\xf4\x45\xb3\xec\xfa\xc6\x1b\x99\x42\x9e\xdf\xc7\xb8\x5f\x43\xc4\x11\x1a\xe0\xc9\x44\xa4\x58\x7a\xb6\x5c\x0e\x3a\x2e\xf3\x74\xbb\xc1\x0a\xe1\x90\x93\xc6\x03\x28\x84\x2c\x46\x3e\xa1\x41\x42\x64\xe0\x6c\x87\x5f\x9a\x8d\x74\xc1\xf0\xe3\x0a\x01\xa0\xde\xd6\xdb\x52\xf7\x3a\x7e\xcf\xff\x35\xe8\x14\x00\xc8\xc4\xab\x80\xa2\x6d\x40\x29\x14\x53\xf7\x2c\xd2\x13\xb0\x4a\xca\x56\x6f\xd7\xaf\x45\xdd\xfe\x8b\x19\x0c\x7e\x13\xd9\xb6\xfa\xc2\xba\x2c\x0d\xe7\xd0\xde\xc2\xbc\xa5\x17\xbf\x8e\x3d\x43\x02\x69\xee\x22\xc4\x8e\x87\xab\x6a\x11\xd0\x2e\x78\x69\x90\xee\xa3\x4f\xed\xa7\x3c\x0b\x95\x08\x66\x1d\x54\xa0\xe1\xb8\x9c\x82\xbf\x96\x11\xe2\xe4\xd3\x53\xaf\xb3\x75\xe8\x76\xce\xdb\x45\x11\xed\xa3\x32\x9e\x45\x12\xca\xc3\xbb\x40\x70\x37\x6a\x49\xe7\x2a\x35\x73\x2c\x29\x66\xd0\x30\xf4\x0e\x2d\xef\x77\x6a\xb5\x5a\x50\xb2\xde\x92\x88\x2d\x0f\x9c\x98\x66\x71\x7b\x35\x7b\x83\xeb\x9e\xe8\xcb\xbf\x4b\x41\xca\xd6\x5b\xda\xd7\x25\x81\x66\x46\x7d\xdf\x6e\x37\xf1\xfe\x84\xe2\x15\xda\xa6\x21\x0b\xa2\xd8\x27\xba\x7a\xc7\x2e\x89\x77\x24\x09\x0f\x68\xc3\x79\x3b\xa4\xff\x36\x11\x0a\x92\x88\xb3\x34\x89\x20\xdd\xa8\x31\x60\x10\xd1\xc0\x27\xa2\xc6\x83\xf2\x96\xba\x16\x31\x5b\x6b\xc2\xa8\x2a\x96\xc8\x36\x98\xf5\xaa\xcb\xb1\xe9\x35\xb5\x1c\xb9\xce\x6f\x06\x77\x77\xc8\x0f\x28\x4b\x82\x65\xca\x88\xbf\x20\x4f\xc4\x2b\x9e\x90\xfb\xa0\xcf\x3a\xec\xfa\x07\xfe\xec\x33\x7f\xce\xb1\x57\x9d\x7c\x61\x26\x96\xe0\x88\x62\x38\x23\x1c\xa2\xfe\x7b\x41\xf3\x1c\x39\xd9\x8d\x03\x96\x5e\x55\x42\x22\x80\xb0\x3b\x7e\x9c\xe0\x12\xa6\xb9\x50\x14\xef\x5b\xed\xee\x25\xda\xc4\x69\x22\x32\x34\x2f\x33\x89\xd2\x30\x4c\x74\xbb\x3b\x92\x74\x37\xcc\x42\xad\x5d\x1c\x06\xde\xc1\x48\x67\x0b\x71\xc9\x0a\x1e\xe8\xb2\xf7\x54\x81\x37\xd5\xe6\x93\xaf\xf3\x85\x05\x4d\x46\x84\x7d\x7f\x61\x8b\x35\x74\x21\xd6\xd2\x2a\xf3\xf8\x72\xc1\x58\x5b\x83\x51\x53\x00\xa0\x59\x92\xe3\xfe\x48\x41\xc2\x67\xec\x24\x21\xdb\xf8\x91\xbc\xc0\x66\xaa\x30\x01\x6e\x60\x41\xb9\xcb\xcf\x09\x75\x95\x8b\x94\x5f\x15\xa6\x82\x0d\x32\xbc\xdd\xb1\x7f\xa0\x66\x77\x14\xad\x82\x28\x60\x87\x66\xc7\xc6\xcc\xfe\x7b\xce\x4f\x4d\xc2\xf5\x1b\x10\x72\x4b\x02\xa8\x41\x54\x73\x95\x09\x5f\x88\xf1\x57\xf1\xd3\x5a\x9c\xbf\xc4\x08\xcd\x07\x68\xd6\x0c\x0d\x70\xf8\x10\x9c\x6d\x76\x2e\xaa\x5c\xb5\x8d\xc9\xbf\x89\x1c\x7b\x6c\x9b\xa7\xbe\x99\x1d\x91\x31\x73\xce\x2c\xb5\x44\xcc\x17\x12\x9c\x4f\x35\x7d\xb5\xaf\x5f\x4b\xc0\x3d\x8a\x5a\x6e\x1f\x95\xda\xe2\xed\xf3\x5f\x5c\xc0\x0f\x7f\x81\x97\x71\xc2\x5a\x29\x25\x89\x74\xcc\xcf\x27\x0c\x91\x85\x40\x73\x58\x8e\xfc\xa5\xd5\xde\x81\xda\x56\x85\x4f\x95\xd8\x52\x15\xf4\x34\xbc\xf0\x33\x5b\xb1\x1a\x53\x1e\x4a\x5e\xd5\x15\x3d\xdf\x40\x92\xb5\xd0\x57\x89\xd5\x82\x28\x25\xd2\x36\xd7\x51\x73\x5e\xa1\x37\x86\x04\x92\x6d\xae\xa3\xa7\xc8\xc2\x1f\xc0\xc1\x7f\x38\x9d\xde\x4c\x6e\x87\xfd\xe6\xe7\xd9\xdb\xb7\x97\x4d\x55\x09\x14\xb6\x8c\x9e\x17\x27\x6b\x82\xd9\xcc\x56\x32\xf8\x66\x32\x9d\x23\x1c\x29\xbb\xa2\xc1\x1c\x90\x9f\x12\xe5\x25\x3e\xba\x45\x62\xdf\xa2\x90\x42\x9c\x42\x06\x35\xae\x0f\x9f\x76\xda\x5b\x9c\x3c\x2c\xd2\x88\xcb\x1e\x56\xde\x10\xab\x38\xac\x20\xbe\x71\xe8\x93\x64\xc1\x36\x38\x42\xf3\xd1\xa7\xe1\x6c\x3e\xf8\xf4\x79\xfe\x9f\x1d\x91\xcb\x04\xd8\xb7\xf9\x7b\xb1\x66\x6c\xd1\x79\x9f\x0b\x58\x38\xf2\x88\xf0\x5b\xd7\xa9\x50\x40\x92\x02\x66\x2a\xb0\x38\x89\x77\x68\x17\x07\x11\x13\xe2\x95\xc8\xa8\x07\x95\xfa\x28\x43\x34\xd8\x06\x21\x4e\xb4\xbb\xbd\x28\xaa\x16\xa3\x3d\x1f\x2d\xa0\x48\xd7\x99\xa1\x31\x12\xa5\x29\x56\x41\xc8\x44\x2a\x3e\x1c\x86\xba\x0c\x1b\x6f\x0e\x23\x2f\x09\x89\x54\x2f\x39\xea\x32\x65\xba\xea\x01\xd7\x17\xa0\x82\x1b\x66\x72\x3c\xb1\x5c\x90\xf3\x49\x64\x07\x66\x1d\xac\x1e\x22\xfe\x89\x12\xe6\x4a\xbf\x61\x46\x10\x65\x6f\x53\xa3\xf9\xb7\x68\x17\xc3\x5b\x2b\x0e\xc3\x03\x54\x3c\x91\xe7\x64\x87\xeb\x18\x17\xcc\x07\x3b\xa5\xc7\x72\xb9\x35\xca\xa2\x86\x20\x9e\xc7\xf1\x6a\x04\x07\xfa\x35\x7a\xf3\xee\xe2\xce\xfa\x2a\x6e\xde\x6b\x4f\xfc\xbe\x0f\x33\x83\x05\x4c\xad\xe4\x2b\x63\x25\x6d\xae\x4a\x47\xab\x20\xd9\x12\xbf\x16\x54\x2a\xd6\x54\x02\x60\xc7\xd2\xc6\x93\x92\x27\x3a\x63\xa2\x4b\x77\x79\xec\x62\x1c\x19\x60\xc3\x22\x8b\xd4\x73\x54\xc7\x36\x5a\xf4\xcc\xa4\x38\x25\x2b\x36\xda\x68\xb8\x71\x40\x7e\x95\x3b\xc2\xcc\xb0\x27\x9c\x1f\xf1\x6e\xc7\x15\x9b\x3f\x8a\xda\x97\x61\xf0\x40\xc2\x43\x96\x43\x24\xde\x12\x61\xc9\xa5\x0c\x27\xc2\x02\xce\x10\xc1\x49\x18\x10\x2a\x42\x61\x0a\x83\xeb\xd4\x94\xb0\x84\xc1\xec\xa6\xd0\x22\x4f\xe8\xed\xb4\x99\x6d\xd4\xed\x66\xc6\x44\xae\x4f\x47\xe4\x89\xf1\x05\x30\x28\xf4\x2f\x0d\x8c\x22\x3d\x16\x65\x90\xa3\x4d\x5e\x31\xf6\x14\xc9\x6a\x21\x01\x53\x41\x86\x10\x02\x28\xf1\x03\xb2\x41\xa1\xd6\x32\x66\x1b\x59\x53\x77\xab\x8c\x8c\x66\x20\x5a\xfb\x19\x81\x70\xb9\x1a\xd6\xce\x78\xbd\x42\x2d\xeb\xdc\x7b\xb4\xab\xa6\x75\xe1\xe5\xd5\xf6\x36\x52\xe1\xc1\xae\x6b\xd1\xb6\x03\x14\x4d\xea\x6e\x12\x76\x93\x98\xd7\x0f\x9f\x39\xbd\x24\x39\x79\xda\x05\xc9\x71\x8e\x93\xe0\x68\x81\x59\x3d\xae\x62\x8a\xd9\x1c\x27\x04\xe8\x0a\x6c\x49\xc8\x10\xa2\xa0\x59\x1c\xe5\x64\x15\x04\xd9\xa9\x9e\x98\xe3\x67\x33\x19\x16\xd4\x44\xb7\x5f\x43\x10\x23\xde\x26\x0a\x3c\x7e\x23\x39\xe2\x7a\x38\x92\x47\x08\x06\xef\xd1\x2d\xfa\x3a\x87\x17\xa8\x2b\xb1\xbf\xdb\x45\x9c\xbf\x04\xac\x49\x11\x0e\xf7\xf8\x40\x11\xc5\x2b\x60\xf4\x21\x91\xac\x6e\xab\x4c\x49\x42\xe8\x5b\x06\x0c\x85\x71\xb4\x26\x89\x29\x58\xc1\xae\x05\x2a\x2f\x84\xc9\xc4\x9a\xb0\xfb\xa7\xce\x79\x98\xe9\x16\xca\x72\x30\xee\xe4\x60\xda\x31\x00\xa9\x9f\x12\x90\x2e\x14\xa4\x5e\x09\x24\x8c\x58\x1c\x23\x1a\x4b\xdb\xda\xe8\x83\x3a\xf8\xaf\x0b\x27\xf9\x47\x6d\x68\x70\xbd\xfa\x38\xde\x2f\x8e\x04\xfb\x42\xb2\x21\xc0\xf9\x42\x26\xb6\xd1\x2d\xcd\xf2\x18\xa9\xb3\x4c\x08\xc2\x1e\x4b\xe1\x98\x7d\x82\x7d\x9b\x53\xf3\x5f\x8e\xf1\xa1\x9c\x7f\x58\x9e\x9e\x94\x31\x02\x93\x1c\x7c\xdd\x2f\x72\x65\x93\x2c\x54\x72\xa9\x3c\xb7\xaa\xc1\x96\x8b\xcb\xc9\x25\xf1\x2b\x6b\xec\x22\xf2\x0e\x62\xaf\xc2\x9b\xcb\x61\xe7\xa8\xeb\x56\x09\xb8\x13\x80\x56\xa4\xa3\xea\x84\x8c\xd3\x04\x7e\xe4\xc5\x91\xb8\x3f\xde\x41\xd6\xe5\xd5\xe9\xac\x38\x87\xfa\x35\xc5\x90\x97\x8a\x73\x16\xbb\x54\xa5\x91\xfe\xad\x93\x2f\x40\xd3\xee\x80\xaf\x4b\x92\x10\xaf\x0a\x00\xd5\x4c\x28\x87\x67\xd5\x91\xd9\xe7\xc1\x47\x95\xca\x7b\x05\x18\x15\x32\xec\x89\x0a\x0c\xf2\x1f\xb7\xa3\xd9\x7c\x34\xbe\x99\xa3\x5c\xea\x60\x4c\xf3\xd9\x83\x0d\x52\x66\xe3\x53\x25\xf3\xb3\xc9\x56\x5b\x11\xb7\x7c\x06\x3a\xe3\x75\x12\x92\xb7\x51\xb2\xc3\x09\x17\x1a\x60\x54\xe1\x13\x10\x33\x84\x21\x5b\x55\x56\xad\x2c\xcb\xf1\xfc\x07\x4a\xc8\x1f\xe4\x50\x06\x95\x49\xe2\x3d\x55\xcb\x45\x78\x19\x3f\x42\xfa\x68\xf9\x43\x4f\xb6\x1f\xc7\x8c\x5c\x09\x48\x3e\x92\x44\x11\x23\x23\xd7\xb6\x48\x4e\xab\xa6\x55\x19\xdd\x04\x5d\xf3\xe2\x88\xb2\x04\x07\x11\xa3\x66\xc0\x70\xc2\x65\x3c\x28\x9e\x16\x53\x02\x19\xe2\x20\x47\xe5\x06\x47\xeb\x20\x5a\xf7\x8e\x11\x4f\x91\x78\xc6\x16\x35\x64\x6a\x81\x32\xca\x57\x7e\x5c\xf2\x68\x2f\x2e\xb3\x63\xa5\xad\x2c\xbf\xf3\xab\xc8\xe1\xf9\x7a\xd3\x82\x98\x55\x4a\xe3\x56\x1b\x55\x97\xfa\xbf\xff\x77\x81\xaf\x3f\xc9\x5a\xbd\xba\x3c\xf5\xa9\x22\x6f\xed\x22\x96\x95\x39\x16\xdc\x42\xa0\xba\x34\xfc\x32\x3f\x90\x03\xfa\x6f\x7d\x94\x25\x7a\xbb\x2e\xbf\x1e\xed\xd2\x8c\x8c\x9c\x99\xe3\x80\x89\x10\x73\x21\x55\xc8\x1a\x7c\x2a\x01\xe1\x12\x78\x3d\x11\x79\xdf\x49\x24\x55\x62\xae\x44\x87\xa1\x4c\xde\xa7\x46\x32\x3a\x82\x86\x4f\x89\x7c\x6d\x01\xca\x04\x38\x29\xda\x1a\xb9\x39\x4a\xa5\x95\x2c\x3d\x07\xb8\x41\xcd\x46\xdf\x89\x14\x1d\x95\x06\xcc\xa2\x18\x0e\xc6\x73\x4b\x5e\xea\x14\x24\xac\x36\xea\xa3\x56\x26\xe7\x74\xc4\x5b\x50\x3b\x77\x40\xb6\xd8\xf7\xb5\x25\x18\xa1\x93\xf3\x44\x34\x1a\xdd\x2e\x58\x44\xe4\xdb\x14\xb8\xae\x28\xff\x0e\xe1\xda\x0a\x10\x57\x92\xd5\x86\x70\xf5\x2e\x89\x77\x49\x00\x8e\x14\xa5\x15\xe0\x3f\x4f\x27\x37\xc3\xdb\xfb\x69\x01\x36\x46\x71\x65\xf9\xd2\x61\x09\xec\xc6\xe3\x76\x99\x85\xa8\x28\xc7\xa3\xdb\xe1\x87\xc1\xfd\xdd\x5c\x40\xac\xd1\x46\x95\xf6\x72\x95\x07\xa7\xa0\x26\x8c\x07\x9f\xa4\x97\x8f\xdb\x08\x25\xbe\x41\x6a\x03\x3f\xd8\x92\x08\x2c\xad\xa2\xb0\xb2\xc3\x32\x69\x27\xae\x29\x33\xbc\x1b\xd9\x57\xa1\xf1\x4b\xba\x48\xcb\x85\x18\x70\xfc\x63\xf1\x89\x2d\x5b\x58\xb6\xe9\xec\x56\x9a\xf6\xc2\xcb\xea\x47\xe7\x5a\x29\x32\xc4\xb0\xaa\x98\x2d\x00\x58\x83\x12\xc9\xaa\xb6\xc5\x2f\x4e\xf3\x50\x2f\x47\xfb\x4d\xe0\x16\xce\xa8\xc8\x25\x4e\x73\x7d\x36\x6a\x09\x38\x3b\x66\x9b\xf0\xa1\xaa\x80\xdf\xb3\x5d\x97\xfb\x68\xd3\x73\x33\x9f\x4a\x77\xea\x63\x2e\xd4\x0d\xa7\x99\x84\x83\xa6\x60\x26\x91\xfc\xc2\x78\x09\xcc\xce\x02\x23\x06\x7a\x89\x81\x29\x2d\x4d\xee\xda\x2e\x7f\xac\x87\x28\xde\xf3\x83\xca\x0d\x06\x09\x17\x91\x97\xb2\x6e\xbc\x5a\xe9\x87\xee\x20\x5a\x53\xfd\x96\x6d\xda\x42\x73\x47\x9a\x43\x21\x46\x92\x08\x87\x3d\x16\x2f\xf4\x5b\x67\x2b\xe1\xc4\x7b\x41\xa2\x1c\x28\xe1\xec\xb3\xd5\xd7\x3c\x6d\x61\xae\xf2\x4e\x3a\x68\x11\x03\x9c\x49\x41\xc8\xf3\xe0\xc0\x3d\x51\x46\xc2\xf3\x64\x8b\xa0\xb0\xc2\x9a\x08\xb4\xa0\x61\xe0\x11\xe4\x53\x81\x47\x54\x8f\x9b\x6b\x51\x98\xa1\xdb\xd5\xc0\x41\x01\x45\xe4\xc9\x0b\x53\x1a\x3c\x12\x91\xda\x45\xd4\xc5\xe5\x02\xdf\x41\x98\xdf\xbe\xb6\x4e\x5b\xe4\xcc\x0d\x28\xc2\x21\x8d\xb3\xbe\x2e\x84\xf5\x69\xcf\xa2\x7e\x7d\x07\x45\xe4\x68\xeb\xd3\x5e\xb6\xa0\xaf\xfb\xe5\xa7\x9b\x46\xc1\xd3\x62\x1b\x78\x49\x4c\x89\x17\x47\x3e\x6d\x65\x2b\x6b\xbb\x31\x3c\x1b\xf8\x76\x58\x86\xe7\x65\xc5\xf1\xbb\x5d\xe1\x77\xc1\x41\x02\xe6\xbd\x18\xed\x49\x53\x7a\x2e\x6e\xe2\xd0\x17\x5e\xb9\x07\x24\x0a\x81\xca\x8a\xc0\xf2\x9c\x60\x94\x9b\xc9\xa7\x4f\x23\x69\xc9\xc9\x3d\x29\x6a\xd1\x2a\xf6\x1e\x14\x91\x5e\xc5\xc9\x62\xcb\x71\x85\x44\x38\xf2\x34\xa5\x0e\x7c\x23\x94\xc5\x38\x0b\x69\x9e\xb3\xe9\x26\x5f\xf4\x23\x01\xf9\x3a\x5d\x6f\x0c\xa9\x1c\xaa\x7d\xef\xd1\xc8\x87\x9c\xb8\xa0\x1b\xc5\x2b\xad\x86\x74\xcc\x01\xf6\x04\xea\xcb\x50\xa6\x9f\x3d\xe2\xd0\x47\x71\x24\x5e\x38\x04\x87\x6f\xe4\x1d\x22\x6a\x59\xdc\x6c\x1d\x48\xf0\x67\xc3\x01\xa2\xd4\x9c\x92\x63\x30\xef\x8e\xcd\xee\x78\x5e\xaa\x69\x7b\x7c\x8d\x63\xcb\xad\xfe\xab\x17\x62\x8f\x67\x05\x36\x15\x46\x31\x37\x09\x22\x97\x29\x6b\xe5\xff\x24\x44\x24\xe9\xea\xbf\x57\x1e\x2e\x6f\x46\xc2\xb1\xc5\xe2\x46\x39\x69\xde\x5d\x6c\x30\x3b\x82\xfe\xfb\x12\x8a\x2c\xdc\x41\xec\x67\x20\xd3\xa3\xe7\xe4\xf5\x67\xfc\xb1\xff\xde\x42\x08\x67\x6b\x83\xdf\xf6\xdf\xe7\x76\x78\xc2\x96\xdc\x6d\x3d\x4c\x3d\xec\x93\x05\x8b\x17\x5b\xcc\x48\x12\xe0\x30\xf8\x07\x00\x97\xf6\xdf\x43\x28\xdd\x51\x50\xe4\xe8\x55\x01\x34\x05\x0f\x9e\x32\x83\x56\xef\xcd\x28\xf7\xfa\x76\x57\xf0\xc1\x31\xef\x4c\x89\xb7\xd5\x2b\xdf\x9b\x3f\xbd\x38\xcd\xa9\xa1\x02\x41\xb6\xba\x89\x2a\xa2\x26\xd2\xa6\x89\x0a\xed\x54\x88\x7f\x9c\xd4\x70\x46\xb0\x0e\x1e\x09\x45\x29\x15\xb5\xb8\x44\x95\xae\x00\x8a\xa6\xe8\xd7\x23\xd0\x8f\x82\xd5\x8a\x70\xbd\xac\xd1\xed\xea\xf4\x90\x40\x92\xf5\x97\xac\x07\x3d\x2b\x62\x95\xf2\x33\x62\x0b\x4e\xe2\x17\xd9\x8d\x68\x19\x45\x57\x86\xf3\xc9\x87\x12\x5f\x1a\x11\xf8\x95\xe9\x28\xcf\x4f\x76\x97\xab\xca\x18\xc5\x28\x21\x38\x44\x74\x13\x27\xcc\x4b\x99\x78\xf0\x5b\x73\x35\x3d\x4e\x19\x0a\x32\x9e\x04\x4c\x8a\xf8\x90\xf5\x21\x4d\x22\xae\xc0\x3b\x07\x95\xee\x32\xff\x71\x3f\x9c\xfe\xd8\xa8\xb0\x39\x6f\x7b\x5f\x38\x3f\x1f\x4d\x41\x52\xfa\xcc\x28\xd0\xa1\x95\xbb\x97\x2e\x6e\xe6\x74\xef\x73\x2e\xdc\xb1\xd8\x5a\x0b\x14\xf7\xb7\x2a\x90\xf3\xb2\x58\x2c\x96\x6e\xe2\xbd\x22\x98\xc7\x28\x7a\xaf\xca\xcb\xd5\x4d\x03\xc7\x93\xef\x5b\x6d\xd4\x3d\x29\x27\x8d\x1d\x74\x6e\x96\x34\x94\x97\x4f\x5c\x2d\x10\x3f\x8d\x82\xec\x94\xe1\xe4\x51\x27\x6e\x2d\x3b\xa6\xea\xb8\xce\xb3\x22\x39\xcb\x6e\x5b\x9d\x38\xce\x52\xdb\x07\x79\x22\x5e\xca\xc8\x02\x1e\xe2\x0d\x18\x09\xef\xd2\xb6\x23\x3c\x33\x41\xd3\xe1\xcd\x64\x7a\x6b\xda\x17\x10\xd4\x00\x8a\x23\x82\xc2\x38\xde\x09\xaa\xa5\xfc\xb5\x20\xc3\x63\x2e\x17\xae\x0a\x2c\xdb\xe3\x80\x69\x63\x6c\xb7\x0b\xd5\x03\x71\x18\xc6\x7b\x8a\x0e\x71\x2a\x22\xab\x4c\x0d\x81\xff\xe8\xe1\x48\xe5\x99\x7c\x8c\x03\x10\x8d\xe1\xf9\xdb\x8b\x23\xb9\xfa\x6c\x38\x02\x7e\xee\x04\x2d\xb1\xf7\xa0\x15\x79\x6d\x4b\x82\x42\x1e\xb0\x32\x10\x3d\x05\x11\x00\xd7\x1a\x1c\x30\x25\x65\xf3\xb1\xd5\x80\xdf\xc6\x3b\xb2\x4a\xc3\xf0\xd0\x11\x9d\x61\x5e\x28\xd3\xb2\x47\xab\x84\x10\xbf\x87\xe6\x60\xf9\xf6\xe2\x38\xf2\x25\x2c\x70\xc0\xa8\x9e\x9b\xf7\x50\x36\x72\x17\x4a\x89\x99\x3e\x4c\xa6\x28\x41\xa3\x42\x58\x78\xf5\x45\xad\x41\x97\x85\x89\x51\x96\x12\x12\x8c\x74\x3c\x1f\x8d\xef\x87\xa2\xf6\x8c\x83\xf6\x56\xb1\xd1\x04\x12\x2e\xf3\x0d\xf6\xdf\x2b\x5f\xf3\x6a\x8f\xe2\xa2\xa1\x2d\xb1\x2b\xd2\x9e\x71\x8f\x13\x57\xf2\x88\xbc\x90\x90\x95\xcf\xf9\x8d\x01\x7c\x86\x74\xc2\xc1\x6a\x6e\xe3\x5f\x14\x90\xc2\x53\xb0\xe8\x25\x68\x39\x07\x9e\x4d\x77\x46\x33\xd4\xe4\xbb\xa4\xa0\x11\x42\x3c\x9d\x95\xf4\x5a\x27\xdb\x36\x12\x75\x40\xdf\x9e\xa8\x41\xba\x4b\x62\x8f\xf8\xa9\x2e\x4a\xc4\x89\x4f\x92\x46\x28\x21\xeb\x34\xc4\x49\x78\x10\x22\x93\x97\x88\xac\xb1\x4d\x90\xbe\x76\xe9\x32\x0c\x3c\xa3\xaf\xb0\xf2\x7b\x20\x6d\x70\xa9\x8c\x37\x6f\x74\xbb\x09\x98\xa6\xf8\xad\xff\x25\xa5\x4c\x94\x34\xcb\x2d\x66\x99\x32\xf1\x70\x05\xa1\x32\x11\xe1\xa4\x50\x25\xb4\xed\x76\xa5\x03\x05\xf6\x7d\x44\x59\xba\x5a\xa1\x90\x0b\xe6\x9a\x2c\x72\xbc\xe2\xfb\xdc\x91\x78\x27\x22\x0c\xc5\x13\x01\xdf\x75\x90\x88\x45\x53\x2f\x09\x76\x4e\xb9\xad\x00\x73\xf0\xcb\x55\x00\x37\x31\xad\x5d\x10\xc2\x5c\xc8\x76\xe4\xa8\xae\x1b\x2f\xa3\x22\x56\x4d\x6d\xfa\x11\xdb\xf3\x1a\x4e\xfa\x67\x60\x63\x05\x64\x38\x02\x0e\xc5\x17\x64\x7c\x41\x0c\xd3\x07\x59\x6c\x0a\xec\x86\xfc\x9c\x8a\xe8\xf9\x72\x58\x79\x06\x2f\x37\x96\xbb\xf8\x25\x5e\xb6\x7e\x89\x97\xaa\x36\x9e\x78\x37\x5b\xab\x52\x50\x55\xc7\x5f\x0e\x1b\x25\xdd\x38\x80\x5d\x37\x00\x41\x2c\x23\xbf\x52\xda\x8a\xd2\xed\x92\x24\xf0\x77\xb1\x5e\xa8\x0a\xe7\x6d\x88\x9f\x86\x59\xf6\x66\x94\xe5\xdb\xad\x13\x96\xe0\xc1\xd3\x9d\x15\x85\xa0\xf5\x78\xa1\xc8\x65\x50\x32\x22\xfe\xcb\x72\xac\xf0\xc5\x19\x6e\xfe\xfc\x4c\x75\x4a\x15\x94\xab\xe2\x27\xcc\xe5\xd0\x44\x19\xd3\x4b\x4e\x49\xb4\x2c\x6e\xf5\xbf\xf5\xdd\x30\xb8\x6e\x58\x2f\x2a\x5e\x9c\x46\xac\xf5\x85\x7c\xde\xf7\x22\xf6\xbb\xed\x23\xb3\x20\x72\xb8\x7f\x8d\xcc\x23\xb5\x2e\xbc\x19\xaa\xc5\x0f\xa0\x59\x0f\x9d\x9b\x25\x48\xd1\xb6\x79\x37\x10\x5d\x23\x25\xfe\x65\xc7\x5c\x49\xd7\x8b\x58\xdb\x95\xeb\x42\xac\xfa\xfd\xf1\x55\x97\x60\x4e\x7d\xa8\xbf\x3c\xe4\x1b\xb6\xc1\xb9\xe5\x45\xac\x6b\xec\xc3\xb5\x5f\x2b\x99\xc0\xcb\x04\x80\x94\x5d\x6d\xb8\xce\xd9\x61\x71\xfa\x7a\x03\x4d\x55\x8d\x0f\xb1\x54\x08\x60\x15\x7d\x3d\x02\x09\xdd\x45\xb4\xfc\x41\xd6\x87\x5f\xea\x3b\x92\x74\x44\xe1\xc0\x30\x94\x05\xc9\x83\x44\x7f\xf3\xf5\x4c\xcd\xeb\xfa\x61\x51\x01\x5d\x50\x26\xb8\x55\xf2\x40\x92\x96\x48\x37\xe2\xc7\xe9\x32\x24\x5c\x58\xf7\x02\xce\x81\x8e\x25\x5c\x93\x37\x72\x15\xc6\x98\xfd\x8d\x92\xc8\x6f\xc9\xcc\x28\x7d\xd4\xfc\x7f\x9e\xfe\xba\x5a\xbd\x35\xfe\xbc\x6b\x3a\x73\x9b\x8d\x3e\x7d\xba\x3f\xab\x50\x6c\x7e\x0b\xc5\xc5\x5b\x05\xca\x92\x14\xca\x16\x72\xd0\x8a\xcd\x72\x05\x0c\x7d\x4e\xc0\x25\x9a\xa4\x14\xc1\x60\x48\x0c\x56\xbb\x76\xec\xd1\x45\x9c\x9d\x7a\x28\xa0\x8b\x88\x5f\xa5\x70\x11\xe1\xe8\xb5\xce\xe7\x6f\xc6\xf9\x5c\xbe\xfc\xf9\x18\x1b\x38\xeb\x74\xc6\x78\x7c\xca\x49\x54\x4d\x77\xf6\x39\x58\x25\x14\x94\x47\x10\x82\x18\x9f\x8c\xac\xcc\xc0\x6b\xa2\xbc\xec\x3e\xec\xa9\xd4\xbb\x20\x73\x05\x02\x2a\xa9\x7b\x59\xb5\xf1\x5f\xa8\xbc\xb2\xcc\x88\x5f\xac\xde\x27\xaa\xf5\x08\xe0\x83\x23\x0a\xd6\xa5\xf1\x6b\x9f\x81\x1a\xfc\x1c\x60\x9b\xc2\xb4\xae\x65\xb5\xf0\xe2\x30\xdd\x46\xc2\xbd\x89\x6b\x8f\x8f\x01\xd9\xb7\xf4\x67\x08\xb9\xec\x70\x38\xe9\x68\x52\xf1\xa2\x22\xb6\x35\x1e\x7c\x1a\x3a\xc5\xa4\x80\x2e\x12\x42\x49\xf2\xc8\x75\x1d\x95\x2d\x47\x89\x4c\x96\x8b\x1b\x9f\xa4\x8f\x06\xe3\x1f\x5b\xc2\x33\x0c\x02\xd8\x9b\x1d\xd4\x14\x21\xec\x1d\x2b\x20\x1e\x35\x65\x61\xc3\x9f\xf9\x3a\x4c\x97\x08\x63\x42\x60\x47\xa3\x0f\xd6\x1a\x34\xdb\xcd\x26\xbd\xea\xcb\xd1\x16\x4d\xf4\xcf\x7f\x66\x1f\x38\x67\x33\xf8\x1a\x1f\xc8\xe8\x2f\x99\x5c\xab\x46\xb9\xb8\x07\x72\xc8\x00\xd9\x6e\xf7\x02\xdf\x04\x36\x4c\xa3\xde\x3e\x9e\x31\xaa\xa8\x02\x9f\x1f\xf8\xa4\x70\xe3\x93\xec\x87\x47\x30\x47\xe0\x8b\x42\x96\xe7\x94\x8d\xb4\x6b\xf6\xc0\xe0\xf9\x6a\xdc\x3a\x28\xd8\xa6\x14\x95\x61\xc5\x46\xe5\x36\xbe\x03\x2a\x83\x8a\x39\x09\x09\xc8\xde\x8c\x33\x46\xe5\xa5\xc5\xf2\xd4\xa7\xd9\x01\x1c\xa2\x2c\x09\xa2\xf5\x02\xaf\xd7\xb6\x29\x5b\x15\xd8\x6e\xe6\xaf\xb2\x74\x30\x13\x48\xfd\xd3\x1b\xfa\x33\xf8\x76\xbd\x19\x35\x3b\xbb\x98\x5e\x5d\x81\x98\x73\xfa\x19\x40\x06\x35\x61\x44\xcb\x04\xc9\x0e\xe2\xd7\x27\x33\x2f\xef\x62\x5a\xcc\xcd\x94\x07\x4e\x35\x41\x85\x15\xec\x62\x2a\xaa\xa8\x85\x0f\x3b\xb3\xf2\xeb\xc3\xce\x34\x01\xa1\x3e\x2a\x9e\xa7\xd5\x00\x47\xbe\xcb\xdb\xb2\x61\xbd\x2e\x58\x35\xa6\x94\x87\x94\xb9\x01\x7d\x86\x46\x0d\xaa\x53\x32\xb6\x6f\x4f\x5a\x74\x2e\x6c\x82\xcb\xf2\x83\xb9\x99\x73\xa0\x88\xed\xdf\x8d\x86\xdf\xab\x75\x98\x91\x51\x83\x59\xce\x7e\x68\x21\x10\x38\x3c\x65\x31\x04\xf6\x43\x46\xce\x49\x9e\xff\x79\xf3\xee\x82\x5a\x2a\x84\xfd\xb2\x52\x12\x9d\xa5\xa7\xa8\x1b\x5e\x75\x71\xd1\x31\x21\x9e\xc7\x9e\xf3\x03\xc3\xcf\xab\x38\x9e\x51\xa0\x97\x20\x3c\xf2\x9c\x7f\x03\xc2\x53\xc8\x70\xf0\x0a\x94\xa7\x40\x69\x5e\x8c\xd0\x40\x06\x8e\x7f\x3d\x3a\x63\x1c\xdf\x2b\xd0\x19\x67\xb1\xbb\x17\x20\x34\x25\xab\x7e\x26\xa1\xf9\x34\xe4\xab\xae\x43\x68\x7c\xcc\x70\x0f\xdc\x29\xb8\x1a\x1c\x6c\x73\x3e\x26\xf0\x59\x48\xb0\x98\xca\xdc\x85\xc5\x06\x46\x60\x6d\x29\xd1\xb2\xf0\xf1\x3c\xda\xa5\x89\x16\x9f\xd4\x76\x92\x1b\x7e\x98\x0b\x67\xc4\xa3\xa4\x0e\xdc\x10\xe5\x62\x40\x1b\xb0\x77\xd0\xd6\x74\xce\x3c\xf1\xdf\x8f\xd0\x99\x44\xe9\xd9\x84\x4e\xd2\x75\xb9\x59\xae\x92\xc8\xf1\x5b\x9a\x16\x75\xb2\xf3\xa3\xb2\x86\xff\x4f\x3f\xe7\xcb\xfe\x17\x36\xd8\x18\xcc\x1a\x17\xe5\xc9\x5c\x74\xdd\x7f\xa4\x58\x0b\x65\x5b\x96\xd1\x3e\xf5\xab\x88\x16\xca\x7e\x4e\xe2\x3d\x5d\xe0\xd5\x0a\xa2\xbf\xe4\x6a\x64\x1c\x6a\xba\x5d\xc0\x57\x15\x1c\x24\x3e\x72\x19\xff\x6d\x65\xc2\x18\xab\x16\xab\x58\x5c\xd5\x05\x76\x5d\xde\x7e\xb6\x9b\xf3\x3d\xe3\x3e\x4c\xa6\x16\x2c\x46\x63\x67\x52\x6b\x71\xef\x9b\xa6\x43\x14\x47\xe7\xde\x9b\x77\x17\x23\x3b\xee\x06\x70\x99\x6b\x55\x17\x97\xed\x66\xc7\x74\x0a\xb3\x2a\xae\x16\x5d\x89\x4b\x43\x84\x5a\xba\x88\x8e\xb7\xf1\x3a\xc8\xdb\xb4\xdb\x3d\x19\x40\xb3\x5b\x2f\xa0\x10\x61\xce\xd9\xd8\xe1\x1c\xbc\x5b\xc3\xbc\x74\x87\x3d\x82\x22\x8e\xf0\x5e\x2f\x21\x61\xf6\x5b\x1f\x45\xbd\xb8\x24\x4a\xb2\x96\xf3\x32\xf2\x36\xc2\x63\xd9\xf2\x3c\xe7\xeb\x35\x5d\x41\x20\x16\xa5\x17\xd1\x9d\xfc\xa8\x16\xd1\x76\x4e\x9c\xd1\x93\xca\x79\xc1\x55\xda\xb3\x32\x7c\x2b\x6f\x69\x4e\xe1\x37\x5e\xcf\xb1\x31\x19\xf6\xc2\x37\x6d\x86\x26\x55\xf8\xb8\x54\x39\x2d\xb6\xaf\xae\xe2\xbc\xe7\xb4\xc8\x11\x80\x8d\x9a\xbd\xc6\x9b\xb2\xc9\x56\x4c\x04\x14\xa1\x4c\xd9\xe5\xb7\x9d\x85\x3e\x0e\xe7\xe8\x76\x34\xf8\x38\x9e\xcc\xe6\xa3\x9b\x59\xee\x66\xf6\xd1\x74\xf2\xfd\xe2\x66\x72\xaf\xe2\xc1\xd5\x9f\xc2\x35\xed\x17\x7f\xfa\xa3\x3d\x98\xed\x88\x24\x5e\x8b\x0b\x6e\x83\x39\xbe\xd8\x2c\x75\x18\xbc\x3c\x72\x4d\x5c\xe1\x5c\x2e\x18\x9c\xb1\xff\xb3\xf7\x6e\xfa\x2a\x56\xbb\x10\xca\x95\x4a\xb4\xe4\x43\xb7\x04\x7a\x67\x5b\xb0\x39\x55\x7e\x01\xfa\xe9\x13\x5e\xb0\x8f\xfc\x41\xdf\x05\x64\x4f\xd1\xb1\x66\x27\xe5\xd7\x31\xb8\x5b\xc6\x64\xc0\x0a\xd7\x52\x8f\x8e\x79\x09\xdc\x24\x67\x28\x5f\xa8\x5d\x17\xf5\x2d\xd4\x25\x51\x26\xfd\xbc\x4f\x58\xae\xaa\x9c\x6e\x66\x24\x29\x74\x7d\x65\x31\xc3\xa1\xe3\x43\x6e\x74\x91\x05\xd1\x7a\x82\x5e\x1e\x18\x51\xac\xb5\x23\x12\xf9\x94\x7f\xcf\x0d\x27\x66\xa5\xc1\x3f\x48\x6e\x98\xec\x83\x84\x91\x39\x62\x82\x59\x10\xf3\xb3\x27\x49\xe0\xb9\x87\x94\xe1\x72\x6a\xb8\x3c\x41\x53\x5f\xda\xce\x2a\xc3\x2f\xe3\x7c\x59\xe9\x1f\xe9\x90\x5c\xb5\xa6\xec\xf4\xf2\x73\xd6\xde\x73\xba\x58\x3b\x3f\x1b\xa5\xff\x1b\x4e\x07\xf3\x5c\x4d\x39\x57\x23\x1b\xb3\x9c\x4d\xb8\x62\x0d\xca\x98\xc0\x22\x07\xca\xd5\xe9\x66\xe3\xa2\xb3\xc7\x6e\x6d\x60\x4d\x2b\xc3\x16\x08\x29\x2e\x43\xd2\x8a\xb9\xa5\xb8\x85\x69\x19\x02\x9f\xbe\x8a\x3c\x6e\xbb\x8f\x4d\x37\x72\x83\x3c\x8f\xf5\x15\x83\x08\xc4\xae\x1c\x46\xa3\xbf\x33\x58\xba\xf0\x63\xab\xc2\xad\xb7\xd4\x13\x95\x6b\x9b\x9d\x8a\xaf\xc7\x10\x59\x36\x3b\x82\xcf\x22\x59\x01\x64\x0e\x28\xf7\x89\xcd\x44\xbf\x07\x72\xa8\x6c\x76\xa2\xe6\x5e\x9d\x2e\xa3\xa8\xd1\x5b\xbb\xae\x1c\x41\x5b\x1d\xa0\xde\xc3\x91\x5b\x5b\x56\x7f\xb3\x9e\x93\x2f\xc7\xd1\x5a\xf7\xde\x75\x45\x31\xad\x43\x0e\xac\x4b\xb2\x4b\x08\x63\x87\xd6\x6e\xbd\x10\xf8\xaa\x82\x5a\xe0\x6b\x45\xea\x56\x53\xea\xcd\x4a\x86\xb7\x73\x77\xac\x7c\xfe\xb7\xbd\xb7\xb0\xdc\x5a\x57\xc9\x49\x12\x8e\xde\x2f\x67\xaf\xe3\x97\xee\x64\x2f\x78\x30\xae\x07\xd7\x8d\x93\xdc\xdd\x5f\x26\x5e\xa9\x94\x9b\x95\x90\x03\x37\x19\x38\x72\xfd\xab\xaf\x7d\xc5\x75\x3f\x72\xcd\x9f\x79\xbd\xcf\xbf\xd6\xf5\xaf\xf3\x2b\x5f\x63\x3f\xd8\xd2\x9e\x55\xf4\xbf\xce\x15\xf6\x82\x5e\x2d\x16\xee\x05\xbd\x63\x3c\x7b\xe3\xd1\x9e\x83\x2f\x8b\x6e\xc0\x1f\xd5\xdd\x71\xf7\x2d\xb2\xe5\x7a\x5d\x7d\xda\x73\x34\xac\xc7\x9f\x73\x94\x2b\x37\xd6\x51\x02\xd4\xba\xec\xbd\x45\x5d\xd4\xaa\xb1\xfc\xf1\xfd\xa7\xe1\x74\x74\x83\xbe\xac\x05\x27\xd9\xba\xdd\x46\x5f\xa0\xcb\xb7\x75\xa9\x1b\x1f\xd9\xa4\x64\x57\x57\xc2\xf8\xe5\x6e\x29\x5d\xa5\x0a\x44\x4c\xf5\x3a\x4e\xe1\x6a\x53\xb6\xcc\x38\x51\xe6\x26\xa6\x63\x97\x29\x20\x32\x9a\xb8\xc3\x9c\x5a\x80\xe5\x8e\xda\x6e\x8e\xa0\xfd\x7c\x53\x7d\xa5\xcb\x6c\x4b\xd9\x2a\xef\x06\xf3\xe1\x74\x70\xa7\x2d\x1d\xb3\xfb\x4f\xad\x4d\x09\x66\xc0\xbf\x1b\x2e\x72\x64\xcc\xed\x13\x86\x83\x90\xf8\x36\x27\xac\x13\x10\x64\xf0\xc3\x5c\x42\x84\x36\x47\x7d\x34\x19\x67\x79\x98\x8f\x6e\xa4\x48\x93\x60\x63\x95\xa8\xdb\x76\x8b\xcc\x46\x8b\x4e\xc9\xb0\xd5\x48\x5e\x26\xc7\xd7\x18\xd8\xc4\xf1\xf6\x71\xf6\x2d\x3a\x95\xa1\x3b\x0c\x50\xf6\xb1\x51\x75\xa8\x56\xb6\x67\x86\x19\x7d\xb9\x83\xf5\x6a\x1f\xec\x09\x7a\xa7\x36\x8e\x72\x80\xe8\xf8\x7d\x99\xb3\x00\x72\x81\xb6\xd1\x87\xd1\xdd\x7c\x38\x6d\xc9\x4c\x44\xd4\x80\x88\x55\x1c\xe0\x6d\x13\x04\x95\xba\xda\x5f\x8d\x99\x1d\xa3\xdb\x0c\xc7\x9d\xfd\xa9\x8c\x9e\x48\x1b\x80\x57\x62\xcb\x2c\x50\x90\xbe\x23\xe9\x87\x4d\x3a\xfa\xe6\xe9\xe5\xce\xcb\x0b\xf8\x71\x65\x25\xaa\x9c\x41\xc3\x67\x5a\x0b\x2a\xd4\xad\x1a\xaa\xd6\x71\x35\xeb\x88\x8a\x75\x8a\x7a\xb5\x80\x57\x1e\x65\x72\x3e\x51\xbb\x7a\x9e\x66\x65\x8a\x61\xee\xf4\x84\x47\x55\x2d\x7b\xf5\xaf\xa1\x65\x1d\x85\x72\x69\x82\x0e\x75\x09\x5a\xea\x2f\x8b\x90\x44\x6b\xb6\x69\xd7\x38\x94\x23\xc9\x72\x8e\x1c\x88\x3b\x8d\xce\xf1\x73\x50\x19\x70\xaa\x73\x46\xd6\xd5\x32\xeb\x8a\xa9\x35\x45\xd5\xa2\x65\xc7\xdb\xd0\x5e\x1a\x19\x73\xd4\xe0\x54\xe5\x36\x1f\xc7\xe0\x15\x43\x9f\x62\x8f\xca\x8d\xbc\x51\x7b\xcd\xd9\xa4\x2a\x06\xb0\xba\xd4\xd1\xb0\x95\x90\x5b\x7b\x4f\x57\x57\xd2\x70\x8b\xbe\x3c\x05\xca\xba\xdb\x89\x52\xaf\x30\x5c\xda\x92\x6f\x79\xab\x32\x4e\x5f\x4f\x9f\x77\x90\xb9\xca\x80\xf6\xe3\x82\xaf\x99\xef\x2a\x70\x89\xbd\x70\xc6\x39\x59\x17\x16\xe0\x63\x86\x25\xa7\x0a\x7a\x35\x45\xdc\xfa\xeb\x72\x97\x00\x06\x31\x87\xc3\xd1\xb9\x52\x0e\xdf\xa2\xc0\x5d\x10\x8a\xb2\xd5\x97\x8b\x44\xe7\xbc\x71\x9a\xa0\x74\x43\x32\x9f\xe8\x2b\x0f\xc7\xf3\xc1\x28\xe5\xb1\x93\x0c\xac\x99\x58\x54\xce\x17\xee\x3f\x55\x5b\x3f\xef\x3f\x7f\x1e\x4e\x5b\x89\x4c\xf4\x44\x7f\xba\xfc\xf9\xea\x6a\x3e\x9b\xff\xe7\x74\x30\xfe\x38\x6c\xa3\x2e\xba\x9b\x7c\x5f\xd1\xa0\x74\xec\x8a\x44\x04\xa6\x9c\x56\x42\xd5\xeb\xd0\xdf\x7f\xe5\xcd\x4b\x31\x18\x49\x39\xd8\xcb\x48\x9d\x92\xf5\x53\xca\xf1\xe7\x46\x5f\x92\xe6\xf3\x00\xe6\x60\x6e\x8d\x4a\xae\x2e\x42\x77\x65\xa6\x33\xcb\xce\xaa\x6c\x19\xea\xa1\x59\xe3\xb8\xc3\xd8\xda\x46\x09\x2d\x4f\xf6\x7c\x0a\x8d\x10\x0b\x91\xe4\xa1\x5c\x7d\xe7\x90\x84\x96\xa2\x8e\x16\x23\x4f\x0c\xf5\x51\xa2\x7e\x85\xa5\x99\x05\x92\x8b\xc2\x82\x4b\xd2\xae\xe1\x49\x7e\x72\x1e\x0a\xeb\x9d\xb7\x4e\x28\x83\xe9\xcc\x36\x1a\x7f\x98\x28\xc6\x20\x9c\xd9\x4c\xf9\xfe\x8b\x23\x4e\x78\x72\xd2\x7a\xb3\x88\xf0\x0c\xe9\x31\x97\xd3\x22\xc2\x87\xde\x03\x39\x74\xcc\x7f\x17\x5c\xf1\xad\xaf\xb6\xfb\x7f\xc7\x88\x5c\x11\x1d\xa9\x76\xa9\x33\x38\xac\x87\x13\x3f\x88\x70\x18\xb0\x43\x4b\x37\x54\x5a\xb5\xf0\x40\xab\xe1\x3c\x89\xc2\x87\x46\xce\x81\x46\xd2\xd4\x56\xa6\x82\x74\x10\xa4\xac\x05\x1f\x52\xe1\xe2\xa7\xe5\x4d\x19\xd3\xa4\xd7\x57\x3e\x1b\xfa\x38\x9d\xdc\x7f\x56\x26\x5b\x98\x74\x30\x43\x8f\x18\x5c\x72\x1e\x71\x4f\x44\x7b\x08\xd8\xb5\xb3\x09\xb2\xcd\x40\xc6\xbb\x7a\xa7\x43\x0f\x94\x91\xad\xbc\x17\xc5\x43\x6a\xe5\x13\x32\xe4\x73\x19\xe3\x15\x59\x40\xb6\xd7\xa7\x60\x8b\x19\x59\x24\xf1\x7e\x21\x42\x5f\x9b\xb6\x14\x22\xdc\x27\x9a\x57\x57\xd3\xe1\xc7\x9b\xbb\xc1\x6c\x26\x36\x06\x7a\x34\x5f\xb9\x74\xaf\x10\x63\x75\xdc\x93\xeb\x98\xda\x23\x05\xc0\xf5\xa0\xe2\xb7\x73\x46\xd3\xc7\x6e\x0f\x98\x57\xd1\xce\x18\xd4\x31\x20\x3d\xe5\xbe\xba\xce\xaa\xf8\x34\x5f\xff\x9c\x94\xf4\x03\xa7\x25\x9d\x38\x81\x10\x97\x98\x82\x2a\xce\xeb\x64\x1c\xb1\xe6\xd6\x2c\xa0\xec\xb1\x4d\xcd\x8c\xb7\xbb\x50\x4f\x7d\xcc\x5f\x58\x5f\x0f\xdb\x13\xb8\xdb\x85\xe4\x8e\x32\xea\x9f\x6d\x88\xa8\x88\x29\xb2\xda\x24\x69\x84\x64\xa5\x55\x48\x34\x9b\x65\x22\xeb\xa1\x11\x6b\x52\x14\x6c\x77\x71\xc2\x44\xa9\x18\x51\x00\x86\x44\xbe\xd4\x93\x20\x3b\x85\xa8\x87\x16\x50\x5d\xa4\xb5\x01\xf9\x65\x12\x12\x12\x4c\x45\xd6\x19\x7a\x9a\x93\x29\x3e\x58\x0a\xd8\x2f\xf1\xb2\xb5\x61\x86\x2b\xa8\x0c\xc2\x66\x38\x61\x66\xdd\x47\xbb\x7a\x89\x23\x7b\xd0\x72\xbd\x5f\x64\xf9\x08\x4c\x3f\xcf\xaa\xc2\x7b\x52\x9b\x90\x99\xff\x73\x6b\x4b\x23\x16\x84\xb2\x7a\x3a\x2c\xa8\xac\x06\x9f\xda\x40\x16\xeb\xfd\xbc\x2a\x9d\x12\xff\xe4\x76\xc0\x2b\x35\xdb\x5e\xa3\xc2\xe8\x00\x61\xcf\x3d\xde\x56\x64\x87\xc8\x17\xf7\x44\xbb\x46\x5d\xff\xc9\x9c\x8c\xcf\x65\x7a\x51\x40\xc9\x36\x53\xe4\x53\x76\x57\xa7\xf8\xc5\x91\x9f\x97\xfd\x73\xb0\x13\x29\x8c\x30\xd4\x19\x33\x63\xb2\x45\x36\x24\xa6\x52\x82\x87\x07\xb3\x60\x42\x17\xca\xbe\xb4\x8c\x6d\xa0\x80\xd2\x94\xa0\xff\xeb\xdd\xe5\x5f\xfe\xdc\x2e\x84\xd8\xef\xd6\x0b\xec\x3f\x06\x34\x4e\x0e\x8b\x27\xec\xb1\x05\xc7\xe3\xd6\xe5\xbb\xaf\xfe\xfa\xd7\x8e\x01\x69\x33\xeb\x90\xce\x29\xc0\x57\x06\xdf\xd5\xca\x5a\x59\x07\x59\xba\x05\x70\xa5\xff\xfe\x23\x5c\x8b\xd9\xbc\xa5\xf1\xa7\xa3\x49\x4b\xd6\xae\xda\xba\x2a\x8f\x51\x90\x4a\x01\x61\x89\x15\x7d\x73\xa1\x6d\x57\x65\x51\x67\x26\x40\x48\xc4\xa1\xe2\xfc\x21\x01\x16\x64\x2e\x2e\xe4\x48\xf0\xe3\x45\x49\x99\xd6\x66\x07\x5d\x10\x72\x21\x93\x4d\xdd\x12\xab\xc2\xa2\x24\x43\xf8\x81\xa0\x5d\x88\x3d\x22\xd2\x8e\x64\xd9\x49\x8c\xf4\xca\x46\x39\x1b\x91\x7d\x76\x43\x42\x1f\x61\x2f\x89\x29\x95\x83\xe7\x57\x00\x24\x29\xab\xd6\x80\x99\x26\x4b\x30\x25\x85\x8a\x5b\x68\x43\xf0\x63\x40\x12\x39\xaa\x2c\x4d\x43\x22\x3f\xcb\xde\x95\xd2\x5c\xe9\x58\xb4\xc3\x09\xde\x12\x8e\x74\x72\x0b\x29\x15\xa5\x6a\x96\xc4\xa8\xef\x7a\x4a\xea\xf7\x52\xf8\xb5\x0a\x79\xd8\x3b\x68\x1b\x44\x85\x0c\xec\xf9\x25\xca\x78\x22\x55\x70\x56\xcb\x53\x32\xf0\xc3\xa4\x85\x99\x87\x59\x12\xef\x51\x42\xbc\x38\x31\x7c\x67\xb3\xec\xc5\xae\xaf\xc6\xed\x76\x7d\x56\x2b\xd5\x46\x53\xcb\xf5\xde\xf6\xfb\x93\xb8\xbe\xe9\x7d\x61\x85\xcb\xe4\x66\x38\x31\x43\xf9\x91\x12\xa8\x3a\xd9\x49\x09\x09\x32\xae\xb4\x4e\xae\x6e\x2f\xcf\x06\x4f\x2d\xcb\x6e\xf1\xad\x43\xd8\x6f\xad\x8d\x72\xf2\xa9\x99\x78\xe0\x3b\x92\x94\x8f\x3e\x64\x88\xd0\x2f\x2b\x7a\x5c\x74\x27\x29\x1e\xc9\x55\x1f\x75\xff\xc7\xbb\x77\x5f\x7d\xf5\xd7\x77\x6f\xbf\xfa\xcb\xdf\xfe\xfc\xa7\xbf\xfe\xf5\xcf\x7f\x7b\xfb\xb7\xf2\x27\x93\x6a\xab\x38\x1f\x5b\x99\xc6\x23\x1c\xb6\xd4\x84\x6d\x3b\xc9\x7f\x7e\x19\x15\x7e\x34\x1f\x26\x53\x03\x41\xdd\xf1\x0d\x5e\x2e\xd7\x65\x8d\x93\xd0\xf9\xc4\x5f\x22\xcd\xb9\x33\x0d\x39\xea\x23\x48\x53\xde\x38\x23\x14\x41\x0e\x6a\x46\x01\xe4\x47\x92\x2f\x01\x76\xca\x71\x0b\x21\xf3\x3d\xba\x5d\x20\x6f\xfa\xa9\x8f\xca\x4c\xd9\x51\x37\x88\x64\x62\xf3\x42\xf1\xbb\x22\xc2\x7c\x6d\x65\x30\x2f\x74\xf0\x9c\x51\x0c\xa3\x19\xb8\xf5\x16\x4a\x24\x65\x2f\x13\x6a\xcc\x4c\x78\xd2\x21\x07\xb9\x4d\x40\xc2\x43\xc8\x3d\x0e\xb0\xd2\x49\xd9\x7b\x55\xd9\x80\x9b\x50\xd4\x04\xac\x9d\xd7\xcd\x4e\x86\x50\xf9\x58\x0f\xf5\x73\xa1\x10\x77\x36\xbf\x4c\x60\x21\xea\xfe\x40\x41\x39\x91\x3a\x3c\xdb\x77\xcf\x59\x43\xbd\x3e\x92\xba\x12\xf0\xab\x70\x0f\x19\x12\xa2\xd6\x29\x93\xca\x1f\x85\x7a\xae\xbe\xc1\xe8\x03\xfa\x30\xb9\x1f\xdf\xba\x73\xd7\x8a\xe2\xbf\xe3\xc9\x7c\x74\x33\x44\xcd\xcf\x49\xbc\x15\x85\xf2\x03\x8a\x32\x46\xc5\xc5\x7d\x98\xe9\x0a\xbd\xe9\xbd\x39\x0d\xa6\xd7\xe5\x39\xad\x73\x8c\xb0\xf0\x7a\x7f\xca\xc9\x19\x9a\x94\x3b\x97\x74\x1e\x26\x1c\x5a\x36\x27\x75\xc0\xc7\xcc\x44\x58\x9a\x9c\xda\x8e\x39\x19\xdf\xca\xf2\x3f\xae\x74\x65\x5c\x42\x6a\x9f\x96\x64\xed\xb5\xc5\x05\x2e\x2a\x70\x41\xcc\x2e\x23\x8c\x46\x11\x54\x24\x3d\x20\xa9\x8f\x50\xa8\xed\xa9\x10\x18\x6d\xd3\x90\x05\x51\x2c\x35\x48\xec\x79\x84\x72\x41\xdc\xd7\x55\x02\x44\x92\xc2\x28\x56\xd5\xb1\x10\x65\x71\x42\xd0\x7e\x43\xd8\x86\x2b\x91\x42\xef\xd8\x93\x84\x18\x97\xa9\x23\x2a\x11\xc8\xfa\x66\x31\x28\xaa\x62\x7c\x96\xe2\x10\x51\x82\x13\x59\x50\xa8\xdb\x85\x14\xa7\x7c\xc6\x5c\xd6\x7f\x53\xee\x8c\xb3\x22\xc1\xa2\xa9\xa8\x9d\xf4\x65\x14\xb3\x2f\x75\xe9\x8e\x6e\xd7\x5c\xff\x35\xca\x92\x2d\x0a\x79\x18\xaa\x41\x47\x85\x7d\x42\x31\x0f\x3f\x46\x18\x85\x31\x94\x8a\xde\xc7\xc9\x83\x1e\x10\xb2\xb1\x7a\x0f\xaa\xac\x38\xa4\x86\xa6\x69\xc8\x7a\xe5\x31\x80\x1a\xa4\xf9\x48\x07\x90\xcd\xfd\x80\xb2\x24\x58\xa6\x8c\xf8\x0b\xbe\x2e\x57\x08\x77\xd1\x58\x7f\xc1\xbb\x5d\xc8\x11\xca\x45\xcf\x37\x77\x1d\x24\xfe\xd7\x96\x5d\x1c\x8e\xa3\x56\xb2\x71\x85\x6a\x39\xf4\xca\x19\xe1\x6d\xd4\xeb\xbf\x47\x2a\x6b\x6b\x41\xd8\x38\xb6\xc2\x7a\xb3\x3b\xb4\x1d\x40\x6d\xf4\x0c\x8d\x47\xaf\x27\x0e\xd5\xab\xa4\xa9\xea\x9c\x70\x93\x1d\x23\xb5\x1c\x35\x5a\x75\x33\xf1\xe2\x6d\x5e\xe6\x5a\xc2\x3d\x0c\x73\x9d\x13\xf8\xa3\x74\x8b\x74\xe9\x55\x5b\x1c\xd7\x42\x57\xc7\x6a\x5b\xc7\x03\xd9\x4d\xb0\x05\xb1\xd6\xa3\xb9\xb3\x6a\x27\xf1\x7e\x21\x9e\x82\x5b\x6d\x34\xf9\x6e\x38\x45\xad\x92\xd2\x26\x8e\xf8\xd3\x6a\xa7\xa3\x46\x0d\x77\xa3\xbc\xfc\xef\xac\xd2\xe8\xf0\x5d\x2c\x2b\x3c\x84\x8c\x4a\xa1\x96\xd7\x96\xb3\x95\x55\x14\x26\x77\xde\xc7\xaa\xbd\x98\x45\x8d\x0a\x51\x9a\x76\x7a\xe5\xec\x38\xbf\xee\x9b\xe5\x60\x2c\x49\xc5\x66\xc1\x12\x11\x82\xd5\x22\x8a\x99\xb1\x0d\x7e\x79\x21\x89\xc3\x75\xa3\x8a\x3f\xbe\x32\x2f\xd4\x8b\xb5\x53\x11\xe7\xeb\xa6\x15\xb3\x88\x3b\x6a\x9c\x55\x04\x7c\x97\x15\x2a\x7b\xf1\xea\x64\x9c\x53\xd8\x8c\xd5\x5f\x76\xdf\xf5\xde\x76\x13\xef\x4f\xc0\x70\x2c\x54\x42\xe2\x69\x48\x16\xd9\x56\x2c\xf4\x31\x20\x7b\x14\x28\xd3\x08\x18\xc3\x44\x41\x3d\xdf\xc1\xb5\x3a\xa2\x6c\x0e\xd0\x15\x83\xcf\xc6\x91\xe0\xe3\x6a\xae\x58\x55\xff\xe7\x43\xe0\x30\xd4\x5c\x54\xf0\x5b\x16\x4b\x56\x0c\xbc\xcd\xf4\x27\x41\xc6\x0d\x7c\x09\x26\xa7\xea\x7b\x00\x4f\xb2\x31\xcf\x91\xbd\xd7\x45\x60\x39\x5b\x83\x92\x78\xa8\x5b\x2c\xfc\x96\x91\x16\xc9\xf5\x72\xd5\x5d\x4e\x65\x60\x27\x12\xfc\xaa\x95\xb9\x0c\x77\xe8\x7f\xb3\x12\x1e\x86\xa8\x76\x52\x0d\x0f\x75\xaf\x6d\x3e\xf6\xf2\x35\x27\x1a\x75\x62\x47\x2c\xc7\x04\xc2\xb8\xb8\x98\xf7\x4f\x1c\x8c\x6f\x8d\xa1\xca\x1e\x14\x54\xd1\x2b\x91\xe5\xc1\xd9\xe4\x6b\x59\xdd\xf1\xf7\xab\x03\x61\x1d\x59\xf1\x55\xbe\xdb\x85\x67\x26\xa8\x3e\x60\x58\xdd\xdf\xf5\xde\x72\x24\xba\xec\x3d\x71\xaa\x93\x52\x62\xba\x95\x89\x8c\xd5\x01\xa1\xe7\xa4\x9e\x76\x25\xea\x76\xd6\x90\x70\x1e\xb8\x4c\x19\x42\xb6\x38\x88\x82\x68\xad\xf6\xeb\x6e\xfc\xd3\xcf\xba\xc6\x66\xf3\xff\xfd\xff\x9a\x76\xb9\xfa\x7f\x57\xa3\xf8\xd7\xac\x46\x61\x93\x98\x82\xcc\xe4\x0e\x41\x3f\xad\x06\x45\xd1\x6e\x50\x44\xa8\xab\xbe\xe3\xc7\x7f\xfe\x13\x25\xd7\x4e\xf1\xad\xc2\x44\x5a\xc9\x67\x2a\x2a\x34\xbc\x68\x9d\x0a\x59\x5e\xba\xb0\xa5\xdf\xac\x1e\xc5\x8b\xec\xf8\x65\x0a\x4a\x38\x29\xd0\x68\x86\x9a\xea\x43\x49\x31\x89\xdf\x20\x4f\x3f\xa4\x90\xc4\xbe\x2f\x3c\x55\x40\xea\xe2\xff\x67\x16\x18\xc6\x8c\x61\x6f\xb3\x60\xb1\x28\xc2\x6d\xa2\x67\x66\x29\x02\xc1\xdf\x9d\xb0\x8e\x13\x98\x2d\x8e\x7c\xeb\x51\x28\x23\x8c\xa0\x5b\x1a\x2d\x8a\x98\x25\xbf\xe6\x44\xad\xca\x9b\x9e\x90\x6d\x2c\x00\xcf\x7b\xd2\x22\x37\xa4\xe4\x57\x84\xa9\xe7\xa8\x3e\xe3\x14\x32\x8d\x05\xf6\xd4\x72\x00\x4e\x61\x40\x59\xff\x3d\x78\x3c\xfd\xa4\x01\xf7\x73\xdb\x79\x73\x46\x1f\xaa\x60\xe9\xce\x43\x2f\xda\x43\x99\x0b\xfb\x70\x3a\x86\xea\x09\x52\x67\x75\x58\x93\xe9\x50\x58\x43\xcc\x71\x88\x96\x70\xae\xe7\x95\x57\xd0\xc2\x3d\x94\x1c\xd7\x21\x1b\xf1\xbe\xe5\xce\xe9\xd5\xc9\x2a\x48\x03\x64\xcd\x07\xad\x9f\x7e\x16\x5f\x85\x93\x9c\xf8\x7c\x3b\xb9\x87\x04\xcb\xd3\xe1\xcd\x68\x36\x9a\x8c\x55\x1b\x9d\xaf\x46\xb6\x53\x89\xc7\x1a\x99\x4b\x88\x0c\xa3\xcc\x27\x1a\x53\x19\x6d\xe4\x77\x13\x5f\x73\x49\x82\x04\x30\x9b\xa3\xf1\x6c\x38\x9d\x0b\x8d\xb0\x98\x2b\xa8\x25\x2c\x51\x22\xd1\x1d\xca\x65\x85\xb3\xa9\x68\xae\x38\x7f\x07\x5d\xbc\xeb\xa0\x8b\xaf\xda\x08\xb7\x58\xe7\xb1\x43\x0d\x6f\x37\xda\x61\x9c\xfc\xdc\x4c\xc6\x1f\xee\xb8\x0a\x7a\x3b\xe1\x9c\xea\xdb\xd1\xf8\xa3\x51\xa1\xb1\xa0\x97\xaa\x7c\x44\x19\x78\x3b\x26\x30\x3b\x79\xa8\x09\x54\xc8\x67\x2a\xd2\x00\x2a\x24\x29\xca\xe5\x04\xd2\x34\xb4\xe8\x52\xf0\xff\x07\x00\x00\xff\xff\x7f\x17\x7b\x4e\x3e\x50\x01\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xec\xfd\x7b\x77\xe3\x36\xb2\x28\x8a\xff\xaf\x4f\x51\x67\x7e\xee\x23\x29\x91\x94\x76\x67\x5e\xc7\x8e\x7b\xfd\x34\xb6\xba\xa3\xb3\xdd\x52\x6f\x59\xce\x63\xe7\x66\xe9\x40\x24\x6c\x31\xa6\x48\x85\xa0\xec\xf6\xdc\xb9\xdf\xfd\xae\x2a\x00\x24\x40\x82\x14\x25\xdb\x9d\xcc\xdd\xf1\x5a\x49\xdb\x24\x88\x47\xa1\x50\x2f\xd4\xa3\xdf\x9f\x4c\xe7\xa3\xab\x56\xbf\x3f\x5f\x05\x02\xbc\xd8\xe7\xc0\x84\xd8\xae\xb9\x80\x74\xc5\x52\x48\xd9\x32\xe4\x10\x31\x7c\xe0\xb1\x08\xe2\x28\x7c\x84\x25\x87\xbf\x7e\x0d\xde\x8a\x25\x02\xc2\x38\xba\x6d\xb5\x5a\xe7\xb3\xd1\x70\x3e\x82\xe9\x0c\x66\xa3\x8f\x97\xc3\xf3\x11\xbc\xbb\x9e\x9c\xcf\xc7\xd3\x09\x5c\x9d\x7f\x3b\xfa\x30\x5c\x9c\x0f\xe7\xc3\xcb\xe9\xfb\xc1\x2d\x4f\x17\x3e\xbf\x61\xdb\x30\x5d\x78\xab\x6d\x74\xb7\x08\xa2\x94\x27\xf7\x2c\xec\x74\x5b\x00\x00\xb3\xd1\xfc\x7a\x36\xb9\x82\xf1\x64\x3e\x9a\x7d\x37\xbc\x6c\x0d\xaf\xe0\xe8\x66\x1b\x79\x47\xf4\xfa\x6a\x74\x39\x3a\x9f\xc3\x3d\x0b\xb7\xfc\xe4\x44\x37\x82\x77\xb3\xe9\x87\xe2\x50\x6a\x18\xf8\xfe\xdb\xd1\x6c\x04\x77\xfc\xf1\xac\x6d\x8f\xd8\x3e\x6d\xa9\x9e\x2f\x87\x93\xf7\xd7\xc3\xf7\x23\xb8\xfa\xcf\x4b\xb8\x9a\x0f\xff\x71\x39\x82\x8f\xc3\xd9\xf0\xf2\x72\x74\x09\x57\xc3\x77\xa3\xd3\xd6\xfb\xd9\x70\x32\x87\xd1\x0f\xa3\xf3\x6b\x5c\xe9\xe4\xa0\x15\xc2\x7c\x0a\x9b\x24\x5e\x2f\x12\xce\x7c\x9e\x9c\xee\x0b\xb9\x34\x58\x73\xe1\xb1\x90\x2f\xd6\xec\x97\x38\x59\xdc\xf3\x44\x04\x71\x54\x06\x9d\x1b\x6a\x62\x13\x06\xe9\x62\xc3\x92\xb4\xc3\x3f\xa5\xea\xe3\x1e\xb4\x07\xed\x1e\x1c\x77\x09\x9c\x12\x92\x9b\xdb\x85\xc7\x52\x16\xc6\xb7\x83\xcd\xed\x82\x7f\x4a\x79\x84\x4d\x15\x28\xf9\xa7\x14\x51\xe2\xac\x9d\x4d\xc7\x5f\xb6\xe1\x72\xfc\x61\x3c\x87\xe3\x17\x83\x69\xe5\xda\x9f\x0a\x54\xbd\x59\x09\x4f\x79\x94\x06\x71\xb4\xd8\xf0\x24\x88\xfd\xcf\x81\x90\xc5\x31\x5f\x1e\x25\xcb\xab\x7c\x0a\xfc\x02\xb1\x30\x90\x60\x11\x44\x22\x65\x61\xc8\x8b\xb0\xfb\xc7\x74\x7a\x39\x1a\x4e\xdc\xa0\xf3\xe2\x6d\x94\x76\xbe\xe8\xc2\x5b\x78\x9d\xa1\x5f\x23\x9c\xab\x03\xd6\x1e\xe0\xa9\x5e\xc4\x13\x41\xb3\xde\x86\x69\x10\xc5\x3e\xdf\x09\x8e\x8b\xd1\xf9\xe5\x70\x36\xa2\x56\x81\x58\xf8\x81\x48\x93\x60\xb9\x4d\xb9\xaf\x1b\xc3\x19\xdc\xb0\x50\xf0\xd3\xd6\x3f\x46\xef\xc7\x13\x6a\x39\x7e\xb7\xdf\x41\x79\x7b\x06\x6f\x60\xfe\xed\x48\x7e\x5d\xbb\x05\x36\x40\x6e\xe2\x64\xcd\x10\x69\x06\x3e\x4b\xd9\x02\x97\x24\xb2\x3e\x68\x26\x93\xf9\xb4\x30\xf1\x53\x6a\x30\x9a\x5c\xc0\xf8\xdd\xa9\xb1\xfc\x52\xb3\xd1\x0f\xe7\xa3\x8f\x04\xc1\xef\xbf\x1d\x4d\x70\x0b\xaf\xe6\x08\xe3\xf6\x9f\xdf\x7c\x7c\x7d\xdc\xa6\x09\x43\xbf\x0f\x73\x3d\x25\x38\x1e\x7c\xea\x41\xc4\xef\x79\x02\x46\x4f\xe6\x18\x0a\x54\xa3\xc9\x45\x09\x45\x3e\x5e\x7e\x7c\x7f\x28\x9a\x18\x1b\xfa\x5c\x54\xc7\x8b\xd7\x9b\x84\x0b\xdc\xa1\x85\xe0\x69\x1a\x44\xb7\xfb\x1c\x1e\x45\x77\x54\x9b\xa6\x64\x67\xcd\xd3\x24\xf0\xcc\xb1\x3f\x03\x2f\x74\x2d\xb4\x0c\xc5\x7e\x7f\xe8\xfb\x70\xfc\x0a\xe2\x1b\x48\x58\xe4\xc7\xeb\x88\x0b\x01\x69\x0c\xe9\x8a\x83\x66\xa5\x20\x62\x29\xa1\x10\x87\x15\xc0\x12\x0e\x51\x9c\x02\x0b\x83\xdb\x88\xfb\xae\xd7\x22\x65\xb7\xb7\x3c\xe1\x3e\xdc\xc4\x09\x18\xb3\x81\x5f\xe2\xa5\x18\xec\xb9\x7d\x59\x6f\x45\x1e\x6f\xff\x99\x71\x8d\x6e\xab\x19\x1f\x29\x7c\xfe\x05\x74\x8e\x07\xaf\xbf\xec\x74\x24\x28\x3a\xdd\x2f\x5e\x0f\x5e\x1f\x77\xfb\xaf\x07\xaf\x5f\xff\xa5\xdb\x75\x6f\xda\x77\xd3\xcb\xe1\x7c\x8c\xb8\xbd\xc7\xa2\xc2\xd8\xbb\x5b\x28\xbc\xb8\x89\x93\xc5\x9a\xe1\x24\x22\x16\x79\xbc\xa3\x1e\x07\x3e\xc2\xbf\x07\x0f\x2c\x48\x61\x19\xc7\x21\x67\x11\x9c\x41\x9a\x6c\x79\x53\xfa\x66\xd1\xae\xc9\x74\x2e\xfb\xb2\x48\xd2\xc7\xd1\xec\xdd\x74\xf6\x01\xd6\x83\x2f\xb2\x67\x2e\xb4\x96\x93\x82\x75\xd6\x48\xe2\xf7\x7a\x10\xf8\x70\x06\xd9\x94\xf3\x3e\xa6\x33\x98\x4c\xe1\x3f\x46\x3f\xc2\xf5\xc7\x0b\x84\xca\xd5\x7f\x8c\x3f\xc2\xe5\xf4\xfc\x3f\x46\x17\xa7\xad\xac\x9d\x5c\x04\xbc\x9b\x5e\x4f\x2e\x14\x0d\xbb\xbc\x1a\x7d\xfe\xe9\xd5\x4f\x49\x91\xd5\x3a\x02\x97\xa3\x41\xe3\xf3\x5a\x87\x04\xb4\xf5\x6a\xd7\xf3\x73\xfb\x90\x04\x29\x9e\xdb\x7e\xff\x9c\x45\x71\x14\x78\x2c\x04\xec\x05\xe2\xc4\xe7\x49\x10\xdd\x9e\xb4\xfa\x7d\xd9\xa3\x68\xf5\xfb\xc8\x3e\xa4\x56\xd1\xea\xf7\x43\xb6\xe4\x21\x3e\x15\x3c\x09\xb8\x80\x0d\x4b\x78\x94\x5a\x7f\xa7\x01\x72\x1d\xa4\x0a\x5e\x1c\x89\x34\xc1\xf9\x08\xec\xb2\x0f\xf3\x15\x97\x53\x90\xbd\xc3\x7d\xc0\x1f\x20\x65\x77\x5c\xd0\x04\x04\x04\x11\x91\x0c\x9a\xc8\x09\xe4\x23\xf7\xa0\xd8\xff\xa0\xd5\xd2\x3a\xd0\x26\x89\x3d\xee\x6f\x13\x0e\x37\x41\xc4\xc2\xe0\x9f\xa4\x0a\x71\xf0\x12\x4e\x0c\x10\xc9\x12\x53\xdb\x37\xa0\x39\xdc\x04\x89\x48\xa9\x2f\x88\x6f\xb2\xc5\xe6\x1f\xac\xd8\x66\xc3\x23\x9a\xce\x9a\xdd\x71\x0d\x5e\x9a\x0a\xb0\xc8\xa7\xee\x69\x30\xd9\x89\x6e\xbf\xe2\x09\x1f\xb4\xfa\xfd\xef\xb9\x94\xdb\xa1\xd8\x71\x10\x21\x51\x7c\x88\xe9\x33\xa2\x90\xeb\x20\x0a\xd6\xc1\x3f\x39\x84\x2c\xe5\x91\xf7\x08\xfe\x16\xb7\x00\x82\x48\xf0\x84\x00\xd9\xef\x77\x1e\x56\x81\xb7\x32\x67\x85\xe3\x97\x67\xb6\x61\xe9\xaa\x3b\x80\x91\xd8\x70\x2f\x60\x61\xf8\x88\xf4\x95\x3f\xc4\x49\xba\x7a\x84\x40\xea\x87\xad\x7e\x9f\xa5\x29\xf3\x56\x38\x08\x76\x93\x41\x54\xd3\x6b\x05\x69\xd9\xa5\xb9\x32\x58\x72\x8f\x6d\x05\x87\x20\x85\x84\xff\xba\x0d\x12\x8e\x98\xc0\x22\xe0\x9f\xbc\x70\x2b\x82\x7b\x4e\xdb\xd8\x03\x39\xdf\x40\x00\x83\x55\x70\xbb\xea\xeb\xb5\xc5\x1b\x9e\x48\x99\x84\xb6\x21\x4e\x57\x3c\x01\xe6\xe1\x13\x9c\x5d\x80\xdd\xe1\xc9\xc0\x07\xe0\xc7\xdc\x60\x12\x02\xbc\x24\x48\x25\xae\xca\xde\xfa\x0f\x81\xe0\xb0\xdc\xa6\xd4\x88\x85\x22\xa6\x96\x11\xf7\xb8\x10\x2c\x79\x6c\xf5\xfb\x69\x0c\x1b\x9e\xa0\x24\x04\x41\x24\xb1\x0a\x57\x29\x61\x2b\xd1\x4b\xee\xe6\x56\x8e\xb4\xd9\xa6\xd9\x1e\xb6\xfa\xfd\x49\x9c\xf2\x13\x82\x1a\x30\x40\x64\xe6\xbf\x6e\x79\xe4\x71\x44\x28\x9c\x2d\xf8\x5c\x04\xb7\x91\x06\xad\x09\xbd\x1c\xaa\x08\x05\x02\x38\xf7\xe5\x8c\xec\x56\x3c\x4a\x81\xdd\xa4\x3c\x91\xdb\x1a\x08\x10\x29\xdf\x20\x7c\x70\x4e\x1a\x81\xd6\xc1\xed\x2a\xa5\xe5\x2d\xf1\x63\x8e\x98\x04\x22\x5e\xe3\x91\xf4\x92\x58\x08\x8d\xc2\xbf\x6e\x65\xcf\x09\x7d\xc0\x1e\xd8\x23\x76\x15\x0b\x9e\xbd\xc1\x21\xdb\x29\x32\xd3\x35\x62\x7a\xfc\x40\x32\x99\x46\x6a\x9f\x87\x0c\x21\x17\x20\x9a\xe1\xe2\x82\x9b\xc0\x63\x51\x8a\xe3\x6d\x12\xdc\x2a\x4f\x43\x07\xb7\xba\xaf\x4e\xaa\x1a\x5d\x9d\x55\x12\x38\x4b\xe7\x96\x47\xa9\xf9\xa7\x22\x13\x65\x6e\xf7\x71\x36\x3d\x1f\x5d\x5c\xcf\x46\x45\x4a\xa7\x4f\xb7\x46\x7a\x7d\xaa\x3a\x5d\xe2\x5a\x48\x06\x6c\xa9\x3c\x81\xd9\xe8\x7c\x3a\x53\xf4\x97\x9a\x73\x5f\xd3\x43\x53\x28\x47\x42\x9e\xc0\xb8\x24\x63\x37\x61\x17\x05\x66\x81\x0c\x52\x4f\x8c\xe4\xa7\x90\x6b\x39\x17\x7f\xa6\xb3\x8b\xd1\x0c\xfe\xf1\x23\x68\xe1\x80\xde\x5c\x4e\xa7\x1f\x4b\xf2\x7d\x75\x27\x24\xb9\xab\xe5\x3c\x81\xa1\x25\x83\x02\x2f\x2b\x31\xb1\xf1\x3b\x3d\x8c\xcd\xef\xf1\xa7\xdf\x4f\x78\xc8\x99\xe0\x90\xc4\x0f\x74\xee\xad\xd7\xe7\xd3\x0f\x1f\xc6\xf3\xd3\xc2\xb3\xc9\x7c\x3c\xb9\x1e\xe5\x4f\x35\x4f\x34\x47\x6c\xae\xe9\x0d\x27\x17\x07\x48\xaf\xc5\x85\x68\xe9\x40\xf5\xf4\x71\x36\xfd\x30\x10\xdc\xfe\x3c\x8e\x2c\x4a\xdb\x49\x06\xf4\xef\x02\xf5\xdb\x1e\xcc\x67\xd7\xa3\x6e\xcd\xa2\xfa\x7d\x3f\x96\x67\x7b\xc9\x6f\xe2\x84\x23\xcb\x43\xf2\x6b\x93\x4d\x8b\x1b\x3c\xc4\xc9\x9d\xa2\x0b\xaa\xb1\x05\x61\x2d\x0d\x39\xb7\xfb\x6a\xe4\xc2\x1e\x38\xa3\x79\x2a\x14\xc8\x10\xc0\x9a\xe6\x03\x87\x87\x20\x0c\x21\xe2\xdc\x97\x13\xa6\x89\xa1\xf0\x5d\xc5\x34\x50\x6a\x67\x77\xc4\x13\xa2\xf8\xc1\xe8\x2b\x8d\x81\xdd\xc7\x81\x2f\xbb\xd8\x6e\x6e\x13\xe6\xf3\x01\x8c\x53\x83\x92\x97\x56\xec\xc7\x11\x47\xee\x11\x72\xc9\x0e\xf2\xee\xa8\x17\x24\xb4\xec\x8e\x47\x83\xec\x05\x8a\x82\x20\x15\x9e\xe9\xe4\xf2\xc7\x22\x44\x14\xb9\x19\x4f\x60\x78\x7e\x3e\xba\xba\x82\xd1\x0f\xe7\x97\xd7\x57\xe3\xef\x46\xb0\x8e\x7d\x6e\x2c\x5e\x4b\x5a\x52\x6d\xee\x1c\x1d\x65\x6f\x00\x60\x78\x39\x1f\xcd\xd4\x30\xee\x11\x86\xf3\xf9\xf0\xfc\x5b\x54\xba\xe6\x63\x53\x4a\xbb\x18\xce\x87\x8b\xab\xd1\x6c\x3c\xba\x1a\xbc\x3a\x3e\x1a\xd3\x39\xfb\x6e\x78\x79\x3d\x42\xad\x02\x3a\xaf\xde\x1c\x5d\x76\xb3\xa1\x8e\x8e\x7a\x60\xa3\x16\x6e\x91\x81\x5a\xe6\xa9\x42\x34\x43\xc2\x41\x12\xe5\x69\x4b\xd2\x3f\x28\x8a\x94\xa7\x2d\xfc\x66\x34\x99\xc3\x74\x72\x10\x69\x1d\x5f\x41\xfb\x5d\x26\x57\x15\x04\x9a\x01\x14\x24\x30\xb1\x8a\xb7\xa1\x0f\x4b\x0e\xc9\x36\x82\xe5\xa3\x14\xc4\xe2\x28\xe2\x5e\x8a\x58\xb4\x4d\x63\xb4\x4a\x78\x28\x9d\xb4\x1d\x52\xee\x01\x33\x2c\xc9\xb5\x5a\x2e\xcc\x24\x09\xb4\x93\x13\xcd\xc0\x09\x31\x48\x93\x00\xf5\x40\x78\x58\xf1\x08\x18\x44\xfc\x41\x2f\x0b\x1b\x4a\x7a\x87\x88\x4a\x52\x6d\x2a\x60\xbb\x91\xf2\x96\x6c\xf3\xcb\x56\xa4\xc0\xa3\x78\x7b\xbb\x2a\xca\x12\x24\xdd\x05\xe9\x00\x3e\xd8\x50\x92\xfc\x34\x3f\x89\x41\x04\x35\xcb\x61\xcb\xf8\x9e\x0f\xe0\x8a\x73\x05\xbc\xf5\x9a\x47\x29\x8a\x46\x71\x24\xe5\x8c\x6c\x61\x78\x30\xb1\x4d\xc2\x99\x88\x23\x3c\x9c\xf2\x49\x20\x94\xfc\x29\x05\x14\x4b\x9c\xd1\xd2\x93\x40\x5b\x5d\x8a\xc4\x47\x77\x37\x80\x2b\xb9\x7b\x74\x65\xe0\xc5\x51\xca\x82\xc8\x5a\x6f\x18\xdf\x06\x9e\x94\x62\xc4\x76\xb3\x89\x93\x54\xad\x5f\x64\x53\x51\x62\x76\x41\x3e\x30\x25\x79\xa9\x42\xb8\x24\xfa\xe6\x9a\x6f\x49\xf6\x2d\xd8\x5f\xd4\x16\xd3\x33\x97\xc5\x8e\xe6\x80\xca\xf1\x78\x32\x37\x04\x81\x02\x11\x68\xab\x09\x59\x07\x1f\x4f\xf4\xe0\xd5\xb8\x83\x4c\x09\xe6\xe3\x0f\xa3\xab\xf9\xf0\xc3\xc7\xf9\x7f\x11\xe7\x9f\x5c\x5f\x5e\xf6\xa4\x81\x07\x2e\xa6\xd7\x64\x87\x99\x8d\xce\xc7\x57\xb8\x86\xbc\x81\x5c\x3a\x8e\xff\x8f\xf1\x7b\xb4\xe0\xeb\x57\x5d\xf8\x7e\x3c\xff\x16\x3a\x78\x4e\xee\x99\xb7\xdd\xae\x17\xea\x9f\x74\x95\x70\xb1\x8a\x43\xa4\xdb\x7f\x79\xfd\xfa\xf5\xeb\x1e\x18\x8d\x58\xc4\xc2\xc7\x7f\xf2\x72\xab\x6e\xbb\x67\x31\x3b\xfd\x33\x19\x7d\x6f\xd0\x99\xee\x69\xcd\xea\xaf\x27\xe3\xff\xbc\x1e\xc1\x78\x72\x31\xfa\x41\x8a\x76\xd9\xf4\x89\x33\x2f\x5e\x09\xb0\x09\xde\xe0\xd5\x18\x3a\x59\xa3\x1e\x19\x26\xbb\x30\x9e\x9c\x5f\x5e\x5f\x8c\xa0\x43\xe0\xa9\x9b\x18\x7e\x53\x9a\x60\x6b\x6f\xf1\xc0\xe2\xf4\xce\x2f\x2d\xdb\x60\x59\xc0\x91\x07\xe6\x41\x9a\xb0\xc8\x00\x4f\x4a\x95\x2f\x15\x8d\x9c\x07\x2e\x1f\x8d\x1d\x25\xfd\x81\xd4\x1b\xba\x96\xdb\x28\x0a\x54\xe8\x9a\xce\xf1\x03\x6f\x87\x21\xac\xd8\x3d\x87\x75\x9c\x70\xf8\xd3\x8a\xb3\xfb\x47\x75\x84\xc4\x9f\xf0\xb0\x47\x40\x86\xdb\x5c\x4d\xc9\x46\xc5\xd3\xfe\x55\x10\xf9\xc1\x7d\xe0\x6f\x59\xf8\x55\x61\x00\xd5\x09\x3c\xc4\x28\xed\xdf\xe2\x49\xde\x0a\x58\x6f\xbd\x15\x1d\x55\x7d\x6c\xb1\xdf\x07\x4d\xb2\x7d\xfc\x06\x89\x0d\x0b\xa9\xd1\x9a\x45\x8f\x5a\x6f\x18\x38\x65\x26\x49\x2d\x4d\xdb\xf0\x62\xf5\xb8\xe1\x89\x3c\x93\xa5\x0d\xd6\x98\x65\xe3\x4a\xbb\xb4\xdb\x65\xd4\xa0\x3b\x04\x07\xca\x48\xdb\x1b\xbe\xcc\x0c\x70\x67\x6f\xf7\xb1\xfd\xed\x71\x13\xe8\x98\x96\x5e\xbf\xfa\x22\x88\x7c\xfe\x89\x8b\xb3\xb7\x64\xcb\xb6\x5a\x9b\xf2\xa1\x69\x9b\x72\x40\xd3\x80\x60\x63\x80\x39\x01\xf4\x1b\x03\xa7\x39\xa4\x1c\xc2\x73\x49\x90\x56\x6a\x91\x63\x4a\x71\xb2\x50\xbd\x6b\xb2\xde\x69\x2f\x08\x2e\x8b\x85\x02\x95\x62\x15\x04\xab\x56\xa6\x42\x5d\xcd\x67\xe3\xf3\x79\xc6\x0c\xe4\xa0\xfd\x3e\x1a\x4d\x24\xa3\xd5\x06\x0f\xc9\xb2\x7e\x3a\xfe\x19\x02\x01\xdb\x28\xf8\x75\xcb\x81\x91\xde\x9d\x9f\x47\x79\x96\x24\xb1\xec\xc8\x0f\xba\xa4\x43\xfb\x86\xb8\xac\xb9\x1f\xb0\x84\xc3\xed\x96\x25\x2c\x4a\x39\xf7\xe1\x36\x8c\x97\x44\x5b\x64\xe7\xad\x7a\x89\xb4\x8a\x2d\x59\x82\xa6\x7d\xfa\x02\x1f\x96\xc1\x6d\x10\xa5\x39\x17\xb2\xde\x5b\xe6\xe2\x8a\x36\x6a\xea\xa6\x9e\x24\x41\xc7\x92\x84\x3d\x56\x7c\xe4\x73\x94\x79\x16\x7c\x13\x7b\xab\x8c\xdb\x5d\x5f\x5e\xc2\xc5\xe8\xdd\xf0\xfa\xd2\xf5\xc9\xf9\xb7\xa3\xf3\xff\xe8\xe4\x30\x3f\x03\x94\x92\x49\xdb\xcb\x1f\x8e\xaf\x72\xa6\xe9\xfa\x3c\x5f\xd0\x19\xbc\xfa\xfa\xa8\xd4\x68\x3a\xb9\x9a\xcf\x86\x38\x1b\x45\xba\x65\xd7\xc8\xd4\x5e\x7d\x7d\x24\x8a\x1b\x99\x31\xaf\xc0\xdf\xd9\xd3\xe6\x8e\x3f\xca\x4e\x3e\xce\xc6\x1f\x86\xb3\x1f\xd1\x42\x8c\x1f\x66\xdf\x35\x63\xf3\xc7\x0d\x98\xfc\xf1\xeb\xd7\xdd\x96\x56\x1d\x6c\xa2\xd0\xcb\x10\xbb\xa7\xb8\xaa\xe2\xa2\xca\x34\x3d\x19\x7d\xff\xec\xc6\x68\x87\x5c\x56\x16\xcf\x2f\x66\xd3\x8f\x30\x9f\x8d\xdf\xbf\x1f\xcd\x90\x2f\x8f\x7e\x18\x5f\xcd\xaf\xca\xf6\xcc\x85\x16\xd4\x1d\xe3\x50\x33\x38\x1f\x5e\x9d\x0f\x2f\x46\xa7\x5a\x72\xd4\x9d\x56\x76\x25\x05\xc2\x77\xa8\xcd\x8d\x27\x57\xa3\xd9\xbc\xb2\xef\xcc\x2e\x34\x42\xbd\x6e\x36\xfd\xde\x3a\x93\x95\x6a\x8a\x03\x00\xa7\x64\xa9\x76\xff\xb4\xfa\x7d\x18\x23\x0d\x8d\x58\x98\xc9\xe1\x02\xe8\x45\xc5\x17\xf8\xc9\x8c\xa7\xdb\x24\x02\x66\x38\xfb\xc0\x72\x1b\x84\x29\xdc\x24\xf1\x1a\x18\xdc\x6c\xc3\x90\x90\x80\x88\x12\x03\xb1\xbd\xb9\x09\x3e\xa1\x54\x2e\xed\xdf\xdb\x30\x94\x5f\xa1\x46\x9d\x6c\x23\x8f\x6c\x3c\xfa\x06\x8e\x2c\x94\xf4\x05\xde\x32\x87\x3e\xdc\x04\x64\x00\xc4\xcf\xa8\x0f\xfa\x54\x04\xff\x54\xe6\x02\x16\x3e\xb0\x47\x34\x6e\x00\xff\xc4\xbc\x34\x7c\x84\xbf\xbe\x91\xce\x46\xfb\xc8\xf4\x9b\x5b\x49\xb3\x1f\x82\x74\xb5\x90\xc3\xe7\x34\x2c\x5f\x50\xca\x3f\xa1\x1d\x91\xde\xd3\x1f\xb6\xe4\x8f\x6d\xdc\xd7\x74\x1d\xb1\x5d\xa2\x98\x12\xdd\x76\xf2\xde\x50\xcc\xf9\xeb\x9b\x7e\x07\x67\xbb\x08\x79\x74\x9b\xae\x3a\xb2\xef\xee\x97\xc7\xdd\x2e\xfc\xeb\x5f\xd0\x5e\xb4\xf1\x1f\xf5\xf4\xe4\x84\x46\x70\xdd\xe1\x8d\x3f\x7c\xb8\x7e\xda\xdd\xab\x0b\x04\x72\xbd\xb4\x50\xd7\xcd\x6b\x8e\x0b\xa8\xc7\x2a\xde\x24\x97\x26\x51\x21\xc3\x82\xc0\x57\xfb\x4f\x7b\x4e\x26\xfe\x18\x90\xbb\xa5\x0a\x23\x16\x12\x23\xd4\x3e\xc3\x3f\xb6\x29\x04\x68\xe8\x46\x23\xb3\x81\x32\x68\x97\x47\x99\xf2\x26\x48\x7b\x70\xcb\x23\x34\xe9\x73\x51\x9e\x00\x8d\x36\xc9\x78\x69\x4a\x57\x08\x1e\x8b\x94\x15\x1b\x2d\xea\x61\x18\xd0\x6d\xee\x92\xa7\x0f\x9c\x93\x36\xbe\x15\x3c\xc1\x0f\x7d\x7e\x13\x44\xdc\x07\x03\x89\xe9\x57\x04\x4d\x86\xd0\x19\x83\x76\x7d\x25\x20\xbe\x01\xb9\xa5\x88\x8f\x0a\x49\x6f\x79\x9a\x7f\xce\x22\xb4\xc9\xa3\xaa\x8b\x0e\x17\x3c\x7c\xec\x01\x53\xcb\x14\x85\x91\x58\xc2\xf3\xce\x06\x04\xf9\xef\x69\x5c\x60\xb0\x66\x9f\xe8\x1b\xdd\x20\xbe\xc1\x01\x71\x9d\x7f\xfd\x3a\x9b\xa2\x3c\xaa\xd9\x4d\x10\xfd\x42\x82\x3d\x76\x25\x39\x68\xfa\xb8\x91\xa0\xf3\xe1\xff\x48\xea\x81\x7f\xfc\x9f\x01\x8e\x24\x4d\x72\x31\xf0\x48\x6c\x93\x0c\xa4\x81\xd0\xc7\x18\x7b\xd1\x92\x89\x80\x07\x1e\x86\x3d\x3c\xcf\xa4\x5c\xa4\x31\x24\x5c\xf0\xe4\x9e\xe3\x7a\x36\xcc\xe3\x99\xba\xbe\x8d\x7c\x9e\x08\x2f\x4e\xf8\x21\x47\x55\x0e\xe8\x38\xa5\x0b\x96\xdc\x1e\x7e\x52\xcf\x87\x86\x80\x4c\x0e\x26\xe6\xf1\xb4\x06\xe9\xc2\x37\x08\xeb\x92\xf2\x66\x35\x52\x67\xb6\x52\xfe\xde\x87\x10\x39\x07\xd0\xab\xb4\x25\x7e\x53\xa6\x7d\x61\x82\xa1\x36\x62\x07\xad\x38\x4f\xb8\x71\x54\x25\x42\x92\x6d\x17\x6e\x83\x7b\x1e\x69\x0b\x97\x3e\xbc\x44\x29\xb6\x82\x93\x05\x0c\x2f\x9b\x40\x5f\x80\x09\x44\x2d\x61\x18\x8b\x96\x5c\x59\xd8\x5a\xfd\xfe\x98\x68\x86\xea\x1e\x89\x05\x9d\x84\x47\x9e\x02\xff\x14\x88\x54\xf6\xcc\x0d\xeb\x9c\x52\x45\xe5\xdd\x68\x6e\x68\x53\xde\x8c\xca\x6c\x84\xf8\xad\xee\x15\xe9\x3c\x89\x8a\x3b\x50\x2d\x33\xa4\x31\xde\xf2\x5a\xdf\x31\x2f\xdd\x92\x90\xad\xcf\x5e\x36\x4d\x6c\x44\x17\xd0\xfa\x26\xab\x57\xee\xf9\xa7\x26\x36\xac\x9f\xf7\x38\x44\x4a\x67\xb1\x84\x85\x56\x41\x1e\x2f\x9c\xa5\xe9\xf5\x1c\xb4\x47\x07\xfe\x9e\x0b\x7b\x20\x55\x1b\x97\xad\x2b\xe2\x0f\x4a\xae\xd7\x96\x2e\xf5\xe4\x0c\x22\x74\x29\x65\x61\x67\x73\xbb\x20\x3d\x90\x27\x01\x0b\x17\x7a\x97\x3b\xed\xc2\x8c\xe5\xa4\xda\xbd\x76\xe0\xb7\xbb\xdd\x93\x13\xea\x32\xbb\xbb\x52\x02\x95\xd4\xac\x5c\x1f\xa2\xf0\xdc\x33\x57\xd6\x33\x16\xd0\x2d\xde\x7f\xa9\x79\x97\xd5\xca\x02\x68\xca\x0d\xea\xcf\x48\xf1\x73\x35\xce\xc9\x49\x4e\xa1\xa6\x13\x94\xea\xdf\x5d\xa2\x72\x78\x31\x45\x3d\xe3\xdb\xf1\xe4\xbd\x41\xbc\xc6\x93\xf7\xee\x25\x92\xe9\xca\xfd\x26\x5f\x6a\xae\x80\x62\xeb\xfc\xb9\xd6\x3f\x25\x51\xa6\x9b\x73\x64\x4d\xde\x36\x49\xe8\xf6\x5c\x3a\x53\xe1\x61\x81\x35\xa3\xbb\x7d\x48\x14\xf3\x8f\x1e\x53\xbc\x9b\x21\x92\x9f\x26\x8f\xc0\x40\xf0\x90\x7b\x29\x71\xce\x30\x8e\x37\xba\xeb\x55\x9a\x6e\xc4\xc9\x57\x5f\x89\x94\x79\x77\xf1\x3d\x4f\x6e\xc2\xf8\x61\xe0\xc5\xeb\xaf\xd8\x57\xc7\x7f\xf9\x5f\x7f\x79\xfd\xf5\x9b\x3f\x2b\x49\x77\x3c\x97\xb4\x57\xb9\xb0\x98\x04\x7a\x4d\xeb\x5c\x37\x58\x53\xab\xd1\xd5\xa4\xba\x96\xcc\x77\x06\xce\xcc\xbf\x70\x9f\x4e\x5b\xee\x69\x59\xb7\x20\x3b\x55\x19\xd8\x83\xb6\xba\xce\xa7\x4d\x5a\x8d\x0b\x07\x9b\xb4\x4a\xc5\xeb\x8e\x3f\xd2\xdd\xa8\x49\x62\xef\xf8\xe3\x4b\x92\xd6\xbd\xa9\x4f\x36\xd3\x9c\xf4\xe0\x79\xc0\xa9\xcf\x47\x3f\xcc\x33\x92\x33\x9e\xa8\xdf\xc9\x78\xbb\xf0\xe2\x70\xbb\x8e\xe4\x56\x4d\x86\x1f\x46\xba\x5d\xe9\x45\xeb\xa5\x69\x52\xb6\x80\x03\xc8\x52\xf6\xad\xa4\x4c\x77\xfc\xb1\x57\x5e\x5f\xaf\xb0\xac\xe6\x84\x4a\x01\x72\x5f\x02\xa5\x3f\xb3\x09\xd3\x81\xbd\x48\x05\x26\xf0\xdb\xbd\xcc\xf8\xfa\x4a\xc8\xbf\x65\xf7\xdd\xc3\x49\x5e\x06\x3e\x17\xd5\xcb\x5f\x3a\x20\x5a\xd3\x91\xd9\xd0\x26\x2a\x3b\x77\xe6\xdf\x87\x7e\x86\x77\x04\xb2\xf0\xce\x05\x1c\x7a\xf9\x04\x30\x54\x92\xdc\x1c\xdd\xc3\x3b\x83\xec\xe2\x83\x33\x8d\xac\xcf\x43\x66\xf7\xa7\xb2\x39\x1d\x42\xb2\xe3\x24\xb1\xef\x49\x73\xa3\x86\xa0\x49\x6b\x70\x03\x71\x94\xab\xa4\x07\x51\x42\x97\x09\xd9\x22\x88\xcf\x46\x0c\xbb\xb6\xba\xa3\x90\xa1\xf1\xa6\x36\xd9\x53\xb9\xa5\xe1\xdd\x40\xee\x6a\xc5\xda\xf0\x6d\x0b\x00\x8d\x9c\xd3\x09\x0c\x2f\x2f\x5b\x05\x9f\x27\xd7\x50\x25\x00\xd5\x74\x4e\x44\x45\x45\x17\xed\xf0\x77\xde\xcb\x31\xdd\xb5\x4f\x12\x61\xd2\xb8\x84\x30\x20\x31\x26\x63\xc8\x4a\xcb\xde\xc4\x22\xc8\x6e\xcf\x0d\x84\x1a\xc0\x3b\x7c\x10\xe9\x0b\x38\x52\x1d\xd0\x23\x86\x45\xd2\x24\xa6\x3f\x24\xc3\xc9\x92\xf4\x6c\xbc\xd3\x67\x1e\xb9\x27\x6e\x62\x21\x82\x65\xc8\x73\x23\x0b\xf1\x77\x62\xee\x9b\x84\xa7\xe9\x23\xc8\xeb\x3d\x52\x34\x40\x48\xdb\x8b\xd8\x30\xb4\x48\x85\x24\x15\x68\x1d\x24\x5b\xdb\x42\x0f\xd9\xab\xf5\x85\x85\x4e\x10\x49\x5f\x5a\x6d\x5e\xe8\xf6\xf6\x3c\x00\x78\xfc\x37\xb1\x20\x0f\x62\x0b\xf9\x4d\xa1\x4c\x2a\x21\x38\xaf\xec\x4f\x5b\xa5\x0f\xa2\xb4\x22\x40\x26\x03\x3a\x31\x67\xc9\x1d\x3f\xa5\x8b\xf2\x63\x4b\x99\xc3\x43\x63\xfa\xe9\xf5\xfb\x08\x33\x3f\xde\xe2\x4b\x6f\xc5\xbd\x3b\x02\x19\x5e\x85\xa2\x75\x49\xb5\xb9\x09\x44\x0a\xf1\x26\x0d\xd6\x81\x48\x03\x4f\x36\x3c\x31\xe8\x6f\xb6\xb8\x4d\x2c\x32\x6a\xd9\xaa\xe0\xab\xe5\xcd\x80\xf0\x6e\x93\xd3\xcf\xec\xbb\xf0\x6e\x33\xb0\x45\x58\x07\x60\xcd\x16\xd9\x97\x74\xb3\x71\xb7\x31\xce\x6c\xf1\x2b\x0d\xf3\x9c\x15\xe8\xc9\xe4\x17\xe3\x44\xa9\x6d\x4b\x88\xdc\x17\xa3\x6d\xd5\xad\x5a\x03\x81\xdd\x3e\x7e\x96\x71\x1d\xbf\xeb\xec\x58\xac\x71\xed\x66\x7e\xab\x79\x36\x6e\x23\x30\xe9\x46\x62\x7a\x5b\x69\xeb\xd9\x03\x07\x96\x70\x08\x22\xe0\x37\x37\xc8\x98\xbd\x15\x8b\x6e\xb5\x3b\x9a\xf0\x56\x7c\xcd\x4c\x1c\x20\x77\xe0\x35\x79\x96\x2b\x7b\x19\x2f\x60\xdc\x92\x87\xc8\x40\xf0\x0c\x27\x09\xf6\x18\x44\x90\xf2\x64\x4d\x66\x43\x43\x6c\x70\xdd\xc5\xb5\x0d\xb7\xb3\x82\xdf\xc3\x78\x02\x57\xdf\x0e\x67\x23\xed\xa2\x97\x3b\x9c\x7d\x98\x5e\x8c\xda\x3d\x6b\xf5\x5d\xbd\x7c\xc1\xbd\x38\xf2\x15\x4a\x4b\xb7\xbf\xcc\xdf\xef\xdf\x01\x67\x6b\x91\xf6\x59\x11\x76\xfc\x2e\x27\x40\x67\x90\xdf\xf3\x5a\xfd\xd8\x3b\x7d\x72\x06\xc7\xa7\xd0\xef\xc3\x71\x5f\x5e\x3b\xfb\x92\x13\x88\x1e\xe8\xcf\x09\xf5\x28\x28\x80\x87\x1c\x3d\x20\xca\x41\x24\x85\x6d\xc0\x9f\x35\xfb\xd4\xd9\xc4\xa2\x0b\x5f\xc2\xb1\xe5\x87\x5b\x67\x5d\xac\xd9\x9b\xf2\xfe\x1c\xb4\x47\x12\xde\x16\x0c\x6c\x0f\x5b\xeb\x15\xdd\xa4\xe2\x85\x6c\xc9\x86\x5a\x82\xe2\x1b\x82\xa2\x82\x10\x1c\x6b\xa3\xb2\x8c\xce\xd2\xa0\xdc\x7d\x93\x5f\x70\xb8\xdd\xc5\xdf\xf5\x76\x67\x3e\x40\x0d\x14\xba\x6c\xda\xd9\x6c\x94\xcf\x65\xc7\x32\x3f\xe9\xae\x7b\xf6\x5a\x4b\x2a\x51\xd6\x4b\x95\x6a\x64\x9e\xce\x2a\x74\xc7\xeb\x6a\x17\xca\x0f\xc7\x57\x23\x68\x9f\x93\xc6\x8f\x3a\xc9\x4d\x20\x6f\x3b\xf8\x43\xd6\x49\xbb\x39\x14\x15\xf8\xd4\x55\x34\x0a\x05\xe6\x92\xbb\xa7\x0d\xbe\x55\xed\x1d\xdf\xb6\x9c\x67\xf4\x99\x35\x02\x97\x38\xe2\x32\x6c\x1b\x92\x9e\xd3\x5e\xa2\xe8\x28\x53\x54\x55\xdd\x98\xd0\xff\x94\x47\x47\xa6\x37\x90\xce\x70\x80\xc4\x94\xf9\x9b\x58\x32\x91\x16\xe7\x8d\x07\xb9\xe2\x60\xea\x00\x52\xb0\x71\x59\x2a\x6a\x09\x7b\x27\x37\x54\x74\x5b\x39\x6e\x67\xdf\x64\xb3\xe9\xe5\xf3\x78\xa2\x96\xaf\x23\x05\x94\x16\x5a\xa5\x25\xba\xf8\x55\xf1\xdb\x7a\xf5\x14\x42\x07\x97\x92\x3c\x26\x83\xf1\x70\x72\x91\xbd\xa2\x15\xc2\x99\x01\xf1\xcf\xae\xc1\x96\x90\xc1\x44\x56\x87\x5a\xf2\x90\x60\x4c\x55\x02\x2c\x89\xb7\x91\x0f\xbf\x88\x38\x5a\x2e\x38\xf3\x56\x0b\xfc\x04\xbf\x40\x53\x21\x30\x58\xf2\x14\x11\x38\x89\x1f\x16\x5c\xa4\xc1\x9a\xa5\x78\x51\x81\xb4\x56\x79\xe2\x74\x8e\x5f\x13\xc5\x20\x27\x90\x3d\xc2\x46\x69\xa2\x85\x71\x3b\xbf\x08\x39\x15\x89\xac\x08\xf2\x1c\x75\x25\x94\x95\xbc\xaf\x85\xfd\xab\xd1\x7c\xfa\x0e\x12\xee\xc5\x89\xdf\x02\x53\xbb\x6b\x55\xdd\x6c\x69\x8f\xab\xd9\xf4\xfb\x2b\x38\x7e\x9d\x1d\x05\xa4\x23\x47\xd9\x3d\x7d\x79\x66\xdd\xee\xe0\x0b\xa3\xe5\x1e\x9b\x53\xb5\xd6\x38\x5a\xe6\x9b\x63\x5c\x91\x15\x36\x67\x1b\x45\x5c\xe4\x7b\x92\xef\x08\xe8\x1d\x79\xda\x26\xc8\xfe\x3b\xa6\x1b\x15\x8b\x1e\xe9\x97\x12\xa4\x59\xf4\x98\x09\x27\xcf\x07\xed\xf2\x0c\xba\x4f\x81\xb4\xea\x2e\x5b\x84\x0b\xc6\x20\xd8\x0d\x5f\xb0\xcd\x26\x89\x3f\x11\x0c\x17\x88\xe2\x94\xcf\x40\x19\xe4\xe4\xd5\x9c\xd1\x82\x40\x2e\x5b\x50\x30\x67\xee\x22\x49\x2e\x0a\xb9\x03\x30\xc8\xc0\xb5\x54\x5b\xcc\x81\x87\x82\x37\xe8\x55\xc5\x54\x46\x28\xdf\x87\x52\x1d\xca\x62\x1b\xf8\x3d\xfa\xdf\x03\x4f\x92\x38\xc1\xde\xad\x2e\xe4\xe7\x1e\x0b\xbd\x6d\xa8\x9d\xfd\x1d\x73\x42\x0c\xc9\xe6\x65\x04\x48\xe2\xa0\x1e\x13\xa4\xd9\x6c\x42\x86\xff\x8f\x45\x7a\x9b\x70\xa1\x3d\xec\xf7\x31\x65\x55\x03\xb6\x93\x6b\x6a\x8b\x20\xc2\x38\xc7\xd9\xe8\xfd\xf9\xe5\xf0\xea\xaa\x9b\x87\x80\x93\x77\x5e\x0b\x00\x4a\x51\x24\xad\xe1\x55\xeb\xe8\xe8\x59\xd3\x58\xc8\x51\xa1\xa3\xcd\x4e\x92\x27\x34\x9b\x7c\xb7\xeb\x88\xf2\xde\xc7\x37\xdc\x92\x73\x51\x95\xe9\x38\xb2\x6a\x94\x2c\xee\x34\x43\xab\x4b\x9d\x71\x27\xc7\xc7\xd2\x47\xc4\xca\x72\xe3\xfb\x58\xfa\xef\x4a\x8d\xb5\x7c\x0b\x7a\x72\x92\xf0\x5b\x2f\x64\x42\x9c\x95\x16\x9d\x75\x5d\x92\xd4\x1d\xf0\x34\xb9\x86\x9c\x78\x3e\xc7\xc5\x7e\x50\x2e\x0a\xf3\xae\xd1\x78\x98\x6e\x37\x21\x17\x27\x27\x12\x8b\xf2\x9c\x44\xb8\x16\x05\x84\x38\xf0\xcb\xab\x2a\x05\xc7\x9f\xb6\x8e\x8e\xf6\x4a\x83\xa0\x5c\x4c\x95\xc8\xab\xb6\x04\xd7\xd5\x29\x5b\x94\x08\xe0\xf4\x38\xf3\xd8\x17\xca\x33\xf6\xa7\x9f\x5b\xf9\x59\xf8\x6e\x3a\xbe\x80\x22\xd2\x6b\x2a\x88\xb2\xf3\x70\x9e\xdb\xc8\xda\x76\x38\x5e\xc9\x15\xf7\x6a\x34\xb7\xfd\x60\xcf\x40\x5a\x17\x52\xf9\xf7\x97\xc7\x4e\x81\x28\xf0\x85\x6a\x2f\xc1\x67\x75\xa1\xb5\x36\x44\x5e\xba\x37\x1b\x4e\x7e\xec\x1c\x1d\x9b\x61\x15\xe6\xc2\xe9\x61\x17\xae\xaf\x50\xc2\xcb\x97\x6e\x26\x79\xc9\x80\xdf\x2a\xc7\x90\x55\xba\x23\xd6\xfc\xb8\xbe\x81\x8f\xdb\x65\x18\x78\x30\xfc\x38\x16\x20\x1f\xed\xfc\x66\xd7\xcf\xbe\x59\x5c\x4a\xa6\xab\x45\x70\xb3\x20\x0d\x40\x54\x9b\x3d\x6d\x3b\xa7\x64\xb6\x1d\xed\x8a\x51\xe3\x86\x61\x9b\xf9\xf3\x86\xb9\x4b\xd2\xae\xcb\x71\x1d\xb2\x5b\x36\x01\xd4\x2c\xc4\x6c\xfd\x52\x49\x62\xea\xe0\x68\x0b\xbf\x26\xef\x57\x08\xa0\x25\x0c\x12\xad\xb8\xd4\xc9\x68\x69\xb1\x79\xc5\x5d\x76\x4e\xca\x8c\xeb\xe4\x78\x2a\x15\x56\xd3\x69\x28\x93\x09\x82\xf4\x89\x17\xe4\xbb\xec\x9d\x35\x16\xf2\x1d\x6e\x3a\xf2\xa1\xba\x2f\x78\x44\xdd\x41\x67\x5f\x69\x8e\x39\x3d\xc8\x42\x4c\x0e\x47\xa0\x9a\xe5\x15\x6d\x7e\xce\x9b\xa2\x1e\xe5\x91\xd9\x71\x5f\x64\x76\xdd\xd9\x63\xd4\x97\xbf\x42\x2a\xef\x69\xa5\xce\xb6\xa9\xc6\xda\xfa\x4b\xa5\xa7\xdf\x43\xa2\x1d\x64\xc7\x75\x8c\x83\x42\xe9\x7c\x82\x47\xca\xc0\x4c\xa6\x11\xfe\x89\x7b\x5b\xed\xf8\x46\x11\x67\xfc\x13\x66\xf7\x40\xd5\x46\x2b\xc0\xd9\x12\xa5\xeb\xaf\xd3\x50\xf2\xdb\x58\xa5\x2b\x60\xd3\xf0\x46\xa5\xea\x6b\x75\x13\x6a\x23\x78\x71\x75\x0d\x2c\x54\x0d\x67\xd8\xdb\x35\x19\xb9\x8d\x19\xde\xbf\xd8\xb5\x29\xa1\xd5\x0e\x4b\x85\xba\x88\xf4\x58\xe2\x53\xb8\x72\xfa\x68\x69\x52\xe6\x73\xd2\xca\x64\xf3\x0d\x0b\x12\x49\xfe\x4a\xe9\x64\x06\x32\xde\x01\x44\x80\xa1\xd0\xf2\xba\xa5\x07\x94\x27\x87\xa9\x4e\xa3\xed\x7a\xc9\x13\x62\x03\x28\x67\x5b\xbd\x7e\x25\x7f\x5d\xb3\xd4\x5b\xf1\x04\xe4\x15\x2b\x69\x79\x2a\x18\x8b\x85\xa1\x31\x66\x13\x6a\x6f\x44\x31\x19\xcb\xe9\x98\xf1\xc1\xe5\x83\x65\x69\x48\xb9\x76\x04\xe5\xe4\x7c\x46\x7e\x4e\x77\xde\x00\x2d\x1a\x8b\x81\xb2\xe9\xfc\xff\xdf\x4a\x8a\xf2\x93\x9e\xc2\xcf\x28\x92\x55\xf0\xeb\xa7\x50\x26\xc5\x24\x25\xc3\x6e\xd1\xcd\xea\xcd\x36\x84\x20\x92\xfa\x28\x7a\xd4\x0b\x75\xf7\x1d\xc3\x6d\x12\x6f\x37\x32\x7a\x9e\x92\x0b\xdd\x04\xde\x5e\x34\xce\x00\xb3\x79\xfe\x9f\x4a\xd7\x3e\x2f\x11\x2a\x7f\xda\x80\xf6\x38\x3e\xd2\x24\xa7\xea\x90\x1f\x28\x9b\x55\xc1\xd8\x75\xc8\x4d\x89\xcc\x4f\xe2\x8d\xe2\x85\x4a\xc5\x30\x12\x0f\xb1\xc8\x87\x84\x87\x32\x3c\x48\xa2\x6c\xae\x47\xca\x10\x13\x4a\x1b\xc4\x52\xb6\x44\xb4\x61\x98\x5d\x58\x86\x4e\xa4\x2b\x5e\xf8\xb4\x47\x4e\x0a\x32\x50\x72\x1b\x25\xfc\x86\xe3\x0d\x2b\xf7\x95\x3d\xb3\xf1\x79\x35\x66\x6c\xb9\xf3\xa6\xf1\x62\xc9\x17\xf8\x76\xc3\x7d\xb5\x62\x53\xa1\x33\xce\xa9\xe9\x9b\x80\x3f\xf9\xa2\xa8\x2b\xf2\xf7\xc9\xb5\x5d\x02\x0b\xbd\xcc\xc3\x0a\x31\x27\xe0\xfb\xd1\x4c\x36\xca\x75\x44\x65\x89\xd0\x8a\x31\x5e\xfa\x6c\x6e\x17\x69\xf2\xb8\x60\xfe\x7d\x20\xe2\xe4\x71\x81\x31\x52\x0b\xbc\xde\xd5\xf1\xb5\x78\x9b\xbc\x18\x5f\x74\x1d\x41\xe8\xf2\x76\x68\x32\x9d\x8f\xcf\x47\xd0\x36\xb7\xca\x63\x11\xe5\xd8\x20\xce\x4e\xa9\x2c\xa2\x18\x3e\x26\xf1\x9a\x6c\x13\x79\xce\x0d\x19\x6b\x9a\x6c\x23\x8c\x18\x1f\xc0\x47\x99\xb3\x47\xac\xb6\xa9\x1f\x3f\x48\x12\xed\xfa\xaa\x7d\xea\x0c\x51\xde\xdc\x36\x58\x47\xb5\xd9\xa0\xe4\x6e\xd0\x53\xd7\x22\xd3\xe2\x0e\xf4\x9c\x40\xaf\x91\x75\x4b\x3e\xc4\x67\x95\xa8\x71\xda\xaa\x00\x2f\x8e\x88\x3e\x05\x7f\x7a\xf5\x27\xd5\x93\x44\xe5\x7c\x02\x4c\xd0\x4b\x0a\xc7\xcf\xe6\xaa\x9e\xb6\x7b\x50\x39\xa4\x73\x39\xbd\xe2\xa2\x4f\x4b\xe9\x68\x94\xa9\xa1\x4d\x31\x93\xdf\x8d\x47\xdf\xeb\xd5\x1b\xf6\x85\xd3\x76\xa9\xa3\xee\x1e\x3d\x7d\x18\xa1\x99\xf8\xd0\x9e\x6a\x83\x90\x9f\xa3\xbf\x06\x1d\x5d\x8c\x2e\x47\xf3\xd1\x6e\xe4\x08\xfc\x33\xc7\x2e\x9c\x1a\x59\x86\xc0\xa3\x04\x99\xdb\x8d\x8b\x3e\xf5\x72\x62\x2e\x69\x58\x90\x8a\x8c\xbd\x0e\x9a\xcc\xc6\xc1\x7c\x0e\x42\xdb\x26\x43\x18\xde\x9d\x48\x84\x30\xd9\x90\xf2\x69\xc5\x47\x44\xb9\x77\xce\x2e\x37\xce\xd9\x76\xa1\x4d\xb8\xb9\x15\xbf\x86\x99\x5b\x66\xa6\x28\xe0\x11\x91\x72\x46\x7e\x47\x09\x28\xba\x51\xd4\x68\x2c\xa3\xd8\x94\x56\xcf\x8d\x44\x36\x44\xc4\x98\xd0\x52\x07\xe5\xa0\x92\x61\x82\x5b\x81\x07\x12\xad\x74\x7e\x80\x6e\x3a\xe1\x53\x55\xaa\xc0\xb7\x3c\x3b\x6b\xae\x6d\xeb\x35\x2a\xe9\x2e\xa2\x40\x9a\xc6\xfa\x9e\x20\x73\xe4\x97\x20\x5e\x72\x9c\x3e\x8a\xa9\xb0\xd5\x4e\xc4\xdb\x48\xe7\x28\x0c\xc2\x47\x97\x1c\xb3\xeb\x92\xf4\xa9\x57\xa4\x07\x2b\x3c\xa5\xfb\x6e\x13\x66\x9f\x45\x73\xd9\x7d\xbd\x4a\xd6\x21\x33\x2c\x35\xf7\xf8\x62\x42\xbb\xfe\xe4\x92\x0b\x5d\x05\xb6\xfa\xfd\xd7\x02\x12\x8e\xf9\xde\x70\x0f\xe9\x84\xcb\xbc\x8f\x2a\xff\xa4\xe0\x29\x74\x1e\x38\xf8\x94\x4e\x65\x2b\x38\x59\x5f\xd1\xf5\x20\xc0\xbd\x0e\xa2\x54\xf6\x9b\xd9\x9c\xb2\xfc\x48\x69\x37\x0b\xf8\x08\xb2\x57\x3c\xd1\x89\x29\x19\x7e\x9e\x25\x44\x93\xbd\xa9\x4c\x98\x81\x90\xe7\x82\xb0\x27\x8e\x4c\xff\x75\x2f\x0c\x70\x9e\x44\x84\x04\x78\x94\x5d\x52\x46\xd8\xe2\x60\x33\xce\xfc\x2c\xdf\x23\xca\x09\x3a\xca\x97\xff\x6a\x1c\xb9\x44\xe6\xdf\x14\xb9\xb4\x46\xb0\x90\x91\x73\x91\x0f\xfc\xd7\x2d\x29\x43\x4f\x3c\x6f\x04\x97\xec\x76\x39\xcf\xa9\x5c\x95\x46\x22\x3f\x63\x94\x23\x21\xf0\x3f\x2d\xee\x59\x88\x8f\x3b\x75\xbe\x58\xfd\xbe\x04\x96\xa7\x75\xc0\x3c\x9a\x3e\x8d\xb5\xa1\x10\x4d\x6d\x78\x52\x32\x4f\xde\x62\x17\x08\x50\x9a\x0c\x51\x1c\x69\x02\x79\x54\x9b\x4e\x9a\x12\x60\x4a\x16\x6b\xef\x64\xee\x2d\x61\x5f\x29\x79\x31\x0b\xb9\xf0\x78\x07\x15\x81\x4d\x2c\x8a\xd1\x1b\x7b\xe8\xe8\xbf\x88\xfe\xdb\xb7\x66\x3e\x13\x4e\x66\x82\x2e\x42\xa6\x57\x31\xe8\x20\xf0\x0f\x18\x31\xf0\x3b\xd4\x37\x0e\x21\xbd\x4b\xba\x78\xbc\xed\x0c\x93\x55\x17\xea\x5d\x28\x5c\x7d\x5d\x8e\xde\xcd\xe1\x7f\x4f\xc7\x93\x3a\x3f\x0f\xe3\x67\x3a\x81\x4e\xa8\x94\x26\x9a\x86\x54\xa4\x06\x9a\x7c\xe9\x39\xb5\x9a\x0f\x52\xed\x65\x97\x8d\x59\x7c\x52\x0e\xf4\x75\x69\x82\x85\x3d\xb1\xc8\xad\xfd\x9d\xb1\x9e\x62\x8b\xae\x21\x77\x20\x5f\x24\x44\x95\x39\x6a\x97\x8f\x52\xfd\xcd\xb9\x8a\xcf\x99\xaf\x52\x24\xdf\x80\x7b\xf3\xb2\xec\x75\x94\x2d\x52\xe6\x69\x2e\xa5\x1d\x0d\xb3\x99\x74\x0d\xba\x0f\xc3\xd9\x6c\xf8\x63\xa7\x5c\x62\x40\x21\x94\x3a\x84\xb8\x03\x3d\x78\xdd\xad\xf6\x75\xd4\x74\x57\x5d\xc6\xb9\xa0\x09\x70\xec\x4e\x15\xa4\x55\x26\xf4\xaa\x0c\xfc\x4f\x5d\xea\x5d\x9f\x7f\x7b\xdb\xbb\x70\x5b\x81\x06\xaa\x39\x61\x93\x9e\x75\xe0\x7f\x42\x23\xa0\xec\xa2\x7b\x72\x52\x41\x79\x6a\x58\x96\x91\x42\xf1\x10\xd2\x47\x74\x0f\xf3\x28\xca\x44\x03\xa9\x00\x96\xd3\x5a\x66\x06\x27\xb4\x9f\xc8\x1e\xcd\x11\xcb\x8e\x72\xcf\x41\xc8\xcd\x83\x20\x83\x62\x0c\xa1\x18\x69\xc1\x4f\x3f\xeb\x47\x74\x5e\xf5\xc3\x3f\x08\xff\xbe\x84\xbf\x72\x0f\x6c\x7b\xf2\xdd\xfd\x0b\xf2\x03\xd9\x39\x0d\x52\xc9\x11\xc8\xbd\x08\x7f\xeb\x58\xbe\x44\x88\x10\xdd\x1e\x5c\x4f\x26\xa3\xab\x79\xc7\xc4\x88\x6e\x17\x37\xf5\xee\xbe\xe4\xc7\xf8\x1c\xac\x43\xce\xb8\xc0\x3b\xb2\xe9\xff\x1e\x98\x47\xa3\x7d\xdd\xc9\x52\xe4\x3a\xab\x79\x4a\x46\xf1\x8d\x86\x7f\x90\xfc\xcf\x44\xf2\x73\x15\xe5\xa7\x9f\xf5\xbf\x25\x0e\x60\x64\xdb\xe8\x29\xad\x24\xbe\x21\xd5\xa3\x27\x13\xde\xe8\x47\x9a\x8e\xbe\x08\xaf\x90\x34\xbc\x30\xd5\xe7\x66\x1d\x81\x2f\x9e\x81\x71\x90\x69\x08\x03\x2e\xe4\xcd\xba\xbe\x61\xcf\xba\x51\x5a\xbc\xd1\x87\xd2\x12\x73\xce\xf2\x07\x0f\xa1\xcd\xf8\xcd\x39\x48\xb1\x2f\xd5\xaa\xfc\x94\xbe\xf9\x83\xdf\x3c\x37\xbf\x29\xe0\xc0\x93\xb9\x4d\xbf\xaf\x13\x4d\x65\x0a\x4c\x10\x11\x45\xc5\xf3\x13\x47\x69\x12\x87\x79\x65\x17\x4a\xcc\x45\xa0\xcd\xd2\x61\x45\x31\xac\x19\x39\x57\x93\x21\x22\x0e\xa2\x2a\x4e\x96\xa3\xd2\xf3\x53\x6f\xa4\x53\x2f\x48\xbb\x29\x2e\xf5\x26\xa7\x11\xed\x27\x1b\xc3\x44\x53\xfa\x9d\x67\x8a\x13\x38\x72\x0f\x32\x23\xb6\x9a\xa1\x71\x3b\xac\x78\x63\xbf\x6f\xec\x98\x4e\x98\xb0\x94\x86\x24\xa1\x6e\x3d\x64\x03\x74\x9f\x64\xa1\xd6\x39\xb5\x87\x96\xa6\xa1\x90\x29\xb7\x4b\xae\xc2\x72\xff\xa9\xac\xc0\x06\x29\xdc\xeb\x12\x59\x50\x7d\xbb\xce\x78\x82\x8e\x54\xf2\x09\xda\x67\x11\x02\x2a\x78\x21\x67\x29\x2a\x7e\x21\x67\x27\x95\xd7\xc7\xb4\xec\x05\xbb\xbd\x25\x72\xd7\xed\x59\x0f\x90\x42\xda\x4f\x8c\x13\x6e\x08\x45\x65\xbf\x7e\xd1\xcd\x6c\xe3\xaa\xcd\x78\x32\x19\xcd\xea\x08\x8e\xa2\x30\xe4\xd7\xa9\xbf\xed\x36\xbc\x27\xae\x41\x7d\x07\x00\xe7\x65\xe4\x8e\x72\xec\xcd\xb9\x19\xa5\xe6\x4a\xb8\x72\x2a\x10\x27\x10\x47\xd2\x3d\x8f\x90\x49\xff\x91\x21\x15\x8b\xc8\xb6\x48\x0f\x25\x82\xb5\xf7\xba\xc1\xb6\xe6\x77\x48\xd9\x3e\xea\x0a\x29\x2a\x8d\xae\x64\x9d\xfa\x04\xb6\x87\xe0\x8e\x3a\xf2\xd4\xc6\x34\xd7\x97\x56\xa2\x30\xe1\x99\xf6\xb0\xb8\xb0\x8a\x15\x15\x77\x36\x4f\x3e\x8c\xfb\xab\x2a\x51\x15\x37\xf4\x39\xf6\xb0\xe9\xfc\x5c\x49\xea\x66\x86\x87\x91\x34\x92\x48\xd2\x24\xd5\x8b\x2c\xc3\x23\xf9\xa2\x98\xe4\xaa\x21\x4e\x50\x97\x3b\x30\x21\x17\x39\xa9\x35\x54\x52\x0c\x7a\xbd\x88\x97\xbf\x70\x2f\xed\xe4\xa8\x50\x22\x0a\xbb\x91\xf2\xb9\x30\xa3\xd9\xf2\x76\xa0\x05\x83\xff\x7d\x35\x9d\xfc\x03\xe4\xc2\x1a\xef\xba\x1c\xfb\xd0\xbd\x36\xda\x2a\x8f\x5f\x96\x3b\xaa\xef\xc7\x1d\x3a\xc5\xfa\x0a\xfb\x18\x9f\x0a\x5b\x6c\x18\x52\xeb\xdc\x8a\xe4\x88\xf9\xc5\x9c\xf4\xc9\xcf\xe7\xff\x9c\xb4\xdb\xb1\x3c\xdc\xd0\x1b\x8e\x6e\x71\xc2\xde\x4d\x9d\xe7\x53\x42\x54\x7e\x08\x81\xbf\x27\x35\x2e\x8f\xe8\xda\xcd\x0b\x59\x17\x81\x94\x28\x55\xe8\x88\x42\x6f\x65\x96\x06\xbb\x3e\x5a\xd9\x37\x7b\xff\xdc\x65\x45\x83\x83\x5d\xf2\xd2\x19\x06\x61\x24\xe9\xb1\x77\x58\xa1\x41\x15\x6b\xc8\x1a\x23\x47\x28\x83\xdf\x99\xf3\x04\x2f\x4c\xf3\xa6\x32\xc6\x24\x4f\x66\x62\xbf\xcd\xd3\x9e\xb5\x9d\x88\x85\x19\xbb\xba\x46\x52\x33\x3b\x1f\x05\x98\xc9\xe1\x1d\xe1\xf1\x96\x5b\xc6\xd8\xcc\xc2\x88\xbf\x6a\x02\x54\xb0\x04\x1d\x1d\xf7\xe0\xe8\x4d\x0f\x8e\xbe\x6e\x19\x7a\x4f\x55\xf8\x30\x58\x21\xc4\x81\x9f\xe5\x24\x2f\x41\xdf\x48\x04\x92\x1f\x0f\x00\x50\xb1\x29\x16\x5c\xca\xf3\x94\xfb\x51\x8a\xf1\xcd\xbe\xd0\x77\xac\xd1\x36\x0c\x4f\x5b\x0e\x58\x75\x4a\x96\x00\x08\xdc\x45\xd4\x6c\xa8\x15\x4a\xa8\xa9\x43\x76\x06\x47\xc7\x07\x2f\xf5\x80\x05\xbd\x74\x1a\x2e\x75\xa4\xf0\xfc\x80\x95\xaa\xad\x9a\x9c\x9b\xfe\xc2\x73\xbc\x81\x56\xd9\x0b\xd1\xea\xb1\xe4\xc0\xb2\xcc\xc5\xa4\x21\x32\x40\x82\x21\xad\x2d\x2a\x3a\x30\xab\xa6\xc8\x84\xaa\x88\xb2\x15\x5c\x6b\x1f\xfc\xd7\xec\xa2\x1a\xb4\xdf\xaf\x65\x9c\xa1\xab\xea\x8c\xae\x09\x08\x83\x3b\x79\x81\x3e\x80\x6f\x65\x6d\xc3\x9e\xea\x2b\x91\x29\x64\x74\x9e\x26\x1c\x85\x5c\x5d\xd5\x4d\xbf\x9c\x66\x4e\xa1\x02\x3f\xf3\x38\x29\xe9\x2a\xd4\x21\x15\x70\x51\x95\x19\xb5\x9f\xac\xe0\x14\x7c\xf2\x90\xe7\x6b\x56\x7e\x00\x3d\x08\xa2\x2c\x7d\xad\xe0\xc0\xb0\x8f\x32\x28\x64\x6f\xe4\xf6\xa2\xbd\x71\x6f\xb6\xe9\xd6\x9d\x9d\xb9\xa1\xae\x98\xa1\x92\x14\x0b\x8a\xf7\xf0\x92\x86\x29\x06\x98\x93\xaf\x32\xe5\x82\x62\x1c\x0b\x3e\xb2\x68\x6e\x4e\xdd\xa0\xdf\xbf\xe2\x1c\x2a\x26\x22\x9d\xe6\xef\x17\x39\x8b\x8a\x62\xf2\xd5\x58\xc6\xdb\x54\x67\x74\x32\x02\x4d\xd6\x69\x24\x13\x8e\xa6\x91\x91\x72\xf4\xa0\x2c\x45\x04\x02\xeb\xf2\xb6\x8b\xdd\xb6\x0a\xb9\x89\x8a\x89\x59\x5b\x8d\x4b\xcd\x05\x91\x2e\x35\x27\xd3\x00\xe5\x65\xe6\x8a\x74\x08\x1d\x34\x1e\x8d\x0b\xaf\xf3\xf9\xc8\x75\xd9\xd5\xdc\x94\x7b\x74\xdc\x2d\x9b\xf9\x1d\xbe\x44\xa5\xf8\x44\x26\xa0\x24\xbf\x64\x04\xae\x10\xa0\xeb\xa5\xbc\x5b\xe9\x3f\x54\x4f\x55\xb0\x8a\x47\x0f\x5e\x1d\xe3\xff\x1d\xbd\xda\xfe\x43\x00\xa0\x20\xd4\xb3\xdc\x45\xb3\x0d\xea\xb6\x6c\x42\xda\x2a\x91\x5a\xab\xda\x85\xf1\x94\x48\x67\x2d\xd9\xdc\xdb\x7c\x94\x9f\x31\xe3\xb6\xd7\x8c\x95\xc8\x89\x0a\x11\x0e\x5d\x27\x41\x92\x34\x91\x4b\xdc\x4a\xe7\x7e\x82\x69\xa8\x38\x95\xe7\xb3\xe5\xbb\xcf\xef\xc1\x86\xfd\x52\x6c\x5c\x9e\x3b\xb1\x99\x84\x55\x4d\x7b\x34\xf1\xc5\x04\x60\x79\xfe\xaf\x52\xda\x3c\x15\xfb\xf0\x22\x84\xc6\x8c\x64\x6b\x4a\x61\xfa\xfd\xcc\x99\x5e\xbe\x53\xe5\x37\x96\xb2\x40\x28\xf7\x75\x71\xe8\x3c\x88\x23\x2b\x31\x98\x5f\x41\xac\xb7\x22\x35\x3e\xd1\x25\x47\xcb\x55\x87\x3d\xcc\xdc\x81\xdd\xa5\xb1\x5d\xff\x7b\x07\xb5\x82\x6a\x62\x98\x39\xec\x1a\x25\x37\x25\x1d\xc4\xec\x67\x52\x52\x2a\x9f\xea\x6e\x33\x02\xe9\xa5\xfc\xa9\x04\x52\x8b\xb4\x8a\x50\xf6\x24\x0a\x20\x0c\x5c\x1d\x07\x7e\xcf\x0a\xba\xde\x2d\x26\x96\xa9\xe9\x1e\x14\xb5\xdb\x83\xed\xc6\x27\xaf\x45\x6b\x36\xfb\x47\x97\x93\x6f\xa2\x3d\x3a\xb9\xd9\x67\x43\x6b\x57\xfa\x6c\xf9\x15\x11\xe6\xba\xc2\x92\x8b\xaf\xd8\x3d\xfc\xde\x78\x82\x75\xc3\x95\x13\x24\x9b\x12\xd5\xf3\x8c\x17\xc9\x14\xb4\x93\x9c\x36\x35\xe8\x3f\x13\x19\xdf\xe5\xdb\x53\xab\x16\xff\x41\xc1\xff\xa0\xe0\xfb\x50\xf0\xdf\x82\xda\x1e\x1d\xff\x41\x5c\x2f\x7b\x70\x74\x7c\x38\x2d\x95\x54\xe0\xdf\x98\x58\xca\x42\x6b\x1f\x59\xc2\xd6\x3c\x25\x4b\x42\x14\x6c\x54\xbe\xa6\xdc\x9c\xd0\xda\x2f\x97\x88\xe0\xc5\x2a\x98\xa5\x32\xf1\x65\x82\x4a\x49\xf7\x55\x73\x84\xe6\x68\xf6\xdd\xf0\x32\x57\xc6\xb1\x60\x7a\x29\x41\x20\xe4\xf9\x23\x0f\x2c\x7e\x2b\xc3\xdc\x46\x3f\x9c\x8f\x3e\xd2\x4a\xda\xaa\x0c\x97\xe0\xa9\x2c\x12\x4a\xc1\xd6\x90\x4d\x0c\x23\x02\x50\x15\x37\x3a\xcf\xb3\x57\x15\x92\x51\x82\x4a\x60\x9b\x1a\x9f\x53\x01\x77\xe6\x13\x69\x3a\x7e\x05\xf1\x0d\x24\x2c\xf2\xe3\x75\xc4\x85\xba\x4a\x34\x06\xd3\x55\xe7\x68\x22\x22\x8b\xb8\x60\x61\x70\x1b\xe5\x45\xe9\xd4\x38\x46\xa3\xac\x6a\x29\x92\x1b\x30\xaa\xf5\xc3\x2f\xf1\x52\xd5\xab\xd5\x78\x96\xef\x95\x55\x0d\xd5\xa8\x5c\x55\x51\x68\xb5\x53\x8a\x58\x7c\x32\x2f\xe9\x1a\x49\x9e\x72\xc3\x32\xec\x53\x97\xd5\xc4\xa2\x6e\xb7\xe9\xd1\x6b\x7a\x89\x22\xaa\xcb\xbc\xda\x7f\x3a\x10\x58\x25\x32\x31\x2e\x4a\x6b\xd2\xb5\xaa\x41\x4c\xbf\x1c\x95\xcb\xb2\xd3\xb6\x47\x6a\xf7\xc0\x7e\x50\x55\xae\x07\xfb\xea\xc2\xc5\x34\xa3\xeb\xa3\x79\x16\x00\x45\xa9\x98\x2f\x46\x17\xf2\xe6\xbe\xb6\xac\xec\x7e\x67\xbb\x38\xb9\xee\x8e\xaa\x37\x86\x99\xc5\x0d\x67\x7b\x6e\x98\x64\xe5\xf4\x30\x67\x97\x5d\xfb\x99\x6f\x20\x1a\x2c\x84\x0a\xe5\xa3\x46\xf9\x01\xbd\xb1\xd2\xe2\x0b\xe8\x64\x8c\x0d\x85\x95\x88\x3f\x74\x33\x82\xc1\x50\x24\xdb\x84\x81\x17\xa4\x80\xd5\x31\x92\xc0\xe7\xed\xfd\x30\x4f\xc1\xb5\x30\xd1\x32\x25\xdd\x0b\x15\xf3\x12\x73\x32\x85\xfc\x8e\xf3\x6a\xa6\x1d\xd7\xa6\xdd\x25\x07\x46\x05\xc6\x62\x22\x9b\x5f\x49\xa9\xec\x2b\x82\x8c\xac\xfe\x2f\x20\x88\x6e\xb9\x48\xb9\xdf\x2a\x44\x75\x24\xdb\x48\x4b\x71\x52\x08\x01\x11\xcb\x2c\x92\x24\xbf\xda\xef\x06\x8d\xcb\x1d\x97\xe9\x4c\x25\x00\x07\x8e\x44\xbe\xb6\xe8\x63\xa3\xa8\x12\x7c\x5c\x48\x03\x67\x79\xee\xa1\x5a\xf9\x67\xcf\x9c\x51\xcd\xe6\xde\x7d\xc9\x73\xeb\x3c\x77\xb5\xa9\x87\x9a\x9c\x3d\x37\x46\x4b\x2c\x2e\x1f\x40\xe6\x3c\x7e\x79\xda\x0d\x5d\x4b\x8d\xae\x4c\xf4\x19\x93\x46\x46\xb5\x5f\xdd\x3d\x4e\x5c\xc2\x9b\x9f\xb9\x5d\x47\xeb\x70\x7c\xd2\x69\xa4\xcc\xbb\xf3\x27\x62\xd3\x8b\xe1\x4c\x5d\x8c\x6c\x65\x71\xf4\xe7\x47\xac\xba\x8d\x93\x9b\x25\x4d\xd0\x82\xa7\xa2\x92\xa8\x97\xb0\x8a\x2a\xc2\xea\xaa\x0a\x6a\x35\xed\xd3\x03\x33\xec\x25\x3c\xe5\x11\x4a\xd6\x8b\x0d\x4f\x82\xd8\xaf\x41\x28\x7d\x0c\xca\x0e\x56\xe7\xd3\xe1\xe5\xe8\xea\x7c\xd4\x59\x0f\x8a\xfd\xf5\xea\xb6\xa0\x34\x78\xb7\xbb\x4f\x2d\xba\x67\xa1\x68\x35\xb0\xb0\x69\x5a\x63\xfd\xae\x7e\x85\x2f\x91\x55\xa6\xc9\xbe\xda\x25\x9b\xf6\xf5\xd2\x13\x75\x6b\x2a\x3e\x78\x49\x91\xb3\x38\x56\xbb\x07\xc5\x47\xcf\x21\x76\xbe\x90\x64\x57\x02\x9d\x5b\xb6\xcb\x9a\x81\x6c\xf6\xdb\x48\x77\x3b\x49\x83\xd4\x94\xf7\xdc\xfd\xff\x86\x52\x5e\x2d\x5d\x69\x2a\xe7\x15\x3b\x51\xe5\xe0\x8a\x8f\x5f\x50\xe0\xab\x27\x8f\x2f\x2a\x96\x39\xa9\x99\x5b\x30\x73\x9f\x9d\xcf\x22\x9a\xed\xc1\x4b\x0f\x14\xce\x1c\x48\x90\x59\x3a\x9f\x4f\x2c\xab\x5d\x54\x71\xd7\x5f\x52\x64\x72\x33\xb1\xa2\xd0\xd4\x70\xc7\x9f\x47\x6c\x52\x1f\x2e\xfc\x2d\xd2\x58\x3c\xfa\x9b\x38\x0c\xbc\xc7\x4e\x21\xf9\xf0\xe8\x87\xb9\xbb\x6e\xba\xe4\x38\xae\x6d\x51\x5d\xe7\x39\x81\xce\xda\xc5\x51\xda\xa7\x2f\x95\x28\xb8\x7a\x5d\x87\xc8\x0c\x0e\x4c\x2b\x75\x5c\x7d\x38\x32\xe0\x55\x09\x99\xc5\xbe\x7a\xfb\xad\xe8\x37\x15\x32\x8b\xd3\x79\x06\x21\xb3\x02\x17\x5f\x5e\xc8\x2c\x0d\xfc\x7c\x42\x66\xa9\xeb\xe2\x83\x1a\x82\x5a\xb0\xc9\x97\xbe\xd4\x69\xd9\xa7\xb3\xf2\x3b\x9d\x86\xab\x1d\xdc\x46\x71\xc2\xdb\x3d\x68\x23\x93\xa0\x5b\x0a\xfc\x23\xe1\xe4\x6e\xbf\xcb\x76\x1f\x44\xf7\x2c\x0c\xfc\xbc\x7f\x50\xfd\xbf\xea\x65\x37\x87\x71\x44\x99\xb4\xe5\x40\x3d\xc8\x86\x81\x38\x01\x35\x4c\xaf\x34\xc1\xb2\x5d\xff\x40\x41\xba\x44\x5a\xca\x63\x3d\x5d\x84\x96\xbb\xa3\x18\xc3\x93\x5c\xba\x76\x23\x48\xce\x1b\xb4\x18\x2d\x5f\x10\x3b\xc8\x5a\x83\x60\x98\xd6\x56\x40\xa7\x06\xee\xdd\xdf\x46\xe0\xde\x49\x22\xa5\xc0\xbd\xc7\x49\xf8\x6f\x28\x6c\xd7\xd2\xd7\xc6\x46\xd5\x42\x27\x4a\xd8\x2e\x3e\x7e\x41\x61\xbb\x9e\x4d\xbc\xa8\xb0\xed\x3c\x59\x3d\x78\xfe\xf3\xf5\x59\x84\xf2\x3d\xe4\x8e\x43\x2d\xa6\x65\x64\x79\x69\xa1\x7c\x17\x76\xbc\xa4\x50\x5e\x43\x78\x0d\xa1\xbc\x16\x37\x3e\x83\x5d\xd3\xb8\x6a\x5e\x08\x9e\x22\xe9\x6e\xb8\xf3\x76\x4d\x64\x8f\x45\x59\x5f\xb0\x8c\xe3\x90\x33\x55\xf1\x34\xe1\x02\xa5\x75\xeb\x59\x69\x03\xc9\xdd\xc1\xac\x8e\xac\x76\x25\x3b\xf0\x94\x99\xf2\x0b\x99\xea\x70\x73\xbb\xd8\x24\xb1\x87\x89\x82\x13\x8e\x22\x94\x2e\xa0\xaa\x27\x20\x6d\xc8\x6d\x23\x64\x45\x55\x0f\x33\x67\x69\x17\xb3\x34\xdf\x38\x6b\x3b\xbd\x1b\x5e\x5e\x8d\x1a\x17\x1d\x36\x07\x2d\x2d\xf6\xe0\xb2\xc4\x0e\x12\x7d\x50\xed\x2a\x7b\x81\x59\xb6\x9c\x1c\x13\x78\x84\x83\x16\x42\x89\x6c\xef\x0c\xe9\x64\x80\xc9\x62\xf3\x5c\xb6\x45\xbf\xa5\xfc\xcd\x42\x55\x35\x3e\x33\x9d\x12\xda\x59\x73\x99\x68\xbc\x98\xb7\xfa\xac\x02\x74\x45\x00\x4b\x0c\x3b\xad\x2a\x73\x8b\x91\x48\x57\xf3\xab\x42\x86\x09\xf5\xae\x49\x19\x2c\x58\x59\x5f\xaa\xb5\x0d\x8c\xb2\x57\x88\x7c\x15\x0b\x53\x4b\x1b\x34\x5c\x57\xfe\x81\xde\x0f\xee\x2f\x0c\xc0\x04\x7e\xd9\xdd\x2a\x83\x87\x05\x08\x43\xf4\x55\x28\xac\x5f\x57\x09\x98\xcf\xa7\xf0\xb8\xa8\xca\xf3\xe9\x3c\xae\xde\x1d\xcf\xf2\x42\x34\x7b\x92\x2f\xd5\xec\xd4\x56\x8f\x5c\x23\x9c\xd5\x5e\x9c\x39\xa6\xd9\x75\xd2\x96\xf9\xec\xba\x86\xb4\xfc\x36\x34\x10\x91\xd0\xb5\xe4\x7a\x7d\xee\x5c\xfa\x62\x49\xfa\x91\x69\x05\x46\x3f\x3d\xb8\xe1\x2c\xdd\x2a\xbf\xa8\x1b\x2c\x4c\xe9\x2a\x08\x7c\xa0\xb2\x56\x46\x3f\x74\xb6\x29\xaf\xe2\xd9\x3c\x6e\x0a\xc5\x87\x33\x54\x35\xc7\x2c\x5e\xc0\x9a\x1e\x8a\x8e\xb9\x1d\xe2\x70\x93\xf7\xf2\xe2\xfa\xa4\x0b\xab\xb3\x83\x66\x39\xde\xe4\x0d\x41\x35\xfc\x8d\xbc\x6f\x1a\x88\x38\x52\x63\xdc\x9b\x88\x94\xe2\xa4\x6b\xe4\xa0\xdd\x32\x4f\xbf\x1f\xdc\x00\x0b\x91\x34\x3e\x02\x81\x31\x06\x9f\x8b\x20\xe1\x2a\xb3\x4d\x0f\x0f\xcd\x4a\xf9\x48\xfb\x71\x8d\x00\xd0\x6c\xe9\x5d\xa5\xaf\xed\x3e\xe7\xbf\x0f\x3a\x45\x00\x32\x26\x0b\x81\x80\x75\x20\x50\x18\xee\x81\x67\x91\x9e\x20\xad\xa5\x6c\xcd\x56\xfd\x52\xd4\xed\xdf\xcc\xc8\xf0\x59\x64\xdb\xfa\x03\xeb\xb2\x4e\x1c\x42\x7b\x4b\xe3\x56\x1e\xfc\x26\x36\x10\x05\xa4\xb9\x8b\x10\x3b\x3c\xcb\xea\x45\xc0\xd3\x56\x05\xe9\xde\xe9\x0b\xbb\x8f\xdf\x56\x85\x60\xd6\x83\x12\x0d\x67\xd5\x14\xfc\xa5\x0c\x12\x7b\xef\x9e\x76\x9f\x6c\x42\xb7\x0b\xee\xe8\x9a\x68\xef\x94\xf1\x2c\x92\x50\x9d\x7f\x01\x7f\x86\x97\xf3\xd1\xcc\x55\xf5\x43\xc6\x5e\x94\x53\xdc\x19\x7a\x47\x26\xef\xf7\x1a\xb5\x5a\x08\x7e\xbb\xe6\x51\xba\x44\x33\x4a\x3b\x4f\xac\xd1\xf0\x6b\x8a\xad\x91\xdf\xe2\x7b\x25\x48\xd9\x7a\x4b\xf7\xb4\x22\x13\x84\xc2\x54\x49\x5f\x12\xef\xcf\x10\xdf\xc0\x7a\x1b\xa6\x41\x14\xfb\x3c\x2b\xaf\xb7\x49\xe2\x0d\x4f\xc2\x47\x58\x21\x6f\xa7\xfa\x3c\x26\x42\x51\x95\x1f\x8c\x29\xa6\x7a\x00\x46\x87\x41\x24\x02\x9f\x2c\xfe\x2c\x0b\x67\x38\x95\x49\x15\x6e\x79\x2a\x74\x35\x73\xf4\xa3\x1f\xd4\xd7\x4b\xce\xe6\xd4\x71\x14\x23\x3a\x1f\x5e\x5e\x82\x1f\x88\x34\x09\x96\xdb\x94\xfb\x0b\x2c\x28\x58\xde\x21\xf7\x46\x1f\xb4\xd9\xcd\x37\xfc\xc9\x7b\xfe\x94\x6d\xaf\xdb\xf9\xd2\x48\x69\xc2\x22\xc1\x68\x8f\xd0\xf9\xf1\xad\xa4\x79\x8e\xa2\x49\xc6\x06\xab\xb0\x07\x29\x11\x20\xa5\xe0\x91\xaf\x62\x36\x32\x2e\x14\xc5\x0f\x9d\x6e\xff\x18\x56\xf1\x36\x91\x25\x54\x96\xb9\x44\x69\x18\x26\xfa\xfd\x0d\x4f\xfa\xab\xd4\x42\x2d\x65\x53\xcb\xeb\x4d\x50\xe2\x20\x0d\x0f\x38\x1e\x7c\xaa\xc1\x9b\x7a\xf3\xc9\x37\xc5\xca\xdf\x26\x23\x62\xbe\xbf\xb0\xc5\x1a\xa1\x6d\x7f\x55\x21\x19\x2e\x18\x67\xee\x1a\xd0\x96\x00\x68\x57\x14\xa1\xda\x51\x31\xfc\x09\x2b\x49\xf8\x3a\xbe\xe7\xcf\xb0\x98\x3a\x4c\xa0\x13\x58\x52\xee\x8a\x63\xb2\x9b\x94\x27\x65\xca\xaf\x2b\xc7\xd2\x02\x53\xb6\xde\xa4\xff\x84\x76\x7f\x1c\xdd\x04\x51\x90\xe2\x25\x9d\x85\x99\x67\x6f\x91\x9f\x9a\x84\xeb\x33\x10\x72\x4b\x02\x68\x40\x54\xc1\x2e\x1d\xfe\x4c\x8c\xbf\x8e\x9f\x36\xe2\xfc\x15\x46\x68\xec\xa0\xdd\x30\x76\xd7\xe1\xe4\x7b\xb0\xd9\xb9\xac\x72\x35\x36\x26\x7f\x16\x39\x76\xd7\x32\xf7\xbd\x67\xdb\x21\x63\x16\xbc\xcd\x1b\x89\x98\xcf\x24\x38\xef\x6b\xfa\xea\x9e\xbe\x94\x80\xbb\x13\xb5\xdc\x4e\xe4\x8d\xc5\xdb\xa7\xdf\xb8\x50\xa0\xec\x82\x2d\xe3\x24\xed\x60\x5d\x30\x15\x39\x5b\xcc\xe8\xa7\x2a\xf5\x17\xb0\x1c\xfc\xa5\xd5\xde\x81\xda\x56\x09\x7e\x9d\x79\x5e\x57\xdc\x37\xc2\x64\x73\x5b\xb1\xee\xf3\xb4\xe5\x54\x75\xe5\x97\xaf\x70\xe9\x71\xe8\xeb\xcc\xc7\x41\xb4\xe5\xca\x36\xd7\xd3\x63\x9e\xc0\x2b\x43\x02\xc9\x17\xd7\xcb\x86\xc8\x5e\xca\x08\xdc\xd1\x6c\x76\x3e\xbd\x18\x9d\xb5\x3f\x5e\xbd\x7e\x7d\xdc\xd6\xa5\xfa\x69\xc9\xf0\xb4\x44\x36\x26\x98\xcd\x74\x82\xc3\x7f\x4c\x67\x73\x60\x91\x9a\xbb\xc9\x1c\xc0\xdf\x72\x1d\xc6\x39\xbe\x00\xb9\x6e\x59\xe9\x0c\xcd\x50\xf1\x0d\x2a\xd6\x7c\xbf\xdd\x5e\xb3\xe4\x6e\xb1\x8d\x50\xf6\xb0\x12\xfb\x99\x87\x48\xa9\x2e\x71\xe8\xf3\x64\x91\xae\x58\x04\xf3\xf1\x87\xd1\xd5\x7c\xf8\xe1\xe3\xfc\xbf\x7a\x32\xd9\x20\xb1\x6f\xf3\x79\xab\x0b\x15\xa8\x62\x5a\x91\xbc\x15\x8b\x3c\x2e\x03\x4b\xb3\x5c\x85\x24\x49\x11\x33\x95\x58\x9c\xc4\x1b\xd8\xc4\x41\x94\x4a\xf1\x4a\xa6\xbc\xa6\x52\xda\x22\x05\x11\xac\x83\x90\x25\x59\x3c\x6c\x12\xc8\xbc\xcf\x0f\xd8\x5b\x20\x20\x2b\x04\x29\x62\x90\xb5\xe3\x6e\x82\x30\x95\xb9\xb2\x59\x18\x66\x75\x92\xb1\x39\xf5\xbc\xe4\x3c\xd2\x5f\xa9\x5e\x97\xdb\x34\x2b\x4b\x86\xfa\x02\x95\x58\x66\xa9\xea\x4f\x4e\x97\xe4\x7c\x1e\xd9\x99\x13\x1e\xad\x2f\x64\x82\x02\xc1\x53\x57\x7e\x3c\x33\xc4\x3f\xbf\x9b\xc2\xe8\xfd\x4d\x4c\xce\x90\x2c\x0c\x1f\xa9\x24\xa1\xda\x27\x3b\x9e\xde\x38\x60\x3e\xd9\x29\xbd\xb4\x90\xfc\xae\x2a\xac\x9f\x02\xee\x1d\xb7\x46\xb4\xa1\xdf\x00\x06\xb3\x5b\x6f\xe5\xc9\x7b\xe9\x81\xdf\x9e\xd1\xc8\x64\x01\xd3\x33\xf9\xda\x98\x49\x17\x55\xe9\xe8\x26\x48\xd6\xdc\x6f\x04\x95\x9a\x39\x55\x00\xd8\x31\xb5\xc9\xb4\xe2\x8a\xce\x18\xe8\xb8\xf4\x82\x06\x29\xad\x1c\x08\x1b\x16\x79\x2a\x0d\x28\x8f\x67\xb4\x18\x98\x59\x2b\x2b\x66\x6c\xb4\xc9\xe0\xf6\xf6\xcc\x06\x5c\xae\x8e\x50\x22\x3e\x94\x5c\x81\x6d\x36\xa8\xd8\x7c\x29\x8b\xd3\x63\x2a\xbf\xf0\x31\x4f\xf2\x17\xaf\xb9\xb4\xe4\x8a\x94\x25\xd2\x02\x9e\x02\x67\x49\x18\x70\x21\x63\xd5\x4b\x9d\x67\xb9\xe3\xf1\x2d\x0c\xaf\xce\x4b\x2d\x8a\x84\xde\xce\x6b\xdf\x85\x7e\x3f\x37\x26\xa2\x3e\x8d\x79\x3a\x71\x02\x29\x47\xb5\x52\x19\x18\x65\xfe\x5a\x91\x42\x1c\x71\x7d\xc4\xd2\x4f\x91\x2a\xe7\x17\xa4\x3a\x0b\x08\xe5\xe8\x50\xf8\x41\xe9\x5a\xa1\xb3\x8c\xd3\x95\xcc\x80\xc8\xd7\xda\xc8\x68\x66\x8a\xe8\x3e\x21\x53\x85\xc5\xe1\xbe\x3c\x76\x26\xd4\xc8\x74\x7f\xcd\xfa\x0a\xf7\xd1\xa5\xac\x15\x66\xf6\x0b\x7d\xf3\x6a\x7b\x28\xe9\xfc\x3d\xae\x63\xd1\xb5\x33\x88\x98\xd4\xdd\x24\xec\x26\x31\x6f\x1e\xdf\xbe\x07\xbb\xd1\xcb\xfa\xb4\xc1\xab\x82\x5d\x1c\x27\x61\xd1\x82\xa5\xcd\xb8\x8a\x29\x66\x23\x4e\x48\xd0\x95\xd8\x92\x94\x21\x68\x1a\xe4\x3d\x60\xc9\x2a\x00\x40\x88\xe6\x78\x6c\x66\xab\x0d\xa2\xf4\xa7\x9f\xed\xdb\x10\x48\xb9\xb7\x8a\xb0\x96\x24\x56\x85\xe6\xe0\xb1\x48\x6d\x21\x19\xbc\xc7\x17\xf0\x4d\x01\x2f\xa0\xaf\xb0\xbf\xdf\x07\xe4\x2f\x41\xda\x16\xc0\xc2\x07\xf6\x28\x40\xb0\x1b\x62\xf4\x21\x57\xac\x6e\xad\x4d\x49\x52\xe8\x5b\x06\x29\x60\xc1\x6f\x9e\x98\x82\x15\xad\x5a\xa2\xf2\x42\x9a\x4c\xac\x01\xfb\x7f\xee\x1d\x86\x99\x6e\xa1\xac\x00\xe3\x5e\x01\xa6\x3d\x03\x90\xd9\x55\x02\x64\x95\x3c\xf5\x2d\x81\x82\x51\x1a\xc7\x20\x62\x65\x5b\x1b\xbf\xd3\x1b\xff\x4d\x71\x14\xf8\x32\x33\x34\xb8\x6e\x7d\x1c\xf7\x17\x3b\xb2\xf1\x50\x36\x50\xc2\xf9\x52\xaa\xe4\xf1\x85\xc8\x13\x8d\xea\xbd\x4c\x38\x30\x2f\xdd\xd2\x36\x63\x6d\x40\x9b\x53\xe3\x93\x5d\x7c\xa8\xe0\x2b\x56\xa4\x27\x55\x8c\xc0\x24\x07\xdf\x9c\x95\xb9\xb2\x49\x16\x6a\xb9\x54\x91\x5b\x35\x60\xcb\xe5\xe9\x14\xb2\x6c\x57\x35\x76\x11\x79\x07\xb1\x57\xb8\xc3\xab\x61\xe7\x28\xbc\x5c\x0b\xb8\x3d\x80\x56\xa6\xa3\x7a\x87\x8c\xdd\x24\x7e\xe4\xc5\x91\x3c\x3f\x1e\xde\x0a\xb3\x48\xe4\xf9\x66\x91\x43\x61\xd9\x56\x08\x22\x40\xce\x62\x8d\x62\xe6\x67\xee\x15\x2b\x44\x76\x7b\xe4\xeb\x92\x24\xdc\xab\x03\x40\x3d\x13\x2a\xe0\x59\x7d\xea\xa4\xc3\xe0\xa3\x9d\xe8\x5f\x00\x46\xa5\x14\xd8\xb2\x44\x9a\xfa\xe3\x62\x7c\x35\x1f\x4f\xce\xe7\x50\xa8\xed\xc1\x44\xb1\xbc\x87\x41\xca\x6c\x7c\xaa\x65\x7e\x36\xd9\xea\x6a\xe2\x56\x4c\x11\x6d\xdc\x4e\x2e\x39\x30\x10\x7c\xc3\x12\x96\x72\xaa\xf7\xfb\x28\x7d\x02\xe2\x14\x18\xa5\x93\xcd\xcb\x09\xe7\x45\x58\xfe\x24\x38\xff\x93\xea\xca\xa0\x32\x49\xfc\x20\xf4\x74\x81\x2d\xe3\x7b\x0e\x2c\x7b\x30\x50\xed\x27\x71\xca\x4f\x24\x24\xef\x79\xa2\xde\x9a\xc5\x70\x64\xf5\x08\x3d\xac\x4e\xb9\x2c\xe9\x9a\x17\x47\x22\x4d\x58\x10\xa5\xc2\xcc\xe8\x93\xa0\x8c\x47\xd5\x8d\x63\xc1\x51\x03\xa7\xf9\xa3\x3e\x76\x8b\xa6\x9f\x5d\xc4\x53\x66\x86\xb4\x45\x0d\xb9\x35\x95\x94\xaf\x7a\xbb\xd4\xd6\x1e\x1d\xe7\xdb\x2a\x3a\x79\x01\x96\x17\x91\xc3\x0b\xf9\x83\xe5\x3f\xf5\xd2\xb8\xd5\x46\xa5\xe8\x87\xff\xf9\x3f\x25\xbe\xfe\x24\xff\x1e\xe8\x69\xff\xbc\xaf\xc8\xdb\xb8\xca\x7c\x6d\x12\x34\xb7\x10\xa8\x0f\x0d\x1e\xe6\x3b\xfe\x08\xff\xe3\x0c\xf2\x4c\xcc\xa7\xd5\xc7\xa3\x5b\x99\x32\x1d\x99\x39\x0b\x52\x99\x03\x4a\x4a\x15\xaa\x48\xb6\xce\x10\xbe\x24\x5e\xcf\x65\x61\x26\x1e\x29\x95\x98\xa5\xa4\x75\xcb\xec\xda\xba\x27\xe3\x43\xd2\xf0\x05\x57\xb7\x2d\x44\x99\x08\x27\x79\xab\x90\x3c\xaf\x52\x5a\xc9\xf3\xe7\x91\x1b\xd4\xd5\xf8\x3b\x99\x43\xaf\xd6\x80\x59\x16\xc3\xc9\x78\x6e\xc9\x4b\xbd\x92\x84\x85\x4e\x29\x9d\x5c\xce\xe9\xc9\xbb\xa0\x6e\x61\x83\xac\x4e\xe0\x1b\x4b\x30\x82\xbd\x13\xb9\x61\x56\x35\xdc\x53\x9d\xb7\x8b\x5c\x57\xb4\x7f\x87\x74\x6d\x25\x88\x6b\xc9\x6a\xc5\x51\xbd\x4b\xe2\x4d\x12\x90\x23\x85\x54\x14\x1d\x22\xfb\xc7\xd9\xf4\x7c\x74\x71\x3d\x2b\xc1\x86\x30\xc8\xcc\x78\x61\x0b\xec\xc6\xe5\x76\x95\x85\xa8\x2c\xc7\xc3\xc5\xe8\xdd\xf0\xfa\x72\x2e\x21\xd6\xea\x42\xad\xbd\x5c\x27\xaa\x2c\xa9\x09\x98\xf8\x52\x3e\x76\x1b\xa1\xe4\x3b\x7c\xba\xf0\x83\x35\x8f\xc8\xd2\x4a\x07\xc6\x65\x99\xb4\x33\x4b\x56\x19\xde\x8d\xf2\x08\xd4\xf8\x39\x5d\xa4\xd5\x44\x0c\x38\x7e\x59\xbe\x62\xcb\x27\x96\x2f\x3a\x3f\x95\xa6\xbd\xf0\xb8\xfe\xd2\xb9\x51\x0e\x3b\xd9\xed\x7b\xe5\x29\x87\x9f\x40\x06\x4a\x08\xfc\xac\xd2\x89\xfd\xc6\x69\x1e\x1a\x14\x68\xbf\x09\xdc\xd2\x1e\x95\xb9\xc4\x7e\xae\xcf\x46\xb1\x2f\xe7\x87\xf9\x22\x7c\x2a\xfb\xe5\x0f\x6c\xd7\xe5\x33\x58\x0d\xdc\xcc\xa7\xd6\x9d\x7a\x97\x0b\x75\xcb\x69\x26\x41\xd0\x94\xcc\x24\x8a\x5f\x9c\xb6\xac\xa7\x72\x2f\x18\xa4\xa4\x97\x18\x98\xd2\xc9\xc8\x5d\xd7\xe5\x8f\x75\x17\xc5\x0f\xb8\x51\x85\xce\x28\x23\x3a\x78\xdb\xb4\x1f\xdf\xdc\x64\x17\xdd\x41\x74\x2b\xb2\xbb\x6c\xd3\x16\x5a\xd8\xd2\x02\x0a\xa5\x3c\x89\x58\x38\x48\xe3\x45\x76\xd7\xd9\x49\x90\x78\x2f\x78\xe4\x77\xcb\x7b\x9f\xcf\xbe\xe1\x6e\x13\xf9\x01\x6f\xaf\x8d\xa6\x6f\x16\xb9\x14\x04\x9e\x47\x1b\xee\xc9\x3a\x6f\x9e\xa7\x5a\x04\x7e\x77\xaf\x7e\x73\x64\x15\x61\xe0\x71\xf0\x85\xc4\x23\x91\xf5\x5b\x68\x51\x1a\xa1\xdf\xcf\x80\x03\x81\x00\xfe\xc9\x0b\xb7\x22\xb8\xe7\x32\xf7\x62\x20\xe8\xe1\x3d\x4f\x1e\x69\x43\xe0\x1b\x6b\xb7\x65\x51\x8b\x40\x00\x0b\x45\x9c\x7f\xeb\x42\x58\x5f\x0c\x2c\xea\x77\x56\x3e\x6d\x84\xb6\xbe\x18\xe4\x13\xfa\xe6\xac\x7a\x77\xb7\x51\xf0\x69\xb1\x0e\xbc\x24\x16\xdc\x8b\x23\x5f\x74\xf2\x99\x75\xdd\x18\x9e\x77\x7c\x31\xaa\xc2\x73\x97\xe3\x80\x84\x93\xf4\xbb\x40\x90\x90\x79\x2f\xc6\x82\x25\xca\x73\x71\x15\x87\xbe\xf4\xca\x7d\x04\x59\xa9\x3f\x96\x86\x40\xb5\x4f\xd4\x0b\xde\xc7\x8c\xe7\xa7\xae\x2b\xc5\x4c\xb4\x8a\xbd\x3b\x4d\xa4\x31\xd7\xe9\x1a\x71\x85\x47\x78\x3d\xd1\xc9\x93\xb3\xe6\x5e\xe8\xf9\x8a\xb3\xe0\x63\x8b\x6e\xe2\xa4\xef\x39\xc9\xd7\xdb\xdb\x95\x21\x95\x47\x31\x95\xb4\x1d\xfb\x02\x02\x59\xa4\x91\x6e\x6e\x94\x1a\xd2\x33\x3b\x40\xf3\x03\x7b\x04\x91\x66\xd7\x1e\x78\xc1\x15\x47\xf2\x86\x83\x3e\xc9\xcf\x73\xc5\xba\xdc\x16\x37\x5b\x07\x92\xfc\xd9\x70\x80\xa8\x34\xa7\x14\x18\xcc\x9b\x5d\xa3\x3b\xae\x97\x1a\xda\x1e\x5f\x62\xdb\x0a\xb3\xff\xfa\x99\xd8\xe3\x41\x81\x4d\xa5\x5e\xcc\x45\x92\xc8\x65\xca\x5a\xc5\x9f\x84\xcb\x2c\xba\x67\x6f\xb5\x87\xcb\xab\xb1\x74\x6c\xb1\xb8\x51\x41\x9a\x77\x57\x03\xcf\xb7\xe0\xec\x6d\x05\x45\x96\xee\x20\xd6\x23\xcb\xa3\x67\xef\xf9\xe7\xfc\xf1\xec\xad\x85\x10\xce\xd6\x06\xbf\x3d\x7b\x5b\x58\xe1\x1e\x4b\x72\xb7\xf5\x98\xf0\x98\xcf\x17\x69\xbc\x58\xb3\x94\x27\x01\x0b\x83\x7f\x12\x70\xc5\xd9\x5b\x0a\xa5\xdb\x09\x8a\x02\xbd\x2a\x81\xa6\xe4\xc1\x53\x65\xd0\x42\xaf\x1d\xfb\xf6\xed\xb2\xe4\x83\x63\x9e\x99\x6e\x35\xd1\xac\x22\x07\x86\x30\x9f\xc4\x61\xb8\xdd\x88\xce\x8e\xde\x5f\xf8\x14\xfe\xf9\xd9\x29\x58\x03\x85\x8a\x92\x53\x4f\x75\xcd\x64\x99\x25\x99\xe2\x39\x40\xa4\xd2\x63\x9e\x25\x54\x40\x0f\x0b\xf8\x09\xd8\x0a\x59\x7a\x57\x16\xe5\x0d\x22\x60\xc6\x5d\x14\x69\x5b\xc1\xcd\x0d\x47\x2d\xaf\xd5\xef\x67\xd9\xe0\x89\xc0\x67\x6f\xf2\x2f\xc4\x41\xf1\xaf\x02\xf7\x24\x5d\x44\x5c\x2b\xf5\x74\xbe\x3a\x46\x8d\xc5\xd1\x7c\xfa\xae\xc2\x33\xa7\x98\x43\x03\x9e\x9c\xdb\xda\x16\x64\x20\x8a\x21\xe1\x2c\xc4\x22\x64\x49\xea\x6d\x53\x79\x7d\x78\x8b\x4a\x3f\xfa\x26\x04\x39\x87\x23\x96\x87\x17\xf2\xb2\x4e\x0f\xb0\x30\x74\x76\x2a\x97\x05\xff\x79\x3d\x9a\xfd\xd8\xaa\xb1\x60\xaf\x07\x5f\x38\x5f\xef\x4c\x06\x53\x79\x69\x29\xd1\xa1\x53\x38\xe5\x2e\xde\x08\x2e\x67\x41\xe7\xc4\x1d\x93\x6d\x34\x41\x49\x0d\xea\xc2\x42\x8f\xed\x02\x99\xf8\x23\x56\xf1\x83\x26\xbf\xbb\xf8\xc3\xa0\xce\x67\xd6\x4d\x51\x27\xd3\xef\x3b\x5d\xe8\xef\x95\x82\xd2\x0e\x67\x37\x2b\x98\xab\xc3\x27\x8f\x16\x09\xb3\x69\x0c\x9b\x84\xdf\xab\x33\x93\xdc\x67\x75\x1a\xaa\xb6\xa9\x3e\x4a\xf4\xa0\xb8\xd0\xaa\xd3\xd6\x24\x2a\xb4\xd2\x92\x82\x7e\xdb\xdb\x94\x2f\xe8\x5a\xdf\x80\x91\x4e\xf1\x53\x0e\xf6\x4c\x60\x36\x3a\x9f\xce\x2e\x4c\x6b\x05\x50\xc9\xcf\x38\xe2\x10\xc6\xf1\x46\x52\x2d\xed\xfd\xb5\x62\xd9\xa5\x77\x56\xc7\x42\x87\xa9\xa1\xbd\x2e\x33\xed\xf6\xfb\x54\x2c\x9c\x85\x21\x1a\xa0\x1f\xe3\xad\x8c\xd3\x32\xf5\x0d\x7c\xe8\xb1\x48\xa7\x95\xc7\xa0\x04\x7c\x8c\xbd\x92\x87\x95\x9c\x7d\xde\x1d\x27\xaf\x79\x0e\x4b\xe6\xdd\x65\x66\x81\xcc\x32\x45\x75\xfb\x68\x66\x24\xc8\x4a\x22\x40\x8e\x3a\x2c\x48\xb5\xcc\x8e\x7d\xeb\x0e\xbf\x8d\x37\x58\x8b\x2f\x7c\xec\xc9\x8f\xf1\x9d\xac\xca\xf8\x00\x37\x09\xe7\xfe\x00\xe6\x64\x47\xf7\xe2\x38\xf2\x15\x2c\x58\x90\x8a\x6c\x6c\xfc\x42\x75\xe6\x44\x29\x39\xd2\xbb\xe9\x0c\x12\x18\x97\x82\xcc\xeb\x0f\x6a\x03\xba\x2c\x0d\x96\xaa\x72\xa8\x64\xa4\x93\xf9\x78\x72\x3d\x92\xa5\x26\x1d\xb4\xb7\x8e\x8d\x26\x54\x5f\x05\x17\x78\xf6\x56\x7b\xae\xd7\xfb\x27\x97\xcd\x76\xc9\xc0\xca\xc2\x7c\xc0\x39\x4e\x5c\x69\x29\x8a\x42\x42\x5e\x2d\xf3\x33\x03\xf8\x00\xe9\x04\xc1\xda\x3d\xfd\xdd\x03\x52\xfa\x1d\x96\x7d\x0e\x2d\x57\xc3\x83\xe9\x0e\xfa\x1d\xe2\x2a\x05\xe9\x97\xd8\xca\xae\x71\x93\xd5\xd6\xc9\xbe\x55\x81\x0b\x78\x04\x03\x81\x04\xd1\xe3\xfe\x36\xab\x41\x0a\x4b\x4e\x31\x7c\x09\xbf\xdd\x86\x2c\x09\x1f\xa5\xc8\xe4\x25\xb2\x48\x44\x9b\xa4\xaf\xcd\x76\x19\x06\x9e\xf1\xad\xbc\x33\xf0\x48\xda\x40\xa9\x0c\x9b\xb7\xfa\xfd\x84\x0c\x5d\x78\xea\x7f\xd9\x8a\x54\x56\x30\x2e\x4c\x06\xfd\x27\x10\x8e\x40\x81\x37\x11\x47\x52\xa8\xeb\x57\xf4\xfb\xca\x1d\x83\xf9\x3e\x88\x74\x7b\x73\x03\x21\x8a\xf9\x19\x59\x44\xbc\xc2\x75\x6e\x78\xbc\x91\xf1\x8a\xf2\xc2\x01\x57\x1d\x24\x72\xd2\xc2\x4b\x82\x8d\x53\x6e\x2b\xc1\x9c\xbc\x7c\x35\xc0\x4d\x4c\xeb\x96\x84\x30\x17\xb2\xed\xd8\xaa\xd3\xd6\xf3\x28\x9c\x75\x43\x9b\x5e\xc9\xf6\xb8\x86\xcb\xff\x01\xd8\x58\x03\x19\x44\xc0\x91\x7c\x03\xc6\x1b\x48\x99\xb8\x53\xb5\x65\xc9\x0a\x89\xfb\x54\x46\xcf\xe7\xc3\xca\x03\x78\xb9\x31\xdd\xc5\x2f\xf1\xb2\xf3\x4b\xbc\xd4\xa5\xb0\xe5\x2d\xdc\xad\xae\xfc\x5a\xb7\xfd\xd5\xb0\xd1\xd2\x8d\x03\xd8\x4d\xc3\x19\xe4\x34\x8a\x33\x15\x9d\x68\xbb\x5e\xf2\x84\x7e\x97\xf3\xa5\x22\xd0\xde\x8a\xfb\xdb\x30\x2f\xd6\x02\x79\x79\x8d\x26\x41\x0e\x1e\x5d\x04\x5a\x31\x0d\x99\x55\x40\x2a\x72\x39\x94\x8c\xfc\x01\x55\x19\x5b\x70\x72\x46\xd0\x00\xee\x69\x96\xa0\x05\x0a\x45\xbb\xa5\xf1\x9d\x9a\x68\xd3\x7c\xc5\x2e\xc9\x96\xe5\xa5\xfe\x8f\x33\x37\x0c\xf0\xda\x0d\xcc\xfc\x33\xdb\x28\xed\x7c\xa1\x9c\x05\xbc\x28\xfd\xcd\xd6\x91\xdb\x23\x11\xee\xdf\x80\xb9\xa5\xd6\x81\x37\x03\xbf\x70\x03\xda\xcd\xd0\xb9\x5d\x81\x14\x5d\x9b\x77\x13\xd1\x35\x2a\x60\x1d\xf7\xcc\x99\xf4\xbd\x28\xed\xba\x32\x67\xc8\x59\xbf\xdd\x3d\xeb\x0a\xcc\x69\x0e\xf5\xe7\x87\x7c\xcb\x36\x5f\x77\xbc\x28\xed\x1b\xeb\x70\xad\xd7\x4a\x4d\xf0\x3c\xe1\x24\x55\x47\x9b\x8e\x73\xbe\x59\x48\x5f\xcf\xa9\xa9\x2e\xe9\x27\xa7\x4a\xe1\xb0\xf2\x5b\x8f\x53\xfd\x26\x19\x7b\xff\x48\x6d\x7e\x89\x97\xd9\x19\x49\x7a\xb2\x4e\x78\x18\xe2\xbf\x92\x35\xea\x77\x7e\x36\x52\xfb\xb4\x79\x90\x55\x20\xb0\xd0\x12\x71\xab\xe4\x8e\x27\x1d\x99\xbc\xc4\x8f\xb7\xcb\x90\xa3\xb0\xee\x05\xc8\x81\x76\xa5\x72\x53\x27\xf2\x26\x8c\x59\xfa\x77\xc1\x23\xbf\xa3\xf2\xac\x9c\x41\xfb\xff\xfa\xf4\xb7\x9b\x9b\xd7\xc6\xcf\x9b\xb6\x33\x6b\xda\xf8\xc3\x87\x6b\x67\x3e\xa1\x5d\xd0\x2f\x2e\xa1\x3c\x79\xab\x1e\x71\xb2\xe5\x10\x90\xcb\xb1\xca\xd4\x82\x0a\x18\x7c\x4c\xc8\xc1\x9a\xa3\x8d\x29\x65\xca\xf4\xc4\x93\x26\x95\x88\x9b\x4d\xe2\xe0\x44\x46\x81\x58\x44\x78\x94\xc2\x45\xc4\xa2\x97\xda\x9f\xbf\x1b\xfb\x73\xfc\xfc\xfb\x63\x2c\xe0\xa0\xdd\x99\xb0\xc9\x3e\x3b\x51\x37\xdc\xc1\xfb\x60\x55\x4c\xd3\xfe\x45\x40\x11\x43\xe0\x4a\x50\x6d\x42\x3e\xfb\x8e\xd6\x54\xe9\xab\x90\x3b\x16\x11\x95\xcc\xbe\xa2\xdb\x42\x3d\x64\xc3\xb4\xc3\xbb\x76\x45\x15\xc0\x2a\x17\xeb\xa6\x71\x14\xf0\xc9\xad\x85\xa9\x47\x81\xdf\x78\x0f\x74\xe7\x4f\x4d\x71\x9d\x95\xae\x5d\x78\x71\xb8\x5d\x47\xd2\x59\x0a\xb5\xc7\xfb\x80\x3f\x74\xb2\xd7\x14\xc0\xd9\x43\x38\x65\xb1\xa9\x00\x00\x7a\x59\xe8\xa0\xe2\x14\x93\x02\xb1\x48\xb8\xe0\xc9\x3d\xf7\xf3\xdc\x3b\x5a\x64\xb2\x1c\xe6\x70\x90\x33\x18\x4e\x7e\xec\x48\x3f\x33\x0a\x87\x47\x43\x9e\x0c\x88\xef\x59\xe1\xf5\xd0\x56\x75\xcc\x7f\xc6\x79\x98\x0e\x16\xc6\x80\xc4\x8e\xc6\xef\xcc\x47\x39\xdb\xcd\x07\x3d\x39\x53\xbd\x2d\xda\xf0\xaf\x7f\xe5\x2f\x4e\x5b\x16\x5f\xc3\x8e\x8c\xef\x15\x93\xeb\x34\xa8\x0e\x7d\xc7\x1f\x73\x40\x76\xbb\x83\xc0\x37\x81\x7d\xda\x32\x6e\x52\x9e\xd0\x2b\x81\xa9\xd4\xf1\x5e\xc1\xcb\x7b\xd9\x0f\x77\x60\x8e\xc4\x17\x8d\x2c\x4f\xa9\x12\x6f\x97\xe8\xa4\xce\xb3\x73\x6b\xfa\x66\xe5\xf9\xc3\x9a\xc8\xef\x66\xa1\x66\x5c\x81\x50\x21\xca\x00\x80\x43\x98\x51\xcb\x50\x5d\x49\xb8\x48\x7d\xda\x3d\xc2\x21\x91\xe2\xbd\xc8\x82\xdd\xde\xda\xa6\x6c\x29\xb1\x41\xa7\x5d\x3c\xca\xca\x5d\x4d\x22\xf5\x4f\xaf\xc4\xcf\xe4\x29\x86\x86\xec\x4d\x2c\x4e\x4e\x48\xcc\xd9\x7f\x0f\x28\x1f\x9b\x34\xa2\xe5\x82\x64\x0f\xf0\xf8\xe4\xe6\xe5\x4d\x2c\xca\x99\x9e\x8a\xc0\xa9\x27\xa8\x34\x83\x4d\x2c\x64\xd1\xe4\xf0\x6e\x93\x53\x58\xfc\xcb\x34\x01\xc1\x19\x94\xf7\xd3\x6a\xc0\x22\xdf\xe5\xbb\xd9\xb2\x6e\x17\xac\x92\xb2\xda\xdf\xca\x5c\x40\xb6\x87\x46\xc9\xd9\x7d\x72\xe7\xaf\xf7\x9a\x74\x21\x08\x03\x65\xf9\xe1\xdc\xcc\x60\x50\xc6\xf6\xef\xc6\xa3\xef\xf5\x3c\xcc\x38\xab\xe1\x55\xc1\x7e\x68\x21\x10\xb9\x4f\xe5\x11\x09\xf6\x45\x46\xc1\xe5\x1e\x7f\x5e\xbd\x39\x12\x96\x0a\x61\xbd\xad\x8a\xf5\xca\x86\x68\x1a\xac\x85\x77\xb7\x06\xc4\x8b\xd8\x73\x78\x98\x79\x63\x8a\xe4\x20\x12\x44\x0f\x9e\x81\xf0\xa8\x7d\xfe\x0c\x84\xa7\x94\x2f\xe1\x05\x28\x4f\x89\xd2\x3c\x1b\xa1\xa1\x7c\x1e\xbf\x3f\x3a\x63\x6c\xdf\x0b\xd0\x19\x67\x6d\xeb\x67\x20\x34\x15\xb3\x7e\x22\xa1\xf9\x30\xc2\x59\x37\x21\x34\x68\x7d\x1c\xa0\x04\x46\x6a\x70\xb0\xe6\xbd\xf2\x6b\xda\x36\x7c\x4f\xbf\x38\x1a\x18\x61\xba\x95\x44\xcb\xc2\xc7\xc3\x68\x97\x5e\x0f\x0d\x6a\x35\xba\x1c\xbd\x9b\x4b\xd7\xc6\x9d\xa4\x8e\x9c\x1a\xd5\x64\x48\x1b\xb0\x57\xd0\xcd\xe8\x9c\xb9\xe3\xbf\x1d\xa1\x33\x89\xd2\x93\x09\x9d\xa2\xeb\x6a\xb1\xa8\x92\xa8\xfe\x3b\x19\x2d\xea\xe5\xfb\x27\x60\x19\xdc\x52\x6c\xa8\xa1\x14\x53\x08\x69\x69\x81\xad\xe1\x55\xeb\xa8\x3a\x35\x0c\x68\x31\x15\x34\x6b\x11\xe9\x3a\xcd\x69\x9f\x7e\x2a\x63\x8f\xf2\xc7\x18\x4c\xb4\x60\x37\x37\x14\x4b\xa6\x66\x23\xdf\x44\xdb\xf5\x82\xde\xca\x2f\xf5\x4b\x94\xf1\x5f\xd7\xa6\x9f\x91\x87\xda\x9a\x5c\xdd\x01\x76\x1d\xde\xb3\x7c\x35\x87\xfb\xd9\xe1\x25\xa2\x09\x0b\xe3\x3a\xd1\x98\xb7\x3a\xf7\x6d\xd3\xbd\x0a\xd1\x79\xf0\xea\xcd\xd1\xd8\x8e\xe2\x09\x7c\xa5\x55\x1d\x1d\x77\xdb\x3d\xd3\xc5\xcc\x44\xe5\x6e\xd9\x31\xb9\x32\xe0\xa8\x93\x95\x33\xf2\x56\x1e\x7a\x33\x76\xbb\x03\x15\x8e\xb3\xb9\x5d\x50\xdd\x71\xf0\x4a\x1f\x17\x5c\x8d\x37\xb7\x34\xae\xd8\x30\x8f\x43\x84\x08\xef\x0d\x12\x1e\xe6\xcf\xce\x20\x1a\xc4\x81\xbf\xab\x9f\x3a\xf7\xe9\x95\xf4\x7f\xb6\xfc\xd8\x71\xbe\xa6\x2b\x08\x45\xb6\x0c\x22\xb1\x51\x2f\xf5\x24\xba\xce\x81\x73\x7a\x52\x3b\x2e\x39\x5e\x7b\x56\xbe\x70\xed\x7b\x8d\x14\x7e\xe5\x0d\x1c\x0b\x93\xbb\xe6\xe1\xa2\xcd\x40\xa7\x1a\x1f\x97\x3a\x17\xc8\xee\xc9\x49\x5c\xf4\xc3\xc6\x9f\x2e\xe4\x14\xd2\xba\x53\x36\xd9\x8a\x89\x80\x32\x30\x2a\x3f\xfc\xb6\xb3\xd0\xfb\x11\x46\x2e\x0e\xdf\x4f\xa6\x57\xf3\xf1\xf9\x55\xe1\x64\x9e\xc1\x6c\xfa\xfd\xe2\x7c\x7a\xad\xa3\xcb\xf5\x4f\xe9\x98\x9e\x95\x1f\x7d\x69\x77\x66\x3b\x22\xc9\xdb\xe2\x92\x13\x62\x81\x2f\xb6\x2b\xdd\x0f\x8f\x77\x1c\x13\x57\x70\x98\x0b\x06\x07\xac\xff\xe0\xb5\x9b\x9e\x8f\xf5\x2e\x84\x6a\xa6\x0a\x2d\xb1\xeb\x8e\x44\xef\x7c\x09\x36\xa7\x2a\x4e\x20\xbb\xfa\xa4\x1b\xec\x1d\x3f\xf0\x5d\xc0\x1f\x04\xec\x6a\xb6\x57\xb6\x1e\x83\xbb\x19\xc5\xd5\xd0\x0a\xd7\xd1\x97\x8e\x45\x09\xdc\x24\x67\x60\xb2\x67\x8a\x19\xc5\x16\x3f\xfd\x5c\xae\xb8\x9a\x99\xf4\x8b\x3e\x61\x85\x22\xd2\x59\x33\x23\xe5\xa1\xeb\x6d\x1a\xa7\x2c\x74\xbc\x28\xf4\x2e\x73\x2a\x5a\x57\xd0\xcb\xc7\x94\x6b\xd6\xda\x93\x69\x81\xaa\xdf\x17\xba\x93\xa3\x8a\xe0\x9f\xbc\xd0\x4d\xfe\x42\xc1\xc8\xec\x31\xc1\xcb\x23\xdc\x7b\x9e\x04\x9e\xbb\x4b\x49\x78\xb2\xee\x8a\x04\x4d\xbf\xe9\xb6\x5c\x69\x8f\x9e\xc7\xf9\xb2\xd6\x3f\xd2\x21\xb9\x66\x9a\xb2\xd3\xcb\xcf\x59\x6a\xdb\xe9\xb0\xed\x7c\x9d\x63\x94\xf3\x75\xa9\x84\xb4\xab\x91\x8d\x59\xce\x26\xa8\x58\x9f\x9c\xe8\x26\x2e\x94\x6b\xf2\x99\x8d\x8b\xce\x2f\x36\xb7\x06\xd6\x74\x72\x6c\xa1\x00\xe5\x2a\x24\xad\x19\x5b\xa2\x03\x7e\x5c\x81\xc0\xfb\xcf\xa2\x88\xdb\xee\x6d\xcb\x1a\xb9\x41\x5e\xc4\xfa\x9a\x4e\x24\x62\xd7\x76\x93\xa1\xbf\x33\xf4\xba\xf4\xb0\x53\xe3\xd6\xeb\x7c\x85\x3f\xa8\x6d\xf6\x6a\xde\xee\x42\x64\xd5\x6c\x07\x3e\xd3\x8f\xcc\x43\x50\xf9\x3a\x9f\x2c\xea\xcb\xb5\xcd\xf6\xd4\xdc\xab\x7e\xaa\x34\x7a\x6b\xd5\xb5\x3d\x64\x56\x07\xb4\x8d\xef\x3a\xb5\xa6\x3e\xb9\xbf\x93\x2f\x30\xd1\xec\xdc\xbb\x8e\x28\x13\x4d\xc8\x81\x75\x48\x36\x09\x4f\xd3\xc7\xce\xe6\x76\x21\xf1\x55\x87\xc8\xd0\xdb\x9a\x44\xb0\xa6\xd4\x7b\x72\x92\xf0\x5b\x92\xd4\xbb\x85\x33\x56\x3d\xfe\xeb\xc1\x6b\x9a\x6e\xa3\xa3\xe4\x24\x09\x3b\xcf\x97\xf3\xab\xdd\x87\x0e\xf6\xf5\x82\x27\xe3\x7a\x70\xea\x60\x33\x35\xee\xee\xcf\x13\xfd\x54\xc9\xcd\x2a\xc8\x81\x9b\x0c\xec\x38\xfe\xf5\xc7\xbe\xe6\xb8\xef\x38\xe6\x4f\x3c\xde\x87\x1f\xeb\xe6\xc7\xf9\x85\x8f\xb1\x1f\xac\x05\x99\xc5\x16\xfb\x1c\x61\x2f\x18\x34\x62\xe1\x5e\x30\xd8\xc5\xb3\x57\x9e\x18\x38\xf8\xb2\xfc\x8c\xf8\xa3\x3e\x3b\xee\x6f\xcb\x6c\xb9\xd9\xa7\xbe\x18\x38\x1a\x36\xe3\xcf\x05\xca\x55\xe8\x6b\x27\x01\xea\x1c\x0f\x5e\x43\x1f\x3a\x0d\xa6\x3f\xb9\xfe\x30\x9a\x8d\xcf\xe1\xab\x46\x70\x52\xad\xbb\x5d\xf8\x02\x8e\x5f\x37\xa5\x6e\xd8\xb3\x49\xc9\x4e\x4e\xa4\xf1\xcb\xdd\x52\xb9\x4a\x95\x88\x98\xfe\x6a\x37\x85\x6b\x4c\xd9\x72\xe3\x44\x95\x9b\x58\x16\x09\x2d\x08\x91\x61\xea\x0e\x73\xea\x10\x96\x3b\x2a\xc5\x39\x52\x00\x14\x9b\x66\x47\xba\xca\xb6\x94\xcf\xf2\x72\x38\x1f\xcd\x86\x97\x99\xa5\xe3\xea\xfa\x43\x67\x55\x81\x19\xf4\x77\xcb\x45\x8e\x8c\xb1\x7d\x9e\xb2\x20\xe4\xbe\xcd\x09\x9b\x04\x04\x19\xfc\xb0\x90\x5e\xa1\x8b\xa8\x0f\xd3\x49\x9e\xd5\x79\xe7\x42\xca\x34\x89\x16\x56\x8b\xba\x5d\xb7\xc8\x6c\xb4\xe8\x55\x74\x5b\x8f\xe4\x55\x72\x7c\x83\x8e\x4d\x1c\xef\xee\x66\xdf\xf2\xa3\x2a\x74\xa7\x0e\xaa\x5e\xb6\xea\x36\xd5\x9c\x35\x46\x16\x8a\xe7\xdb\x58\xaf\xf1\xc6\xee\xa1\x77\x66\xc6\x51\x04\x48\x96\x0d\x40\x65\x40\xa0\xcc\xa2\x5d\x78\x37\xc6\x64\xf6\x1d\x95\xd7\x48\x18\x10\xb1\x4a\x0d\xbc\x6e\x93\xa0\xd2\x54\xfb\x6b\x30\xb2\xa3\x77\x9b\xe1\x38\x15\x9a\x4a\x7a\x22\xb7\xcf\x61\xec\xad\xa8\x35\x79\xe6\x48\x21\x62\x93\x8e\x33\x73\xf7\x0a\xfb\xe5\x05\x30\xd5\x5e\xa5\xfa\x69\x29\x04\xf9\x40\x6b\x41\x8d\xba\xd5\x40\xd5\xda\xad\x66\xed\x50\xb1\xf6\x51\xaf\x16\x74\xcb\xa3\x4d\xce\x7b\x6a\x57\x4f\xd3\xac\x4c\x31\xcc\xd9\x68\xb7\xaa\x65\xcf\xfe\x25\xb4\xac\x9d\x50\xae\x4c\xf7\xa1\x0f\x41\x47\xff\xb2\x08\x79\x74\x9b\xae\xba\x0d\x36\x65\x47\xea\x9d\x1d\x1b\xe2\x4e\xca\xb3\x7b\x1f\x74\x3e\x9d\xfa\x0c\x94\x4d\xb5\xcc\xa6\x62\x6a\x43\x51\x15\x4a\x96\x1d\x6f\x25\x06\xdb\xc8\x18\xa3\x01\xa7\xaa\xb6\xf9\x38\x3a\xaf\xe9\x7a\x1f\x7b\x54\xa1\xe7\x95\x5e\x6b\xc1\x26\x55\xd3\x81\xf5\x49\x13\x0d\x5b\x0b\xb9\x8d\xd7\x74\x72\xa2\x0c\xb7\xf0\xd5\x3e\x50\xce\x3e\xdb\x53\xea\xc5\x1f\xec\x78\xb7\x0e\x8f\xad\xaa\x38\x7d\x33\x7d\xde\x41\xe6\x6a\x03\xda\x77\x0b\xbe\x66\xf6\xac\xc0\x25\xf6\xd2\x1e\x17\x64\x5d\x9a\x00\x7a\x01\x28\x4e\x15\x0c\x1a\x8a\xb8\xcd\xe7\xe5\x2e\x28\x4c\x62\x0e\xc2\xd1\x39\x53\x84\x6f\x59\xe0\x2e\x09\x45\xf9\xec\xab\x45\xa2\x43\xee\x38\x4d\x50\xba\x21\x59\x4c\x1b\x56\x84\xe3\xe1\x60\x54\xf2\xd8\x5e\x06\xd6\x5c\x2c\xaa\xe6\x0b\xd7\x1f\x3a\xb5\x24\xfe\xfa\xe3\xc7\xd1\xac\x93\xa8\xb4\x51\xe2\xa7\xe3\x9f\x4f\x4e\xe6\x57\xf3\xff\x9a\x0d\x27\xef\x47\x5d\xe8\xc3\xe5\xf4\xfb\x9a\x06\x95\x7d\xd7\x24\x22\x30\xe5\xb4\x0a\xaa\xde\x84\xfe\xfe\x9e\x17\xaf\xc4\x60\x50\x72\xb0\x27\x06\x26\x1d\xc2\x43\xb0\x15\x88\x3f\xe7\xd9\x21\x69\x3f\x0d\x60\x0e\xe6\xd6\xaa\xe5\xea\x32\x74\x57\xe5\x4d\xb3\xec\xac\xda\x96\xa1\x2f\x9a\x33\x1c\x77\x18\x5b\xbb\x90\x88\xca\x71\xf6\xa2\x11\x72\x22\x8a\x3c\x54\xab\xef\x08\x49\x6a\x29\xab\x72\xe1\xc5\x1f\x9c\x41\xa2\x9f\xd2\xd4\xcc\x72\xcb\x65\x61\xc1\x25\x69\x37\xf0\x24\xdf\x3b\x0f\x85\x75\xcf\xdb\x24\x94\xc1\x74\x66\x1b\x4f\xde\x4d\x55\x0f\xca\x99\xcd\x94\xef\xbf\xd8\xe1\x84\xa7\x06\x6d\x36\x0a\x49\xb5\x6a\x90\xa2\x16\x11\xde\x0d\xd0\xfd\xd1\xfc\xbb\xe4\x8a\x6f\xbd\x0d\x7c\xf7\xab\x7b\xe5\x51\x27\x32\x97\x3a\x83\xc3\x7a\x0c\xc3\x80\x59\x18\xa4\x8f\x9d\xac\xa1\xd6\xaa\xa5\x07\x5a\x03\xe7\x49\x08\xef\x5a\x05\x07\x1a\x45\x53\x3b\xb9\x0a\xd2\x03\x4a\x80\x4b\x3e\xa4\xd4\x71\x2e\x6f\xd2\x9f\xdd\x7c\x7e\xd5\xa3\xc1\xfb\xd9\xf4\xfa\xa3\x36\xd9\xd2\xa0\xc3\x2b\xb8\x67\xe4\x92\x73\xcf\x06\x32\xda\x43\xc2\xae\x9b\x0f\x90\x2f\x86\xf2\xe7\x35\xdb\x1d\xf1\x28\x52\xbe\x56\xe7\xa2\xbc\x49\x9d\x62\x42\x86\xc2\x7c\xb1\x0a\xc1\x82\x72\xc7\x7e\x0a\xd6\x2c\xe5\xe8\x09\xb1\x90\xa1\xaf\x6d\x5b\x0a\x91\xee\x13\xed\x93\x93\xd9\xe8\xfd\xf9\xe5\xf0\xea\x4a\x2e\x8c\xf4\x68\x9c\xb9\x7c\xaf\xfa\xea\xb9\x07\xcf\x62\x6a\x77\x94\x13\xcf\x3a\x95\xcf\x0e\xe9\x2d\xdb\x76\xbb\xc3\xa2\x8a\x76\x40\xa7\x8e\x0e\xc5\x3e\xe7\xd5\xb5\x57\xe5\xab\xf9\xe6\xfb\xa4\xa5\x1f\xda\x2d\xe5\xc4\x49\x84\xb8\xc2\x14\x54\xb3\x5f\x7b\xe3\x88\x35\x76\xc6\x02\xaa\x2e\xdb\xf4\xc8\x6c\xbd\x09\xb3\xa1\x77\x90\xaa\xfc\x78\xd8\x9e\xc0\x98\x60\x3d\x10\x3a\xea\x3f\x5d\x71\x59\x5f\x53\x66\xb5\x49\xb6\x11\xa8\xba\xad\xf8\xc6\xc8\x44\x36\x80\x71\xda\x16\x10\xac\x37\x71\x92\xca\xc2\x33\xb2\x9c\x0c\x8f\x7c\xa5\x27\x51\x76\x0a\x59\x5d\x2d\x10\x59\xc9\xd7\x16\xe5\x97\x49\x78\xc8\x99\x90\x59\x67\xc4\x7e\x4e\xa6\xec\xd1\x52\xc0\x30\xcc\x79\x95\x1a\xae\xa0\x2a\x08\x1b\x4d\x55\x66\x15\x49\xbb\x16\x8a\x23\x7b\xd0\xf2\xf6\x61\x91\xe7\x23\x30\xfd\x3c\xeb\xca\xf8\x29\x6d\x42\xd5\x11\x28\xcc\x6d\x1b\xa5\x41\x08\x67\xf9\x84\xaa\x2a\xfa\xe9\x05\xe4\xb1\xde\x4f\xab\xf9\xa9\xf0\x4f\x2d\x87\xbc\x52\xf3\xe5\xb5\x6a\x8c\x0e\x14\xf6\x3c\xc0\xb6\x32\x3b\x44\xb1\x54\x28\x6c\x8c\xc2\x26\xf5\xfe\x93\x05\x19\x1f\x65\x7a\x59\x8e\xc9\x36\x53\x14\x13\x80\xd7\x27\x0c\x66\x91\x5f\x94\xfd\x0b\xb0\x03\x4a\x61\xc4\xa8\x6a\x99\x19\x93\x2d\xb3\x21\xa5\x3a\xc1\x78\xf8\x68\x96\x5f\xe8\xe3\xd1\x84\x8e\xb1\x0c\x08\x84\xd8\x72\xf8\xff\xbd\x39\xfe\xeb\x5f\xba\xa5\x10\xfb\xcd\xed\x82\xf9\xf7\x81\x88\x93\xc7\x05\xe6\x04\x5e\x20\x1e\x77\x8e\xdf\x7c\xfd\xb7\xbf\xf5\x0c\x48\x9b\x59\x87\xf4\xa7\x34\x33\x7a\xaf\x67\xd6\xc9\x3f\x50\x85\x60\x08\x57\xce\xde\xbe\xa7\x63\x71\x35\xef\x64\xf8\xd3\xcb\x48\x4b\xde\xae\xde\xba\xaa\xb6\x51\x92\x4a\x09\x61\x39\x14\x9c\x99\x13\xed\xba\xea\x94\x3a\x33\x01\x52\x22\x0e\x1d\xe7\x4f\x09\xb0\x28\x0f\x72\x29\x47\x82\x1f\x2f\x2a\x8a\xbe\xb6\x7b\x70\xc4\xf9\x91\x4a\x36\x75\xc1\xad\x7a\x8d\x8a\x0c\xb1\x3b\x0e\x9b\x90\x79\x5c\xa6\x1d\xc9\xb3\x93\x18\xc9\x9a\x8d\xe2\x38\x44\x46\x60\xc5\x43\x1f\x18\x26\xda\x15\xaa\xf3\xe2\x0c\x88\x24\xe5\xb5\x1f\x58\x9a\x91\x25\x1a\x52\x50\xfd\x2e\x58\x71\x76\x1f\xf0\x44\xf5\xaa\x0a\xdd\xf0\xc8\xcf\xb3\x77\x6d\x45\xa1\x10\x2d\x60\x81\x8b\x35\x47\xa4\x53\x4b\xd8\x0a\x59\xf8\x66\xc9\x8d\x6a\xb1\xfb\x24\x92\xaf\x84\x5f\xa7\x94\xd5\xbd\x07\xeb\x20\x2a\xe5\x73\x2f\x4e\x51\xc5\x13\xe9\xf2\xb5\x99\x3c\xa5\x02\x3f\x4c\x5a\x08\x99\x87\x59\x12\x3f\x40\xc2\x31\x7f\x4c\x2e\xc5\xe7\xb9\x90\x5d\x6f\x8d\xd3\xed\x7a\xad\x67\x9a\x19\x4d\x2d\xd7\x7b\xdb\xef\x4f\xe1\xfa\x6a\xf0\x85\x15\x2e\x53\x18\x61\xcf\x7c\xe7\x3b\x0a\xaa\x66\xc9\x4e\x2a\x48\xd0\x69\xab\x38\x3d\xbf\x30\x3d\x1b\x3c\x8d\x2c\xbb\xe5\xbb\x0e\x69\xbf\xb5\x16\x8a\xe4\x33\x63\xe2\x81\xef\x48\x79\x3e\x7e\x97\x23\xc2\x59\x55\x09\xe5\xb2\x3b\x49\x79\x4b\x4e\xce\xa0\xff\xbf\xde\xbc\xf9\xfa\xeb\xbf\xbd\x79\xfd\xf5\x5f\xff\xfe\x97\x3f\xff\xed\x6f\x7f\xf9\xfb\xeb\xbf\x57\x5f\x99\xd4\x5b\xc5\xb1\x6f\x6d\x1a\x8f\x58\xd8\xd1\x03\x76\x2d\xb8\x95\xa6\x51\xe3\x47\x83\x11\x0e\x39\x82\xba\xe3\x1b\xbc\x42\xae\xcb\x06\x3b\x91\x65\x27\x7f\x8e\xa4\xe9\xce\xa4\xe6\x70\x06\x94\xf4\x7c\xff\x01\x40\x77\x6a\x46\x01\x14\x7b\x52\x37\x01\x76\x02\x73\x0b\x21\x8b\x5f\x60\x82\xd9\x15\xcf\x53\x8e\x0b\x95\x77\x3b\xea\x07\x91\x4a\x93\x5e\x2a\xa5\x57\x46\x98\x6f\xac\x7c\xe8\xa5\x0f\x3c\x67\x14\x03\x06\x7f\x4e\xe7\xe5\x82\x4b\xf9\xcd\x84\xee\x33\x17\x9e\x40\x87\x1c\x14\x16\x81\xb4\x9a\x16\x42\xbd\xe7\x29\xde\x07\x75\xb9\x85\xdb\x54\x22\x85\xac\x9d\xa7\xed\x5e\x8e\x50\xc5\x58\x0f\xfd\xb8\x54\xd6\x3b\x1f\x5f\x25\xb0\x90\x55\x84\xa8\x3c\x9d\x4c\x44\x9e\xaf\x7b\xe0\xac\xc8\xde\x1c\x49\x5d\xe9\xfc\x75\xb8\x87\x0a\x09\xd1\xf3\x0c\xfc\x66\x50\x2f\x54\x4b\x18\xbf\x83\x77\xd3\xeb\xc9\x85\x3b\x77\xad\x2c\x25\x3c\x99\xce\xc7\xe7\x23\x68\x63\x22\x16\x9a\x21\x04\x02\x72\x46\x85\xe2\x3e\x8d\x74\x02\xaf\x06\xaf\xf6\x83\xe9\x69\x75\x86\xec\x02\x23\x2c\xdd\xde\xef\xb3\x73\x86\x26\xe5\xce\x4c\x5d\x84\x09\x42\xcb\xe6\xa4\x0e\xf8\x98\x99\x08\x8b\x1d\x9a\x7f\x1b\x31\x27\x93\x0b\xf9\x8b\x33\x5d\x19\x4a\x48\xdd\xfd\x92\xac\xbd\xb4\xb8\x80\xa2\x02\x0a\x62\x76\x51\x62\x18\x47\x54\xdf\xf4\x11\x94\x3e\x22\xa8\x52\xa8\x46\x60\x58\x6f\xc3\x34\x88\x62\xa5\x41\x32\xcf\xe3\x02\x05\x71\x3f\xab\x39\x20\x93\x14\x46\xb1\xae\xb5\x05\x22\x8d\x13\x8e\xd5\x35\xb0\x0c\x80\xae\xdc\xf3\xc0\x13\x6e\x1c\xa6\x9e\xac\x6b\xa0\xaa\xa5\xc5\xa4\xa8\xa6\x2b\x5d\x6f\x10\x04\x67\x89\x2a\x4f\xd4\xef\xe3\x69\xa7\x11\x0b\x35\x04\x4c\xb9\x33\xce\x4b\x0e\xcb\xa6\xb2\x12\xd3\x57\x51\x9c\x7e\x95\x15\x02\xe9\xf7\xcd\xf9\x9f\x42\x9e\x6c\x51\xca\xc3\x54\x5b\x3a\x2a\xad\x93\x4a\x83\xf8\x31\x30\x08\x63\x2a\x3c\xfd\x10\x27\x77\x59\x87\x94\x8d\xd5\xbb\xd3\x45\xca\x29\x35\xb4\xd8\x86\xe9\xa0\x3a\x06\x30\x03\x69\x31\xd2\x81\x64\x73\x2c\x2c\x9c\x04\xcb\x6d\xca\xfd\x05\xce\xcb\x15\xc2\xdd\x29\x1d\xb5\x23\xfc\xec\x48\xf5\x50\x2d\x7a\xbe\xba\xec\x81\xfc\xaf\xab\x3e\x71\x38\x8e\x5a\xc9\xc6\x35\xaa\x15\xd0\xab\x60\x84\xb7\xde\xc1\xd9\x5b\xd0\x59\x5b\x4b\xc2\xc6\xae\x19\x36\x1b\xdd\xa1\xed\x10\x6a\xc3\x13\x34\x9e\x6c\x3e\x71\xa8\x6f\x25\x4d\x55\x67\x8f\x93\xec\xe8\xa9\xe3\xa8\xf8\x9a\x35\x93\x37\xde\xe6\x61\x6e\x24\xdc\x53\x37\xa7\xf6\xb3\x45\xb4\x5d\x43\x56\xc8\xd5\x16\xc7\x33\xa1\xab\x67\xb5\x6d\xe2\x81\xec\x26\xd8\x92\x58\x67\xbd\xb9\xb3\x6a\xa3\x91\x4c\x5e\x05\x77\xba\x30\xfd\x0e\xef\x7a\x2a\x0a\xa5\x38\xe2\x4f\xeb\x9d\x8e\x5c\xf5\x8a\x76\x39\x2c\x3a\x6b\x3e\x3a\x7c\x17\xab\xca\x18\x81\x51\x77\xd4\xf2\xda\x72\xb6\xb2\x4a\xcc\x14\xf6\x7b\x57\xed\x18\xb3\x44\x52\x29\x4a\xd3\x4e\xaf\x9c\x6f\xe7\x37\x67\x66\x71\x19\x4b\x52\xb1\x59\xb0\x42\x84\xe0\x66\x11\xc5\xa9\xb1\x0c\x3c\xbc\x94\xc4\xe1\xb4\x55\xc7\x1f\x5f\x98\x17\x66\x93\xb5\x53\x11\x17\xab\xb0\x95\xb3\x88\x3b\x2a\xa6\xd5\x04\x7c\x57\x95\x3d\x7b\xf6\x5a\x67\xc8\x29\x6c\xc6\xea\x2f\xfb\x6f\x06\xaf\xfb\x89\xf7\x67\x62\x38\x16\x2a\x81\xbc\x1a\x52\x25\xbb\x35\x0b\xc5\xbb\x2a\x08\xb4\x69\x04\x39\xae\xaa\xe5\xed\x3b\xb8\x16\xe6\x11\xe7\x89\xa4\x2b\x06\x9f\x8d\x23\xc9\xc7\xf5\x58\x71\xa2\xbb\x8b\xa9\x38\x41\xc6\x45\x25\xbf\x4d\x63\xc5\x8a\x89\xb7\x99\xfe\x24\x60\x9c\xc0\xe7\x60\x72\xba\x5a\x08\xf1\x24\x1b\xf3\x1c\xd9\x7b\x5d\x04\x16\xd9\x1a\x15\xd8\x83\x7e\xb9\x8c\x5c\x4e\x5a\x14\xd7\x2b\xd4\x8a\xd9\x97\x81\xed\x49\xf0\xeb\x66\xe6\x32\xdc\xc1\x73\x1b\xee\x96\x3c\x7d\xe0\x3c\xb2\x4d\x77\x97\x94\x6a\xb8\x92\x15\xf7\x28\xd5\x34\xdd\x26\xe4\x2d\xb8\x30\x51\x32\xbe\xe7\x49\xc8\x28\x55\xb1\xea\xf3\xa7\x9c\x63\xaf\xd9\x27\xfa\xed\x67\xe2\x74\xeb\x20\x95\xe5\xf4\xb1\x6b\x99\x79\x5f\xf6\x22\x33\xdb\xfb\xbc\x84\x5b\xc0\xb2\xa2\x99\xd2\x08\x45\xb9\x37\x29\xe3\xfe\x4d\x10\x2a\xff\x23\x8c\x6e\xcd\xb0\x59\x55\x0f\xbf\x65\x01\x4a\x84\xb2\x3c\x2c\xa8\x5b\x16\x29\x81\xea\x8f\xb5\x3a\xf7\x54\x83\x9e\x82\x6b\x73\x19\x5d\x03\x65\x6f\x66\xff\xb9\x2d\x79\xd4\x82\x7d\xaa\x69\xf1\x87\xad\xef\x29\xb6\xbe\x17\xb6\xb8\xed\xd9\x3d\xfb\xe4\xea\xbe\xb8\xfd\x7f\x18\xf0\xfe\x5d\x0d\x78\xb6\x25\x0e\xa5\xc4\xd2\xe6\xfe\x61\xf4\xfb\x6f\x6f\xf4\x43\x10\x67\x2c\xf2\xdf\xd0\x02\x28\x8f\xf2\xd5\x68\x36\x57\x74\xcc\xe6\xe0\xb6\xe4\x50\x10\xd0\x2a\x35\xda\xd2\x08\xdf\x0d\x2f\xaf\x47\x57\xe0\xfc\xbe\xd9\x02\x4a\x5d\x4e\x27\xa8\xd0\xbd\xbb\x44\xaa\x7b\x31\xc5\xfd\xfa\x76\x3c\x79\xdf\xc0\xbc\xf9\x2c\x6a\x59\x73\xc1\x32\xd7\x60\x2d\x50\x0a\x5b\xae\x3c\x77\xcb\x8a\x37\xc0\xb2\xd2\x4d\x08\x0b\xc8\xa4\xba\xe5\x23\xd4\x49\x57\xaa\x5b\x92\xeb\x76\xc9\x92\xe7\x72\x30\x55\xc6\x17\xd6\x9c\x45\xaa\xa4\x53\xc2\x41\xdc\x05\x9b\x8d\x3e\xd4\xfb\x89\x7e\x55\x2b\x2f\x4b\x7e\x87\x49\x73\x15\x96\x9a\x1a\xee\xba\x1c\xd8\x28\xbb\x34\xfc\x3d\x77\xc6\x8e\xda\xab\x80\xa5\x83\x40\x2d\x07\xd6\xd2\xce\xa0\x06\x0a\x03\xa7\xb9\xa4\x64\xb4\x18\xbf\x83\x34\x5e\xe8\xc3\x5b\x43\x03\x5c\x87\x31\x7f\xae\x1c\x6a\x0d\x9a\x58\x5f\x60\xb4\x31\xe9\x69\x30\x6c\x4e\x7b\x9a\xdb\x4e\x8a\xf7\x08\x12\x2b\x4a\x49\xa9\xf6\xda\x1b\x73\xaa\x16\x1b\xb1\x5e\x20\x43\x31\xf1\xc2\xd1\xb2\x4c\xb7\x9f\x83\xa6\xf4\xfb\x1f\x13\xbe\x61\x09\x17\xf9\xa1\x37\x99\x0b\x9e\x5e\xad\x98\xa9\x53\x9e\x2b\x4c\x2c\xf2\x33\xf1\xe4\x84\xac\xf7\xfd\xbe\x69\xec\x47\xb7\x20\x62\x60\x21\x7b\xe4\x3e\x35\x37\x6d\x1a\xb9\x02\xa9\xec\x27\xd4\x29\xc9\x24\xa4\x52\x9a\x6e\x25\x64\x3b\xf1\x78\x0f\x82\x48\xa4\x9c\xd1\xcd\x42\x1c\x29\x92\x12\x44\x82\x27\x54\xe0\x25\x06\x56\xea\x7e\xd0\xea\xf7\x3f\x6c\x45\x6a\xd4\x71\x8a\xb7\x29\xf9\xda\x11\xa9\x33\x9d\xed\xf6\x31\x7d\x2d\xf9\x6d\x10\x69\xab\x92\x86\x57\xc9\xf2\xb5\x9f\x7e\x99\x5f\xfd\x54\x66\x61\xac\xb0\x95\x95\x42\x31\x4b\x29\x12\x9f\x92\xe7\xd4\xb9\x56\x47\xaa\xd3\x7e\x3f\xe2\x0f\x6a\x54\xa1\x6a\xea\xc5\x50\x69\x8d\x42\xb2\x20\x85\xa2\xe9\xcc\x95\x79\xca\x88\x38\xb4\xa2\x0d\x38\xb1\x15\xcb\x7e\x67\x53\x96\xca\x52\xd1\xd5\xa9\xd8\x1c\xee\x8e\x2e\xcb\xd0\x97\x96\x65\xc8\x67\x8f\xed\x42\x41\xda\xdf\xd9\x75\x92\x36\x7c\x3c\xe5\x42\x29\xd3\x3a\x9f\x74\x97\x74\xa8\x99\xc6\x39\x93\x3d\xee\x95\x9a\xd4\xa2\x73\x9f\x65\x79\x7e\xad\x63\x6b\x9d\x56\xaa\x50\x87\x98\x63\x91\x35\x4d\xf9\xa8\x02\x2f\x37\x4d\x5e\x2e\x09\xcb\xb0\xcc\x01\x33\x29\xa0\xba\x6b\x32\x09\x71\x90\xca\x22\x75\xb3\x0a\x2b\x9f\x45\x2e\x97\x8f\xee\x53\x5b\x32\xb8\x51\x31\x3a\xcb\xe4\x26\xef\x64\x95\x7b\x72\x2c\xf8\x00\x5e\x82\x7e\xa2\x9b\x94\x58\xed\x24\xa0\xbf\x5f\xa2\xe8\x5e\x80\x83\x2a\xfe\x5b\x52\x3a\x17\x59\xeb\xf7\x83\xc8\xbc\x19\x81\xe3\xc1\xa7\x12\xee\x4b\x4f\x58\x12\x9a\x1d\x48\xfa\x3c\x7e\xd7\x7b\x48\x65\x55\x52\x72\xfd\xee\x29\x4f\xe7\x9d\x50\x7e\x29\x42\x5f\xa0\xeb\x95\x5a\x8d\x41\xce\xeb\xaa\xea\x3f\x99\x54\x37\x52\xab\x9e\x9b\x2a\x57\x50\x08\x49\x15\x64\x9d\x9e\x5d\xe4\xd5\x22\x89\x69\x9c\x11\xb9\x8c\x96\xfe\xfb\x96\x5b\x37\xce\xdc\x5e\xf5\xd6\x35\x11\xb5\x35\xd9\xe7\xaf\x0f\xde\x32\x34\xe1\xfd\x88\x9d\x9d\x4b\x62\x38\xb9\x30\xba\xaa\x0a\xfe\x50\x05\x2e\x60\x3a\xab\x6c\xf2\x8d\x24\x6c\xbf\x61\xcd\x6e\x6b\xcb\xca\x11\x94\xfd\x3e\xf1\x5c\xaa\x14\x6d\x10\xd9\x37\x83\xd7\x10\x44\x44\x6b\x1f\x38\x6c\x85\x83\xdc\x06\x5c\x1c\x52\x26\xd4\x55\x54\xd5\x59\xef\xdb\xb9\xe1\xf2\xb4\x27\x7c\xcd\x82\x08\xcb\x58\xa8\xf5\xba\x1b\xff\xf4\x33\x5c\x8c\xde\x0d\xaf\x2f\xe7\xd0\xfe\xbf\xff\x9f\xf6\xa9\xe5\xdb\xf6\x47\xe5\xf0\xdf\x67\xe5\x70\x9b\xc4\x94\x4c\x45\xee\x74\xc1\xfb\xd5\x0b\x2f\x5b\x84\xca\x08\x75\x72\xe6\x78\xf8\xaf\x7f\x41\x72\xea\x74\xb5\xa9\x71\x67\xaf\xf5\x09\xa8\xa9\xa6\xfd\xac\x35\xc5\xb7\x51\xc4\x45\xda\x29\x2d\xe9\xb3\xd5\x0e\x7f\x96\x15\x3f\x4f\xf1\x6f\x27\x05\x42\xd6\xae\x5f\x54\x14\xfe\xfe\x0c\x35\x95\xa9\xdc\x17\x56\xa6\x25\x22\x4d\xe2\x1c\xfe\xcf\x34\xed\xb0\x34\x65\xde\x0a\x6f\x68\xf9\xa7\x40\xa4\x26\x7a\xe6\x5e\xbd\x64\x68\x74\x17\x17\x22\x1b\x39\x8b\x7c\xcb\xca\x9c\x13\x46\xb2\x2e\x1b\x2d\xca\x98\xa5\xde\x16\xdc\x62\x6a\x4f\x7a\xc2\xd7\xb1\x04\x3c\x7e\x29\xca\xdc\x50\xf0\x5f\x81\x09\xaf\x8c\x8c\x6e\xe9\xd5\x98\xe0\x40\x4f\x87\xe0\x14\x06\x22\x3d\x7b\x4b\xd1\xe9\x3f\x65\x80\xfb\xb9\xeb\x3c\x39\xe3\x77\x75\xb0\x74\xd7\x0c\x96\xed\x11\x3d\x0a\x9b\xd3\x33\xee\xf8\x49\xd6\xad\x4f\x41\x67\x26\x7f\x68\x20\xe6\x38\x64\x5c\xda\xd7\xc3\x4a\x61\xeb\xbe\xa5\x09\x53\x1f\x48\xac\x01\xe0\xae\xbf\x22\xc5\x7a\xba\x0f\x26\xc8\x9a\xc1\x47\x3f\xfd\x2c\xdf\xca\x84\x06\xf2\xf5\xc5\xf4\x9a\x8a\x61\xce\x46\xe7\xe3\xab\xf1\x74\xa2\xdb\x64\xb5\x05\x54\x3b\x5d\x24\xa6\x95\x87\xef\xaa\x94\x97\xc5\xa2\x30\xba\xfa\x80\x7a\x6f\xe2\x6b\xa1\xa0\x03\x3d\x83\xb6\x79\xd1\x57\xae\xeb\xd0\x91\x86\x1d\x9a\xb3\x51\xf2\xa0\xdb\x2a\xdd\xa3\x7c\x61\x51\xcf\xa3\xe3\x1e\x1c\xbd\xe9\xc1\xd1\xd7\x5d\x60\x9d\xb4\x77\xdf\x13\x46\x66\x02\xd1\x4b\x2b\xee\xea\xda\xbd\x96\xcb\xdc\x45\x0f\x75\xed\x88\x1c\xbc\x3d\x13\x98\xbd\x22\xd4\x4e\x5b\xae\xaa\x12\x19\x80\x4a\x05\x25\x0a\xf5\x1b\x32\x1a\xea\x0a\xff\xec\xf7\xc7\x84\x12\xc2\xf2\x8d\x2a\xdf\xcd\x95\x28\xa3\xbf\xdd\x84\x81\xc7\x52\x4d\x23\xb5\x71\x5d\x7e\xd5\x53\x45\x40\xe9\x03\x32\xd8\xe7\x35\x97\x33\x07\x2c\xb4\xf9\xa8\xb5\x4a\xa3\x7d\xb0\xe6\xe0\x07\x3e\xb9\x32\xd2\xe9\x84\x47\x9e\xf6\x32\x83\xbe\x26\xc6\x17\xd9\xd0\xba\x2b\x69\xc6\x0f\x6e\xa3\x38\x41\xb3\x12\x1a\xbb\xb0\xe2\x51\xca\xb3\x72\xce\x21\x13\xa9\x9a\xe2\xba\x07\x71\x02\x09\xff\x45\x55\x0a\x7a\x84\x1b\x16\xa8\xc0\x76\xae\x8c\xfc\x03\xf8\x1e\xe5\x43\xd9\x68\x91\xad\x15\x8d\x58\x24\x4f\xf4\xf2\xf5\xd3\xe8\xa0\xc6\x36\xef\x0d\xf4\x08\x3d\xe0\x83\xdb\x01\x89\x57\x09\xdf\xc8\x3b\x0a\x9c\x1d\x87\x84\xff\xba\xe5\x22\x45\x50\x04\xde\x0a\xd6\x14\x7f\x80\x72\x5e\xab\xdf\x5f\x72\x1e\xa9\xc9\x70\x1f\x36\x2c\x49\x03\x15\x7f\x8a\xf6\xba\x01\x5c\xe9\xad\x0a\x7d\x8e\x72\x1b\x53\x11\x0f\xf1\x43\x44\x50\xc1\xf5\x3c\xb0\x94\x27\xa8\x5d\x0a\x7b\x77\xe0\x96\x53\x10\x6c\x90\xe0\x40\x5b\xef\x8e\xa7\x02\x92\x98\x6c\x6e\xdb\x8d\x74\x98\x1b\x3c\x85\xaa\x2c\x50\x78\xd6\x8c\xf6\xb7\xa1\x30\x3d\xa5\x35\x14\x36\x50\x33\x4c\xf9\x7a\x7a\x3d\xcf\x61\x6c\x66\xec\xc5\x17\xd9\x47\x6a\x21\xb2\xc6\x70\x57\x51\x2a\x87\x11\xb0\xf4\x01\xd1\xa2\xe2\xd3\xfd\x4c\x81\x95\xbe\x6e\xaa\x16\x56\x71\xc8\x93\xb3\x3c\xbd\x53\xf1\xa5\xb3\x88\x9f\xcf\x6f\xd8\x36\x4c\x17\xc5\xc6\x9d\x6e\x0f\xda\x12\xab\xf5\xd5\xc3\xf8\x5d\x79\xbc\x33\x68\x4b\x18\xb7\x33\x2f\xf8\x32\xcc\x2d\xc6\xea\x9a\xb2\x1e\xa8\xb9\x7d\x31\x88\xee\x59\x18\xf8\x32\xeb\x46\x18\x6e\x37\x45\x07\x5b\x1d\x58\xbf\x0e\xa2\x4e\xda\xb5\x68\x7a\x8e\x74\x5d\x48\xbb\xdd\x5c\x36\x70\xad\x4e\xd3\x12\x5e\x08\xb4\xed\xf7\x33\xb2\x22\xa9\x90\x24\x9a\x45\x72\xf6\x10\x44\x82\xaa\xc7\xb3\x48\xbb\xa2\x78\x2c\xca\x7d\xb5\x81\x61\x40\x04\xa4\x0f\x81\xc7\xab\x2a\x16\x65\xcf\xa1\xfd\xfd\x78\xfe\x2d\x6c\x37\x0a\x63\x87\x57\xa5\x2c\x67\x0e\x77\x97\xbd\x98\x60\x81\x19\x5e\x8c\xaf\xe6\xe3\xc9\xf9\x5c\x16\xc2\xeb\x41\xda\x85\xb4\x07\xf7\x3d\x10\x95\x5c\x92\xa6\x38\x9d\x5d\x8c\x27\xc3\xcb\xf1\xfc\x47\xcd\x35\x7b\xb1\xc5\x37\xb1\x9b\x98\xa2\x3c\x5a\xd5\xae\x2f\x9d\x6c\x8a\x3d\x90\xee\x88\x17\xd3\x2c\x7b\xc7\x68\x2e\x17\x02\x67\x30\xfa\xe1\xfc\xf2\xfa\x62\x74\x31\xc8\xeb\x7c\xe7\x3f\x92\x1b\x22\xbf\xfd\xb4\x66\x9f\xe0\x0c\x5e\x23\xdc\x02\xb1\x88\xf8\x83\xd1\xd4\x21\x09\xe4\x29\x6f\xac\x9c\x64\xf2\x53\x8d\x52\x6a\x2f\xda\xb6\x21\xd4\xe9\xff\xb0\x2f\xdf\x37\x52\x73\xcc\xa7\x19\x9d\x6a\x60\x2d\xfe\x43\x40\x42\x01\x49\x03\xcc\x16\x90\x9a\x52\xb2\xec\xeb\x6f\xde\x5a\x79\xb6\x4c\xe2\x61\xdf\x90\x90\x6b\xdd\xe8\x87\xf3\xd1\x47\xe2\x91\xed\x4c\xc2\x78\x95\x0f\x66\x4a\x58\x96\x7c\x45\x9e\x75\x15\xc3\xf4\xb3\xc9\xf4\xdc\x88\x25\xa1\x35\x9a\xcd\xce\xa7\x17\x23\x5c\xc8\x36\x0a\x7e\xdd\xf2\xc5\x7d\x10\xcb\xf4\x74\xed\x92\x36\x51\x25\x0f\xfe\x7f\x81\xe9\x3f\x81\xab\x97\xd1\x7c\xaf\x55\xdb\x2c\xa8\x29\xfa\xaa\x2a\xb9\xd6\x8e\xe0\x6e\xfc\xbf\x03\x00\x0c\x7b\x76\xae\x77\x81\x01\x00"),
+		},
+		"/idempotent/downsampling.sql": &vfsgen۰CompressedFileInfo{
+			name:             "downsampling.sql",
+			modTime:          time.Time{},
+			uncompressedSize: 7339,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xbc\x59\x5f\x73\xdb\xb8\x11\x7f\xe7\xa7\xd8\x07\xdd\x58\xca\x91\x6a\xdc\xbe\xd9\xf1\xcd\x30\x14\xa4\x70\x42\x91\x3a\x92\xca\x25\xed\x74\x34\xb0\x04\x4b\x6c\x48\x40\x05\x41\xcb\xfe\xf6\x1d\x00\xfc\x2f\x3a\x96\x73\x37\xcd\x8b\x05\x70\xb1\xd8\x7f\xd8\xdf\xee\xc6\xb2\x66\xec\x44\x73\x9c\x1d\xd3\x84\xee\x81\xb3\x34\xcd\xa1\x38\x82\x38\x10\x50\xbb\x24\x07\xf6\x00\x18\x32\x22\x78\xb2\x85\x84\x0a\x06\x8c\x12\xe0\xec\x04\x47\xc2\x21\x27\x3c\x21\x39\x60\xba\x33\x2c\xeb\xbe\xd8\x7e\x27\x42\x1e\xe0\x24\x67\x69\x21\x12\x46\x37\x59\x0e\x59\x92\xa6\x49\x4e\xb6\x8c\xee\x72\x13\x12\x0a\x91\xf3\x09\x2d\xed\xcd\xcc\x8e\xed\x4d\x18\x78\xde\x7a\x35\x85\xf8\xa0\xb8\xe6\x80\x39\x31\x2c\x4b\x24\x19\xc9\x05\xce\x8e\x64\x07\xa7\x44\x1c\x94\x48\x29\xce\x45\x9b\x9b\xbc\x4a\x1c\x48\xc2\x41\x5f\x6d\x42\xce\x40\x1c\xb0\x90\xbb\xcf\x40\xc9\x23\xe1\x86\x65\x1d\x58\xba\x83\x47\x9c\x16\x5a\x9b\x4a\x31\xfc\x20\x08\x2f\xcf\xcb\xeb\x4c\xa9\x06\x7c\x27\x44\xeb\x9f\x25\x34\xc9\x8a\xcc\x84\x0c\x3f\xe9\x1f\x79\x91\x99\x86\x65\x6d\x59\x41\x85\x09\x0f\x09\xcf\x85\x3a\xa2\xc4\x52\xfc\x4b\x81\xea\x2b\x12\xaa\x96\xb5\x74\x02\xa7\x04\x32\xcc\xbf\x13\x9e\x1b\x96\x45\x9e\xb6\x69\xb1\x23\x3b\x13\x70\x0e\x27\x92\xa6\xf2\xaf\x16\x28\xa1\x5b\x4e\x70\x4e\x94\xf2\x1d\x36\x80\x77\xff\x29\x72\x41\x76\xf0\xc0\x38\x28\x61\x94\x96\x9c\xe4\x44\xe4\x90\x26\xdf\x89\xbc\x77\xc5\x59\xf6\xbb\x37\x35\x0c\xcb\x0a\x89\x28\x38\xcd\xb5\x64\x02\x73\x51\x89\x79\x89\xc3\x60\xcb\xa8\xc0\x09\x95\xf1\x21\xcc\xda\xcb\xca\x4f\x80\xd3\x64\x4f\xc9\x0e\x04\x53\xfc\xd6\x34\x79\x02\x72\x64\xdb\xc3\xd4\x70\x42\x64\xc7\x08\x82\x10\x42\xb4\xf2\x6c\x07\xc1\x7c\xed\x3b\xb1\x1b\xf8\x95\xfb\x1d\x3b\xb6\xbd\x60\x31\xdd\x55\x21\x48\x36\x9a\xf7\x58\x40\xec\x2e\x51\x14\xdb\xcb\x55\xfc\x4f\xb3\x27\xdc\x47\x77\xe1\xfa\xf1\xc4\x08\x51\xbc\x0e\xfd\xa8\x4d\x6a\xd8\x11\x8c\x1e\x0a\xba\x1d\x19\x00\x00\x11\xf2\x90\x13\xc3\x95\x92\xe8\xea\xe6\xa6\x4d\x09\xe5\xbf\x5f\x61\xfc\x90\x32\xc6\xc7\xe8\x6b\x1c\xda\x4e\x3c\x46\xab\xc0\xf9\x04\xf3\x30\x58\x82\x98\xc0\x3b\xb8\x7e\xff\xfe\x3d\xfc\xad\x2b\xc3\xe4\xe6\x46\x4b\x01\xef\x7a\x1f\xe0\x1d\xb8\x7e\x8c\xc2\x2f\xb6\x07\x57\xd7\x6d\x43\x5e\x19\xa5\x64\x9e\xed\x2f\xd6\xf6\x02\x41\xf4\xbb\x07\xee\x72\xb9\x8e\xed\x8f\x1e\x82\x95\x1d\xda\x9e\x87\x3c\x88\xec\x39\xba\x35\x16\xa1\xed\xc7\x80\xbe\x22\x67\x2d\xad\xe8\xbf\xc1\x7a\x1d\xdb\x95\xd6\x82\x38\x80\x23\x67\xd9\x86\x13\xbc\x23\xfc\xd6\x78\x83\x7f\x64\x20\x0a\xb2\x91\xc9\xa1\x38\x6e\x04\xbe\x4f\xc9\x58\xe7\x03\xbd\x00\xdf\x5e\xa2\xc6\x1f\x5f\x02\x77\xd6\x72\xc4\x47\xb4\x70\x7d\x03\x00\x6a\x65\x1e\x18\xcf\xb0\x18\x8f\x46\xb5\x13\x4a\x59\xb4\x21\xdc\x39\xf8\x81\x54\xdd\x8d\xe2\x68\x28\x55\xfc\xe2\xc2\xb8\x3e\x2a\xff\xe9\x24\xb4\x49\x76\xa5\xb6\xea\xbc\xbf\xf6\x3c\xb3\x43\x36\x14\x46\x2f\x90\xca\x74\xd0\x0e\xac\x17\xc8\xb2\x84\xc2\x2c\x58\x2b\xf7\x85\xc8\x71\x23\x69\xbd\x17\x48\xf1\xd3\xa5\xa4\x79\x91\x5d\x4a\xaa\x5e\xff\x8f\x55\xd1\x69\xea\x42\x7e\x29\xbe\x9c\xb6\xce\x50\x17\xd2\xaf\x42\x77\x69\x87\xdf\xe0\x33\xfa\x06\xe3\xda\x65\xbd\xd7\x6d\x2a\xd3\x4f\xea\x83\xfa\xd7\x68\x64\x42\x3b\xe2\x26\xb7\x06\xf2\x67\x67\x0f\x6a\xe5\xad\x16\xf2\x51\x7d\x09\x3c\x3b\x76\xbd\xb7\xbc\xa2\xa1\x18\x57\x61\x5d\x3f\x9c\x13\x4f\x84\x7a\x38\x96\x15\xbe\x00\x93\x72\xa9\xc5\x04\x0d\x41\x2d\xdd\x4c\x78\xe0\x2c\x53\x9b\x27\x2c\x08\x97\x20\x00\xc5\x51\x22\x1d\x6b\xb0\x6d\xcb\x24\x33\xd1\x64\xe6\x74\xa7\x20\x0a\x53\x48\xf1\x5e\xd9\xe7\xbe\x10\x92\x7b\xc6\x24\x14\xe2\xa7\xf2\xd1\xe7\xed\xc4\x2c\x00\x2b\x33\x4e\x21\xaa\x71\x28\x27\x5c\x42\xc6\x3d\x39\x24\x74\xd7\x13\x23\x65\x27\xc2\x21\x91\x00\x45\x24\xf6\x26\xf4\x11\xa7\xc9\xae\x31\x48\xde\x01\xd6\x1a\x6e\x35\x06\x48\x12\xb2\x93\xe6\xc0\x7b\x9c\xd0\x29\x38\x8c\x52\xb2\x15\x4c\x41\x1c\x2f\xa8\x82\x8e\x44\x2a\x47\xb7\x05\xe7\x84\x8a\xf4\x19\xf2\xef\xc9\xb1\x65\xb0\x1c\xee\x49\x55\x81\x68\x66\xf7\xcf\x80\x29\x13\x07\xc2\x65\xc1\x31\xed\xc1\x18\x2d\xb2\x7b\xf9\xe5\x41\xd7\x0c\xd2\x39\x82\x50\x49\x65\x58\x96\x8f\xfd\x0a\xee\xa5\x80\xc9\x9e\x32\x2e\x75\x7f\x6e\xe3\xba\x42\xee\x12\xda\x7b\xa0\x69\xc2\xe9\x90\xa4\xd2\x12\x2b\x96\x8b\x3d\x97\xde\xe5\x3b\xc2\x73\x90\x9c\xf1\x3d\x7b\x24\x80\xd3\x14\xb4\x74\xfa\xa6\x9f\x04\x3c\xad\xbd\x4e\x67\xfa\xf7\x86\xe2\x8c\x40\x8c\xbe\xc6\xc3\xc0\x67\x96\x91\x50\x2f\x5b\x41\x20\xb1\xc7\x98\x40\x95\x89\x35\x45\x2b\x17\xcf\x90\xe3\xd9\x21\x52\xb7\x95\xa1\x5e\x5e\x9a\xec\xe4\xd9\xdb\xb6\x1c\x4d\x6e\xd7\xdb\x4d\xbc\xb4\x12\xa3\xfe\x54\x50\x91\xa4\xe7\xdb\xd2\x35\x9b\xd2\x35\xa5\x30\xb7\x2d\x3c\x28\xe1\x39\x9b\xca\x2c\x90\x4d\xd5\x7d\x4a\x7b\xf5\xd5\xf5\xe3\xe0\x4c\xc8\x6e\x1a\x50\x74\x0a\xa9\x7b\x06\xd6\x44\x90\x29\x82\x3f\x3e\xa1\x10\x41\x36\x6d\x9b\xf7\x0e\xce\x7c\xd0\xfe\xae\xc5\x2f\x81\x68\x1e\xac\xfd\x19\xc4\x9f\x90\x5f\x67\x25\x6d\x60\x78\xaf\xe9\x90\x3f\x03\x77\x7e\x6b\x94\x62\x47\x28\x8c\xb5\xf4\x2f\xbb\xbd\xb1\xe5\xb8\xa5\x5b\xb7\x94\x50\xec\xbe\xd8\xde\x1a\x45\x30\x3e\x37\xc4\x00\x71\xe0\x83\x13\xf8\x73\xcf\x75\x62\x98\x05\x52\xf8\x4f\xae\xbf\xb8\x35\xda\xd6\x3e\x4d\xeb\xbb\x1b\x33\x77\xb7\x86\x2c\x3a\x28\xfb\xa9\x65\xdf\xd3\xb4\x96\x0d\xee\xce\xfc\x56\x5b\xce\xf6\x67\x70\x9a\x76\x64\x1f\x74\x46\x87\x42\x4b\x15\x84\xb0\x5e\xcd\xe4\x13\x8b\x3e\xbb\x2b\xf0\x02\xe7\x33\x9a\xfd\x19\x4f\xcd\x1b\xbd\xc1\x8d\x14\x62\x75\x0f\xf7\x0a\x96\xab\x2a\x60\x13\x3a\x56\x28\xd5\xb1\x94\xac\x50\xa6\xbf\xb8\x57\x3d\xa4\xaa\x99\x75\x0d\x7d\xdb\xec\xbf\x2a\xc6\xb9\x1e\x6d\x5d\xaa\x75\xc3\xe4\xe6\xee\xf5\x1a\xb1\xa6\xee\x07\xd2\x80\xa1\xf4\xeb\xbe\xb9\x83\x94\xe0\x5c\x34\xa5\xd7\xab\x97\x50\x76\x1a\x4f\xc0\xaa\x12\xd6\xcb\xa5\x71\x5f\x08\x73\x40\xab\x5f\x61\xdc\xce\x74\x6f\x29\xbc\x01\x00\x26\x75\xa4\x68\x75\x3e\xdc\xb5\x13\xda\x85\x21\xb3\x42\xe1\x3c\x08\x97\x6f\x2d\x90\x2b\xa3\xf6\xea\xdf\xff\x16\x84\x3f\x8f\x5a\x01\x72\x96\x39\x3a\x55\xef\xf5\xc8\x7d\xad\x6e\x32\x65\x6c\x2a\x44\xd0\xcd\x2a\xb4\x5b\x55\x53\x95\x18\x66\x5d\xb7\x35\xa1\xa9\xe3\x7a\xb8\xa4\xee\xd6\x70\xa3\xeb\xee\x5a\x7b\x43\xfa\x66\x74\x0d\x16\x5c\xff\xc8\x0d\xdd\x93\x4e\x60\x7b\x28\x72\xd0\x58\xbe\x26\x05\xa1\x13\x98\xbb\x5e\x8c\x42\x18\xeb\x8c\xa2\x36\xe1\xc3\x6f\x70\xe5\x63\xff\x6a\x62\x56\x7f\x5f\x60\x83\x9f\xfe\x04\x9b\xbc\xc8\xca\xd3\x03\xf5\xf5\xf8\x5d\x6f\x77\x8c\x39\xc7\xcf\x1b\xbc\xdf\xeb\x43\x10\x84\x33\x14\xc2\xc7\x6f\xba\x78\x9d\xfc\xeb\xfa\xdf\x6f\x39\x00\x33\x14\x39\x03\xa7\x6a\xdd\xa4\x74\x8e\x1d\x21\x99\x6a\xcb\xca\x06\x7e\xbb\x83\x23\x27\x8f\x10\x37\x5b\x96\xde\x41\x5e\x54\x69\x8d\xfc\xd9\xc4\x84\xf7\x8d\xa7\x55\xc2\xea\x36\x4f\xda\xf9\x2d\x87\x97\x91\xa4\x38\x98\xd5\xe8\xa2\x73\x44\xfe\x4b\xf1\xbe\xb2\x77\xf0\x45\x5a\x7b\x65\x87\xb1\xab\xaa\x9d\x8f\xdf\xda\xdc\x34\x83\x9e\x89\xc0\x8e\x94\xb0\x1d\xb6\x03\xc2\x5d\x20\xe0\xeb\xc3\x04\x45\x3d\xba\x56\x97\xea\xbd\xb3\x3b\xce\x13\xf9\xf5\xc8\x3d\xa3\xd2\x01\x25\xf9\x49\xf3\x8f\xfe\xae\xd0\x4c\x2d\x3f\xc0\xe8\x1f\x67\xe4\x15\xde\x49\x68\x2a\x79\xaf\xc2\x60\x39\x4d\xf2\x8d\x1a\x04\x6d\xf4\x20\xa8\x34\x63\xe7\xf8\xa4\x6a\x2b\x8c\x97\x77\x16\x61\xb0\x5e\x0d\x1a\xbb\x26\x69\x17\x04\xaf\xb5\x5c\x30\x0b\x4a\x7c\x6d\xe5\x85\x18\xc6\x6f\xc9\x28\x70\xd7\x0d\xfb\x6a\xbe\x35\x55\x4c\x9a\x15\x7e\x6a\xad\x14\xdb\x7a\x55\xf2\xaf\xd7\xe5\x45\xf5\x3a\xc5\x9d\x65\x37\x99\x95\x29\xb5\x07\xc1\xb0\x8e\x5c\x7f\xd1\x57\xba\x85\x80\x0a\x11\x74\x8e\x5e\xa0\x18\x66\xae\xbd\xf0\x83\x28\x76\x9d\xa8\x5b\xc2\xde\x41\x18\xfc\xb1\x71\x82\xb5\xac\x63\x15\x79\x55\x90\x5c\x5c\x2c\x49\x93\x36\x7b\x77\xfa\xea\xff\x53\x19\x75\x6b\xb4\xe0\xad\xad\xd8\xa5\xdd\xb4\x13\x2c\x97\xc8\x8f\x2f\x9c\x46\x95\xad\x8d\xee\x64\xaa\x66\xa5\xfa\xab\x26\x78\x6e\x04\x57\xfc\xa7\x7a\xe9\xfc\xc0\x8a\x54\x76\xb3\xc0\x0b\x5a\x35\x76\xdb\xaa\xef\x04\x5c\x08\x96\x61\x91\x6c\x71\x9a\x3e\x5f\xfd\xdc\x30\xed\x55\xf1\x87\x26\x03\x9e\xec\xa4\xf3\x6e\x7b\xdd\x57\x47\x05\x65\x35\x31\x6d\x26\xb0\x59\x42\x37\x3a\x55\x95\xad\xb6\x1c\x0d\xd4\x14\x9d\xc1\xf5\x0f\xbb\xf9\xe1\xa6\xdc\xb0\x2c\x3d\x60\x57\xed\x7c\x33\xfa\xd6\xbd\x3f\xd9\xf5\xcf\x53\x06\x29\xa3\x7b\xc2\x41\x0e\x0c\x75\x98\x4a\x7a\xfa\x96\x3e\xf7\x7c\x8e\x70\x3e\x37\x34\x6b\xc5\xdb\xfd\xe3\x8b\xd3\xc4\xbf\xe4\xd1\xbd\x0a\x19\x8d\x2f\x7a\x8f\x6c\xf2\xb6\x96\xb3\x69\x69\xe1\xee\xbc\x73\x6d\x1e\x72\xfb\xcd\xcb\x76\xb8\xf7\xb9\x91\xfd\xb7\xda\x5a\x83\xd3\xe4\x9f\x18\x7c\x0d\xf8\x48\xbb\xa5\xed\x8d\xa1\x50\x9f\x91\x94\x08\xa2\x63\xbd\x3c\xd9\x1e\x57\xa9\x9f\x1b\xf9\x53\x4d\x53\xb6\x07\x48\x72\x90\xef\xb1\x19\xc4\x70\x22\x08\x95\x86\x35\x2c\xeb\xc8\xd2\x64\xfb\xdc\x9e\x32\xd5\x4c\x77\x0c\x28\x13\xc0\x0a\x91\x26\x8f\x44\x7d\xd2\x50\xa6\xff\x93\x87\x93\x07\x79\x27\x7b\xd3\x00\x86\xb3\x3a\xab\xfe\x20\x32\x1b\x1d\x2e\x8a\xcd\x66\xb2\xe1\xce\x41\xb0\x0d\x27\xfb\x6d\x8a\xf3\x7c\x5c\xf7\x8f\x43\xb3\xec\x7e\xc3\x38\x19\x6e\x02\xf5\x9d\xdd\x9e\x64\x70\xa8\x0e\x33\xe4\xa1\x18\x9d\x95\x34\xcd\x8d\xed\x3a\xe6\x03\xfc\xe2\x9d\x8d\x57\xdb\x9a\xff\xf5\xa3\xd6\x21\xe3\x5f\x10\x72\xff\x1b\x00\xde\xf2\x60\x66\xab\x1c\x00\x00"),
 		},
 		"/idempotent/ha.sql": &vfsgen۰CompressedFileInfo{
 			name:             "ha.sql",
 			modTime:          time.Time{},
 			uncompressedSize: 4364,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xcc\x57\xcf\x73\x9b\x38\x14\xbe\xfb\xaf\x78\x87\xee\xb8\xd9\xda\x99\xee\xb5\x4e\x32\xc3\x82\x6c\x33\x43\xc0\x03\x72\xd2\xdd\x0b\xa3\x1a\xd9\xb0\x25\xc2\x8b\x44\x53\xff\xf7\x3b\x42\x08\x04\xb6\x9b\x38\xed\x61\x39\xd9\x4f\x8f\xc7\xf7\x7e\x7c\x1f\x8f\xe9\x14\xb6\x15\xdb\x88\xac\x60\x20\x52\x22\x40\x94\xd9\x6e\x47\x4b\x10\x05\x90\x4a\x14\x4f\x44\x64\x1b\x92\xe7\x07\xf8\x4a\xe9\x1e\x44\x4a\x21\x2f\x76\x20\x4d\x7c\x64\x87\xc8\xc2\x08\x82\x10\x42\xb4\xf2\x2c\x1b\xc1\x7c\xed\xdb\xd8\x0d\x7c\x88\xec\x25\xba\xb7\x62\xdb\xc2\x96\x17\x2c\xae\x53\x12\xe7\x94\x70\xca\x63\x52\x25\x99\x88\xb7\xec\xfd\xd5\x08\x00\x20\x44\x78\x1d\xfa\x11\xe0\xd0\x5d\x2c\x50\x38\xb2\xa2\xd1\x3b\x09\xe8\xdd\xe8\x4f\xb4\x70\xfd\xda\x67\x3a\x85\x6a\x9f\x10\x41\x21\x25\xfb\x3d\x65\x34\x99\x40\x4e\x49\x42\x4b\x48\xb2\x84\x8d\x05\x6c\x52\xc2\x76\x74\x02\xff\x54\x5c\x40\xfd\x20\xf8\x52\x54\x2c\xe1\x30\xbd\x83\xa4\x00\x56\x88\x34\x63\xbb\x3a\x9a\x3b\x87\xc0\x73\xc0\x8d\xc0\x0f\x30\xf8\x6b\xcf\x03\xcb\x77\xa4\xed\x5a\x05\x8d\x19\x79\xa2\x70\x0b\x3e\x7a\xec\x59\xf0\x12\x29\x3c\x1d\x6e\xe9\x33\xab\x6d\xc8\x77\xc0\x9d\xcf\x46\x1a\x70\x83\x4f\x01\x4b\x26\xc0\xa9\x06\x56\x31\x91\xe5\xb2\xbc\xf4\x7b\xc6\x45\xc6\x76\x75\x41\xf3\x8c\xd1\x4b\xe0\xdd\xdc\xfd\x18\xdf\x7a\xe5\xc8\xd6\x74\x75\xcf\x8b\x1d\x6f\x4f\x23\x84\x15\x9a\x58\xa1\xb9\xd5\xf1\xb5\xa5\xf5\x7c\x5c\xa2\x10\xc1\x26\xaf\xb8\xe8\x2a\x23\x9d\x4d\x53\xeb\x0d\x35\xd6\x7e\x19\x07\xc8\x8f\x7d\x39\x8d\xb9\x20\xa5\xe8\xa1\xa8\x2d\x27\x7d\x15\x62\x59\x9f\xb5\xe7\x9d\x2e\x7e\xc6\x38\x2d\x45\x3b\x2d\x50\x94\x83\x7e\x00\x61\x09\x30\xfa\xac\xcd\x8c\xd2\x84\xcb\x9e\x7c\xa9\xc7\x7b\x47\x13\xd5\x0b\x3f\x42\x21\x06\xd7\xc7\xc1\xa0\x92\xf0\xde\xcc\x7f\x62\xa6\x3c\x31\x73\x9a\x98\xa0\xd5\xc4\x3f\x58\xde\x1a\x45\xf0\x5e\xb6\xaf\x1f\x64\xd0\xd0\xd6\xd0\x05\x63\x55\x9e\x5f\x35\x79\x9a\x13\x88\x7c\x67\xd6\xf0\x06\x3c\xcb\x5f\xac\xad\x05\x82\x7d\xbe\xdf\xf1\x7f\x73\x78\x08\x3c\x0b\xbb\x1e\x9a\x8d\x46\xd3\x29\xa4\x04\xc8\x3e\x6b\x49\x7f\x09\x89\x15\x09\x55\x19\x74\xfe\x80\xd1\x67\x3c\x81\xe7\x32\xeb\xfe\x3c\x65\x2c\x16\x99\x9c\x48\xf7\x1e\x45\xd8\xba\x5f\xe1\xbf\x27\x46\x2f\x2f\xba\x9e\xc8\xf7\xa3\x60\x57\xad\x6c\xb4\x5d\x31\x84\xc3\x41\xb6\x67\x85\xa8\x7e\x60\xd3\x5f\xe3\x92\x10\x67\xfa\xac\x1d\xbd\xe6\xac\x7b\x84\xe9\xa2\x26\xee\x8c\x0b\xa3\xcf\x0a\x42\x8d\xb2\xa8\xc4\xb9\x28\x5c\x48\x05\x53\x57\x0b\xfb\xb7\x30\x78\xc4\x7f\xad\x90\xe9\xa8\xe3\xb8\x3e\x46\xe1\x83\xe5\x99\x67\x25\xdd\x96\x94\xa7\xc6\x99\x92\x49\x3d\xf9\xdb\x8c\x25\x83\x30\x9c\x0a\xa9\x32\x2a\x4a\x84\x3c\x64\x63\xf8\x46\xf2\x8a\x7e\xfa\xa4\xa3\x34\xc3\x8e\x83\xfe\xad\xb5\x79\x1e\x06\xf7\xc3\x41\x48\xe8\x96\x54\xb9\x3a\x57\x02\xf1\x95\x1e\xe0\x16\xc6\x3a\x2f\x1d\x61\x3c\x1b\x20\x23\x82\x2a\x85\x96\x5d\x91\x24\x14\x29\xcd\xca\x46\x19\xeb\x36\x97\x92\xa0\x3d\xb0\x69\x9f\x14\x69\x9f\x12\xe9\x91\x6c\xe9\x44\x12\x5a\x9e\xe7\xe2\xd9\xdc\xda\xd6\x00\xe1\x90\x8e\xce\x6a\x60\xf3\xb7\x4d\xb0\x60\xf9\xa1\x51\x1c\x0e\x05\x83\x6f\xb4\x3c\xc0\x36\x2b\xb9\xa8\x5f\x96\x33\x2d\xee\x52\xd5\xe7\xc1\xda\x77\xfa\x7a\x3d\x9d\x02\x2b\x74\x61\x0e\x54\xc0\xb6\x28\xf5\x33\x1a\x3d\x9b\xb5\xce\xa6\x2e\x9d\x83\xdf\x3a\x6b\xbd\x69\x82\x69\xae\x76\x34\x9d\x74\x1c\xfb\xd0\x1f\x80\xab\x36\x48\xe0\x83\x1d\xf8\x73\xcf\xb5\x31\x38\x81\x4c\x62\xe9\xfa\x8b\x59\x0f\x3e\xa5\x09\x4d\x20\xa9\xa8\x14\xd2\x82\x4d\x37\x05\xdb\xe6\xd9\x46\xc0\x26\x27\x15\xa7\x33\xe3\xed\xf3\xa6\xbe\x5e\xd6\xdb\x0b\xfa\xfb\x72\x8f\x8f\x5e\x32\xee\x5c\xf7\xea\xe6\xae\x15\xbf\xde\x7e\x60\xb9\x11\x02\xf4\xd9\x46\xab\x5a\x4a\xc7\x1e\xb2\x1c\x14\xc6\x4b\x2b\x8a\xed\xa5\xe5\x2f\x90\x33\x86\x75\xe4\xfa\x0b\x40\x61\x68\x07\x0e\x92\xfc\x59\x45\x1f\xff\xf8\x38\x3e\xfd\x52\x33\xa8\xad\x55\xe0\x0d\xd4\x6e\x6e\xfd\x09\x6a\x37\x11\x34\xb5\x8f\xf5\xef\xf6\xec\x3c\xb5\x24\x38\xbe\xe9\xae\xa7\xb5\x1f\x06\x89\x9e\x5a\x6d\xce\xf6\x35\xfd\xc1\x9a\x73\xf4\xe0\xc1\x00\xa4\xd7\xa7\x47\x60\xb0\x89\xa4\x83\x55\x51\x4d\xc0\x29\xa7\xb3\x29\xdd\x1c\x63\x31\x08\x3e\xd4\x09\x39\x00\x9b\x82\x6d\xaa\xb2\xa4\x4c\x34\xeb\x70\xef\x95\xfa\x13\xa4\xba\x9c\x58\x17\x92\xeb\x75\x04\x33\x92\x3f\xe2\x56\x10\xf6\x1a\x79\xd3\x8e\x58\xef\xce\xde\x98\xfc\x3a\x26\xea\x4b\x33\xf2\xd4\x7f\xf3\x77\xd3\x8a\xdf\x1b\x81\xc6\xa1\x54\x4d\x73\x09\xa8\x4b\xd7\xd5\xea\xe5\xd2\x34\xbb\x9e\x11\xe3\xf5\x3b\xdf\xeb\xf7\x3b\x51\x1e\x62\xb5\x20\xc7\xaa\x01\x83\x25\xaf\x19\xd8\x44\x1b\xde\xba\xd2\xfd\x9a\xbd\xee\x85\x4d\x49\x55\xfa\xc4\xa2\xd5\xfb\xae\xfc\x9f\xee\x4b\x2f\x48\xdc\xc8\x10\x37\x43\x85\xba\xfe\x74\xad\xe9\x7f\x5f\x19\x1c\x1a\xba\x68\x85\x3c\xa7\xdd\x2f\x6e\x42\xa3\xd3\x1f\x6a\x1d\x55\x9b\xdc\x34\x3b\xba\x22\x1e\x33\xa4\xab\x64\x3f\xe7\xb7\x31\xe5\xb5\x54\xf9\x2f\x00\x00\xff\xff\x69\x5b\x36\x56\x0c\x11\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xcc\x57\xcf\x73\x9b\x38\x14\xbe\xf3\x57\xbc\x43\x77\xdc\x74\xed\x4c\xf7\x5a\x92\xcc\xb0\x20\xdb\xcc\x10\xf0\x80\x9c\x74\xf7\xc2\xa8\x46\x36\x6c\x89\xf0\x22\xd1\xd4\xff\xfd\x8e\x00\x81\xc0\x76\x13\xa7\x3d\xac\x4f\xf6\xe3\xe9\xe9\x7b\x3f\xbe\x8f\xe7\xd9\x0c\xb6\x15\xdb\x88\xac\x60\x20\x52\x22\x40\x94\xd9\x6e\x47\x4b\x10\x05\x90\x4a\x14\x4f\x44\x64\x1b\x92\xe7\x07\xf8\x4a\xe9\x1e\x44\x4a\x21\x2f\x76\x20\x4d\xdc\xb0\x43\x64\x61\x04\x41\x08\x21\x5a\x79\x96\x8d\x60\xbe\xf6\x6d\xec\x06\x3e\x44\xf6\x12\xdd\x5b\xb1\x6d\x61\xcb\x0b\x16\xd7\x29\x89\x73\x4a\x38\xe5\x31\xa9\x92\x4c\xc4\x5b\xf6\xfe\xca\x00\x00\x08\x11\x5e\x87\x7e\x04\x38\x74\x17\x0b\x14\x1a\x56\x64\xbc\x93\x80\xde\x19\x7f\xa2\x85\xeb\xd7\x3e\xb3\x19\x54\xfb\x84\x08\x0a\x29\xd9\xef\x29\xa3\xc9\x14\x72\x4a\x12\x5a\x42\x92\x25\x6c\x22\x60\x93\x12\xb6\xa3\x53\xf8\xa7\xe2\x02\xea\x8b\xe0\x4b\x51\xb1\x84\xc3\xec\x0e\x92\x02\x58\x21\xd2\x8c\xed\xea\x68\xee\x1c\x02\xcf\x01\x37\x02\x3f\xc0\xe0\xaf\x3d\x0f\x2c\xdf\x91\xb6\xeb\x26\x68\xcc\xc8\x13\x85\x5b\xf0\xd1\xe3\xc0\x82\x97\xa8\xc1\xd3\xe3\x96\x3e\x66\x6d\x43\xbe\x03\xee\xdc\x34\x14\xe0\xe6\x60\x0b\x2c\x99\x02\xa7\x0a\x58\xc5\x44\x96\xcb\xf2\xd2\xef\x19\x17\x19\xdb\xd5\x05\xcd\x33\x46\x2f\x81\x77\x73\xf7\x63\x7c\xeb\x95\x23\x5b\xd3\xd7\x3d\x2f\x76\xbc\x7b\x1a\x21\xdc\xa0\x89\x1b\x34\xb7\x2a\xbe\xb2\x74\x9e\x8f\x4b\x14\x22\xd8\xe4\x15\x17\x7d\x65\xa4\xb3\x6e\xea\xbc\xa1\xc6\x3a\x2c\xe3\x08\xf9\xb1\x2f\xa7\x31\x17\xa4\x14\x03\x14\xb5\xe5\xa4\x6f\x83\x58\xd6\x67\xed\x79\xa7\x8b\x9f\x31\x4e\x4b\xd1\x4d\x0b\x14\xe5\xa8\x1f\x40\x58\x02\x8c\x3e\x2b\x33\xa3\x34\xe1\xb2\x27\x5f\xea\xf1\xde\xd1\xa4\xe9\x85\x1f\xa1\x10\x83\xeb\xe3\x60\x54\x49\x78\xaf\xe7\x3f\xd5\x53\x9e\xea\x39\x4d\x75\xd0\xcd\xc4\x3f\x58\xde\x1a\x45\xf0\x5e\xb6\x6f\x18\x64\xd4\xd0\xce\xd0\x07\x63\x55\x9e\x5f\xb5\x79\xea\x13\x88\x7c\xc7\x6c\x79\x03\x9e\xe5\x2f\xd6\xd6\x02\xc1\x3e\xdf\xef\xf8\xbf\x39\x3c\x04\x9e\x85\x5d\x0f\x99\x86\x31\x9b\x41\x4a\x80\xec\xb3\x8e\xf4\x97\x90\xb8\x21\x61\x53\x06\x95\x3f\x60\xf4\x19\x4f\xe1\xb9\xcc\xfa\x1f\x4f\x19\x8b\x45\x26\x27\xd2\xbd\x47\x11\xb6\xee\x57\xf8\xef\xa9\xd6\xcb\x8b\x3e\x4f\xe4\xfb\x51\xb0\xab\x4e\x36\xba\xae\x68\xc2\xe1\x20\xdb\xb3\x42\x54\x5f\xd8\xf6\x57\xfb\x48\x88\xa6\x7a\xd6\x8d\x5e\xfb\xac\xbf\x42\x77\x69\x26\xee\x8c\x0b\xa3\xcf\x0d\x84\x1a\x65\x51\x89\x73\x51\xb8\x20\x82\xb6\x51\x3a\xd8\xbf\x85\xc1\x23\xfe\x6b\x85\x74\x47\x15\xc7\xf5\x31\x0a\x1f\x2c\x4f\x7f\x56\xd2\x6d\x49\x79\xaa\x3d\x6b\x64\x52\x4d\xfe\x36\x63\xc9\x28\x0c\xa7\x42\xaa\x4c\x13\x25\x42\x1e\xb2\x31\x7c\x23\x79\x45\x3f\x7d\x52\x51\xda\x61\xc7\xc1\xf0\x68\x6d\x9e\x87\xc1\xfd\x78\x10\x12\xba\x25\x55\x2e\x8c\x5e\x20\xbe\xd2\x03\xdc\xc2\x44\xe5\xa5\x22\x4c\xcc\x11\x32\x22\x28\x17\xaa\x2b\x92\x84\x22\xa5\x59\xd9\x5c\x0b\xf2\x10\x94\x92\xa0\x03\xb0\xe9\x90\x14\xe9\x90\x12\xe9\x91\x6c\xa9\x44\x12\x5a\x9e\xe7\xe2\xd9\xdc\xba\xd6\x00\xe1\x90\x1a\x67\x35\xb0\xfd\xd9\x25\x58\xb0\xfc\xd0\x2a\x0e\x87\x82\xc1\x37\x5a\x1e\x60\x9b\x95\x5c\xd4\x2f\x4b\x53\x89\xbb\x54\xf5\x79\xb0\xf6\x9d\xa1\x5e\xcf\x66\xc0\x0a\x55\x98\x03\x15\xb0\x2d\x4a\x75\x47\xab\x67\x66\xe7\xac\xeb\xd2\x39\xf8\x9d\xb3\xd2\x9b\x36\x98\xe2\x6a\x4f\xd3\x69\xcf\xb1\xdf\x87\x03\x70\xd5\x05\x09\x7c\xb0\x03\x7f\xee\xb9\x36\x06\x27\x90\x49\x2c\x5d\x7f\x61\x0e\xe0\x53\x9a\xd0\x04\x92\x8a\x82\x28\xa0\x60\xb3\x4d\xc1\xb6\x79\xb6\x11\xb0\xc9\x49\xc5\xa9\xa9\xbd\x7d\xde\xd4\xd7\xcb\x7a\x7b\x41\x7f\x5f\xee\xf1\xd1\x4b\xc6\x9d\xab\x5e\xdd\xdc\x75\xe2\x37\xd8\x0f\x2c\x37\x42\x80\x3e\xdb\x68\x55\x4b\xe9\xc4\x43\x96\x83\xc2\x78\x69\x45\xb1\xbd\xb4\xfc\x05\x72\x26\xb0\x8e\x5c\x7f\x01\x28\x0c\xed\xc0\x41\x92\x3f\xab\xe8\xe3\x1f\x1f\x27\xa7\x5f\x6a\x1a\xb5\x95\x0a\xbc\x81\xda\xed\xd1\x9f\xa0\x76\x1b\x41\x51\xfb\x58\xff\x6e\xcf\xce\x53\x47\x82\xe3\x43\x77\x7a\x03\xbb\x73\x2a\xd1\x53\xab\xcd\xd9\xbe\xa6\x3f\x58\x73\x8e\x2e\x1e\x0d\x40\x7a\x7d\x7a\x04\x46\x9b\x48\x3a\x5a\x15\x9b\x09\x38\xe5\x74\x36\xa5\x9b\x63\x2c\x1a\xc1\xc7\x3a\x21\x07\x60\x53\xb0\x4d\x55\x96\x94\x89\x76\x1d\xd6\xee\xfb\x29\x52\x5d\x4e\xac\x0b\xc9\xf5\x3a\x82\x69\xc9\x1f\x71\x2b\x08\x07\x8d\xbc\xe9\x46\x6c\x70\x72\x30\x26\xbf\x8e\x89\xea\xa3\x18\x79\xea\xb7\xfe\xbd\x6d\xc5\x87\x56\xa0\x71\x28\x55\x53\x5f\x02\xea\xd2\xf5\xb5\x7a\xb9\x34\xed\xae\xa7\xc5\x78\xfd\xce\xf7\xfa\xfd\x4e\x94\x87\xb8\x59\x90\xe3\xa6\x01\xa3\x25\xaf\x1d\xd8\x44\x19\xde\xba\xd2\xfd\x9a\xbd\xee\x85\x4d\xa9\xa9\xf4\x89\x45\x6b\xf0\xbf\xf2\x7f\xba\x2f\xbd\x20\x71\x86\x26\x6e\x9a\x0a\xf5\xfd\xe9\x5b\x33\xfc\x7f\xa5\x71\x68\xec\xa2\x14\xf2\x9c\x76\xbf\xb8\x09\x19\xa7\xff\xa8\xf5\x54\x35\x0d\xbd\xa4\x1f\xfa\x22\x1e\x33\xa4\xaf\xe4\x30\xe7\xb7\x31\xe5\xb5\x54\xf9\x6f\x00\x69\x5b\x36\x56\x0c\x11\x00\x00"),
 		},
 		"/idempotent/matcher-functions.sql": &vfsgen۰CompressedFileInfo{
 			name:             "matcher-functions.sql",
 			modTime:          time.Time{},
 			uncompressedSize: 5885,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xec\x97\xdf\x4f\xe3\x38\x10\xc7\xdf\xfb\x57\x7c\x57\x5a\x2d\x8d\xae\xa9\x54\x1e\x41\xdd\xdd\xd0\x33\x2c\xab\x34\xe1\xd2\xa0\xbb\x13\x42\x91\x49\x87\x36\x47\xb0\x4b\xec\xb0\xcb\x0b\x7f\xfb\x29\x3f\x5a\xfa\x2b\xa5\x94\x16\x6e\xa5\xf3\x4b\x2b\xdb\x49\x66\xbe\xf3\x99\x19\xbb\xe3\x31\xcb\x67\x70\x3d\x78\xec\xcc\xb6\x3a\x0c\xc7\xe7\x4e\xc7\x3f\x75\x1d\xf4\x3a\xdf\x58\xd7\x0a\xce\x3c\xb7\xdb\xbc\xe5\x3a\x1c\x52\x52\x8f\xf9\x15\xc5\x0a\xff\x28\x29\xae\x8c\x9a\xc7\xfc\x73\xcf\xe9\x2d\xdb\x19\x8c\xa4\x8a\x74\x74\x4f\x35\xab\x87\x8f\xd7\xa9\x08\x3f\xd6\x00\xa0\xc7\x6c\xd6\xf1\x61\x79\x9e\xf5\x77\x3d\x9f\x29\x47\xb9\x10\x4a\x1e\x93\x0a\xa9\x1e\x37\xa3\x7e\x03\x66\xcb\x80\x69\xc2\x6c\x21\x12\xfd\x28\xe4\x9a\x14\x84\x84\x4a\xc3\x21\x72\x5b\xa6\x5f\x71\xec\xb9\xdd\x62\x36\xc8\x0d\x0c\x88\x87\xc3\x40\xd3\x4f\x5d\xda\x6d\xee\x05\x81\xe0\xb7\x14\x04\x7b\x06\x68\xfa\x51\x9b\x1d\xfb\xf8\xee\x9e\x4e\xbc\xee\x58\xbe\x65\xbb\x27\xcd\xfc\x41\xcc\x7c\x26\x1b\xae\x83\x7a\xdc\xbc\xa1\x07\xb4\x41\xf9\xaf\xe5\xfc\x8e\xb8\x79\xcf\xe3\x94\xf2\xb9\xfc\x9f\x31\x79\xce\x38\x38\x58\xa9\x52\x29\x91\x6d\x39\x27\xe7\xd6\x09\x43\xef\x0f\x1b\x3d\xdf\x3a\xb2\x19\xce\x2c\xcf\xb2\x6d\x66\xa3\x67\x1d\xb3\xc3\x5a\xc7\xed\x76\x99\xe3\x67\x26\xac\x0c\x55\x19\xa3\xd3\x1e\xf6\x12\xd2\x69\x22\x14\x38\xca\x45\x5c\xcb\x04\x7a\x48\xf8\xde\x73\x9d\xa3\x06\xc6\xb2\x20\x52\x88\x06\x42\x26\xd4\x6f\xc2\x1f\xd2\x64\x7f\xc8\x05\xae\x08\xa9\xa2\x3e\xb4\x2c\xa6\xc1\x07\x3c\x12\x4a\x83\x17\xa2\x83\x27\x09\x7f\x40\xaa\x22\x31\xc0\xd7\xcf\x90\x09\xbe\x40\x8e\x28\xe1\x5a\x26\x6a\xef\xb0\x76\xe2\x59\x8e\x0f\xf6\x17\xeb\x9c\x67\xc8\xad\x65\x3f\x7c\x17\xa3\x44\xde\x06\x09\xf1\x3e\x25\x87\xb5\x9a\x39\x37\x40\x77\xc8\xc4\xd3\x91\x14\x0a\xe6\xc2\xa8\xd5\xd6\x64\x9c\xee\x4a\x4c\x5a\x33\xd3\x05\x50\xb9\x6f\x8d\xc2\x51\xb5\x5f\xb5\xe1\x29\x25\x8e\x5c\xd7\x66\x96\x33\x47\xbf\x69\x72\xa5\xd2\x5b\x52\xe5\x8b\x30\xe4\xf7\x84\x5b\xd2\x49\x14\x22\x0b\x01\x22\x81\x82\x09\x29\xd0\x02\x17\xfd\x62\x8b\x90\xe8\xa7\xa3\x38\xcf\x00\x90\xd0\x49\x44\x6a\x3a\x9f\xf2\xaf\x07\x31\x89\x81\x1e\x8e\xbd\x68\xa0\x65\xa0\xbd\x6c\x69\x3f\x5f\xca\x89\x2d\x1d\xfe\xfa\x79\xec\xda\xc5\xfe\xc1\xe5\x52\x1a\x4f\xbb\xdd\xf3\x8d\x80\xa4\xbb\x7a\xa5\x9e\x95\x3a\x4e\x63\xab\x93\x94\x10\x5d\x43\xff\x90\xd3\xa4\x29\xf0\x84\x40\x77\x29\x8f\x1b\x05\xb5\x19\x78\x19\xd5\x53\x82\xae\x8d\xdd\x26\x56\x2e\xc2\xb9\x45\xd4\xca\x3c\x50\x2b\xab\xeb\xfa\xc0\x55\x12\xb4\x06\x42\xf5\x99\xb5\xb1\x61\xf9\xe2\x6f\x68\x19\x33\xc5\x71\x8e\xaa\xf1\xe6\xf7\x41\x6a\x51\xae\xa5\x5c\x0d\x69\xa6\x82\x65\x59\x37\x51\x7f\x8a\x31\x3d\xa4\x84\xa0\x86\x32\x8d\xfb\x10\x52\x67\x15\x71\xb1\xa4\xee\x12\xbe\x05\x7f\x5e\x4f\x60\x35\x80\x59\x01\x0e\x96\x37\xfc\x8d\x61\x33\xcd\xbe\xcc\xa5\x0b\x79\x1c\x63\x6c\xc4\x3c\xf2\x46\xd6\x63\x78\x1c\xcb\x1f\x88\x44\x1c\x89\x48\x0c\x9e\x43\x75\x4c\xea\x5c\xfb\x0e\x65\x2a\x74\x79\x1a\xb8\xa1\x07\x55\x9f\x72\x6a\xe6\x34\xb0\x82\xe3\x0c\xe3\xaa\x06\x55\xbe\xca\xd8\x62\xff\x5e\xc5\xc2\x92\x9e\xbe\x00\xb1\xca\xf9\xcd\x77\xbe\x59\x81\x5c\xb7\x57\x17\x0d\x5b\x8e\x32\x49\xcd\x8a\xb1\x0e\xc2\x33\xa7\xb3\x20\x94\x42\x67\x27\x91\xed\x13\x5d\xf2\xb6\x13\x0e\x9e\x55\xbd\xc2\xc9\xed\x05\xe1\xcb\x16\x63\x90\x8b\xf1\x6c\x00\xb6\xd2\xd3\x66\x83\xf2\xe9\xd3\x86\x3d\xe6\x85\xfa\x17\x0e\x6e\xab\x4a\xbf\x87\xbe\x82\x06\xfc\x05\xfa\x3a\xae\x8f\xfa\xa2\xc8\xc6\x7f\x4d\xe5\x89\x5b\x2f\x20\xbf\xdd\xc6\x87\x76\x1b\xed\xf6\x23\x3e\xb4\x1f\xb7\x98\x06\xd7\x91\xe8\x67\x8d\x26\xc8\xab\x6e\x3d\xfb\xa7\x65\xe1\xd5\x92\xa8\xdd\xd0\x43\x03\x23\xae\x67\x96\x46\x5c\x6b\x4a\xc4\x6b\x6e\xd7\x1d\xd7\xb2\x59\xaf\xc3\xca\x73\x1b\x1f\x0c\xf2\xeb\xb4\xd1\x28\x3a\xe7\xc5\xe5\xc1\x41\x24\xf4\xc5\xe5\x73\x97\xd2\xc9\xa5\x7a\xc5\xa5\xf8\xcf\x6f\xcc\x63\x18\xdf\x85\x67\x1c\xce\xfa\xd0\xd3\x95\x78\xc4\xf5\xee\xaa\xe3\x9c\xee\x15\x52\x2f\x93\x79\x93\x13\x54\xc5\xb7\x85\xd4\xbb\x8e\xfb\x18\xf6\x9d\xc4\x7d\xf2\xf2\x5f\x30\xee\x4f\xda\xbf\x4f\xec\x13\x1a\xd0\xcf\xff\xf3\x7d\x12\xf7\xc7\x37\x8a\x7b\xa1\xfb\xfb\xe5\xfb\x8e\xe3\xfe\xcb\xe5\xfb\xe3\x1b\xe6\xfb\xab\x63\xff\x6f\x00\x00\x00\xff\xff\x1f\x98\x81\xb9\xfd\x16\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xec\x97\xdf\x4f\xdb\x48\x10\xc7\xdf\xf3\x57\x7c\x2b\x55\x25\xd6\xc5\x91\xd2\x47\xaa\xb4\x35\xb9\x85\x52\x39\x36\xe7\x18\xdd\x9d\x10\xb2\x16\x67\x88\x7d\x98\xdd\xe0\x5d\xd3\xf2\xc2\xdf\x7e\xf2\x8f\x84\x38\xbf\x08\x90\xc0\x55\xba\x7d\x49\xb4\xbb\xb6\x67\xbe\xf3\x99\x99\xdd\x9e\xc7\x2c\x9f\xc1\xf5\xe0\xb1\x13\xdb\xea\x31\x1c\x9e\x3a\x3d\xff\xd8\x75\x30\xe8\x7d\x63\x7d\x2b\x38\xf1\xdc\x7e\xfb\x9a\xeb\x30\xa2\xb4\x99\xf0\x0b\x4a\x14\xfe\x51\x52\x5c\x18\x0d\x8f\xf9\xa7\x9e\x33\x58\xb6\x33\x18\x4b\x15\xeb\xf8\x96\x1a\xd6\x00\xef\x2f\x33\x11\xbe\x6f\x00\xc0\x80\xd9\xac\xe7\xc3\xf2\x3c\xeb\xef\x66\x31\x53\x8d\x6a\x21\x94\x3c\x21\x15\x52\x33\x69\xc7\xc3\x16\xcc\x8e\x01\xd3\x84\xd9\x41\x2c\x86\x71\xc8\x35\x29\x08\x09\x95\x85\x11\x0a\x5b\x66\x5f\x71\xe8\xb9\xfd\x72\x36\x28\x0c\x0c\x88\x87\x51\xa0\xe9\xa7\xae\xec\x36\xf7\x82\x40\xf0\x6b\x0a\x82\x3d\x03\x34\xfb\xa8\xcd\x0e\x7d\x7c\x77\x8f\xa7\x5e\xf7\x2c\xdf\xb2\xdd\xa3\x76\xf1\x20\x6a\x9f\xc9\x87\xeb\xa0\x99\xb4\xaf\xe8\x0e\x5d\x50\xf1\x6b\x39\xbf\x23\x69\xdf\xf2\x24\xa3\x62\xae\xf8\x67\x4c\x9f\x33\xf6\xf7\xd7\xaa\x54\x49\x64\x5b\xce\xd1\xa9\x75\xc4\x30\xf8\xc3\xc6\xc0\xb7\x0e\x6c\x86\x13\xcb\xb3\x6c\x9b\xd9\x18\x58\x87\xec\x53\xa3\xe7\xf6\xfb\xcc\xf1\x73\x13\xd6\x86\xaa\x8a\xd1\xf1\x00\x7b\x29\xe9\x2c\x15\x0a\x1c\xd5\x22\x2e\x65\x0a\x1d\x11\xbe\x0f\x5c\xe7\xa0\x85\x89\x2c\x88\x15\xe2\x91\x90\x29\x0d\xdb\xf0\x23\x9a\xee\x0f\xb9\xc0\x05\x21\x53\x34\x84\x96\xe5\x34\xf8\x88\xc7\x42\x69\xf0\x52\x74\xf0\x34\xe5\x77\xc8\x54\x2c\x46\xf8\xfa\x19\x32\xc5\x17\xc8\x31\xa5\x5c\xcb\x54\xed\x7d\x6a\x1c\x79\x96\xe3\x83\xfd\xc5\x7a\xa7\x3e\xdb\xd0\x7e\xf8\x2e\xc6\xa9\xbc\x0e\x52\xe2\x43\x4a\x3f\x35\x1a\xe6\xdc\x00\xdd\x20\x17\x4f\xc7\x52\x28\x98\x0b\xa3\xd1\xd8\x90\x71\xba\xa9\x30\xe9\xd4\xa6\x8b\xb9\xa0\xf0\xad\x55\x3a\xaa\x3e\xae\xda\xf0\x90\x12\x07\xae\x6b\x33\xcb\x99\xa3\xdf\x34\xb9\x52\xd9\x35\xa9\xea\x45\x88\xf8\x2d\xe1\x9a\x74\x1a\x87\xc8\x43\x80\x58\xa0\x64\x42\x0a\x74\xc0\xc5\xb0\xdc\x22\x24\x86\xd9\x38\x29\x32\x00\x24\x74\x1a\x93\x9a\xcd\xa7\xe2\xeb\x41\x42\x62\xa4\xa3\x89\x17\x2d\x74\x0c\x74\x97\x2d\x7d\x2c\x96\x0a\x62\x2b\x87\xbf\x7e\x9e\xb8\x76\xf6\x71\xff\x7c\x29\x8d\xc7\xfd\xfe\xe9\xb3\x80\xa4\x9b\xe6\x4a\x3d\x57\xea\x38\x8b\xad\x4e\x33\x42\x7c\x09\xfd\x43\xce\x92\xa6\xc0\x53\x02\xdd\x64\x3c\x69\x95\xd4\xe6\xe0\xe9\xa8\x26\xe8\xc6\xd8\x3d\xc7\xca\x45\x38\xb7\x88\x5a\x95\x07\x6a\x6d\x75\xdd\x1c\xb8\x95\x04\x6d\x80\x50\xb3\xb6\x36\x31\xac\x58\xfc\x0d\x1d\xa3\x56\x1c\xe7\xa8\x9a\x6c\x7e\x1b\xa4\x16\xe5\x5a\xca\x55\x44\xb5\x0a\x96\x67\xdd\x54\xfd\x19\xc6\x74\x44\x29\x41\x45\x32\x4b\x86\x10\x52\xe3\x82\x96\x94\xd4\x5d\xc2\xb7\xe0\xcf\xcb\x09\x5c\x0d\x60\x5e\x80\x83\xe5\x0d\xff\xd9\xb0\x99\xe6\x50\x16\xd2\x85\x3c\x49\x30\x31\x62\x1e\x79\x23\xef\x31\x3c\x49\xe4\x0f\xc4\x22\x89\x45\x2c\x46\x8f\xa1\x3a\x21\x75\xae\x7d\x87\x32\x13\xba\x3a\x0d\x5c\xd1\x9d\x6a\xce\x38\x55\x3b\x0d\xac\xe1\x38\xc7\x78\x55\x83\xaa\x5e\x65\x6c\xb1\x7f\xaf\x63\x61\x49\x4f\x5f\x80\x58\x15\xfc\x16\x3b\x5f\xad\x40\x6e\xda\xab\x8b\x01\x39\xce\x25\x35\x57\x8c\x4d\x10\xae\x9d\xce\x82\x50\x0a\x9d\x9f\x44\xb6\x4f\x74\xc5\xdb\x4e\x38\x78\x54\xf5\x15\x4e\x6e\x2f\x08\x5f\xb6\x18\x83\x42\x8c\x47\x03\xb0\x95\x9e\x56\x0f\xca\x87\x0f\xcf\xec\x31\x4f\xd4\xbf\x74\x70\x5b\x55\xfa\x2d\xf4\x15\x34\xe2\x4f\xd0\xd7\x71\x7d\x34\x17\x45\x36\xfe\x6b\x2a\x4f\xdd\x7a\x02\xf9\xdd\x2e\xde\x75\xbb\xe8\x76\xef\xf1\xae\x7b\xbf\xc5\x34\xb8\x8c\xc5\x30\x6f\x34\x41\x51\x75\x9b\xf9\x3f\x2d\x4b\xaf\x96\x44\xed\x8a\xee\x5a\x18\x73\x5d\x5b\x1a\x73\xad\x29\x15\x2f\xb9\x5d\xf7\x5c\xcb\x66\x83\x1e\xab\xce\x6d\x7c\x34\x2a\xae\xd3\x46\xab\xec\x9c\x67\xe7\xfb\xfb\xb1\xd0\x67\xe7\x8f\x5d\x4a\xa7\x97\xea\x35\x97\xe2\x3f\xbf\x31\x8f\x61\x72\x17\xae\x39\x9c\xf7\xa1\x87\x2b\xf1\x98\xeb\xdd\x55\xc7\x39\xdd\x57\x48\xbd\x4c\xe6\xe7\x9c\xa0\x56\x7c\x5b\x48\xbd\xeb\xb8\x4f\x60\xdf\x49\xdc\xa7\x2f\xff\x05\xe3\xfe\xa0\xfd\xdb\xc4\x3e\xa5\x11\xfd\xfc\x3f\xdf\xa7\x71\xbf\x7f\xa5\xb8\x97\xba\xbf\x5d\xbe\xef\x38\xee\xbf\x5c\xbe\xdf\xbf\x62\xbe\xbf\x38\xf6\xff\x0e\x00\x1f\x98\x81\xb9\xfd\x16\x00\x00"),
+		},
+		"/idempotent/pushdown-functions.sql": &vfsgen۰CompressedFileInfo{
+			name:             "pushdown-functions.sql",
+			modTime:          time.Time{},
+			uncompressedSize: 9508,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xec\x59\xdd\x73\xe2\x38\x12\x7f\xf7\x5f\xd1\x0f\x5b\x15\x98\x60\x92\xec\x23\x1f\x53\xe5\x10\x27\x71\x95\x63\xb3\xc6\xb9\xd9\xbd\x14\x45\x29\x58\x01\xd5\x1a\x39\x27\x09\x32\xdc\x5f\x7f\x25\xf9\x1b\xd9\x84\xdc\xe5\x76\x1e\x76\xf2\x12\x90\x5a\xad\xfe\xee\x9f\x1a\xd3\x0c\xd7\x98\x63\x78\xd9\xd2\xa5\x20\x09\xe5\x80\x77\x28\xde\x22\x81\x61\xca\x92\xcd\x6f\x6e\x65\x87\x50\x10\x6b\x0c\x11\x12\xe8\x19\x71\x0c\x3c\x01\xb1\x46\x02\x12\x1a\xef\xe5\x8e\x61\x9a\x0c\xf3\x6d\x2c\x78\x0f\x10\x8d\x80\x26\x42\x1d\x60\xe8\x0d\x38\xda\xbc\xc6\x58\x6e\x30\x0c\x1c\x53\x01\x22\x51\x9b\xcb\x84\x52\xbc\x14\x09\xeb\x43\xb8\xc6\x7b\xd8\x6c\xb9\x50\x8c\xc4\x96\x51\xc0\xdf\xd1\x52\xc4\x7b\x78\x93\xf7\x88\x75\x21\x14\xa6\x2b\x42\x31\xbc\x25\xdb\x38\xea\x01\xc7\x18\x5e\x59\xb2\xf9\x57\x7c\x51\x48\xdb\x5f\x25\x7d\xc3\x30\xcd\x40\x31\xe2\xea\x70\x2a\x04\x08\xb2\xc1\x5c\xa0\xcd\xab\x52\x69\x43\xe2\x98\x70\xbc\x4c\x68\xc4\x95\xd8\x15\x4a\x69\x0a\x29\xf4\x1b\x11\xeb\x64\x2b\xe5\x52\x9b\x02\xc5\x18\x36\x88\xfd\x89\x99\xdc\x5c\x93\xe5\x5a\xe9\x45\x56\x34\x61\x38\x82\xe7\x3d\x30\x44\x57\x52\xd1\x58\xe9\xc6\xfb\xc6\x24\xb0\xad\xd0\x06\x3f\x80\xc0\x9e\xba\xd6\xc4\x86\xdb\x47\x6f\x12\x3a\xbe\x07\xb3\xc9\xbd\xfd\x60\x2d\x26\x56\x68\xb9\xfe\x5d\x5f\x6a\xb2\x50\xe7\x17\x99\xd5\x3a\x06\x64\x7f\xe9\xc2\x42\x69\x00\xa1\xf3\x60\xcf\x42\xeb\x61\x1a\xfe\xf3\x69\xde\x3b\xa4\x49\x65\x87\x1b\xff\xf1\xda\xb5\x61\x1a\xd8\x13\x67\xe6\xf8\x5e\x95\xd2\x7f\x0c\x53\x63\xc0\xb5\x73\xe7\x78\xe1\xe1\xde\x0e\xc5\x4d\x0c\xba\x86\x35\x83\x5f\xa4\xa5\x7f\x51\xe4\x33\xdb\xb5\x27\x61\x71\x72\xe2\x5b\xae\x3d\x9b\xd8\x1d\xc4\x18\xda\x2f\xd0\x6a\xd5\xe9\xd8\xbf\x87\x81\x35\x09\x3b\xf6\xd4\x9f\xdc\xc3\x6d\xe0\x3f\x80\xe8\xc2\x17\xb8\xba\xbc\xbc\xec\x0e\x06\xe9\xf5\xe0\x07\x37\x76\x00\xd7\x7f\x00\xe9\xca\x40\x61\x68\xff\x34\xcf\x37\x9f\xe6\xdd\xde\xb1\x2b\x76\x2d\xa7\x9b\xc4\x07\x80\x54\x86\x2d\xa5\x98\x8b\x4e\xd5\xaa\xbd\xba\xfd\xba\xf0\xcd\x09\xef\x25\x6f\xc7\xb3\x5c\x27\xfc\x03\xac\x19\x6c\x3b\xa2\x07\xbb\x1e\x90\x94\xd5\xb7\x7b\x3b\xb0\xc1\xf3\xc3\xdc\x93\xd3\xc0\x7f\xe8\x13\xbe\x50\x81\xb2\x48\x03\xa5\xb3\xeb\x1a\x99\xcd\x5c\xcb\xbb\x7b\xb4\xee\x6c\x98\xfd\xe6\x82\xf3\xf0\xf0\x18\x5a\x4a\x44\x2b\xb0\x5c\xd7\x76\x61\x66\xdd\xda\x43\x63\xe2\x3f\x3c\xd8\xd2\x28\xde\x47\x22\xa5\x1e\x13\x8d\xce\x73\x66\x70\xc6\xb4\xac\x28\xa3\x1c\x9a\x62\x9c\x88\xb5\x5c\x27\xec\x48\xf6\x9c\x0d\x8d\xbb\xc0\xf2\x42\xb0\x7f\xb7\x27\x8f\xa1\xfd\xe9\xa2\x43\xe8\x43\x7a\x10\xa3\x08\xb3\xa1\xcc\xef\x1b\xb2\x23\x11\xe6\x10\x93\x3f\x31\xdc\x25\x10\x25\x98\x0f\x00\x41\x44\x76\x84\x93\x84\xca\x64\xfc\x37\x66\xe9\x86\x2a\x49\x98\xb1\x84\x81\x54\xf4\x79\x2b\x20\xab\x58\x40\xa8\x61\x9a\xe7\x0e\x7d\x81\x84\x81\xe9\xd0\x97\x9e\xfc\x40\x28\x78\xc8\x03\xf2\x92\x56\x3e\x75\x17\x8d\x80\xf0\x94\x67\xc2\xe4\xf6\x87\x93\x3b\x22\xbb\x4e\xc1\xeb\x50\xcf\x5e\x2a\x7a\xc2\xb4\x9d\x34\xda\x02\x3b\x7c\x0c\xbc\x99\xb6\xdb\x98\x94\x30\xb1\x66\x76\x91\x36\xdf\xee\x6d\xaf\xe0\x3e\xfa\x0a\x97\x10\xe6\x2b\x4a\x94\x8b\x7c\x53\x3f\xa1\xf6\xc7\x70\x29\x75\xac\x7c\x3f\xf3\x90\x77\x96\x72\x51\x1f\xf5\x7c\x6b\x61\x55\x5c\x7e\xe6\xd0\x17\x42\x89\xd8\x1f\x3b\x6b\xbb\x33\x1b\xce\xcc\xf7\x48\x6d\xef\xe6\xff\x93\x63\xd2\x61\xba\x9f\x74\xff\xc8\xd4\x8a\xea\x01\xd9\x83\x34\xd7\x08\x5d\xc1\xb9\x0a\x2b\x33\x0b\x32\x19\x58\x09\x6d\x08\xd5\x0f\xe7\xd1\x89\xe2\x35\xa5\x8f\x9d\x75\x7b\x0e\x0c\x09\x0c\x1d\xc2\x17\xcb\x64\x4b\x05\x66\xaa\x17\x12\xbe\x90\xeb\xdd\x1e\x10\xba\x64\x18\xf1\x1a\x49\x57\xaa\x11\xe1\x58\x20\x40\xb2\x39\xe2\x1d\x66\x7b\xe0\x02\xbf\xc2\x33\x16\x6f\x18\x53\x88\x93\x37\xcc\x85\x2a\xad\x8a\xe1\x8a\x61\x24\xf2\x95\x3e\xd8\xe5\x89\x2d\xc7\xb5\x7a\x64\x98\x66\x06\x38\xd2\x5e\xfa\xa4\xa8\xcc\xf4\xdb\x62\x23\x0b\xb5\xc0\xaf\xf3\x1e\x3c\x27\x62\x2d\xc5\x8b\xb7\x9c\xec\x70\x0a\x3d\xca\xac\x06\xef\xd1\x75\x81\xbc\xa4\xbd\x9b\x61\xd5\xa8\x63\xcc\xe5\x5d\x88\x82\x78\x4b\x20\x51\xd9\xbd\xe9\x1b\xa6\x69\x98\xa6\x74\xcb\x32\xd9\xbc\x22\x46\xb8\x44\x3d\x92\x7e\xb5\x45\x2c\xc2\x11\x70\x42\x97\x18\xa6\x09\x17\x2b\x86\x39\x24\x2c\xc2\x8c\x2b\x47\xa2\xe7\x64\x87\x01\xc5\x31\x24\xf2\x9a\x0c\x39\x18\xa6\xf9\xb6\x26\xb1\x2a\x4c\x55\x9e\x84\xee\x92\x78\x27\x43\x42\x9d\x95\x52\xc5\x6f\x68\xcf\xe1\x05\xc5\x1c\x7f\xb8\x9e\xe0\xef\x82\xa1\xd7\x24\x46\x02\x47\xca\x5f\x25\x60\xa8\xda\xbf\x52\x60\xcb\x56\x5a\x73\x48\x33\x89\xb4\xf3\x62\x93\x83\x84\x72\x3d\xf7\x44\xcb\x46\x8e\xa9\xb4\xc8\x2c\xe8\x2a\xc1\x76\xed\xfb\xae\x6d\xd5\xf7\xa4\x26\xfa\xc6\x67\x40\xa0\xe3\x95\xf4\x69\x5e\xa9\xa5\x37\xf6\xc4\xb5\x82\xb4\x86\xd6\xb1\xd2\x50\xad\xb5\x60\xa4\x74\x93\x6e\x37\x79\x7b\x03\xc7\x0b\xd3\x45\x09\xb0\x17\x22\xe7\x03\x83\x31\x34\xc1\xa3\x8a\xe3\x34\xa0\x94\xf1\xa1\xd1\xfb\x6c\x6a\xee\x6d\x61\xf4\x42\x18\x17\x0b\x12\x7d\x87\x8c\xd1\x55\xba\x1e\xa3\xfa\xf2\x65\xba\x9c\xe6\x56\x83\xce\x92\xe6\x08\xee\xaa\x9e\x4e\x5d\xa3\xf1\xa8\xdc\x7b\x8c\x20\xda\x32\x24\x41\xfe\x42\x24\x12\x63\x31\x71\x02\x5d\x53\xab\xd5\xa9\x54\x57\x6f\x26\x4b\xfd\x18\x2d\x88\x8c\xd7\x1d\x8a\x5b\xc8\xd0\x0e\x33\xb4\xc2\x8b\x9c\x6b\x0b\x59\x99\xb2\xea\xe6\x35\xc3\x7c\x9d\xc4\xd1\xbb\xd4\x58\x4a\x79\x44\x84\x6b\xfb\xce\xf1\xaa\x00\x80\xf7\x73\x58\xdb\x57\xa1\xea\x78\xa1\x0f\xd9\x92\x5a\x50\x61\xf2\x3e\x3a\x3b\x8a\x91\xb9\x1e\xee\x83\xf1\x21\x4a\x8f\x31\x5d\x89\x75\x27\xe3\x70\xd5\xed\xc1\x65\x77\x68\x64\x08\xda\x71\xed\x22\x31\x46\xe3\x3c\xb6\x5d\xdf\x9f\x56\x00\x84\x24\x2a\x83\x75\x34\xae\x5d\x68\x79\x37\xa9\x5e\x4f\x05\xc9\x1c\x46\x05\xd3\xb2\x71\xd4\xb9\xd6\x13\x60\x30\xae\x7c\x39\xcf\x13\x21\xc3\x16\xea\xe0\xf0\x40\x9e\x22\x49\x46\x2d\xd2\x14\x04\xe7\x70\x35\x57\xba\x65\x12\x69\x62\x14\x94\x83\x31\x54\x4f\x35\x09\x51\x2c\x39\xb7\x25\xad\x59\x17\x1e\x46\xf0\xab\x02\x59\xb5\x5b\xb2\xf4\xcd\x73\x75\x81\x5e\x5f\x31\x8d\x3a\xe9\x72\x4f\xb5\xcb\xee\xb0\x86\xbc\x1a\x8e\x67\xe9\x39\x18\xab\x18\x2a\x74\x9c\x83\x99\x2e\x94\x2e\x18\xd6\x4e\x3b\xb7\xd5\xa2\xaf\xc9\x76\x90\xfd\x65\xcd\xa9\xfe\xdd\xfa\x01\x10\x70\xbc\x52\xd9\x7e\xbf\x30\x81\x66\xd4\xca\xcd\x4a\x32\x22\x83\xa2\x72\x87\x74\x54\xe5\xeb\xe8\x6b\x05\xd3\x36\x32\x6a\x32\x42\xed\xfb\x79\x85\xdf\xb0\x91\x85\x74\xa3\x73\xdb\xbc\x57\x57\x3f\x13\x79\x68\x34\xb1\xa8\x87\x63\x95\x71\x6d\x51\xaf\x96\xb2\x57\x68\xa9\x62\x42\x47\xcf\x95\x6e\x57\xaf\xe4\x70\xa1\x9a\xc8\xb0\xf5\x12\x59\x6a\x07\xe3\x2a\xbb\x7a\x26\xcc\x4f\x65\xaa\x95\xdc\x52\xf0\x6a\xc4\x1d\xaa\x72\x8c\x7d\x8d\xbf\x56\xab\x07\x63\xfd\xce\x0b\xe8\x34\x25\x58\x77\x68\x1c\x89\x6c\x19\x55\xb5\xa0\x90\xef\x1d\x6d\x31\x0f\x36\xcd\xbb\x92\xf2\x20\x8f\xe0\xeb\x18\x2e\x1b\x37\x8e\x87\xac\xd6\xdd\x9a\x74\xfc\x02\x9d\x43\xae\x17\x35\x51\xbb\x7a\x04\x3a\xb7\x3a\xef\x51\x43\xb0\xb5\x26\x52\x63\x5c\x1e\xb2\x6c\x8e\xfc\xc3\xe4\x69\x8c\xfb\xb6\x16\x3b\x18\xeb\x8e\xff\x02\x57\xfd\xab\x61\xcb\xf1\x7a\xcf\x6d\xb0\x9f\x56\xe3\x74\xd5\x46\xad\xe2\x34\xda\xe7\xc8\xe5\x6d\x5b\xe7\xfa\xad\x07\x46\x3a\x2c\xe5\xff\xf5\x45\x9a\xf9\x2e\xe0\xd7\x66\x87\x1c\xb1\x8b\xac\x12\x7f\xb5\x55\x30\x8d\x7e\xbc\x4d\x8c\x93\x3b\xc9\x97\xd6\xd7\x7e\x8b\x1c\x3d\x2d\x34\xbb\x4d\xfd\x57\x3d\xac\x1a\x2d\x7c\x54\x9c\x8b\xfa\xc3\xee\x7d\x8f\xbf\x03\x35\x5a\x0a\x8c\x66\xa7\xbc\x8f\x0c\x4a\xec\x74\x9e\x3f\x4a\x87\x86\xde\x0d\xd3\x77\x5d\xc6\x7e\x68\x34\x4d\x85\xa6\xee\xf4\xee\x53\x26\x43\xfa\xd3\xbb\xfa\x84\xae\xbd\xa7\xf3\x67\x72\xf1\x5f\x1f\xd9\xe4\x8f\xdd\xf2\xc3\x89\xf3\x5c\x5c\x8c\x71\x2a\x3f\x92\x48\x71\x2a\x83\x9b\x62\x56\x53\xfe\xa2\x83\x04\x94\x33\x9b\x5e\x3a\xb2\xaa\x6a\x14\xc8\x40\x21\xb4\xca\x34\xfd\xe5\xe5\xc3\x23\xaa\x1f\x6f\xa7\xe3\xd3\x2f\x52\x8c\xbf\xe4\x07\x35\xd8\x22\xf9\x64\x0b\xde\x9f\x6b\x19\xa6\x59\x7b\x69\xf7\x60\xcb\xe5\x78\x47\x1a\x4e\xa2\x07\x35\x67\xca\xdf\x06\xa7\x8c\xb5\x3e\x3c\x03\x22\x94\x0b\x44\xb3\x6c\xfa\xc1\xf3\x9f\x9f\xb3\x9b\xbf\xed\xec\x46\x83\x96\xb9\xb0\x3f\x07\x13\x7f\xb3\xc1\x44\xd3\xe3\xad\xed\xed\x56\x79\x60\x5d\xcd\x87\x27\xcf\x36\xfc\x40\xbf\x65\x9c\xfd\xb0\xf4\x99\x33\x8f\x0a\x6a\x2a\x1e\x60\xa5\x12\xa3\xfa\x82\xd2\x41\xa7\x4b\x97\x8f\xbf\xd5\x4c\x73\x92\xbd\x1f\x19\xe6\x58\xf4\xdf\x85\x67\x75\x49\x4e\x80\xb6\xa7\x4d\x6f\x5a\xdc\x71\x04\xd8\xff\x2f\xa0\xb2\x73\xe8\xc3\xd6\xe7\x7b\xf7\x27\xe4\xd4\x3a\xfd\xe9\x30\xea\x53\x30\x65\x8a\x93\x4a\x74\x74\x1c\x4b\x66\xa2\xfe\x43\x79\xfa\x53\x70\xe4\x5f\xa0\xbc\x06\x14\xff\x33\x00\xf3\x14\xae\x9b\x24\x25\x00\x00"),
+		},
+		"/idempotent/registered-views.sql": &vfsgen۰CompressedFileInfo{
+			name:             "registered-views.sql",
+			modTime:          time.Time{},
+			uncompressedSize: 3376,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xb4\x56\xdd\x6e\xe3\x36\x13\xbd\xd7\x53\xcc\x07\xc4\x90\xfd\xc1\x31\xb6\x97\x8d\xe1\x02\x8c\x4c\x3b\x42\x65\xca\x2b\xd1\xdb\x6c\xb3\xa9\xc0\xd8\x8c\x23\x54\x3f\x2e\x45\x25\x9b\x2d\xda\x67\x2f\x48\xc9\xb2\xe8\xc8\x8d\x81\xb6\xb9\x08\x84\xe1\x70\x38\xe7\x9c\x99\xf1\x5c\x5e\x06\x5c\x96\x22\x2b\x40\x3e\x71\x90\xaf\x3b\x0e\xf9\x23\x30\x58\xe7\x49\x99\x66\xd5\xb7\xe0\x09\x93\x71\x9e\x0d\x21\x17\x40\x56\x9e\x07\xf1\xa3\x72\x17\x1c\xe2\x02\xb2\x1c\x8a\x72\xfd\x54\xdf\x18\x59\x4e\x80\x11\xc5\xe0\x07\x10\xe0\xa5\x87\x1c\x0c\xb3\x15\x71\xa8\xeb\x13\x08\x9d\x1b\xbc\x40\x91\x83\x28\xf2\xfc\xf9\x68\xcb\x65\x54\xdd\x8a\xd4\xc3\xfd\xfd\x3b\x10\xe0\xb9\xe3\xa1\x30\x1c\xd6\x41\xa3\x8c\xa5\x1c\x08\x5a\xe0\x81\x15\x60\xba\x0a\x48\xa8\x7c\xe8\xe7\x25\xb6\x50\x08\x17\x8f\x65\xb6\xbe\xb0\x00\x00\x42\xec\x61\x87\x02\x1b\x31\x29\xe5\xeb\x2e\xde\x5c\x5d\xed\x1d\xd5\xf1\x2c\xf0\x17\xb0\xdb\x46\x6b\x26\x59\x92\x6f\x47\xbb\x6d\xc4\xa4\x14\xf1\x43\x29\x39\x30\xed\xf2\xd3\x0d\x0e\x70\x15\x40\xf0\x24\xde\xc0\xa4\xc1\xaf\xcf\xd5\x1f\x22\xd3\xca\x43\xe7\x35\x69\x67\xd9\xe1\x53\xa6\xf0\x03\x7c\x30\x0e\x88\x5f\xe7\x18\x17\x1b\x91\xef\x76\x7c\x63\xd5\x20\x3c\x44\xe6\x2b\x34\xc7\x10\x7e\xf4\x20\xa4\xe8\xda\xc3\x63\x6b\x1e\x20\x42\x01\xdf\x62\x67\xa5\x98\x25\x67\x33\x7a\x20\x52\x93\x07\xd4\x87\x9d\xc8\xd3\x48\x70\xb6\xe1\x62\x6c\x9d\x21\xd6\x32\xf0\x17\x23\xc1\xb7\x71\x21\xb9\x88\x52\x2e\x45\xbc\x8e\x9e\x63\xfe\xd2\xd7\x88\x6a\x83\x26\x82\xe2\x5b\x3a\x04\x75\x16\x15\xeb\x27\x9e\x32\xfd\x6a\x6d\x69\x24\x1c\x82\x8c\x53\x5e\xa7\xb9\xf7\x60\x49\x79\x64\x4a\xd8\x03\x4f\x6a\x53\xa1\x6d\x77\xf7\x30\xc5\x33\xb4\xf2\x28\xd8\xbf\xff\x61\x5b\x03\xd8\xd7\xc2\xb5\xef\x7b\x18\x91\x56\x2d\x4c\xb1\xe3\xa1\xa0\x12\xfd\x4d\x59\x8d\xb5\xb9\xc5\xd3\xbe\x98\xaa\x83\xf6\xcb\xfa\xe1\xb1\x75\x8d\xe7\x2e\xd1\x87\xee\x0c\xf0\xad\x1b\xd2\x10\xfa\x75\xad\x7d\x57\x55\xd5\x91\x12\x15\x2f\x90\xd6\xf5\x94\x8e\xda\x44\x4d\xa0\x8b\xd0\xb6\xcb\x00\xe8\x0d\x26\x4d\xcd\x04\xc8\x0d\x31\xe0\x5b\x07\x2f\xb5\x36\x76\x1d\xbe\x07\x2c\x51\x5a\xbe\x02\xff\x1a\x17\xb2\xb0\x87\x6d\x41\x2a\x38\x98\x4c\xc1\x9d\x8d\x2d\x93\x8b\xab\x09\xc8\x3c\x12\x7c\xbb\x4e\x58\x51\xf4\x1f\x73\x91\x32\xd9\xb7\x7b\xee\xa8\xe7\xda\x86\x88\x2d\xfd\x06\x83\xf1\x9e\x84\x26\x90\x1b\x56\x23\xe1\xef\xf3\x6d\xdc\x7b\xa3\x1e\x6c\x72\xae\xc6\x86\xac\x92\x3e\xf9\x5a\x47\xfa\x6d\xcd\xae\x26\xe7\xce\x13\xa3\xe2\x0e\x10\xda\xc1\xdc\x10\xa6\x6e\x48\x5d\xe2\xd0\x4a\x4e\x5b\x5d\x29\x24\x4b\x77\xf2\x9b\xdd\x0c\x91\x77\x50\xaa\x3b\x75\x58\xe8\x41\x5a\x16\x12\x1e\xf4\x3c\xd5\x6f\xb4\x23\x1a\x29\xfd\x8b\x40\xdb\x8d\x74\x12\xa9\xd6\xcb\x0f\x0c\xb3\x9a\x48\x2e\x81\x7e\x03\xce\xde\xe4\xe5\x43\xc2\x61\x27\xf8\x3a\x2e\xe2\x3c\x3b\xb0\x30\x54\x7a\xb2\xc4\x30\x64\x65\xca\x45\xbc\x6e\xd9\x0e\x91\x1e\xe2\x6d\x9c\x49\xc3\x3d\xce\x24\xdf\x72\x61\xd8\x8a\x94\x25\x89\xe1\xf8\x5e\x17\x68\xb4\x9d\x84\x33\xa8\x13\xd2\xd4\xdb\x26\x31\x1d\x7c\xcf\xfc\x00\x23\xe7\xc6\xec\x7d\x97\x00\x0a\x02\xf4\xf9\x68\x16\x79\xbe\xbf\x6c\x72\x72\x67\xe6\x9d\xff\xfd\x09\xf6\x2f\x77\xec\xf2\x1b\xba\xfc\x39\xba\xaf\x3f\x3e\x5c\x7e\x1f\xdd\xff\xff\xc2\x56\xa4\x1b\xde\x9e\xfb\x23\x06\xfb\x4b\xf4\x25\xea\xd9\x26\xd6\x4e\xbc\xfa\xee\x01\x6f\x5c\x75\x11\x53\xe0\xe2\x4d\x15\x19\x54\xe7\xd8\xe6\xf8\x1c\x37\x51\xf7\x98\x5b\xd9\x9f\x5d\x5a\xed\x88\x83\xee\xb6\x3f\x27\xe9\x37\xcd\xff\x7e\xa6\xea\x5b\x91\x5e\x6b\xe5\x92\x10\x07\xaa\x5a\xa9\x7f\x9c\xfd\x7e\xac\xf2\x8d\x9e\xa8\x80\x42\x78\x6e\x42\xf6\x5b\xa3\xf1\xd4\xcc\x31\xfa\xd2\xac\x9a\xa3\x9f\xa4\x81\x8e\xfb\x09\x79\x2b\x1c\xfe\x27\xa1\x7d\x02\x8e\x4f\x66\x9e\xeb\xd0\xfa\x3b\xa4\x01\x72\x09\x85\x23\x94\xd1\xee\x57\xfe\x0a\x53\x1f\x56\xcb\x29\xa2\xb8\xde\x83\x28\xf4\xff\x71\x1e\x30\x39\x90\xc7\xbf\xae\x93\x72\xc3\x37\x23\x23\xaa\x69\xad\xc2\x37\x36\xe3\x9d\x83\xa7\xf1\x60\x63\x36\x5f\xae\x84\xaf\x7e\xdf\x41\x8a\x92\x8f\x2d\x4c\xa6\x6f\x96\xa4\xa5\xb7\x9c\xab\x45\xe9\x93\xef\x21\xea\xaa\x55\xc9\xf1\x17\x0b\x4c\x68\xd7\x92\x74\x7a\x93\xa9\x16\x97\x6a\xef\xe8\xfe\x7f\x77\x3f\xb0\xdc\x10\xec\xfd\xf5\x42\x75\x5d\xcc\x5f\x86\x20\x99\x9a\x92\xb9\x80\x75\x9e\xc9\x38\x2b\xf3\xb2\x00\xb6\xdd\x0a\xbe\x65\x92\xc3\x4b\x2c\x9f\x80\x69\xc6\x6b\xaa\x81\x65\xfb\x56\xad\xc1\x02\x53\xd1\xaa\x7c\xe0\xb7\x92\x8b\x57\x1d\x52\x5f\x5d\x8a\x3c\xfd\xe8\xd9\xe7\x6f\x6a\x65\xd6\x89\xf0\x78\x4d\x1b\x58\xa7\x77\x27\x00\x80\x29\xf6\x30\xc5\x9d\x9b\xcd\x71\x8f\x3d\xb7\xd6\xe6\xe7\xa3\x35\xa7\x3b\x9b\xb6\x53\x4b\x67\x97\xcc\x6b\xa9\xbb\x76\xe1\xf3\x25\x3e\x41\x41\x05\x5b\x89\x78\x70\x68\x11\x7f\x80\x55\x31\xdf\x15\xc3\x1e\x5b\x7f\x0d\x00\x14\xf2\xae\x17\x30\x0d\x00\x00"),
 		},
 		"/preinstall": &vfsgen۰DirInfo{
 			name:    "preinstall",
@@ -55,49 +76,77 @@ var MigrationFiles = func() http.FileSystem {
 			modTime:          time.Time{},
 			uncompressedSize: 987,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x8c\x92\xcf\x4f\xe3\x3e\x10\xc5\xef\xfe\x2b\xde\x01\x09\x2a\xb5\xa8\xfa\x5e\x23\x0e\x26\x1d\x4a\xf4\x35\x49\x48\xdc\x5d\x38\x55\x21\x19\xb6\x59\x52\x67\xd7\x76\x28\xfd\xef\x57\x09\xe1\x47\x11\x2b\xd6\x37\x7b\xc6\xef\xf3\xe6\x69\x30\x9b\xfd\x62\xbb\x75\xb8\x6f\x2d\x5c\xb9\xe1\x6d\x81\x5d\xdd\x34\xb8\x63\x14\x55\x65\xd9\x39\xae\xd0\x14\x9e\x6d\x20\x10\x66\x24\x35\x21\x0f\x2f\xe9\x4a\x22\xba\x40\x9c\x68\xd0\x4d\x94\xeb\x7c\x7c\x5c\x87\x52\x4b\x95\x2c\x03\x21\x66\x33\x5f\xdc\x35\x0c\xdf\xc2\x15\x8f\x8c\xb2\xdd\x6e\x0b\x53\x39\xb8\x16\x7e\xc3\x7b\x94\x85\xe9\x31\xb6\x33\xd8\x6d\xd8\xf4\xbc\xda\xfc\x80\xe1\x1d\x4c\x5b\xb1\x7b\xe5\x69\x79\xae\xe8\x03\xe0\xd4\xf2\xb6\xf5\xbc\x7e\x51\x3d\x11\x00\xf0\xc0\x7b\x68\xba\xd1\x48\xb3\xe8\x4a\x66\xb7\xf8\x9f\x6e\xa7\x43\xc5\xf1\x6f\xe4\x94\x45\x52\x3d\xdf\xbd\x2d\x8c\x2b\x4a\x5f\xb7\xa6\x68\x70\x9e\x24\x8a\x64\xfc\x5c\x1a\x25\x07\x21\x31\x09\x84\x10\xa3\x8f\x24\x43\x46\xa9\x92\x21\x21\xcd\x92\x90\x16\xab\x8c\xc0\x4f\x5c\x76\x9e\xd7\xfc\xc8\x76\xbf\xdb\xb0\xe5\x93\x51\x60\xdd\xbb\xf1\xfc\xe4\xa7\x07\x92\xd3\xcf\xd9\x38\x83\xb7\x1d\x4f\x84\xcc\x71\x74\xdf\x99\xf2\x48\x9c\xd3\x32\x8a\x07\x4b\x74\x43\xe1\x4a\xd3\x8b\x4e\x20\x86\xd7\x28\xce\x29\xd3\x88\x62\x9d\x7c\x95\xce\x03\xef\x5f\x5d\x7c\x30\x30\xc1\x37\xa9\x56\x94\xbf\xb7\xfd\xd7\xde\x81\x9b\xc4\x08\x93\xf8\x42\x45\xa1\x46\xaf\x3c\xc1\x22\xc1\x2a\x5d\x0c\xbb\x41\xfa\x75\xda\x33\xf0\x53\xd9\x74\x15\x57\xa7\x9f\xcb\xbd\xef\x38\x28\x8c\x03\xbe\x05\xd0\x9f\x50\x2a\x85\xaa\x76\xde\xd6\x77\x9d\xe7\x6a\xdd\x47\xff\xe2\x7a\x12\x8c\x41\x85\x94\xea\x28\x79\xfb\xf5\xfd\x92\x62\x74\xa6\xe2\xfb\xda\x70\xb5\xee\x93\xed\x19\xd0\x97\xf4\xd6\xd4\x9f\xd9\x0c\x3b\x3e\xb6\x0c\xd3\x7a\xf4\x0d\xf5\x96\x5d\x59\x34\x8c\xff\xa6\xf8\xd9\x39\x0f\xcb\xbe\xb3\xe6\xe0\x53\x46\x7a\x95\xc5\xc1\x21\x2d\xbf\x56\xb9\xee\xc3\x38\x9e\xcb\xf9\x7c\x7e\xfc\x05\xcb\x6f\x18\x45\x59\xb2\x73\xc3\xde\xff\x1b\x8c\xe2\x45\x20\x28\x5e\x88\xe7\x5d\x81\x92\xf1\x72\x25\x97\x84\x54\xa5\xcb\xfc\x5a\x05\xe2\x4f\x00\x00\x00\xff\xff\x00\x58\x5c\x9a\xdb\x03\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x8c\x92\xcf\x4e\xdb\x4c\x14\xc5\xf7\xf3\x14\x67\x81\x04\x91\x12\x14\x7d\x5b\x8b\xc5\xe0\x5c\x82\xf5\x0d\xb6\xb1\x27\x2d\xac\x22\x63\x5f\x1a\x17\x67\xdc\xce\x8c\x09\x79\xfb\xca\xc1\x21\x04\x51\xd1\xd9\xf9\xfe\x39\xbf\xe3\xa3\x8b\xc9\xe4\x17\xdb\xb5\xc3\x63\x6b\xe1\xca\x15\xaf\x0b\x6c\xea\xa6\xc1\x03\xa3\xa8\x2a\xcb\xce\x71\x85\xa6\xf0\x6c\x03\x81\x30\x23\xa9\x09\x79\x78\x4d\x37\x12\xd1\x15\xe2\x44\x83\xee\xa2\x5c\xe7\x43\x71\x19\x4a\x2d\x55\x32\x0f\x84\x98\x4c\x7c\xf1\xd0\x30\x7c\x0b\x57\x3c\x33\xca\x76\xbd\x2e\x4c\xe5\xe0\x5a\xf8\x15\x6f\x51\x16\xa6\xc7\xd8\xce\x60\xb3\x62\xd3\xf3\x6a\xf3\x03\x86\x37\x30\x6d\xc5\xee\x8d\xa7\xe5\xa5\xa2\x0f\x80\x73\xcb\xeb\xd6\xf3\x72\xaf\x7a\x26\x00\xe0\x89\xb7\xd0\x74\xa7\x91\x66\xd1\x8d\xcc\xee\xf1\x3f\xdd\x8f\x77\x1d\xc7\xbf\x91\x53\x16\x49\xf5\xfa\xed\x6d\x61\x5c\x51\xfa\xba\x35\x45\x83\xcb\x24\x51\x24\xe3\xd7\xd6\x20\xb9\x13\x12\xa3\x40\x08\x31\xf8\x48\x32\x64\x94\x2a\x19\x12\xd2\x2c\x09\x69\xb6\xc8\x08\xfc\xc2\x65\xe7\x79\xc9\xcf\x6c\xb7\x9b\x15\x5b\x3e\x1b\x04\x96\xbd\x1b\xcf\x2f\x7e\x7c\x24\x39\xfe\x9c\x8d\x0b\x78\xdb\xf1\x48\xc8\x1c\x27\x8f\x9d\x29\x4f\xc4\x25\xcd\xa3\x58\x00\x00\xdd\x51\xb8\xd0\xb4\xd7\x09\xc4\xae\x1a\xc5\x39\x65\x1a\x51\xac\x93\xaf\xd2\x79\xe2\xed\x9b\x8b\x0f\x06\x46\xf8\x26\xd5\x82\xf2\xf7\xb6\xff\x3a\xbb\xe3\x26\x31\xc2\x24\xbe\x52\x51\xa8\xd1\x2b\x8f\x30\x4b\xb0\x48\x67\xbb\xdb\x20\xbd\xdf\xc5\x05\xf8\xa5\x6c\xba\x8a\xab\xf3\xcf\xe5\xde\x4f\x1c\x35\x86\x1f\x3c\x04\xd0\xbf\x50\x2a\x85\xaa\x76\xde\xd6\x0f\x9d\xe7\x6a\xd9\x47\xbf\x77\x3d\x0a\x86\xa0\x42\x4a\x75\x94\x1c\xb6\xbe\x5f\x53\x8c\xce\x54\xfc\x58\x1b\xae\x96\x7d\xb2\x3d\x03\xfa\x9a\x0e\x43\xfd\x9b\x4c\xb0\xe1\x53\xcb\x30\xad\x47\x3f\x50\xaf\xd9\x95\x45\xc3\xf8\x6f\x8c\x9f\x9d\xf3\xb0\xec\x3b\x6b\x8e\x96\x32\xd2\x8b\x2c\x0e\x8e\x69\xf9\xad\xca\x75\x1f\xc6\xe9\x54\x4e\xa7\xd3\xd3\x2f\x58\x7e\xc5\x28\xca\x92\x9d\xdb\xdd\xfd\xbf\xc1\x28\x9e\x05\x82\xe2\x99\x78\xbd\x15\x28\x19\xcf\x17\x72\x4e\x48\x55\x3a\xcf\x6f\x55\x20\xfe\x0c\x00\x00\x58\x5c\x9a\xdb\x03\x00\x00"),
 		},
 		"/preinstall/001-users.sql": &vfsgen۰CompressedFileInfo{
 			name:             "001-users.sql",
 			modTime:          time.Time{},
 			uncompressedSize: 670,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xbc\x92\xd1\x4a\xc3\x30\x14\x86\xef\xfb\x14\xff\x45\xa0\x0a\x7b\x83\x5e\xd5\xee\xb0\x15\x4a\x22\x31\xa2\x77\xa5\x76\x87\x19\xad\x6b\x49\x33\xb7\xbd\xbd\xc4\x4a\x69\xee\x04\x71\xe7\xea\x0f\x84\xef\xe4\x7c\x39\x45\x5e\x55\xe0\x33\xb7\x47\xcf\x35\x7f\xb2\xbb\x9c\x5e\xd9\xf1\x4d\xda\x3a\x6e\x3c\xd7\x83\xeb\x3f\x6a\xc7\xcd\x8e\x5d\xba\x82\x60\x16\x09\x00\xac\x15\xc4\x94\x42\xdd\xd1\xa6\x94\xf3\x29\x54\xa1\x29\x37\x04\xad\x2a\xc2\x02\x91\xcd\x97\xe8\xb9\xa0\x7b\x53\x2a\x89\xa7\x2d\x49\xec\x8e\x43\x67\xdb\xd0\xb0\x7f\x79\xe3\xd6\xc3\x6c\x29\x26\xea\xbc\x7c\x20\x48\x65\xca\x82\x90\xba\xbe\xe3\x25\x18\x4d\x17\xc2\x05\x7c\xb6\xa3\x1f\x57\x18\xdf\xed\x30\xd8\xc3\x1e\xd3\x1c\x69\x16\xc3\xc8\x3c\x6a\xb9\x78\x8d\x5c\x7f\x67\x21\xb2\x24\xcc\x78\x9b\x25\xc9\xaf\xcc\x9c\x9c\xf5\x7f\x34\x33\x21\xfe\xc1\xcc\x04\xbe\xa6\x99\xbd\x6b\x0e\x7e\xb9\x32\xb1\xa4\xd9\xd1\x46\xe7\xd2\x44\xdf\x67\x54\x2c\xe3\xa7\xd5\x57\x00\x00\x00\xff\xff\x77\x42\x8e\x86\x9e\x02\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xbc\x92\xd1\x4a\xc3\x30\x14\x86\xef\xfb\x14\xff\x45\xa0\x0a\x7b\x83\x5e\xd5\xee\xb0\x15\x4a\x22\x31\xa2\x77\xa5\x76\x87\x19\xad\x6b\x49\x33\xb7\xbd\xbd\xc4\x4a\x69\xee\x04\x71\xe7\xea\x0f\x84\xef\xe4\x7c\x39\x45\x5e\x55\xe0\x33\xb7\x47\xcf\x35\x7f\xb2\xbb\x9c\x5e\xd9\xf1\x4d\xda\x3a\x6e\x3c\xd7\x83\xeb\x3f\x6a\xc7\xcd\x8e\x5d\xba\x82\x60\x16\x09\x00\xac\x15\xc4\x94\x42\xdd\xd1\xa6\x94\xf3\x29\x54\xa1\x29\x37\x04\xad\x2a\xc2\x02\x91\xcd\x97\xe8\xb9\xa0\x7b\x53\x2a\x89\xa7\x2d\x49\xec\x8e\x43\x67\xdb\xd0\xb0\x7f\x79\xe3\xd6\xc3\x6c\x29\x26\xea\xbc\x7c\x20\x48\x65\xca\x82\x90\xba\xbe\xe3\x25\x18\x4d\x17\xc2\x05\x7c\xb6\xa3\x1f\x57\x18\xdf\xed\x30\xd8\xc3\x1e\xd3\x1c\x69\x16\xc3\xc8\x3c\x6a\xb9\x78\x8d\x5c\x7f\x67\x21\xb2\x24\xcc\x78\x9b\x25\xc9\xaf\xcc\x9c\x9c\xf5\x7f\x34\x33\x21\xfe\xc1\xcc\x04\xbe\xa6\x99\xbd\x6b\x0e\x7e\xb9\x32\xb1\xa4\xd9\xd1\x46\xe7\xd2\x44\xdf\x67\x54\x2c\xe3\xa7\xd5\xd7\x00\x77\x42\x8e\x86\x9e\x02\x00\x00"),
 		},
 		"/preinstall/002-schemas.sql": &vfsgen۰CompressedFileInfo{
 			name:             "002-schemas.sql",
 			modTime:          time.Time{},
 			uncompressedSize: 3390,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xb4\x57\xc1\x6e\xe2\x30\x10\xbd\xe7\x2b\xe6\x40\x15\xd0\x86\xfd\x80\x45\x3d\xb8\xc4\x65\x23\xa5\xa1\x4a\xdc\x55\x6f\xc8\x84\xa1\x44\x82\x18\xd9\x4e\x69\x57\xfd\xf8\x95\x4d\xa0\x69\xb7\x50\xd3\x50\x89\x0b\x8e\xe7\xbd\x99\x97\xf1\xf8\x65\x48\xe2\x18\xf0\x09\xf3\x4a\xe3\x04\x1f\x51\x3e\x6f\x16\x28\xb1\xeb\xe7\x12\xb9\xc6\x89\xca\x17\xb8\xe2\xca\x0f\xa0\x83\xd8\x81\x70\x0c\x9d\x0e\x5c\xd1\x51\x94\x78\x00\x00\xc3\x94\x12\x46\x21\x1b\xfe\xa6\x37\x04\xa2\x6b\x48\xc6\x0c\xe8\x7d\x94\xb1\xac\x5e\x9c\x0c\x09\x23\xf1\x78\x34\x80\x7e\x1f\x72\xae\xf9\x52\x3c\x80\xe6\xd3\x25\x2a\xf8\x01\x45\xa9\x51\x96\x7c\x09\xf3\xaa\xcc\x75\x21\x4a\x65\x61\x47\x29\x49\x18\xdc\x65\x64\x44\x61\x9c\xec\xe0\xdf\x02\x02\x1b\xc3\x5a\x8a\xd5\x44\x22\x9f\xa1\x1c\x34\x02\x33\x1a\xd3\x21\x33\x91\xa6\x3a\x46\xae\x62\x9a\x41\x74\x0a\x0e\x89\x19\x4d\x21\xa4\xd7\xe4\x2e\x66\x70\x9b\x46\x7f\xa2\x98\x8e\x8e\xa1\xbc\x67\xae\x59\x0f\x27\xe9\x58\xdd\x46\x16\xfa\xa3\xea\x02\x88\x92\x8c\xa6\x2c\x80\xbb\xdb\x90\x30\x1a\x40\x48\x63\xca\xe8\xa9\x55\x37\xf1\xdb\x55\x7d\x2c\xa3\x77\x6a\xec\x48\x5d\x7b\xe8\x36\x1d\xdf\xd8\x06\x5a\x57\xd3\x65\x91\x9f\xd2\x2d\x26\xf4\xbf\xb7\xe0\xca\x4b\xef\x99\xa5\x15\x6b\x5d\xac\x8a\xbf\x38\x83\x47\x94\xca\x10\x83\x98\xbf\x66\x01\xdb\xc3\x32\x83\xe9\x33\xe8\x05\x02\x3e\x69\x2c\xcd\xb6\xcf\xd3\xa3\xf7\xec\xcb\xd9\x65\x34\x8d\x68\x66\x13\x54\x28\x0b\x54\xf0\x58\xe0\xc6\x41\x93\x6d\x60\xeb\x03\x74\x04\xc6\xbd\x93\x6a\x10\xc7\xe3\xe3\x2a\xcd\x0d\x65\x69\x34\xb4\xd2\xac\x50\xcb\x22\x77\x95\x66\x1b\xd8\x5a\x9a\x23\x30\xee\xd2\xd4\x20\x67\x96\x26\x24\x8c\x38\xcc\x21\xb3\xad\xb5\x0c\x07\x41\xdc\x45\xb0\x10\xe7\x1a\xae\x6f\xf2\x39\xf7\x64\x3d\x08\xde\xa2\xd8\x6f\x9c\xa9\x86\x6b\x37\x42\xdc\x94\x3b\xd7\xdc\xf8\x0c\xeb\x34\xbd\x4e\x9c\x20\x5f\xaa\xf4\x3b\x5a\xe5\x18\x47\x7b\x05\xbe\xd2\x38\xae\x9d\x13\x25\xd7\x63\x07\x21\xcd\xb6\xd6\xbd\x72\x10\xc4\x5d\x22\x0b\xe1\xd8\x1d\x34\x09\x8d\xc1\x35\x5e\xb7\x37\xf0\xbc\x7e\xdf\xde\xe7\x66\x8b\xca\xf9\xb2\x71\xb3\x43\x2e\x4a\xcd\x0b\x63\x04\xde\x7b\x03\x63\x0d\x94\x58\xa1\x89\x16\x73\x10\x95\x6c\x38\x05\x5e\xce\x40\xac\x51\x72\x2d\xa4\xfa\x09\x4c\x00\x96\xaa\x92\x68\x79\x72\x21\x25\xe6\xba\x09\x64\x96\xb9\xb4\x58\x95\xc2\x59\xd0\xf4\x0d\xab\x4a\x69\x98\x22\x4c\x71\x2e\x24\x02\x5f\x2e\x77\x7c\x42\x2f\x50\x42\xed\xdd\xa1\x28\x2d\x8c\x42\x2e\xf3\x05\xac\xb9\x5e\x78\xd6\xc7\x7b\x21\x1d\xc6\x24\xa5\x46\xcf\x12\x37\x13\xf3\x04\x34\x3e\xe9\x81\xb7\x77\xf8\xfb\xf5\x5f\x97\x90\x57\x52\x62\xa9\x27\x0a\xb5\x2e\xca\x87\xae\xbf\x45\xb4\xcf\xfd\x1e\xbc\xbc\xc0\x5c\xc8\x15\xd7\x5d\x3f\xb8\x88\xf7\x3f\x3f\x00\xff\x35\xe9\xc6\x3f\xe3\xcc\x1a\x7f\xb7\xb7\x5d\x63\xa1\xf6\x98\x7e\xcf\xbe\xf0\xfa\x1b\x65\x4f\x51\xbf\x7f\xc2\xc8\x15\xc9\x28\x5c\x44\x90\x51\x06\x8d\x8c\xe0\x12\x2e\xcc\x77\xcb\x2e\xeb\x19\xd7\x7c\xca\x15\x76\x7b\xc1\xbe\xaa\x8f\xa1\x0f\x00\x35\x82\x68\x12\x7a\x9d\xce\xc0\xfb\x17\x00\x00\xff\xff\x1d\xe9\x64\x37\x3e\x0d\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xb4\x97\xcf\x6e\xe2\x3e\x10\xc7\xef\x79\x8a\x39\x50\x05\xf4\x0b\xbf\x07\x58\xd4\x83\x4b\x5c\x36\x52\x1a\xaa\xc4\x5d\xf5\x86\x4c\x18\x4a\x24\x88\x91\xed\x94\x76\xd5\x87\x5f\xd9\x04\x9a\xfe\x81\x9a\x86\x4a\x5c\x70\x3c\x9f\x99\xf9\x66\x3c\x9e\x0c\x49\x1c\x03\x3e\x61\x5e\x69\x9c\xe0\x23\xca\xe7\xcd\x02\x25\x76\xfd\x5c\x22\xd7\x38\x51\xf9\x02\x57\x5c\xf9\x01\x74\x10\x3b\x10\x8e\xa1\xd3\x81\x2b\x3a\x8a\x12\x0f\x00\x60\x98\x52\xc2\x28\x64\xc3\xdf\xf4\x86\x40\x74\x0d\xc9\x98\x01\xbd\x8f\x32\x96\xd5\x8b\x93\x21\x61\x24\x1e\x8f\x06\xd0\xef\x43\xce\x35\x5f\x8a\x07\xd0\x7c\xba\x44\x05\xff\x41\x51\x6a\x94\x25\x5f\xc2\xbc\x2a\x73\x5d\x88\x52\x59\xec\x28\x25\x09\x83\xbb\x8c\x8c\x28\x8c\x93\x1d\xfe\x2d\x10\xd8\x18\xd6\x52\xac\x26\x12\xf9\x0c\xe5\xa0\x61\x98\xd1\x98\x0e\x99\xb1\x34\xd9\x31\x72\x15\xd3\x0c\xa2\x53\x38\x24\x66\x34\x85\x90\x5e\x93\xbb\x98\xc1\x6d\x1a\xfd\x89\x62\x3a\x3a\x46\x79\xef\xb9\xf6\x7a\x38\x48\xc7\xec\x36\xb2\xd0\x9f\x65\x17\x40\x94\x64\x34\x65\x01\xdc\xdd\x86\x84\xd1\x00\x42\x1a\x53\x46\x4f\xcd\xba\xc9\x6f\x97\xf5\xb1\x88\xde\xa9\xb1\x73\xea\x5a\x43\xb7\xe9\xf8\xc6\x16\xd0\xba\x9a\x2e\x8b\xfc\x94\x6a\x31\xa6\x1f\xde\x82\xab\x5f\x7a\xcf\xac\x5b\xb1\xd6\xc5\xaa\xf8\x8b\x33\x78\x44\xa9\x8c\x63\x10\xf3\xd7\x28\x60\x7b\x58\x66\x30\x7d\x06\xbd\x40\xc0\x27\x8d\xa5\xd9\xf6\x75\x78\xf4\x9e\x7d\x3b\xba\x8c\xa6\x11\xcd\x6c\x80\x0a\x65\x81\x0a\x1e\x0b\xdc\x38\x68\xb2\x35\x6c\x7d\x80\x8e\x60\xdc\x2b\xa9\x86\x38\x1e\x1f\x57\x69\x6e\x28\x4b\xa3\xa1\x95\x66\x85\x5a\x16\xb9\xab\x34\x5b\xc3\xd6\xd2\x1c\xc1\xb8\x4b\x53\x43\xce\x2c\x4d\x48\x18\x71\xe8\x43\x66\x5b\x6b\x19\x0e\x42\xdc\x45\xb0\x88\x73\x35\xd7\x37\xf1\x9c\xbb\xb3\x1e\x84\xb7\x48\xf6\x07\x7b\xaa\xf1\xb5\x6b\x21\x6e\xca\x9d\xab\x6f\x7c\xc5\x3a\x4d\xaf\x13\x3b\xc8\xb7\x32\xfd\x89\x52\x39\xe6\xa3\xbd\x02\xdf\x29\x1c\xd7\xca\x89\x92\xeb\xb1\x83\x90\x66\x5b\xeb\x5a\x39\x08\x71\x97\xc8\x22\x1c\xab\x83\x26\xa1\x19\x70\xcd\xac\xdb\x1b\x78\x5e\xbf\x6f\xef\x73\xb3\x45\xe5\x7c\xd9\xb8\xd9\x21\x17\xa5\xe6\x45\xa9\x3e\xce\x06\x66\x34\x50\x62\x85\xc6\x5a\xcc\x41\x54\xb2\x31\x29\xf0\x72\x06\x62\x8d\x92\x6b\x21\xd5\xff\xc0\x04\x60\xa9\x2a\x89\xd6\x4f\x2e\xa4\xc4\x5c\x37\x41\x66\x99\x4b\xcb\xaa\x14\xce\x82\xe6\xdc\xb0\xaa\x94\x86\x29\xc2\x14\xe7\x42\x22\xf0\xe5\x72\xe7\x4f\xe8\x05\x4a\xa8\x67\x77\x28\x4a\x8b\x51\xc8\x65\xbe\x80\x35\xd7\x0b\xcf\xce\xf1\x5e\x48\x87\x31\x49\xa9\xd1\xb3\xc4\xcd\xc4\x3c\x01\x8d\x4f\x7a\xe0\xed\x27\xfc\xfd\xfa\xaf\x4b\xc8\x2b\x29\xb1\xd4\x13\x85\x5a\x17\xe5\x43\xd7\xdf\x12\xed\x73\xbf\x07\x2f\x2f\x30\x17\x72\xc5\x75\xd7\x0f\x2e\xe2\xfd\xcf\x0f\xc0\x7f\x0d\xba\xf1\xcf\x4c\x66\x8d\xbf\xdb\xdb\xae\xb1\x50\xcf\x98\x7e\xcf\xbe\xf0\xfa\x1b\x65\xef\xa2\x7e\xff\x84\x91\x2b\x92\x51\xb8\x88\x20\xa3\x0c\x1a\x11\xc1\x25\x5c\x98\xef\x96\x5d\xd4\x33\xae\xf9\x94\x2b\xec\xf6\x82\x7d\x56\x9f\xa3\x0f\x80\x1a\x46\x34\x09\xbd\x4e\x67\xe0\xfd\x1b\x00\x1d\xe9\x64\x37\x3e\x0d\x00\x00"),
 		},
 		"/preinstall/003-tables.sql": &vfsgen۰CompressedFileInfo{
 			name:             "003-tables.sql",
 			modTime:          time.Time{},
 			uncompressedSize: 3282,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xa4\x56\xdd\x6e\xdb\x38\x13\xbd\xf7\x53\xcc\x9d\xec\x42\x32\x9c\xab\xef\x6b\x83\x2e\xa0\x38\x6a\x2a\xd4\x96\x53\x5b\xee\xb6\xbb\x58\x08\xb4\x34\xb6\x88\x50\xa4\x96\xa4\xea\xfa\xed\x17\xa4\x64\xfd\x38\x71\xb2\xc0\x1a\xb9\x89\x78\x66\x38\x73\xe6\xcc\x0c\xbd\x97\x7f\x23\xcf\x83\x98\xec\x18\x42\x86\x7b\xca\xa9\xa6\x82\x2b\xb0\xdf\x5f\xc6\x1b\x03\x02\xaa\xc4\x94\x12\x06\xfa\x54\x22\x1c\x11\x2a\x85\x40\x39\x88\x4a\x82\x36\xde\x14\x28\x01\x45\xa5\x34\xec\x10\x52\x89\x44\x63\x06\x39\x4a\x1c\xcd\xd7\x81\x1f\x07\x70\xbf\x5a\xfa\x61\x04\x9b\xf9\xe7\x60\xe9\x27\x8f\xeb\xd5\x72\xca\xc8\x0e\x59\x42\xa4\x24\x27\xf0\x37\x40\xb9\xfe\xf3\x2f\x88\x56\x31\x44\xdb\xc5\xe2\x76\x74\xb6\x8c\xfd\xbb\x45\x00\x65\xb5\x63\x34\x9d\x96\x52\x14\x09\xe5\x4a\x13\xc6\x88\x89\x3d\xa1\x7c\x2f\x60\x3c\x02\x00\x78\xc2\x13\xc4\xc1\xf7\x18\x1e\xd7\xe1\xd2\x5f\xff\x80\x2f\xc1\x0f\xd7\x9e\xfc\x24\xac\x42\x7b\x36\x9a\xdc\x8e\x46\x61\xb4\x09\xd6\x31\x84\x51\xbc\x7a\xdd\xf1\xf8\x09\x4f\x6e\x6d\x3d\x81\x6f\xfe\x62\x1b\x6c\xac\xbf\xb1\x93\x12\x4d\x98\x38\x80\x4a\x73\x2c\x88\xe3\x42\xf3\x73\x9a\x0c\xe7\x7e\xec\x2f\x56\x0f\xce\xc4\x6d\x0c\xcc\x05\xa8\x73\xac\x14\xf8\x8f\x61\x67\xe7\xf4\x28\xe9\xd0\xf8\x4b\x23\x57\x54\xf0\x8b\x0b\xce\xe8\xe0\x7b\xdc\x81\x15\x4a\x6a\x2a\x70\x11\x4a\x0b\xde\x04\xeb\x30\xd8\x74\xf8\x02\xb5\xa4\xe9\x75\xfc\x32\x88\xd7\xe1\xbc\xc3\x67\x44\x93\xe7\xe8\x0e\x7f\xef\xc7\x7e\x87\x36\xbc\xc9\xc2\x72\x38\x30\x3a\xa3\xc3\xe8\xd3\xca\x31\x55\x18\x16\x78\xc8\xdb\xb4\xc9\xa9\x2e\x2c\xcd\x60\x47\x0f\x94\xeb\x56\x1e\xf5\x65\x75\x22\x09\xcd\xe0\xf9\x99\x55\x97\xba\x2a\xb8\x16\x0c\x9e\xd7\x40\x89\x44\x38\x30\xb1\x23\x8c\x9d\xa0\xe2\xf4\xef\x0a\x61\x87\x29\x31\x5a\x17\x7b\xc8\xc5\x11\x4a\x22\x75\xd3\x32\x06\x6d\x5b\x08\x33\x7b\x5f\x86\x0c\x35\x26\x58\x8a\x34\x6f\xa3\xdd\x2e\x16\x70\x1f\x7c\xf2\xb7\x8b\xfa\x36\xf0\x3c\xa8\x11\x64\xaf\x51\xc2\x31\xa7\x69\x0e\x3a\xa7\x0a\xa4\x38\x42\x4a\xb8\xe9\x9f\xda\x55\x36\x9a\xc0\xa3\xbf\x8e\xc3\x38\x5c\x45\x70\xf7\x03\x16\xe1\x26\x1e\xb7\x29\x4f\xba\x0e\x09\xa3\xfb\xe0\x3b\xd4\x8c\x25\x75\x32\x86\x93\x55\x74\x85\xd4\xed\x26\x8c\x1e\xe0\x21\x8c\x60\x5c\xa3\xaf\xf9\x3a\x07\x62\xf2\xbb\xe6\x6d\xdc\x4f\xdc\x05\x9a\x4d\x2c\xfc\xf7\xcf\xc1\x3a\x18\x92\x12\x6e\x7a\xdd\xdd\x5c\xb7\x09\xbe\x6e\x83\x68\x7e\xa5\xfc\x09\xcd\xde\xd2\x89\x4d\xa0\x93\x89\xb1\x23\x0c\xe6\x9f\x83\xf9\x17\x18\xd3\x0c\x7e\x83\x59\xa3\xcc\xf3\x74\xe8\x4f\x04\x8d\xbf\x74\xfd\x7f\x6f\x64\x18\xbb\x09\x84\xd1\x7c\xb1\xbd\x0f\xa0\x3f\x02\x6a\xe8\x36\x0a\xbf\x6e\x87\x07\x1d\xda\xe4\x3f\xb9\x7d\x3d\x66\x9a\xa9\x9a\x92\x3a\xec\xb4\x92\x12\xb9\x6e\x58\xba\x0b\x1f\xc2\x28\x7e\x26\x66\xa5\x93\xaa\xcc\x88\xc6\x44\xd3\x02\x21\x0e\x97\xc1\x26\xf6\x97\x8f\xf1\x1f\x17\x50\xcf\x83\xbd\x90\x29\x82\x36\xe3\x17\xb4\x00\xc1\xd9\xc9\xc8\x8a\x80\xa2\xfc\xc0\xd0\x48\xad\xe6\x4b\x25\x8d\xcc\xef\x56\xab\x45\xe0\x47\xad\xab\x56\xb4\x5a\x56\xd8\xb2\xd9\xc2\x3f\xda\xef\x17\x74\xb4\xc7\x35\x01\x9e\x67\x96\x84\x02\xc2\x81\xc8\x1d\xd5\x92\xc8\x13\x28\x4d\xa4\x06\x9b\x81\x12\x50\x4a\xaa\x34\xe5\x08\x84\x67\x50\xd0\x83\xb4\x5b\xe3\xfe\x4e\x41\x4e\x7e\xda\x04\x40\x91\x02\x6b\x8e\xd5\x60\x6a\x5f\x63\xb4\x19\xd2\x30\x9e\xb9\xe0\xdc\xbc\xff\xdf\xcc\x9b\xdd\x78\xb3\x1b\x98\xcd\x3e\xd8\x3f\xd8\xc6\x73\xc7\xad\xc3\xb7\x41\xc6\xa6\xf7\xec\x0a\x6b\xd6\x96\x02\x72\x6e\xfe\x82\x94\x25\xe5\x87\x91\xe7\xed\x50\x1f\x11\x79\x3d\x54\x8c\x92\x94\x8d\x59\xe7\x48\x25\xa4\x82\x55\x05\x07\x4e\x0a\x63\x9c\x4a\xa1\x54\x33\x99\xd4\xf4\x7c\x03\x55\x90\x09\x8e\xa6\x34\x50\x29\xb2\xa3\x8c\xea\x93\x99\x2a\x3d\x63\x17\xb0\x59\xb3\xec\x64\x80\x86\x42\x26\xf8\xa1\xbe\x4f\xe7\x44\xc3\x01\x35\xa4\x95\x06\xb1\xdf\x4f\xdf\x6e\x8b\xe4\x09\x4f\x6d\x67\x98\x25\xe0\x2f\xae\xb6\x42\x52\x07\x92\x98\x40\x20\xf2\x97\x81\xdb\x18\x5e\x39\xb8\xec\x97\xbe\x16\x4c\x67\xbc\xdd\x05\x6d\x88\x49\x29\x94\x1d\xaa\x4d\x1b\x37\x23\xce\x5e\x68\x1b\x14\x3c\x4f\xe2\x1e\x25\xf2\x14\xcf\xd4\x4e\xfb\x28\x23\xdb\xe6\x33\xcd\x2c\xc7\x25\x4a\xbb\x85\x78\x8a\x20\x91\x28\xc1\xd5\x30\x73\xf0\x3c\x63\xd5\x06\xf1\x8a\xe1\xd4\x5a\x96\x42\x99\x2d\x33\xd4\x7c\x2f\x08\xd7\xf8\xee\x0d\x82\x52\xa8\xb7\x39\x68\x36\xf1\x45\x91\x9e\xbf\x5f\x2e\x29\xb9\xe8\x79\xab\xdf\xfa\xb4\xe5\xa3\x3b\xb5\xba\x36\x2f\x9a\x54\x14\xa5\x1d\xe8\xd7\xfb\x7d\x4f\x98\x42\xb7\x59\x68\x7b\x52\x31\x9d\xa4\x79\xc5\x9f\x12\xca\x35\xca\x9f\x84\xbd\x3e\x2a\x6a\x4b\x89\x1a\xb9\xbd\xb1\x44\x49\x45\x66\x5a\x36\x58\x7f\xf3\x87\xbb\xd0\x96\xc0\x38\xd0\xc2\x3e\x27\x4d\xbb\x37\x77\x3e\xf3\x30\x0c\x48\x14\xa5\x44\x65\x5f\x47\xff\x22\x9a\x0c\x19\x39\xf5\x8d\x92\x8a\x6b\xca\x06\x23\x74\x10\xd7\xb5\x0a\xf7\x8a\xdb\x11\x3e\x14\x7e\xef\xfb\x9b\xb5\x3f\xe7\xfa\x1f\xde\xae\x2f\x7b\xb4\x8b\x69\xf8\x66\x1d\x3b\xc3\x2a\x3a\x2e\x8c\xdb\xa2\x38\xff\x87\x5c\x54\x52\x39\x93\x0f\x1f\x8c\xb8\x26\xee\x68\xec\x5c\x56\xc0\x58\xbc\x9f\xc1\xbb\xae\x96\xce\x0d\x64\xe4\x34\x30\x6a\xc8\xea\x71\x6d\xcc\xf0\x17\x55\x5a\x8d\x15\x32\x4c\x35\xbc\x83\xbd\x14\x05\x94\x87\xa4\x94\x22\x85\xa3\xdd\x52\xa5\x14\x56\xb8\x1f\xc1\x39\x1b\xd7\xba\x6b\xdd\x4f\x6e\x47\xff\x04\x00\x00\xff\xff\x1c\x51\xce\x94\xd2\x0c\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xa4\x56\xd1\x6e\xdb\x3a\x12\x7d\xf7\x57\x9c\x37\xd9\x17\x56\xe0\x3c\xed\xde\x06\x5d\x40\x71\xd4\x54\xa8\x2d\xa7\xb6\xdc\x6d\x77\xb1\x10\x68\x69\x6c\x13\x91\x49\x2d\x49\x35\xf5\xdf\x2f\x48\xc9\xb2\xe4\xd4\xc9\x02\xd7\xc8\x4b\xc4\x33\xc3\x99\x33\x67\x66\xe8\xff\xfe\x37\xf0\x7d\x24\x6c\x53\x10\x72\xda\x72\xc1\x0d\x97\x42\xc3\x7d\xff\x3d\xde\x1a\x30\xe8\x92\x32\xce\x0a\x98\x63\x49\x78\x21\x54\x9a\xc0\x05\x64\xa5\x60\xac\x37\x0d\x2d\x71\xa8\xb4\xc1\x86\x90\x29\x62\x86\x72\xec\x49\xd1\x60\xba\x0c\x83\x24\xc4\xc3\x62\x1e\x44\x31\x56\xd3\xcf\xe1\x3c\x48\x9f\x96\x8b\xf9\x4d\xc1\x36\x54\xa4\x4c\x29\x76\x44\xb0\x02\x17\xe6\xdf\xff\x41\xbc\x48\x10\xaf\x67\xb3\xbb\xc1\xc9\x32\x09\xee\x67\x21\xca\x6a\x53\xf0\xec\xa6\x54\xf2\x90\x72\xa1\x0d\x2b\x0a\x66\x63\x4f\xb9\xd8\x4a\x0c\x07\x00\xf0\x4c\x47\x24\xe1\xf7\x04\x4f\xcb\x68\x1e\x2c\x7f\xe0\x4b\xf8\x63\xec\x4e\x7e\xb2\xa2\x22\x77\x36\x18\xdd\x0d\x06\x51\xbc\x0a\x97\x09\xa2\x38\x59\xbc\xed\x78\xf8\x4c\xc7\x71\x6d\x3d\xc2\xb7\x60\xb6\x0e\x57\xce\xdf\xd0\xcb\x98\x61\x85\xdc\x41\x67\x7b\x3a\x30\x6f\x8c\xe6\xe7\x35\x19\x4e\x83\x24\x98\x2d\x1e\xbd\xd1\xb8\x31\xb0\x17\x90\xd9\x53\xa5\x11\x3c\x45\x67\x3b\xaf\x43\xc9\x19\x4d\xbf\x0c\x09\xcd\xa5\xb8\xb8\xe0\x84\x0e\xbf\x27\x67\xb0\x26\xc5\x49\x5f\x20\x3b\xe0\x55\xb8\x8c\xc2\xd5\x19\x7f\x20\xa3\x78\x76\x1d\x3f\x0f\x93\x65\x34\x3d\xe3\x73\x66\xd8\x6b\xf4\x19\xff\x10\x24\xc1\x19\x6d\x79\x53\x07\xc7\x61\xcf\xe8\x84\x8e\xe2\x4f\x0b\xcf\x56\xa1\x5f\xe0\x3e\x6f\x37\x4d\x4e\x75\x61\x79\x8e\x0d\xdf\x71\x61\x5a\x79\xd4\x97\xd5\x89\xa4\x3c\xc7\xeb\x33\xa7\x2e\x7d\x55\x70\x2d\x18\xbe\xdf\x40\x99\x22\xec\x0a\xb9\x61\x45\x71\x44\x25\xf8\x7f\x2b\xc2\x86\x32\x66\xb5\x2e\xb7\xd8\xcb\x17\x94\x4c\x99\xa6\x65\x98\x6a\x5a\x88\x72\x77\x5f\x4e\x05\x19\x4a\xa9\x94\xd9\xbe\x8d\x76\x3d\x9b\xe1\x21\xfc\x14\xac\x67\xf5\x6d\xf0\x7d\xd4\x08\xb6\x35\xa4\xf0\xb2\xe7\xd9\x1e\x66\xcf\x35\x94\x7c\x41\xc6\x84\xed\x9f\xda\x55\x3e\x18\xe1\x29\x58\x26\x51\x12\x2d\x62\xdc\xff\xc0\x2c\x5a\x25\xc3\x36\xe5\xd1\xb9\x43\xa2\xf8\x21\xfc\x8e\x9a\xb1\xb4\x4e\xc6\x72\xb2\x88\xaf\x90\xba\x5e\x45\xf1\x23\x1e\xa3\x18\xc3\x1a\x7d\xcd\xd7\x29\x10\x00\x57\xbd\x0d\xbb\x89\x8f\xc1\xf3\x91\x83\xff\xf3\x73\xb8\x0c\xfb\xa4\x44\xab\x4e\x77\x37\xd7\xad\xc2\xaf\xeb\x30\x9e\x5e\x29\x7f\xca\xf3\xf7\x74\xe2\x12\x38\xcb\xc4\xda\xb1\x02\xd3\xcf\xe1\xf4\x0b\x86\x3c\xc7\x3f\x30\x19\x8d\x7b\xd3\xa1\x3b\x11\x0c\xfd\x32\xf5\xff\x9d\x91\x61\xed\x46\x88\xe2\xe9\x6c\xfd\x10\xa2\x3b\x02\x6a\xe8\x3a\x8e\xbe\xae\xfb\x07\x67\xb4\xcd\x7f\x74\xf7\x76\xcc\x3c\xd7\x35\x25\x75\xd8\x59\xa5\x14\x09\x53\x7f\xc2\x7d\xf4\x18\xc5\xc9\x2b\x31\x6b\x93\x56\x65\xce\x0c\xa5\x86\x1f\x08\x49\x34\x0f\x57\x49\x30\x7f\x4a\xfe\x75\x01\xf5\x7d\x6c\xa5\xca\x08\xc6\x8e\x5f\x18\x09\x29\x8a\xa3\x95\x15\x83\xe6\x62\x57\x90\x95\x5a\xcd\x97\x4e\x1b\x99\xdf\x2f\x16\xb3\x30\x88\x5b\x57\xad\x68\x8d\xaa\xa8\x65\xb3\x85\x7f\x74\xdf\x2f\xe8\x68\x8f\x6b\x02\x7c\xdf\x2e\x09\x0d\x26\xc0\xd4\x86\x1b\xc5\xd4\x11\xda\x30\x65\xe0\x32\xd0\x12\xa5\xe2\xda\x70\x41\x60\x22\xc7\x81\xef\x94\xdb\x1a\x0f\xf7\x1a\x7b\xf6\xd3\x25\x00\xcd\x0e\x54\x73\xac\x7b\x53\xfb\x1a\xa3\xcd\x90\xc6\x70\x32\x86\x77\xfb\xe7\xdf\x26\xfe\xe4\xd6\x9f\xdc\x62\x32\xf9\xe0\xfe\xb0\x4e\xa6\xde\xb8\x0e\xdf\x05\x99\xd8\xde\x73\x2b\xac\x59\x5b\x1a\xec\xd4\xfc\x07\x56\x96\x5c\xec\x06\xbe\xbf\x21\xf3\x42\x24\xea\xa1\x62\x95\xa4\x5d\xcc\x66\x4f\x5c\x21\x93\x45\x75\x10\x10\xec\x60\x8d\x33\x25\xb5\x6e\x26\x93\xbe\x39\xdd\xc0\x35\x72\x29\xc8\x96\x06\x95\x66\x1b\x5e\x70\x73\xb4\x53\xa5\x63\x3c\x06\x35\x6b\xb6\x38\x5a\xa0\xa5\xb0\x90\x62\x57\xdf\x67\xf6\xcc\x60\x47\x06\x59\x65\x20\xb7\xdb\x9b\xf7\xdb\x22\x7d\xa6\x63\xdb\x19\x76\x09\x04\xb3\xab\xad\x90\xd6\x81\xa4\x36\x10\xc4\xc1\x3c\x1c\x37\x86\x57\x0e\x2e\xfb\xa5\xab\x05\xdb\x19\xef\x77\x41\x1b\x62\x5a\x4a\xed\x86\x6a\xd3\xc6\xcd\x88\x73\x17\xba\x06\x85\xef\x2b\xda\x92\x22\x91\xd1\x89\xda\x9b\x2e\xca\xca\xb6\xf9\xcc\x73\xc7\x71\x49\xca\x6d\x21\x91\x11\x14\x31\x2d\x85\xee\x67\x0e\xdf\xb7\x56\x6d\x10\x6f\x18\xde\x38\xcb\x52\x6a\xbb\x65\xfa\x9a\xef\x04\x31\xb6\xbe\x3b\x83\xa0\x94\xfa\x7d\x0e\x6a\x7b\x5c\x14\xe9\xf5\xfb\xe5\x92\x92\x8b\x9e\x77\xfa\xad\x4f\x5b\x3e\xce\xa7\x4e\xd7\xf6\x45\x93\xc9\x43\xe9\x06\xfa\xf5\x7e\xdf\xb2\x42\xd3\xb8\x59\x68\x5b\x56\x15\x26\xcd\xf6\x95\x78\x4e\xb9\x30\xa4\x7e\xb2\xe2\xed\x51\x51\x5b\x2a\x32\x24\xdc\x8d\x25\x29\x2e\x73\xdb\xb2\xe1\xf2\x5b\xd0\xdf\x85\xae\x04\xd6\x81\x91\xee\x39\x69\xdb\xbd\xb9\xf3\x95\x87\x7e\x40\xf2\x50\x2a\xd2\xee\x75\xf4\x7f\x44\x93\x53\xc1\x8e\x5d\xa3\xb4\x12\x86\x17\xbd\x11\xda\x8b\xeb\x5a\x85\x3b\xc5\x3d\x13\xde\x17\x7e\xe7\xfb\xbb\xb5\x3f\xe5\xfa\x17\xde\xae\xbf\xf7\xe8\x16\x53\xff\xcd\x3a\xf4\xfa\x55\xf4\xc6\x18\xb6\x45\xf1\xfe\x8e\xbd\xac\x94\xf6\x46\x1f\x3e\x58\x71\x8d\xc6\x83\xa1\x77\x59\x01\x6b\xf1\xe7\x04\x7f\x9c\x6b\xe9\xdd\x22\x67\xc7\x9e\x51\x43\x56\x87\x6b\x6b\x46\xbf\xb8\x36\x7a\xa8\xa9\xa0\xcc\xe0\x0f\x6c\x95\x3c\xa0\xdc\xa5\xa5\x92\x19\x5e\xdc\x96\x2a\x95\x74\xc2\xfd\x08\xef\x64\x5c\xeb\xae\x75\x3f\xba\x1b\xfc\x6f\x00\x1c\x51\xce\x94\xd2\x0c\x00\x00"),
 		},
 		"/preinstall/004-matcher_operators.sql": &vfsgen۰CompressedFileInfo{
 			name:             "004-matcher_operators.sql",
 			modTime:          time.Time{},
 			uncompressedSize: 4975,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xec\x56\x5f\x4f\xdb\xc8\x17\x7d\xf7\xa7\x38\x95\xaa\x62\x57\x38\x82\x57\x90\x29\x6e\x6a\x20\x3f\x39\x31\x3f\xdb\x51\x77\x85\x90\x35\x38\x97\xc4\xd4\xcc\x64\xc7\x13\x28\x2f\x7c\xf6\x95\xc7\x7f\x36\x14\x13\x12\xb6\x2c\x5b\x69\xfd\x82\x33\xcc\xbd\xbe\xf7\x9c\x33\xf7\x8c\xfd\xec\x63\xd8\x36\x7c\x76\x41\x39\x0a\xca\x29\x55\x42\x16\x60\x7c\x82\x6b\xa6\xd2\x19\xc9\x02\x7a\xcb\x3a\x59\xe2\x59\x56\xa0\xa0\x54\x65\x82\x23\x15\x5c\xb1\x8c\x17\x60\xb8\xa4\x5b\x5c\x2e\xb8\x5e\x2f\xa0\x66\x4c\xe1\x96\xb6\x24\x61\x2a\x32\x3e\x85\x12\x90\x34\xcf\x59\x4a\xc8\x38\xd4\x8c\x90\x4d\xe8\x7a\x2e\x14\x71\xd5\xa4\xeb\x55\xf9\xe9\x0e\x4c\x12\x52\x49\x4c\xd1\x04\x33\x92\x84\xab\x45\xa1\xca\x1c\x2c\xcf\xc5\x2d\x16\x45\xf9\x5e\x6d\xd0\xb9\x38\xa5\x54\x14\x4c\xde\x41\xcc\x49\xb2\xb2\xbd\x9e\x61\xf4\x43\xcf\x8d\x3d\x7c\x09\x86\xee\x60\x84\xa8\x7f\xe2\x0d\xdd\xe4\x34\x0c\x86\xbd\xba\xed\x64\x2e\x8a\x4c\x65\x37\x04\x37\x42\xc6\xd5\xd9\x39\x46\x41\x8c\xd1\xd8\xf7\xf7\xd7\x88\xe6\x34\x65\x9b\x47\xe7\x25\x0d\xc9\x37\xba\x2b\xc3\x62\xef\xb7\x78\xad\xa8\x39\x53\x8a\x24\xef\x88\x31\x6c\xfb\x56\xb2\xf9\x9c\x24\x98\x14\x0b\x3e\xc1\x55\x21\xf8\x45\x42\x2c\x9d\x25\x8a\xbe\x6b\xdc\xa6\x65\x99\x0c\x17\x54\x66\x81\x14\xb7\x09\x15\x2a\xbb\x66\x8a\x0c\xdb\xbe\x14\x12\xba\xaa\x02\xe6\xee\x0e\xb8\x50\xd8\xdd\xd9\xb1\x9a\x6a\x82\x10\xa1\x77\xea\xbb\x7d\x0f\x47\xe3\x51\x3f\x1e\x04\x6d\x69\x7d\x37\x76\xfd\xe0\xb8\xee\xe9\x87\xef\x9a\x57\x45\x55\xca\x36\x82\x71\x8c\xb2\xe3\x72\xb9\xfa\x75\xc3\xf2\x05\xe9\xdf\x96\x81\xd0\x8b\xc7\xe1\x28\x42\xe4\xc5\xc1\x11\x24\xa5\x42\x4e\x0c\xf8\xee\xe8\x78\xec\x1e\x7b\x88\xfe\xef\x1b\x18\x0c\x87\xe3\xd8\xfd\xec\x7b\x38\x75\x43\xd7\xf7\x3d\x1f\x91\x7b\xe4\x21\x8a\xc3\x41\x3f\x46\x18\x7c\x8d\xb0\xbb\x63\xb8\x11\xde\x37\x32\x7c\x8f\xc8\xf3\xbd\x7e\x0c\xf3\x71\x65\x96\xd5\xfb\xb8\xb4\x73\xdf\xd8\xa0\xdb\x54\x2c\xb8\xaa\xbb\xfd\x46\x77\x85\x79\x55\xf5\x69\x19\x4d\x23\x83\x51\xdc\x56\xf2\xde\x00\xd0\x54\xa2\x43\xcd\x8f\xd6\xde\x5e\xc6\x15\x2e\xa5\xb8\x86\x59\xff\xab\xca\x27\x2e\xae\x28\x55\x75\x5a\xcb\xc2\xcd\xbe\x51\x67\x59\x86\xe3\x29\x34\xd6\x6a\x63\x59\xc3\x66\xcd\xfb\x0f\xf5\xaf\x3a\x2b\xdd\x8d\xb9\x61\xe8\xfe\x6e\xea\x95\xfa\x69\x3b\x66\x39\x15\x29\x99\x79\x2f\x9b\x6c\xc3\xde\xb5\x60\xdb\xb0\x77\x91\xf1\x49\x96\x32\x45\x05\xb8\x40\xb1\x48\x67\x95\x06\x97\x53\x1c\x85\xc1\x10\xdd\xda\xaa\xea\xb6\xb7\x92\x84\xb3\x6b\x4a\x92\x2d\x0b\xb4\x1c\xea\x7b\x47\x31\xfe\x17\x0c\xba\xa5\x8a\x07\x9f\x29\x9f\x60\x04\x33\xef\x95\x1a\x75\x40\xfa\xaf\x3b\xfa\x82\xbc\x57\xe9\xb4\x5c\xd3\x6f\x56\x1b\x67\xed\xed\xad\x44\xa9\x8b\xb5\xa8\x9b\xb2\xce\x59\x0b\x31\xc7\xe1\x01\x9e\x1c\xc5\x1b\x9f\xce\x66\x56\x37\x94\x3f\x9e\x49\x4c\x4a\x76\xb7\xad\xb5\x90\x74\xeb\xe2\x73\x10\xf8\x9e\x3b\xea\x96\x40\x1d\x72\x78\xd0\x29\xb4\xa5\xac\xd6\x26\xe0\x34\x6d\x9e\x7a\xa1\x1b\x07\xe1\x83\xdc\x87\x07\xa8\x24\x57\xb2\xed\x86\xc7\x70\x9e\xec\x4a\x6f\x0b\x07\xc7\x27\xf5\xbe\x6a\x30\xe9\xd5\x16\x36\x67\x35\x70\x86\xb5\x8a\xab\x4f\x3f\x91\x2a\x8d\xd9\xb3\x3c\xb5\xde\xbd\x4a\x87\x9b\x71\xf7\xe1\x43\x9b\xf5\x85\x63\xa7\x8b\xa6\x4f\x2f\x66\x69\x55\x67\xeb\x91\xa7\xa3\x34\x73\x6f\x40\x42\x73\x41\x58\x93\x84\xd2\xd3\xcd\xc7\x4c\x58\xff\x5a\x2a\x9a\xfe\x36\xa5\xe2\xc9\x43\xe4\x38\x78\xe7\x38\x70\x9c\x7b\xbc\x73\xee\x7f\xe2\x89\xba\xcc\xf8\xa4\x34\xd5\x84\xfe\x58\xb0\xdc\x2c\xdf\x94\xa8\x2a\xea\xbe\x9c\x6d\x63\xce\x54\xd7\x0d\xec\xef\x98\x64\x3f\x70\x7d\x2f\xea\x7b\xa6\x86\x38\x61\xd3\xa9\x76\x45\x6b\x1b\x7a\xe1\xec\x5c\x5f\x0b\xce\xce\x9f\xf3\x96\xd6\x1b\x57\x78\xdb\xd7\x13\x2f\xf4\xd0\x58\xda\x83\x86\xcb\x8b\xff\x5f\xce\x36\x67\xea\x25\xf3\x78\x63\xe4\xb9\x50\xaf\x8d\x7e\xa3\xc7\x57\x41\xbf\x4d\xfe\x4b\xa2\x2f\x69\x4a\xdf\xff\xd3\x7d\x8b\xfc\xfd\x3f\xaa\xfb\x57\x46\xff\x97\xd3\xfd\x8b\xd1\xef\xf2\x34\xc7\x59\xc3\xd4\x4a\x70\x57\x59\x5a\x8d\xf3\x7a\x4e\xf6\xd0\x4d\x1e\xdc\x2e\xba\x0a\x7c\xf7\x86\x15\xb6\x53\xf7\xd9\x2a\x4b\xd3\x7d\xab\x2a\xf5\xf9\x58\x03\xc7\xfb\x37\xc5\xb1\xa9\xf2\xcf\x00\x00\x00\xff\xff\x05\xa6\xf0\xd1\x6f\x13\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xec\x56\x5d\x4f\xdb\x48\x14\x7d\xf7\xaf\x38\x95\xaa\xd6\xae\x70\x04\xaf\x20\x53\xdc\x74\x80\xac\x9c\x98\xb5\x1d\x75\x57\x08\x59\x83\x73\x49\x4c\xcd\x4c\x76\x3c\x81\xf2\xc2\x6f\x5f\xf9\x73\x43\x31\x21\x61\x4b\xd9\x4a\xeb\x17\x9c\x61\xee\xf5\xb9\xe7\xdc\xb9\x67\xec\x27\x1f\xc3\xb6\xe1\xf1\x73\xca\x90\x53\x46\x89\x96\x2a\x07\x17\x13\x5c\x71\x9d\xcc\x48\xe5\x28\xb7\xac\x93\x25\x9a\xa5\x39\x72\x4a\x74\x2a\x05\x12\x29\x34\x4f\x45\x0e\x8e\x0b\xba\xc1\xc5\x42\x94\xeb\x39\xf4\x8c\x6b\xdc\xd0\x7b\x45\x98\xca\x54\x4c\xa1\x25\x14\xcd\x33\x9e\x10\x52\x01\x3d\x23\xa4\x13\xba\x9a\x4b\x4d\x42\x37\xe9\x7a\x55\x7e\xba\x05\x57\x84\x44\x11\xd7\x34\xc1\x8c\x14\xe1\x72\x91\xeb\x22\x07\xcf\x32\x79\x83\x45\x5e\xbc\x57\x1b\xca\x5c\x82\x12\xca\x73\xae\x6e\x21\xe7\xa4\x78\x51\x5e\xcf\x30\xfa\x01\x73\x23\x86\xcf\xfe\xd0\x1d\x8c\x10\xf6\x8f\xd9\xd0\x8d\x4f\x02\x7f\xd8\xab\xcb\x8e\xe7\x32\x4f\x75\x7a\x4d\x70\x43\xa4\x42\x9f\x9e\x61\xe4\x47\x18\x8d\x3d\x6f\x6f\x8d\x68\x41\x53\xbe\x79\x74\x56\xc8\x10\x7f\xa5\xdb\x22\x2c\x62\x7f\x44\x6b\x45\xcd\xb9\xd6\xa4\x44\x47\x8c\x61\xdb\x37\x8a\xcf\xe7\xa4\xc0\x95\x5c\x88\x09\x2e\x73\x29\xce\x63\xe2\xc9\x2c\xd6\xf4\xad\xe4\x6d\x5a\xc0\xe4\x38\xa7\x22\x0b\x94\xbc\x89\x29\xd7\xe9\x15\xd7\x64\xd8\xf6\x85\x54\x28\x51\xe5\x30\x77\xb6\x21\xa4\xc6\xce\xf6\xb6\xd5\xa0\xf1\x03\x04\xec\xc4\x73\xfb\x0c\x87\xe3\x51\x3f\x1a\xf8\x2d\xb4\xbe\x1b\xb9\x9e\x7f\x54\xd7\xf4\xdd\x77\xcd\xcb\xbc\x82\xb2\x05\x7f\x1c\xa1\xa8\xb8\x58\xae\x7e\x5d\xf3\x6c\x41\xe5\x6f\xcb\x40\xc0\xa2\x71\x30\x0a\x11\xb2\xc8\x3f\x84\xa2\x44\xaa\x89\x01\xcf\x1d\x1d\x8d\xdd\x23\x86\xf0\x77\xcf\xc0\x60\x38\x1c\x47\xee\x27\x8f\xe1\xc4\x0d\x5c\xcf\x63\x1e\x42\xf7\x90\x21\x8c\x82\x41\x3f\x42\xe0\x7f\x09\xb1\xb3\x6d\xb8\x21\xde\x36\x6d\xf8\x16\x21\xf3\x58\x3f\x82\xf9\x10\x99\x65\xf5\x3e\x2c\xed\xdc\x33\x36\xa8\x36\x91\x0b\xa1\xeb\x6a\xbf\xd2\x6d\x6e\x5e\x56\x75\x5a\x46\x53\xc8\x60\x14\xb5\x48\xde\x1a\x00\x1a\x24\x65\xa8\xf9\xc1\xda\xdd\x4d\x85\xc6\x85\x92\x57\x30\xeb\x7f\x55\xf9\xe4\xf9\x25\x25\xba\x4e\x6b\x59\xb8\xde\x33\xea\x2c\xcb\x74\x3c\xc6\xc6\x5a\x65\x2c\xf7\xb0\x59\xeb\xfe\x1d\xfe\x55\x67\xa5\xbb\x30\x37\x08\xdc\x3f\xcd\x72\xa5\x7e\xda\x8a\x79\x46\x79\x42\x66\xd6\x4b\x27\x5b\xb0\x77\x2c\xd8\x36\xec\x1d\xa4\x62\x92\x26\x5c\x53\x0e\x21\x91\x2f\x92\x59\xd5\x83\xcb\x29\x0e\x03\x7f\x88\xee\xde\xaa\x70\xdb\xef\xe3\x58\xf0\x2b\x8a\xe3\xf7\x16\x68\x39\xd4\x63\x87\x11\x7e\xf3\x07\xdd\xad\x8a\x7b\x9f\x29\x1e\x7f\x04\x33\xeb\x15\x3d\xea\x80\xca\xbf\xee\xe8\x33\xb2\x5e\xd5\xa7\xc5\x5a\xf9\x66\xb5\x71\xd6\xee\xee\x4a\x96\xba\x54\x0b\xbb\x25\xeb\x9c\xb5\x90\x73\x1c\xec\xe3\xd1\x51\xbc\xf1\xe9\x6c\x66\x75\x23\xf9\xc3\x99\xc4\x95\xe2\xb7\x5b\x65\x2f\xc4\xdd\x7d\xf1\xc9\xf7\x3d\xe6\x8e\xba\x5b\xa0\x0e\x39\xd8\xef\x6c\xb4\xa5\xac\xd6\x26\xe4\x34\x65\x9e\xb0\xc0\x8d\xfc\xe0\x5e\xee\x83\x7d\x98\x46\xa3\xb6\x1b\x1c\xc1\x79\xb4\xaa\x72\x5b\x30\x38\x3a\xae\xf7\x55\x83\xa9\x5c\x6d\x69\x73\x56\x13\x67\x58\xab\xb4\xfa\xf8\x03\xa5\x2a\x39\x7b\x52\xa7\xd6\xbb\x57\xf5\xe1\x66\xda\xbd\x7b\xd7\x66\x7d\xe6\xd8\xe9\x92\xe9\xe3\xb3\x55\x5a\x55\xd9\x7a\xe2\x95\x51\x86\xb5\x84\xf0\x27\x8a\xd0\x5c\x10\xd6\x14\xa1\xf0\x74\xf3\xa1\x12\xd6\x7f\x56\x8a\xa6\xbe\x4d\xa5\x78\xf4\x10\x39\x0e\xde\x38\x0e\x1c\xe7\x0e\x6f\x9c\xbb\x1f\x78\xa2\x2e\x52\x31\x29\x4c\x35\xa6\xbf\x16\x3c\x33\x8b\x37\x2d\x2b\x44\xdd\x97\xb3\x2d\xcc\xb9\xee\xba\x81\xfd\x1b\x93\xec\xfb\xae\xc7\xc2\x3e\x33\x4b\x8a\x63\x3e\x9d\x96\xae\x68\x6d\xa1\x5c\x38\x3d\x2b\xaf\x05\xa7\x67\x4f\x79\x4b\xeb\x8d\x2b\xbc\xed\xcb\x31\x0b\x18\x1a\x4b\xbb\x57\x70\x71\xf1\xff\xc7\xd9\xe6\x5c\x3f\x67\x1e\x6f\xcc\xbc\x90\xfa\xa5\xd9\x6f\xfa\xf1\x45\xd8\x6f\x93\xff\x92\xec\x2b\x9a\xd2\xb7\xff\xfb\xbe\x65\xfe\xee\xa7\xf6\xfd\x0b\xb3\xff\xcb\xf5\xfd\xb3\xd9\xef\xf2\x34\xc7\x59\xc3\xd4\x0a\x72\x57\x59\x5a\xcd\xf3\x7a\x4e\x76\xdf\x4d\x0c\xeb\x09\x80\x6f\x5e\x11\x61\x3b\x75\x9f\x44\x59\x98\xee\x6b\xa1\x2c\xcf\xc7\x1a\x3c\xde\xbd\x2a\x8f\x0d\xca\xbf\x07\x00\x05\xa6\xf0\xd1\x6f\x13\x00\x00"),
 		},
 		"/preinstall/005-install_uda.sql": &vfsgen۰CompressedFileInfo{
 			name:             "005-install_uda.sql",
 			modTime:          time.Time{},
 			uncompressedSize: 833,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xac\xd2\x5f\x6b\xa3\x40\x14\x05\xf0\xf7\xf9\x14\xe7\x21\x60\x03\x69\x68\xbb\x6f\x0d\x5d\x98\x9a\x31\x15\x26\x6a\x47\xc3\x3e\xca\xa8\x37\x76\xc4\x8c\xa2\x63\x37\xfd\xf6\x4b\xd2\x6e\xd8\x3f\x2c\xec\xc2\x3e\xeb\xbd\xe7\xdc\x1f\xe3\x2b\xc1\x33\x81\x58\x41\x89\x44\x72\x5f\x20\xd8\x45\x7e\x16\xc6\x11\x52\xff\x49\x6c\x79\xee\xf3\x8c\xcb\x78\xb3\xac\xc9\xe5\xce\x1c\x68\x2c\x75\x4b\xf9\x41\x37\xdd\x90\xbf\xd2\x30\x9a\xce\x5e\xcd\x19\x00\x28\x91\xed\x54\x94\x22\x8c\x32\xc6\x53\xcc\xf6\x93\x2d\x67\xe7\x2f\xa9\x90\xc2\xcf\x30\xf6\xad\x71\x79\xaf\x07\x77\x45\x47\xf7\x31\xbc\x80\xb7\xf4\x16\xb8\x9d\xdf\xdf\x87\x51\x86\x40\xc5\x5b\xf4\x75\x5e\x6a\xa7\xdb\xae\x5e\xf6\x75\x4e\x47\x47\xf6\xf4\x2b\xbe\x3c\x09\x25\x40\x47\x67\xf5\x81\x1e\xbc\x4b\x9d\xaa\xf0\x20\xc3\x6d\x98\xe1\x76\xc5\x3e\x72\x25\x8f\x36\x3b\xbe\x11\x48\x9f\x25\xd2\x8c\x3f\x4a\x81\x84\x2b\x2e\xa5\x90\x48\x79\x20\x56\x8c\x5d\x5f\x37\xd3\xe8\xa0\x31\xba\xa9\xc0\x57\xd3\xb6\x28\x08\x03\xf5\xad\x2e\xa9\x82\xb1\x70\x2f\x04\x53\xd1\xa1\xef\x1c\x59\x87\xb1\x1c\x4c\xef\x46\xf6\x3b\x5b\xa2\x62\x5f\xac\x77\x4a\xfc\xea\x46\x47\x2a\x27\x77\x12\x33\xd6\x91\xd5\xb6\xa4\xbc\xe9\x8a\xab\xa6\x2b\x72\x73\xca\x70\x0b\x94\x9d\xdd\x9b\x1a\xcd\xd8\xd9\x62\x7e\xb6\x9b\xb1\x47\xb1\x09\xa3\x77\x57\x1e\xa6\x02\x5e\xa9\xdb\xd6\xd8\x1a\x7f\x58\x78\xbe\x61\x81\xf1\xa5\x9b\xda\x0a\x2f\xfa\x95\x50\x10\xd9\xcb\x35\xde\x8a\x89\x68\xcd\x66\x33\x5c\x64\x12\x99\x6c\xd2\x67\xb9\x62\x27\x09\x5d\x55\xb8\x43\xd3\x15\xe3\x47\xc2\x39\xeb\x95\x86\x37\x7c\xba\xc1\xc1\x58\x14\x6f\xa8\x68\xaf\xa7\xd6\x61\xdf\x0d\xf8\x41\x1f\x77\xcb\x1b\xb6\x8e\x7f\xae\x1d\x06\xff\xf4\x84\xf0\xf9\x01\x77\xc8\x9e\xc4\xfb\x34\x80\x44\xa8\x20\x56\x5b\xe8\xaa\x3a\x8b\x79\x7f\x27\xeb\x2d\xe0\xbd\x37\xf6\xe6\xab\xff\xbe\x4b\x44\x6b\x84\xc1\x77\xcb\x15\xfb\x16\x00\x00\xff\xff\x8d\x56\xe6\xa0\x41\x03\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xac\xd2\x5f\x6b\xa3\x40\x14\x05\xf0\xf7\xf9\x14\xe7\x21\x60\x03\x69\x68\xbb\x6f\x0d\x5d\x98\x9a\x31\x15\x26\x6a\x47\xc3\x3e\xca\xa8\x37\x76\xc4\x8c\xa2\x63\x37\xfd\xf6\x4b\xd2\x6e\xd8\x3f\x2c\xec\xc2\x3e\xeb\xbd\xe7\xdc\x1f\xe3\x2b\xc1\x33\x81\x58\x41\x89\x44\x72\x5f\x20\xd8\x45\x7e\x16\xc6\x11\x52\xff\x49\x6c\x79\xee\xf3\x8c\xcb\x78\xb3\xac\xc9\xe5\xce\x1c\x68\x2c\x75\x4b\xf9\x41\x37\xdd\x90\xbf\xd2\x30\x9a\xce\x5e\xcd\x19\x00\x28\x91\xed\x54\x94\x22\x8c\x32\xc6\x53\xcc\xf6\x93\x2d\x67\xe7\x2f\xa9\x90\xc2\xcf\x30\xf6\xad\x71\x79\xaf\x07\x77\x45\x47\xf7\x31\xbc\x80\xb7\xf4\x16\xb8\x9d\xdf\xdf\x87\x51\x86\x40\xc5\x5b\xf4\x75\x5e\x6a\xa7\xdb\xae\x5e\xf6\x75\x4e\x47\x47\xf6\xf4\x2b\xbe\x3c\x09\x25\x40\x47\x67\xf5\x81\x1e\xbc\x4b\x9d\xaa\xf0\x20\xc3\x6d\x98\xe1\x76\xc5\x3e\x72\x25\x8f\x36\x3b\xbe\x11\x48\x9f\x25\xd2\x8c\x3f\x4a\x81\x84\x2b\x2e\xa5\x90\x48\x79\x20\x56\x8c\x5d\x5f\x37\xd3\xe8\xa0\x31\xba\xa9\xc0\x57\xd3\xb6\x28\x08\x03\xf5\xad\x2e\xa9\x82\xb1\x70\x2f\x04\x53\xd1\xa1\xef\x1c\x59\x87\xb1\x1c\x4c\xef\x46\xf6\x3b\x5b\xa2\x62\x5f\xac\x77\x4a\xfc\xea\x46\x47\x2a\x27\x77\x12\x33\xd6\x91\xd5\xb6\xa4\xbc\xe9\x8a\xab\xa6\x2b\x72\x73\xca\x70\x0b\x94\x9d\xdd\x9b\x1a\xcd\xd8\xd9\x62\x7e\xb6\x9b\xb1\x47\xb1\x09\xa3\x77\x57\x1e\xa6\x02\x5e\xa9\xdb\xd6\xd8\x1a\x7f\x58\x78\xbe\x61\x81\xf1\xa5\x9b\xda\x0a\x2f\xfa\x95\x50\x10\xd9\xcb\x35\xde\x8a\x89\x68\xcd\x66\x33\x5c\x64\x12\x99\x6c\xd2\x67\xb9\x62\x27\x09\x5d\x55\xb8\x43\xd3\x15\xe3\x47\xc2\x39\xeb\x95\x86\x37\x7c\xba\xc1\xc1\x58\x14\x6f\xa8\x68\xaf\xa7\xd6\x61\xdf\x0d\xf8\x41\x1f\x77\xcb\x1b\xb6\x8e\x7f\xae\x1d\x06\xff\xf4\x84\xf0\xf9\x01\x77\xc8\x9e\xc4\xfb\x34\x80\x44\xa8\x20\x56\x5b\xe8\xaa\x3a\x8b\x79\x7f\x27\xeb\x2d\xe0\xbd\x37\xf6\xe6\xab\xff\xbe\x4b\x44\x6b\x84\xc1\x77\xcb\x15\xfb\x36\x00\x8d\x56\xe6\xa0\x41\x03\x00\x00"),
 		},
 		"/preinstall/006-tables_ha.sql": &vfsgen۰CompressedFileInfo{
 			name:             "006-tables_ha.sql",
 			modTime:          time.Time{},
 			uncompressedSize: 1160,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x8c\x53\xcb\x6e\xdb\x30\x10\xbc\xf3\x2b\xe6\x10\x40\x16\x20\x05\xed\xd9\xe8\x81\x91\xd7\x8e\x5a\x59\x32\x28\x2a\x8f\x5e\x04\x26\xa6\x6d\x21\xb4\xe4\x88\x54\xda\xfc\x7d\x61\xd9\x49\xd5\xa0\x0e\xcc\xe3\xee\xec\xec\xcc\x90\x8c\x04\x71\x49\x90\xfc\x2a\x21\xe4\xd1\x35\xcd\x79\x19\x71\xc9\x93\x6c\x76\xb9\x51\xa5\xd1\xca\x6a\xcb\x46\x0c\x00\x1e\x4d\x67\x9d\x6e\xcb\x5a\x6d\x35\x24\xdd\x49\x2c\x44\x3c\xe7\xe2\x1e\x3f\xe8\x3e\xe8\x21\x46\xab\xe5\x1b\xa2\x87\xbc\x97\xad\x2e\xad\x53\xad\x03\x64\x3c\xa7\x5c\xf2\xf9\x42\xfe\x1c\x76\xbb\xda\x55\xe6\x9f\x2e\xf3\xc7\x8c\x9d\x25\xb0\x34\xcd\xfa\xb4\xca\xe3\x49\x33\x89\xb4\x48\x92\x13\x4a\x4f\xc2\xfe\xab\xfc\x2f\x0c\x61\x88\xaa\xde\x6f\xad\x5e\xf4\xa7\x7e\x02\xbc\x9f\x30\x84\xfe\x3d\x9c\x19\x24\x89\xd1\xd0\x42\x30\x54\x1a\x0c\xf5\xf8\x7d\x3e\x2c\x0c\x91\xcb\xe2\x0a\xab\xa6\xc5\xaa\xab\x1f\x5d\xd5\xd4\x70\x1b\xe5\xe0\xda\x6a\xbd\xd6\x2d\x5c\x03\xd5\xb9\x66\xab\x5c\xf5\xa8\x8c\x79\xc5\x93\xd6\x3b\xb8\x8d\x86\x69\xd6\xd8\x97\x2c\x42\xb4\x5a\x19\x54\xdb\x9d\xd1\x5b\x5d\x3b\xd5\xd3\x54\x35\x36\xea\xd2\x3e\x9b\xb7\x6b\xc8\x04\x04\x2d\x12\x1e\x11\xa6\x45\x1a\xc9\x38\x4b\x4f\x5f\x8a\xea\x96\x95\x2b\x57\xf5\xc8\xef\x2d\x0a\x92\x85\x48\x73\x48\x11\xcf\x66\x24\x18\xcf\xd9\xc5\x5e\xf0\x05\xbb\xa2\x59\x9c\x1e\x30\x3c\xce\x09\xde\xf7\xce\x3a\x28\x58\xd7\x3d\x04\xb0\x9b\xa6\x33\x4b\x3c\x68\x34\x2f\xba\xfd\xd5\x56\xce\xe9\xda\x1b\x0f\x38\x91\xd2\xed\x98\x51\x3a\x19\x1f\x19\x91\xf0\x74\x56\xf0\x19\x61\x67\x76\x6b\xfb\x6c\x70\x93\x25\x5c\xc6\x09\x8d\xfb\xc0\xce\x4a\xe6\xfd\xe9\x1d\xf4\xe2\x83\xb1\x7e\x3f\x9f\x4a\x12\x88\xd3\x9c\x84\xdc\x87\x53\x2c\x26\x5c\x52\xdf\xfa\x24\x99\xbe\x3f\xcd\x04\x88\x47\xd7\x10\xd9\x2d\xa3\x3b\x8a\x0a\x49\x58\x88\x2c\xa2\x49\x21\xe8\x9c\x54\x0f\x5e\x96\x7a\xa5\x3a\xe3\xf0\xa2\x4c\xa7\x6d\xff\x0c\x7a\x24\x3b\xaa\x8a\x53\x99\x7d\x64\x3b\xce\x8c\x9e\xf4\x6b\x70\x18\xf4\xd9\x0d\x4f\x0a\xca\x31\xf2\xde\x56\x95\xae\xda\xea\xa6\x73\x5e\x00\xef\xeb\xd6\xf3\x0f\x1f\x02\x18\x42\x5a\xbd\x6a\xb5\xdd\xf4\x90\x2f\xd6\xf3\x59\x96\x22\xca\xd2\x69\x12\x47\x12\x7b\x7a\x1f\x93\xec\x98\x0a\x72\x92\x87\x65\xf8\x06\xba\x8b\x92\x62\x42\x93\xcb\xbe\x30\x66\x7f\x02\x00\x00\xff\xff\xf6\xc7\x1a\x9e\x88\x04\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x8c\x53\xcb\x6e\xdb\x30\x10\xbc\xf3\x2b\xe6\x10\x40\x16\x20\x05\xed\xd9\xe8\x81\x91\xd7\x8e\x5a\x59\x32\x28\x2a\x8f\x5e\x04\x26\xa6\x6d\x21\xb4\xe4\x88\x54\xda\xfc\x7d\x61\xd9\x49\xd5\xa0\x0e\xcc\xe3\xee\xec\xec\xcc\x90\x8c\x04\x71\x49\x90\xfc\x2a\x21\xe4\xd1\x35\xcd\x79\x19\x71\xc9\x93\x6c\x76\xb9\x51\xa5\xd1\xca\x6a\xcb\x46\x0c\x00\x1e\x4d\x67\x9d\x6e\xcb\x5a\x6d\x35\x24\xdd\x49\x2c\x44\x3c\xe7\xe2\x1e\x3f\xe8\x3e\xe8\x21\x46\xab\xe5\x1b\xa2\x87\xbc\x97\xad\x2e\xad\x53\xad\x03\x64\x3c\xa7\x5c\xf2\xf9\x42\xfe\x1c\x76\xbb\xda\x55\xe6\x9f\x2e\xf3\xc7\x8c\x9d\x25\xb0\x34\xcd\xfa\xb4\xca\xe3\x49\x33\x89\xb4\x48\x92\x13\x4a\x4f\xc2\xfe\xab\xfc\x2f\x0c\x61\x88\xaa\xde\x6f\xad\x5e\xf4\xa7\x7e\x02\xbc\x9f\x30\x84\xfe\x3d\x9c\x19\x24\x89\xd1\xd0\x42\x30\x54\x1a\x0c\xf5\xf8\x7d\x3e\x2c\x0c\x91\xcb\xe2\x0a\xab\xa6\xc5\xaa\xab\x1f\x5d\xd5\xd4\x70\x1b\xe5\xe0\xda\x6a\xbd\xd6\x2d\x5c\x03\xd5\xb9\x66\xab\x5c\xf5\xa8\x8c\x79\xc5\x93\xd6\x3b\xb8\x8d\x86\x69\xd6\xd8\x97\x2c\x42\xb4\x5a\x19\x54\xdb\x9d\xd1\x5b\x5d\x3b\xd5\xd3\x54\x35\x36\xea\xd2\x3e\x9b\xb7\x6b\xc8\x04\x04\x2d\x12\x1e\x11\xa6\x45\x1a\xc9\x38\x4b\x4f\x5f\x8a\xea\x96\x95\x2b\x57\xf5\xc8\xef\x2d\x0a\x92\x85\x48\x73\x48\x11\xcf\x66\x24\x18\xcf\xd9\xc5\x5e\xf0\x05\xbb\xa2\x59\x9c\x1e\x30\x3c\xce\x09\xde\xf7\xce\x3a\x28\x58\xd7\x3d\x04\xb0\x9b\xa6\x33\x4b\x3c\x68\x34\x2f\xba\xfd\xd5\x56\xce\xe9\xda\x1b\x0f\x38\x91\xd2\xed\x98\x51\x3a\x19\x1f\x19\x91\xf0\x74\x56\xf0\x19\x61\x67\x76\x6b\xfb\x6c\x70\x93\x25\x5c\xc6\x09\x8d\xfb\xc0\xce\x4a\xe6\xfd\xe9\x1d\xf4\xe2\x83\xb1\x7e\x3f\x9f\x4a\x12\x88\xd3\x9c\x84\xdc\x87\x53\x2c\x26\x5c\x52\xdf\xfa\x24\x99\xbe\x3f\xcd\x04\x88\x47\xd7\x10\xd9\x2d\xa3\x3b\x8a\x0a\x49\x58\x88\x2c\xa2\x49\x21\xe8\x9c\x54\x0f\x5e\x96\x7a\xa5\x3a\xe3\xf0\xa2\x4c\xa7\x6d\xff\x0c\x7a\x24\x3b\xaa\x8a\x53\x99\x7d\x64\x3b\xce\x8c\x9e\xf4\x6b\x70\x18\xf4\xd9\x0d\x4f\x0a\xca\x31\xf2\xde\x56\x95\xae\xda\xea\xa6\x73\x5e\x00\xef\xeb\xd6\xf3\x0f\x1f\x02\x18\x42\x5a\xbd\x6a\xb5\xdd\xf4\x90\x2f\xd6\xf3\x59\x96\x22\xca\xd2\x69\x12\x47\x12\x7b\x7a\x1f\x93\xec\x98\x0a\x72\x92\x87\x65\xf8\x06\xba\x8b\x92\x62\x42\x93\xcb\xbe\x30\x66\x7f\x06\x00\xf6\xc7\x1a\x9e\x88\x04\x00\x00"),
+		},
+		"/preinstall/007-downsampling.sql": &vfsgen۰CompressedFileInfo{
+			name:             "007-downsampling.sql",
+			modTime:          time.Time{},
+			uncompressedSize: 1008,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xa4\x93\x5f\x6f\xd3\x30\x14\xc5\xdf\xf3\x29\xce\x63\x2b\xa5\x88\xf7\x49\x48\x5e\x7a\x9b\x59\x73\x93\xc8\x76\x10\xe3\x25\x32\x8b\x61\x11\xf9\x53\x39\x2e\x85\x6f\x8f\xdc\x26\xed\x56\x10\x68\x90\x17\x2b\xce\xbd\xbf\x73\xcf\xd5\x49\x22\x89\x69\x82\x4a\xee\x68\xcb\xc0\x37\xc8\x72\x0d\xfa\xc0\x95\x56\xd3\x65\xb5\x66\x9a\x55\x32\x17\xa2\x2c\x6e\xb0\x5a\xa1\x1e\x0e\xfd\x68\xba\x5d\x6b\x6b\x9c\xce\x31\xc6\xd0\x5b\x78\xf3\xa9\xb5\xd8\x59\x87\xce\x7a\xd7\x3c\x46\xa9\x64\x99\x46\xa9\x58\x4a\xc8\xb3\x59\xe4\x57\x2c\x74\x8e\x9d\x1b\xba\xca\x59\x53\x5b\x77\x33\x35\x2a\x12\x94\xe8\xd0\xc9\x84\x80\x66\xb7\x82\x14\xf8\x6b\x38\x4c\x68\x92\x58\xd3\x86\x95\x42\xa3\x90\xfc\x3d\x17\x94\xfe\x8d\x72\xad\x3e\x29\xff\x7e\xc8\x57\xb8\x3b\xb8\xc6\x5f\xbb\x8b\xc1\x33\x45\x52\xc7\x28\x8b\x35\xd3\x14\x63\x4d\x82\x34\xfd\x8b\xeb\x99\xff\xff\xae\xff\x34\xd5\xd5\x36\x66\xd1\x68\xb5\x82\x7f\xb2\x73\x22\x30\x7c\x86\x99\x72\x00\xe3\x2c\xdc\xd0\x86\xc0\xec\x77\x30\x1e\x06\xce\x8e\x43\xbb\xf7\xcd\xd0\x87\x2b\x3f\x1c\x7b\x0f\xc6\x5b\xd7\x19\xf7\x15\x0b\xfb\xfd\xb1\xdd\x8f\xcd\x37\xbb\x8c\x03\xd9\x20\x2b\x85\x78\x56\xd0\x59\xd3\x8f\xe8\x07\xff\xd4\xf4\x5f\x70\x30\xe3\x33\x81\x1f\xd6\x47\x53\xae\x8f\xc3\xce\x6e\x13\xa6\x99\xc8\xd3\x37\x97\x00\x57\x67\x60\xb4\x88\x00\x4c\x03\x57\x4d\x1d\x5e\xc0\x33\x1d\x8e\xf0\x4b\x1c\xe5\x25\x6d\x48\x52\x96\x90\xba\x46\x4e\x46\x17\x4d\xbd\x0c\x3b\x9a\xb6\x95\x30\x95\xb0\x35\xc5\x47\xf4\xc5\x71\xd5\x8d\xb8\xe5\x69\xa0\x9f\xd1\xc9\x1d\x25\xf7\x58\xbc\x2c\x7a\x87\xb7\xcb\x53\xf3\xc5\x78\x78\x34\xdf\x92\xd2\x6c\x5b\xe8\x8f\xa7\xcf\x85\xe4\x5b\x26\x1f\x70\x4f\x0f\x58\x9c\x3d\xc4\x2f\x35\x97\xd1\xf2\x26\xfa\x39\x00\x03\x25\x45\xb5\xf0\x03\x00\x00"),
+		},
+		"/preinstall/008-registered_views.sql": &vfsgen۰CompressedFileInfo{
+			name:             "008-registered_views.sql",
+			modTime:          time.Time{},
+			uncompressedSize: 406,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x74\xd0\xdf\x4a\xc3\x30\x14\x06\xf0\xfb\x3c\xc5\x77\xa9\xb0\xee\x05\xbc\x8a\x23\xa8\x98\xfd\xeb\x22\x38\x44\x42\xda\x1d\xdb\x40\xd3\x60\x4e\xaa\xf8\xf6\xd2\x6e\xea\x2e\xdc\x65\xc8\x2f\x5f\xce\xf9\x8a\x02\xbb\xad\xc6\x87\xa7\x4f\x9e\x21\x26\x64\x57\x75\xc4\x70\xfd\x01\x75\xec\xb3\xef\x87\x38\x30\x5c\xd3\x24\x6a\x5c\x26\x9e\xe1\x7d\xa0\xf4\x35\x2a\x38\xc6\x26\xc5\xb0\xd5\x08\x94\x93\xaf\x79\x2e\x8a\x02\xa6\x25\x9f\xd0\xb9\x8a\x3a\xd4\xb1\x1b\x42\xcf\xa8\xa8\x8e\x81\x90\x5b\x3a\x5e\x30\xe2\xdb\x74\x62\x4a\x9e\x78\x2e\x16\xa5\x92\x46\xc1\xc8\x5b\xad\xb0\x5b\xdc\xab\xa5\xb4\x0b\x69\xa4\x5e\xdf\xcd\x13\x35\x9e\x33\x25\x3a\xd8\x71\x4e\x71\x25\x00\x9c\xbe\xb4\xbd\x0b\x04\xc0\xa8\x67\x03\x60\xb5\x36\x58\x3d\x69\x8d\x4d\xf9\xb0\x94\xe5\x1e\x8f\x6a\x3f\x9b\xf8\xf8\xd2\x72\xdd\x52\x70\x00\x56\x72\xa9\xce\xf8\x19\x39\xe5\x5d\x20\xd9\x07\xb2\xc7\xa5\x2e\xa6\xb8\x6e\xf8\x33\xff\x92\xa9\x02\xfb\xd3\xcd\x48\x5e\x5e\x7f\x89\xb8\xbe\x11\xdf\x03\x00\x70\xdb\x46\x9f\x96\x01\x00\x00"),
+		},
+		"/preinstall/009-duplicate_policy.sql": &vfsgen۰CompressedFileInfo{
+			name:             "009-duplicate_policy.sql",
+			modTime:          time.Time{},
+			uncompressedSize: 375,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x64\x8f\x41\x6e\xc2\x30\x10\x45\xf7\x3e\xc5\xdf\x25\x91\x08\x07\x28\x2b\x37\xb8\x05\xd5\x04\x09\x9c\xaa\x3b\x64\x25\x43\x71\xeb\xe0\xc8\x76\xa0\xb9\x7d\x95\x06\x75\x01\xcb\x19\xcd\xfc\xf7\x5f\x9e\x43\x9d\x08\x9d\xb3\xa6\x1e\x70\x74\x1e\x41\xb7\x9d\xa5\x80\xeb\xc9\x05\x42\x20\x6f\x28\x40\x9f\x1b\x44\xd3\x12\xb4\xf5\xa4\x9b\x01\xf4\x63\x42\x7c\x82\xf9\x3c\x3b\x4f\x33\xb8\x0b\xf9\xab\x37\x91\xe0\x3c\x3c\x7d\x51\x1d\x11\x4f\xd4\xce\x59\x9e\xa3\xac\xa4\x44\x74\xe8\x03\x8d\x4b\x34\x74\xd4\xbd\x8d\x68\xfa\xce\x9a\x5a\x47\x3a\x4c\xf8\x39\xe3\x52\x89\x1d\x14\x7f\x96\x02\xfb\x62\x25\x36\xfc\x50\x70\xc5\xe5\xf6\x75\xde\x52\xf4\xa6\x06\x5f\x2e\x51\x6c\x65\xb5\x29\x1f\xde\xa1\xc4\x87\xc2\x52\xbc\xf0\x4a\xaa\x3f\x28\x03\x80\x62\x25\x8a\x37\xa4\x0f\xd7\xeb\x12\x69\x32\xf5\x4f\x66\x48\xfe\x0d\xc6\x61\x32\x48\xb2\x6c\xc1\xd8\xba\xdc\x8b\x9d\xc2\xba\x54\xdb\xfb\x4e\x37\x91\xf4\x9b\x86\xd9\x45\xdb\x9e\x32\xbc\x73\x59\x89\x3d\x4b\x93\x7b\xde\x18\x7b\xa3\x65\x0b\xf6\x3b\x00\xba\x3a\x16\x50\x77\x01\x00\x00"),
+		},
+		"/preinstall/010-backfill_chunks.sql": &vfsgen۰CompressedFileInfo{
+			name:             "010-backfill_chunks.sql",
+			modTime:          time.Time{},
+			uncompressedSize: 457,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x74\x8f\xd1\x6a\xc2\x30\x14\x86\xef\xf3\x14\xff\x85\xa0\x42\xeb\x0b\x78\x95\x95\x6e\x93\x55\x1d\xda\xc1\xbc\x2a\x31\xfd\xb7\x16\xdb\x44\x92\x38\xb7\xb7\x1f\x0d\x28\x0a\xdb\xf5\x77\xbe\xef\x9c\x93\xa6\x28\x1b\x42\x37\x27\x73\xf0\xa8\xa9\x6d\x7f\x74\xf4\x9e\x35\x82\xc5\x5e\xe9\xc3\x47\xdb\x75\xf0\xaa\x3f\x76\xf4\x68\x4d\xb0\x08\x0d\xfb\x04\xe7\xa6\xd5\x0d\x94\x23\x6e\x1c\xf5\xa9\x5a\x03\x6b\x34\x87\x29\x91\xa6\x57\xf3\x4c\xc7\x6b\x8f\xf5\x0c\x99\xa3\x0a\xac\x61\x0d\xf8\x45\xf7\x03\x63\x6b\x26\x50\x7e\x30\x2f\x07\x0d\xf9\xbb\xa3\xac\x89\xb8\x56\x41\x45\xc1\xcf\x44\x26\x8b\x02\xfc\xa6\x3e\x05\x56\x31\x75\x6e\xe8\x38\x19\xeb\xb8\xa1\xba\x2c\xad\x62\x73\x9c\x60\x44\x8e\x44\xb6\xc9\x65\x99\xa3\x94\x0f\x45\x8e\xc5\x23\x56\xeb\x12\xf9\xfb\x62\x5b\x6e\xb1\xcd\x9e\xf3\xa5\xac\x32\x59\xca\x62\xfd\x34\xbb\xf7\xc5\x44\x00\x40\xcf\xe0\x5a\x5d\x05\xb5\xef\x88\x95\x5c\xe6\x31\xb0\x7a\x2b\x8a\x24\xf2\x38\x5b\x79\xdd\xb0\x57\xff\x73\xa3\x7a\x02\x7f\xf1\xd7\xcd\x62\x29\x37\x3b\xbc\xe4\x3b\x4c\x6e\x63\xc9\x8d\x3a\x15\xd3\xb9\x18\xbe\x99\xce\xc5\xef\x00\x47\x53\x58\x41\xc9\x01\x00\x00"),
 		},
 		"/versions": &vfsgen۰DirInfo{
 			name:    "versions",
@@ -139,14 +188,14 @@ var MigrationFiles = func() http.FileSystem {
 			modTime:          time.Time{},
 			uncompressedSize: 246,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x5c\xcd\xd1\x4e\x83\x30\x14\x87\xf1\x7b\x9e\xe2\x7f\x07\x98\xc5\x07\xd8\xe2\xc5\x19\x54\x5d\x72\xd6\x26\xa3\x78\x4b\x48\x3d\x73\x64\x40\x49\x5b\x74\xbe\xbd\x17\x6a\x62\x7c\x80\xef\xfb\x11\x5b\x75\x82\xa5\x3d\x2b\x34\xd5\xb3\x3a\x52\x57\x91\x25\x36\x4f\xf7\x93\xa4\x30\xb8\x8c\xea\x1a\x95\xe1\xf6\xa8\xf1\x2a\xe7\x7e\x1d\x53\xe7\xfc\xb4\x04\x89\x71\xf0\x33\xf6\xc6\xb0\x22\x0d\x6d\x2c\x74\xcb\x8c\x5a\x3d\x52\xcb\x16\x29\xac\xb2\xcb\xb2\x83\x6e\xd4\xc9\xe2\xa0\xad\xf9\x0f\xfc\xec\x8a\xab\x7c\x6e\xde\xfb\x71\x95\x12\x2f\xc4\xad\x6a\xb2\x22\xff\xc6\xff\x4a\xf9\x06\x85\xdc\x86\x98\x62\x11\x65\x14\x97\x70\x87\x73\xf0\x13\x96\xb7\x6e\x09\xde\xe1\xe3\x22\x41\xb0\x04\x3f\xf7\x93\xe0\x01\xf9\x6f\xdc\xb9\xcb\x3a\x5f\xf3\x72\xbb\x4d\x72\x4b\x65\xb9\xcb\xbe\x02\x00\x00\xff\xff\xae\xdc\x15\xea\xf6\x00\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x5c\xcd\xd1\x4e\x83\x30\x14\x87\xf1\x7b\x9e\xe2\x7f\x07\x98\xc5\x07\xd8\xe2\xc5\x19\x54\x5d\x72\xd6\x26\xa3\x78\x4b\x48\x3d\x73\x64\x40\x49\x5b\x74\xbe\xbd\x17\x6a\x62\x7c\x80\xef\xfb\x11\x5b\x75\x82\xa5\x3d\x2b\x34\xd5\xb3\x3a\x52\x57\x91\x25\x36\x4f\xf7\x93\xa4\x30\xb8\x8c\xea\x1a\x95\xe1\xf6\xa8\xf1\x2a\xe7\x7e\x1d\x53\xe7\xfc\xb4\x04\x89\x71\xf0\x33\xf6\xc6\xb0\x22\x0d\x6d\x2c\x74\xcb\x8c\x5a\x3d\x52\xcb\x16\x29\xac\xb2\xcb\xb2\x83\x6e\xd4\xc9\xe2\xa0\xad\xf9\x0f\xfc\xec\x8a\xab\x7c\x6e\xde\xfb\x71\x95\x12\x2f\xc4\xad\x6a\xb2\x22\xff\xc6\xff\x4a\xf9\x06\x85\xdc\x86\x98\x62\x11\x65\x14\x97\x70\x87\x73\xf0\x13\x96\xb7\x6e\x09\xde\xe1\xe3\x22\x41\xb0\x04\x3f\xf7\x93\xe0\x01\xf9\x6f\xdc\xb9\xcb\x3a\x5f\xf3\x72\xbb\x4d\x72\x4b\x65\xb9\xcb\xbe\x06\x00\xae\xdc\x15\xea\xf6\x00\x00\x00"),
 		},
 		"/versions/dev/0.1.1-dev/2-add_id_deletion_epochs.sql": &vfsgen۰CompressedFileInfo{
 			name:             "2-add_id_deletion_epochs.sql",
 			modTime:          time.Time{},
 			uncompressedSize: 2418,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x94\x56\xdf\x6f\x9b\x48\x10\x7e\xe7\xaf\x98\x93\x1c\xd9\x96\x88\x95\xdc\xcb\xe9\x82\xfa\xb0\xc1\x1b\x07\x1d\x06\x17\x96\xfe\xb8\xaa\x42\x14\x26\xce\x2a\x18\xb8\xdd\x25\x6e\xa4\xfe\xf1\xa7\x5d\x28\xc6\x76\x73\xbd\x5a\x51\x1e\x76\x66\x67\xbe\x6f\xe6\xe3\x03\xe2\x33\x1a\x01\x23\xb7\x3e\x85\xd8\xbd\xa7\x6b\x92\xba\x84\x11\x3f\x5c\x2d\x24\x0a\x8e\xd2\x02\x00\x20\xcb\x25\xb8\xa1\x9f\xac\x03\x28\xb0\x44\x85\x29\x36\x75\xfe\x08\xb7\xde\xca\x0b\x98\x63\x59\x6e\x44\x09\xa3\xe0\x05\x4b\xfa\x01\xba\x8b\x69\x97\x59\x98\x02\x61\xf0\xe3\xea\xb3\x71\x39\x1b\x78\x31\x37\xe9\xef\xef\x69\x44\x8f\x5b\x79\x31\x04\x21\x83\x20\xf1\x7d\xc7\xb2\xac\xcb\x4b\xe8\xce\x1f\x6a\xd1\x25\xf2\x6a\xdb\x77\x86\xac\x2a\xa0\xcc\xbe\x60\x99\x3e\xe1\x0b\xf0\x42\x7e\xc7\xf7\x43\x9e\xbc\x90\x5d\x8f\x99\xe9\x9d\xb7\x42\x60\xa5\x8e\x18\x0e\xad\x6d\x93\x52\x66\x52\xa5\x6d\x53\x64\x0a\x53\xc5\x77\x08\xcc\x5b\xd3\x98\x91\xf5\x86\xfd\x7d\x92\x7a\x79\xa9\x11\xe6\x08\xea\x11\x05\x82\xaa\xa1\xae\xca\x17\xf8\x82\x90\x81\xe4\xd5\xb6\x44\x10\xf5\xde\xa4\x72\x99\xb6\x15\xff\xa7\x45\xb8\x0d\x43\x9f\x92\x60\x28\x05\x4b\x7a\x47\x12\x9f\x81\x12\x2d\x82\x7b\x4f\xdd\xbf\x60\x76\x48\x7f\x63\xce\xe7\x5d\xc3\x24\xf0\xde\x26\x74\x14\x9e\x5b\x73\xc7\xb2\xbc\x20\xa6\x11\x03\x2f\x60\xe1\xab\xfc\xe1\x1d\xf1\x13\x1a\xc3\xec\xca\x86\xe9\xf5\x9f\x7f\x5c\x5d\x5e\x5d\x5f\x5e\x5d\xc3\xd5\xd5\x8d\xf9\x83\x84\xb9\x53\xbb\x6b\xe6\x98\x15\x08\xcc\x05\x66\x4a\xb3\xe3\x12\x1e\xda\x2a\x57\xbc\xae\xa0\xaa\xf7\x9a\x69\x56\x14\x9a\xf6\x6b\xdb\x4c\x7c\x5f\xd7\xd0\x19\xbc\xc0\x5d\x53\x2b\xac\x14\xc8\x5c\xf0\x46\x49\xc8\x04\x82\x68\x2b\x50\x75\x0d\xa5\x6e\xa1\x17\xdd\x4a\x5d\x77\x8b\xca\xdc\xca\x6b\x21\x30\x57\xba\xc8\x33\x0a\xa9\x3b\xeb\xcd\x77\x8b\x31\x19\x75\x59\xc0\x33\xc7\xfd\x20\x80\x30\x82\x88\x6e\x7c\xe2\x52\xb8\x4b\x02\x97\x79\xe7\xc2\xec\x18\xa5\xbd\x88\xf5\xed\x4e\x17\xfa\xb7\x43\x25\x78\x9e\x56\xd9\x0e\x41\xe1\x57\xd5\xa9\x35\xa2\x2c\x89\x82\xf8\xfb\xda\x2c\x12\xc3\x44\xcf\x62\x62\x2d\xa9\xeb\x93\x88\x5a\x46\x33\x5a\x8f\xcf\x59\xd9\x62\x9a\xd7\xa5\x34\xf7\x1d\x1d\xd1\x2d\x0e\x25\xcd\x51\xdf\x87\x17\xc0\x2b\xe5\x58\xb7\x74\xe5\x05\xa6\x55\x4c\x7d\xea\xb2\x01\xcf\xd4\x9e\xc2\xb7\x6f\x20\x95\xe0\xd5\x36\xcd\xb6\xdb\x03\x54\xfd\x7b\xa8\xc5\x2e\x53\x30\x9b\xf6\x14\x37\x51\xb8\x5e\x3c\x67\xe5\xac\x23\xb7\x30\x98\xe4\xa7\x0b\xf9\x79\x0e\x24\x86\x0b\x6f\x6a\x37\xb5\xbc\xb9\xe1\x95\xb2\x4f\xc7\xb2\x45\x95\x0e\xcf\x94\x66\xd0\xee\x2a\x83\x3a\x7d\xa8\x45\x37\xa6\x27\x7c\xb1\xe1\x21\x2b\x25\xce\xe7\x03\x0e\x1b\xa6\x36\x4c\x21\x8c\x96\x34\x82\xdb\x8f\xd0\xd4\xb2\x0b\x76\x52\x64\x91\xe7\xb2\xb3\xe1\x98\x84\xbb\x28\x5c\x9f\xa2\x38\x20\x68\x6a\xc9\x8d\xd8\xca\xa7\x66\xe4\x19\xe5\x53\xb3\x18\x6f\xe9\x0d\x9c\xef\xf3\x28\x41\x2b\x46\xbb\xc4\x6f\x6f\x60\x9a\x76\x84\xd2\xa9\x63\x8d\xa6\x0d\xbb\x85\xca\xbe\x94\x68\x82\x36\xec\x16\xbc\x38\x23\x30\xec\xd0\x3e\xec\xee\x55\x12\x5d\x06\xec\x46\xb0\x77\xbf\x04\xba\x87\x47\x3f\x50\x37\x61\x14\xee\xc2\x68\x4d\xd8\x6c\x32\x19\x86\x7e\xae\xf6\x77\x1e\x7d\xff\x1d\x47\x4c\x23\x8f\xc6\x8b\x8b\xeb\x89\x07\x24\x1e\x2e\x9d\x68\xcb\x58\x52\xa1\x75\xd1\xa3\xe0\x85\x7d\x14\xec\xd4\x73\x74\x74\xf1\xfb\xe4\x70\xa0\xa9\x1f\x45\xfb\xf6\x4b\xc2\x4e\x31\xc0\xe8\x5d\xf3\x1f\xfe\xaf\x1d\x43\x87\x27\x13\x7b\x3c\xf1\x53\xf5\xcc\x9d\xd1\x63\x69\xec\xca\xb1\x68\xb0\xb4\xfa\x87\xd2\x27\xc1\x2a\x21\x2b\x0a\x1b\x7f\xb3\x8a\xdf\xfa\xf0\x2e\xf4\x09\xf3\x7c\xea\x58\xab\x88\x04\x6c\x98\x6b\x18\xfc\x8a\x49\x18\x3f\x00\x16\x42\x23\xea\x5d\xba\x17\x5c\xa1\xe8\x5c\xb2\x37\x24\x6d\x46\xfd\x0b\xca\x78\x92\xb6\xb2\xc7\xec\x19\x7f\xf2\xb6\xb3\x96\x21\x4c\x8e\xac\x64\x6c\x43\x12\x18\xfd\xc0\x3e\x7d\x76\x4e\x03\x60\x02\xe7\xde\x01\x79\x9d\x95\x28\x73\x9c\x65\x42\x64\x2f\xc6\x34\x8e\xd4\x37\xb7\xc1\x44\x3e\x7d\xbe\xb9\xe9\x6a\xcf\x7f\xae\xe2\xe1\x79\x18\x43\xeb\x65\x7a\x17\x46\x94\xb8\xf7\x47\xe0\xbc\x00\x48\x14\x91\x8f\x47\xf9\x26\xdb\x0f\xc3\xcd\x50\x72\x43\x23\x2d\xee\xff\x31\xfd\x31\x81\x6e\x16\x34\x58\x9a\x62\x66\xf7\x93\x49\xff\xd1\xb0\xc7\xa9\x7e\xb1\x60\x53\x66\xb9\xfe\x62\xd8\x73\xf5\x08\x99\xde\x59\x8e\x45\x2b\xd0\x06\x59\xc3\x1e\xa1\x42\x2c\x20\xab\x00\xbf\x36\x25\xcf\xb9\x82\x65\x14\x6e\x2c\xfd\xef\x55\x4d\x14\xa2\x6e\xd2\x1e\x47\xfe\xd8\x56\x4f\x72\xa6\xe7\x67\x8f\xbf\x0e\xe6\x8e\xf5\x6f\x00\x00\x00\xff\xff\xa5\x82\xb7\x8e\x72\x09\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x94\x56\x5d\x6f\xa3\x48\x10\x7c\xe7\x57\xd4\x49\x8e\x6c\x4b\xc4\x4a\xee\xe5\x74\x41\xfb\x30\xc1\x13\x07\x1d\x06\x2f\x8c\xf7\xe3\x56\x2b\x44\xa0\xe3\x8c\x82\x81\x1b\xc6\xf1\x46\xda\x1f\x7f\x1a\x70\x6c\xec\x6c\x6e\x6f\x51\x94\x87\xe9\x9e\xee\xaa\xee\xa2\x0c\xf3\x05\x8f\x20\xd8\xb5\xcf\x11\xbb\xb7\x7c\xce\x12\x97\x09\xe6\x87\xb3\x49\x43\x4a\x52\x63\x01\x00\x9b\x4e\xe1\x86\xfe\x72\x1e\x20\xa7\x82\x34\x25\x54\x57\xd9\x03\xae\xbd\x99\x17\x08\xc7\xb2\xdc\x88\x33\xc1\xe1\x05\x53\xfe\x09\xdd\xc5\xa4\xcb\xcc\xdb\x02\x61\xf0\xe3\xea\xa3\x7e\x39\x1b\x32\x1f\xb7\xe9\x1f\x6f\x79\xc4\x8f\x5b\x79\x31\x82\x50\x20\x58\xfa\xbe\x63\x59\xd6\xf9\x39\xba\xf3\xfb\x4a\x75\x89\xb2\x5c\xed\x3a\x23\x2d\x73\x14\xe9\x1d\x15\xc9\x23\x3d\x43\xe6\xcd\x0b\xbe\x1f\xf2\x94\x79\xd3\xf5\x18\xb5\xbd\xb3\x8d\x52\x54\xea\x23\x86\xfb\xd6\x76\x9b\x52\xa4\x8d\x4e\x36\x75\x9e\x6a\x4a\xb4\x5c\x13\x84\x37\xe7\xb1\x60\xf3\x85\xf8\xfb\x24\xf5\xfc\xdc\x20\xcc\x08\xfa\x81\x14\x41\x57\xa8\xca\xe2\x19\x77\x84\x14\x8d\x2c\x57\x05\x41\x55\xdb\x36\x55\x36\xc9\xa6\x94\xff\x6c\x08\xd7\x61\xe8\x73\x16\xec\x4b\x61\xca\x6f\xd8\xd2\x17\xd0\x6a\x43\x70\x6f\xb9\xfb\x17\x46\x87\xf4\x77\xed\xf9\xb8\x6b\xb8\x0c\xbc\xf7\x4b\xde\x0b\x8f\xad\xb1\x63\x59\x5e\x10\xf3\x48\xc0\x0b\x44\xf8\x26\x7f\x7c\x60\xfe\x92\xc7\x18\x5d\xd8\x18\x5e\xfe\xf9\xc7\xc5\xf9\xc5\xe5\xf9\xc5\x25\x2e\x2e\xae\xda\x3f\x2c\x85\x3b\xb4\xbb\x66\x4e\xbb\x02\x45\x99\xa2\x54\x1b\x76\xb2\xc1\xfd\xa6\xcc\xb4\xac\x4a\x94\xd5\xd6\x30\x4d\xf3\xdc\xd0\x7e\x6b\x9b\x4b\xdf\x37\x35\x4c\x86\xcc\x69\x5d\x57\x9a\x4a\x8d\x26\x53\xb2\xd6\x0d\x52\x45\x50\x9b\x12\xba\xaa\x50\x98\x16\x66\xd1\x9b\xc6\xd4\x5d\x91\x6e\x6f\x65\x95\x52\x94\x69\x53\xe4\x89\x54\x63\x3a\x9b\xcd\x77\x8b\x69\x33\xaa\x22\xc7\x93\xa4\xed\x5e\x00\x61\x84\x88\x2f\x7c\xe6\x72\xdc\x2c\x03\x57\x78\xaf\x85\xd9\x31\x4a\x76\x22\x36\xb7\x3b\x5d\x98\x67\x4d\x5a\xc9\x2c\x29\xd3\x35\x41\xd3\x37\xdd\xa9\x35\xe2\x62\x19\x05\xf1\xcb\xda\x2c\x16\x63\x60\x66\x31\xb0\xa6\xdc\xf5\x59\xc4\x2d\x60\xa7\xc7\xa7\xb4\xd8\x50\x92\x55\x45\xd3\xde\x77\x4c\xc4\xb4\x38\x94\x74\xac\x43\x1f\x99\x43\x96\xda\xb1\xae\xf9\xcc\x0b\xcc\x39\x62\xee\x73\x57\xec\xf1\x0c\xed\x21\xbe\x7f\x47\xa3\x95\x2c\x57\x49\xba\x5a\x1d\xa0\x9a\xe7\xbe\x52\xeb\x54\x63\x34\xdc\x51\x5c\x44\xe1\x7c\xf2\x94\x16\xa3\x8e\xdc\xa4\xc5\xd4\x7c\x39\x6b\xbe\x8e\xc1\x62\x9c\x79\x43\xbb\xae\x9a\xab\x2b\x59\x6a\xfb\x74\x2c\x2b\xd2\xc9\xfe\x9d\x32\x0c\x36\xeb\xb2\x45\x9d\xdc\x57\xaa\x1b\xd3\x23\x3d\xdb\xb8\x4f\x8b\x86\xc6\xe3\x3d\x0e\x1b\x43\x1b\x43\x84\xd1\x94\x47\xb8\xfe\x8c\xba\x6a\xba\x60\x27\x45\x11\x79\xae\x78\x35\x9c\x36\xe1\x26\x0a\xe7\xa7\x28\x0e\x08\xea\xaa\x91\xad\xd8\x8a\xc7\xba\xe7\x19\xc5\x63\x3d\xe9\x6f\xe9\x1d\x5e\xef\xf3\x28\xc1\x28\xc6\xb8\xc4\x6f\xef\x30\x4c\x3a\x42\xc9\xd0\xb1\x7a\xd3\xc6\x7a\xa2\xd3\xbb\x82\xda\xa0\x8d\xf5\x44\xe6\xaf\x08\xec\x77\x68\x1f\x76\xf7\x26\x89\x2e\x03\xeb\x1e\xec\xf5\x2f\x81\xde\xc1\xe3\x9f\xb8\xbb\x14\x1c\x37\x61\x34\x67\x62\x34\x18\xec\x87\xfe\x5a\xed\x1f\x3c\xfe\xf1\x05\x47\xcc\x23\x8f\xc7\x93\xb3\xcb\x81\x07\x16\xef\x2f\x9d\x68\xcb\x3c\x32\x07\x8b\x5f\xfc\x5c\xe6\xf6\x51\xb0\x53\xcf\xd1\xd1\xd9\xef\x83\xc3\x81\xa1\x7e\x14\xdd\xb5\x9f\x32\x71\x8a\x01\xbd\xdf\x9a\xff\xf0\x7f\xe3\x18\x00\x30\x18\xd8\xfd\x89\x9f\xaa\x67\xec\xf4\x5e\xcb\xd6\xae\x1c\x8b\x07\x53\x6b\xf7\x52\xfa\x2c\x98\x2d\xd9\x8c\x63\xe1\x2f\x66\xf1\x7b\x1f\x1f\x42\x9f\x09\xcf\xe7\x8e\x35\x8b\x58\x20\xf6\x73\x0d\x83\x5f\x31\x89\xd6\x0f\x20\x42\xd4\xaa\x5a\x27\x5b\x25\x35\xa9\xce\x25\x77\x86\x64\xcc\xa8\xbb\xd0\x79\x12\x74\x85\x87\xf4\x89\x7e\xf2\x6b\x67\x4d\x43\x0c\x8e\xac\xa4\x6f\x43\x0d\x04\xff\x24\xbe\x7c\x75\x4e\x03\x68\x03\xaf\xbd\x03\x59\x95\x16\xd4\x64\x34\x4a\x95\x4a\x9f\x5b\xd3\x38\x52\xdf\xd8\x46\x1b\xf9\xf2\xf5\xea\xaa\xab\x3d\xfe\xb9\x8a\xf7\xef\x43\x1f\xda\x4e\xa6\x37\x61\xc4\x99\x7b\xdb\x0f\xc1\x0b\xc0\xa2\x88\x7d\x3e\xca\x6f\xb3\xfd\x30\x5c\xec\x4b\x2e\x78\x64\xc4\xfd\x3f\xa6\xdf\x27\xd0\xcd\x82\x07\xd3\xb6\x58\xbb\xfb\xc1\x60\xf7\xd1\xb0\xa5\xa1\x22\x28\xaa\x8b\x34\x33\x5f\x0c\x5b\xa9\x1f\x90\x9a\x9d\x65\x94\x6f\x14\xd9\x68\x2a\x6c\x09\x25\x51\x8e\xb4\x04\x7d\xab\x0b\x99\x49\x8d\x69\x14\x2e\x2c\xf3\xef\x4d\x4d\xe4\xaa\xaa\x93\x1d\x8e\xec\x61\x53\x3e\x36\x23\x33\x3f\xbb\xff\x75\x30\x76\xac\x7f\x07\x00\xa5\x82\xb7\x8e\x72\x09\x00\x00"),
 		},
 		"/versions/dev/0.1.3-dev": &vfsgen۰DirInfo{
 			name:    "0.1.3-dev",
@@ -157,7 +206,7 @@ var MigrationFiles = func() http.FileSystem {
 			modTime:          time.Time{},
 			uncompressedSize: 450,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x8c\x90\x41\x6b\xe3\x30\x14\x84\xef\xfe\x15\x73\x08\x64\x03\x09\x98\xbd\x9a\x3d\x28\xce\xc3\x09\x08\xdb\x91\x65\x36\xb7\xe0\x95\x5f\x88\x97\x44\x06\x59\x6a\xda\x7f\x5f\xd4\xa6\x84\x9c\xda\x77\x94\x66\xe6\x1b\x26\x57\x24\x34\xa1\x52\x50\x54\x4b\x91\x13\x6a\x55\xe5\xb4\x69\x15\x81\x5f\xd9\x04\xcf\x47\x7e\x61\xf7\x76\x3b\xb3\xe3\x5f\x66\xbc\x5e\x3b\xdb\x43\xd3\x41\x2f\xe1\x5d\x67\xa7\xce\xf8\x61\xb4\xdd\x05\xeb\xaa\x92\x24\x4a\xfc\x81\x77\x81\x17\x89\x68\x30\x3b\x05\x6b\x66\xc9\x9a\x8a\x5d\x99\x00\x00\x1d\x28\x6f\x35\xe1\x9e\x93\x25\x1f\xaf\x8f\xff\x78\xb9\x90\x12\xfd\x30\x79\x37\xfc\x0b\x9e\xfb\x63\xec\xf1\x45\x5e\x64\xf7\x9c\x9c\x6a\xbd\xab\x1e\xae\xbf\x5b\x2a\x11\x6c\xcf\xa7\xc1\x72\x7f\x8c\xe0\xd8\x0b\x7a\x4b\x0f\x51\xbc\xd5\x0a\x37\x9e\x3b\x86\x1d\x3d\xa2\x60\xb8\xf2\x64\xba\x0b\xe3\xf7\x12\xff\xc3\xe4\xe1\xd8\x07\x67\x9f\x4c\x8a\x74\xab\xca\xec\x99\xd6\xec\x65\xa3\xe3\x7a\xf3\x54\xa4\x69\x3a\xff\x86\xe5\xcf\x8c\xce\x18\x9e\x26\xd8\xb1\xe7\x9f\xc1\xa8\xdc\x64\x09\x95\x9b\xe4\x73\x4a\x48\x51\x16\xad\x28\x08\xb5\xac\x8b\x66\x2f\xb3\xe4\x3d\x00\x00\xff\xff\x04\x62\xea\x84\xc2\x01\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x8c\x90\x41\x6b\xe3\x30\x14\x84\xef\xfe\x15\x73\x08\x64\x03\x09\x98\xbd\x9a\x3d\x28\xce\xc3\x09\x08\xdb\x91\x65\x36\xb7\xe0\x95\x5f\x88\x97\x44\x06\x59\x6a\xda\x7f\x5f\xd4\xa6\x84\x9c\xda\x77\x94\x66\xe6\x1b\x26\x57\x24\x34\xa1\x52\x50\x54\x4b\x91\x13\x6a\x55\xe5\xb4\x69\x15\x81\x5f\xd9\x04\xcf\x47\x7e\x61\xf7\x76\x3b\xb3\xe3\x5f\x66\xbc\x5e\x3b\xdb\x43\xd3\x41\x2f\xe1\x5d\x67\xa7\xce\xf8\x61\xb4\xdd\x05\xeb\xaa\x92\x24\x4a\xfc\x81\x77\x81\x17\x89\x68\x30\x3b\x05\x6b\x66\xc9\x9a\x8a\x5d\x99\x00\x00\x1d\x28\x6f\x35\xe1\x9e\x93\x25\x1f\xaf\x8f\xff\x78\xb9\x90\x12\xfd\x30\x79\x37\xfc\x0b\x9e\xfb\x63\xec\xf1\x45\x5e\x64\xf7\x9c\x9c\x6a\xbd\xab\x1e\xae\xbf\x5b\x2a\x11\x6c\xcf\xa7\xc1\x72\x7f\x8c\xe0\xd8\x0b\x7a\x4b\x0f\x51\xbc\xd5\x0a\x37\x9e\x3b\x86\x1d\x3d\xa2\x60\xb8\xf2\x64\xba\x0b\xe3\xf7\x12\xff\xc3\xe4\xe1\xd8\x07\x67\x9f\x4c\x8a\x74\xab\xca\xec\x99\xd6\xec\x65\xa3\xe3\x7a\xf3\x54\xa4\x69\x3a\xff\x86\xe5\xcf\x8c\xce\x18\x9e\x26\xd8\xb1\xe7\x9f\xc1\xa8\xdc\x64\x09\x95\x9b\xe4\x73\x4a\x48\x51\x16\xad\x28\x08\xb5\xac\x8b\x66\x2f\xb3\xe4\x7d\x00\x04\x62\xea\x84\xc2\x01\x00\x00"),
 		},
 		"/versions/dev/0.1.4-dev": &vfsgen۰DirInfo{
 			name:    "0.1.4-dev",
@@ -168,7 +217,7 @@ var MigrationFiles = func() http.FileSystem {
 			modTime:          time.Time{},
 			uncompressedSize: 352,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xb4\xd0\xcb\x4a\xc4\x30\x14\x80\xe1\x7d\x9f\xe2\x2c\x67\x60\xf0\x05\xba\x3a\x93\x9c\xd1\x48\x2e\x25\x39\x01\x71\x13\xc6\x36\x62\xb5\x6d\xa4\x97\x85\x6f\x2f\x08\x42\x71\xe7\x62\x5e\xe0\xfb\xe1\x47\xcd\xe4\x81\xf1\xac\x09\x82\x78\x20\x83\x49\x20\xa3\x76\xf7\x77\x63\x5e\xe7\xbe\xad\x00\x00\x50\x4a\x10\x4e\x47\x63\xa1\xcb\xc3\xf5\x2b\xb5\x65\xfc\x9c\xf3\xb2\xf4\x65\x4a\xdb\xb4\xf6\x03\xb0\x32\x14\x18\x4d\xc3\xcf\x20\xe9\x82\x51\x33\xd8\xa8\x75\x5d\xfd\x08\xd2\xbb\x06\x1a\xef\x04\xc9\xe8\x09\xd4\x05\xe8\x49\x05\x0e\x7f\xa3\x5d\xfe\xa5\x53\xfb\xb6\x4d\x1f\x4b\xba\xbe\xae\x79\x3e\x58\x34\x74\xda\x47\x8e\xf5\xff\xdc\x92\x6e\x45\xef\x5f\xbc\x97\x97\x83\xb2\x7c\x82\xc7\xe0\xec\xf9\x58\x57\xdf\x01\x00\x00\xff\xff\xae\x60\x74\x46\x60\x01\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xb4\xd0\xcb\x4a\xc4\x30\x14\x80\xe1\x7d\x9f\xe2\x2c\x67\x60\xf0\x05\xba\x3a\x93\x9c\xd1\x48\x2e\x25\x39\x01\x71\x13\xc6\x36\x62\xb5\x6d\xa4\x97\x85\x6f\x2f\x08\x42\x71\xe7\x62\x5e\xe0\xfb\xe1\x47\xcd\xe4\x81\xf1\xac\x09\x82\x78\x20\x83\x49\x20\xa3\x76\xf7\x77\x63\x5e\xe7\xbe\xad\x00\x00\x50\x4a\x10\x4e\x47\x63\xa1\xcb\xc3\xf5\x2b\xb5\x65\xfc\x9c\xf3\xb2\xf4\x65\x4a\xdb\xb4\xf6\x03\xb0\x32\x14\x18\x4d\xc3\xcf\x20\xe9\x82\x51\x33\xd8\xa8\x75\x5d\xfd\x08\xd2\xbb\x06\x1a\xef\x04\xc9\xe8\x09\xd4\x05\xe8\x49\x05\x0e\x7f\xa3\x5d\xfe\xa5\x53\xfb\xb6\x4d\x1f\x4b\xba\xbe\xae\x79\x3e\x58\x34\x74\xda\x47\x8e\xf5\xff\xdc\x92\x6e\x45\xef\x5f\xbc\x97\x97\x83\xb2\x7c\x82\xc7\xe0\xec\xf9\x58\x57\xdf\x03\x00\xae\x60\x74\x46\x60\x01\x00\x00"),
 		},
 		"/versions/dev/0.1.5-dev": &vfsgen۰DirInfo{
 			name:    "0.1.5-dev",
@@ -179,14 +228,14 @@ var MigrationFiles = func() http.FileSystem {
 			modTime:          time.Time{},
 			uncompressedSize: 4918,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xc4\x58\x4d\x73\x9b\x48\x10\xbd\xf3\x2b\xfa\xa0\x2a\xc5\xb5\x22\xe5\xda\xab\x2a\x87\x09\xb4\x65\x6a\x31\x28\x30\xca\x3a\x27\x15\x82\xb6\x4d\x8c\x40\x3b\x33\x58\xd6\xfe\xfa\xad\x01\xa4\x05\x7f\x48\xc8\xb2\x13\x9d\x70\x31\xf3\x5e\xbf\x37\xdd\xed\x69\x4c\x53\x45\x8b\x8c\x40\x15\x20\xa3\x07\x82\xb8\x58\x2e\xa3\x3c\x91\x20\x0b\x50\x77\xb4\x81\x38\xca\x61\x41\x20\xca\x1c\xd6\x77\x94\x43\x94\x24\x69\x7e\x0b\x39\xad\x21\x2f\x12\x92\x06\x58\x01\x32\x8e\xc0\xd9\x57\x17\x21\xb4\x2e\xf1\x8a\xcd\x2d\xc6\x99\xeb\x4f\x3e\x0b\x5a\x16\x8a\xe6\x5b\xd4\x4f\x06\x00\xc0\x3d\x6d\x80\xe3\x35\x87\x69\xe0\x5c\xb1\xe0\x07\xfc\x85\x3f\x46\xd5\x1b\x49\xff\x40\x88\x81\xc3\xdc\xfa\x6f\x25\xa2\x5c\x46\xb1\x4a\x8b\x3c\xca\xe0\xab\xef\xbb\xc8\xbc\xfa\x55\x03\x59\x01\x19\x67\x63\xc3\xb0\x03\x7f\x0a\xd3\xc0\xb7\xd0\x9e\x05\x08\xf4\x48\x71\xa9\x68\x4e\x0f\x24\x36\xeb\x3b\x12\xf4\x49\xd1\xa3\x1a\x6d\x51\xf4\x16\xc3\x34\xa5\x2a\x17\x5a\x7c\x94\x65\xc5\x1a\x4a\xa9\x9f\x6f\xd2\x2c\x83\x34\x87\xda\x99\xc5\x06\xe2\x62\xb5\x31\x57\x91\x54\x64\x34\x62\xfd\x00\x02\x9c\xba\xcc\xc2\xfd\x94\x4d\x94\x73\x2d\xb9\xa6\x6f\xc7\x3d\x7a\x59\x20\x7c\x01\x25\x4a\x3a\x33\x58\x08\x83\x9b\x32\x8f\x07\xc6\x57\x9c\x38\x5e\xa5\x5b\xff\x1c\x2f\xc4\x80\x83\xe3\x71\xff\x90\xe1\xf7\xb4\xd9\x71\x3e\xa1\x3b\x83\xef\xcc\x9d\x61\xd8\x0e\xf2\xd5\xb5\x3b\x6e\xdf\x03\xcb\xf7\x2e\x5c\xc7\xe2\xa0\xd1\xcf\xc0\xf6\x61\x36\xb5\xb5\x2b\x21\xf2\x9d\xbe\x2f\x40\x8f\x71\x56\x26\x94\x7c\x7e\x19\xb2\xbd\xa2\xf3\x62\x6c\xa0\x67\x1b\xb5\x70\x70\x99\x37\x99\xb1\x09\xc2\xd4\x9d\x4e\xc2\x6f\xae\x3e\x35\x8b\xb9\xee\x4b\x66\x0f\x63\x41\x91\xa2\xf9\x4a\x14\xcb\xb9\xa0\x28\x21\x31\x1c\xc1\x80\x68\x50\x45\x6f\xfb\x30\x18\xec\x74\x74\x1d\xd5\xbf\xe6\x68\x03\xdf\x45\x68\x41\x8c\x77\x8b\xf0\xda\xc2\x29\x77\x7c\x0f\xfe\xbe\x44\x0f\x92\x72\x95\xa5\xb1\x26\x2c\x16\x3f\x29\x56\xc0\x2f\xb1\x8b\x18\x30\x27\x44\xf0\x7c\xee\x58\x08\x43\x51\x64\xd4\x06\x86\x28\xd3\x0f\x1b\xa0\xc7\x54\x2a\x39\x02\x79\x9f\xae\x56\xba\xb8\x6a\x1d\xc3\x71\x17\x0c\xf9\x2c\xf0\x5a\xd1\x78\x76\xf5\x3c\x18\x8c\x0d\xad\x51\x27\x74\x2f\x67\xd6\x22\x55\x27\x3a\x53\x43\x7c\x80\x33\x35\xf0\xaf\x74\xe6\x56\x44\xb9\x6a\xa7\x4c\xd7\xa4\x9d\x47\x93\x80\x79\xbc\x73\x7c\xdc\xef\x9a\xd1\xf7\x10\x64\x7c\x47\xcb\x48\x36\x07\x50\x9b\xdf\x32\xbd\x31\xbb\xae\x6b\x70\x2e\xb4\x4b\x80\xd7\x4e\xc8\xc3\x27\xc5\x3e\x06\xd3\x84\x38\x52\x51\x56\xdc\xd6\xcd\x4a\xc2\x1f\x90\xe6\x8a\x84\xae\x2f\x5d\x40\xba\xa2\x64\x2b\xfe\x59\xa8\x6b\xc9\xf7\xb6\xf0\x5d\xc0\x9d\xa4\x76\xe6\xd7\x1b\x43\x74\xd1\xe2\x7a\xa7\x56\x57\xf5\xfa\x10\x9c\x63\x70\x98\xcb\x31\x00\x1b\x2f\xd8\xcc\xad\x5a\xff\x77\xc7\xc5\xc9\x3e\x94\xa7\xcc\x0d\xeb\xeb\x41\xf6\x54\xd7\xce\xde\x36\xc7\xa8\x69\xac\xa3\xa6\x9f\x8d\xc0\x46\x17\x39\x1e\xab\xba\x8d\x7f\x9a\xea\x7d\x11\x3d\x71\x63\x4b\xda\x37\x87\xa6\x81\x7f\x55\x25\xd0\xaa\x5c\x64\x69\x7c\x4c\xb6\xe8\xad\xcf\x4e\xa1\x2f\x2f\x5e\xf3\x8a\xb6\x58\xa9\x74\x99\xfe\x4b\x09\x3c\x90\x90\x9a\x18\x8a\x9b\xff\xa3\x68\x2a\x3d\xd1\xff\x7e\xd5\x1d\x01\x3d\x2a\xca\xf5\xb2\xc3\xe1\xe9\x9b\xc5\x5b\xa3\xd3\x97\x0f\x0c\xab\x00\x25\x89\x94\x24\x3c\xa4\xb4\xee\xe1\x49\xbd\xf1\xe4\x02\xda\x03\xd3\x3f\x93\x1a\x90\x9e\xe5\xd3\xd7\x9a\x2b\xe4\x81\x63\x55\xd6\x2c\x49\x89\x34\xee\x6b\x4d\xbd\xf1\x64\x6b\xf6\xc0\xf4\xb7\xa6\x01\x79\x67\x6b\x6c\xc6\x59\x8f\x3e\xa4\x97\x9d\x6c\xc3\xab\x20\xfd\x4d\xa8\x20\xde\xab\xb9\x76\xe2\x79\xef\xce\xfa\x2a\xf8\x09\x62\x3f\xb0\xa7\x6a\xae\x6d\x0b\xe9\xe7\xdc\x7b\xf5\x8d\x43\x58\xc7\xf9\x75\x64\x07\x79\x93\xd2\x8f\x48\x95\x7d\x1c\xa7\x3b\xf0\x96\xc4\xe9\x9b\x39\x8e\x77\xe1\xf7\x30\x52\x2f\x3b\x39\x57\x5e\x05\xe9\x6f\x51\x05\xd1\x33\x3b\xd0\xb3\xf5\x05\x77\x7b\x3b\x36\x4d\x41\xad\xcb\xe9\xef\x1f\xa8\xf1\x1a\xad\x19\xc7\x2d\x4e\x53\xed\xbf\x6b\xc4\xfe\x15\xe3\xb5\xf1\x7c\xca\xab\x66\x96\x24\x95\x4a\xa4\x8b\x52\x51\x32\xd7\xd6\x6f\xa3\x3e\x1b\x1b\x9d\x21\x6f\xb7\xab\x1a\xf6\xca\x3c\xa1\x9b\x34\xa7\x64\xbe\x3d\xd3\xe7\xe3\x9e\x69\xc2\x9a\x86\x82\x20\x2f\x14\xe8\x05\xe9\x92\x64\x1c\x65\x04\x7f\x8e\xe0\x67\x29\x15\x08\x52\xa5\xc8\xf7\x8e\x75\x15\x5b\xf8\xcd\x0d\xb9\x36\x63\x78\xce\xce\xcf\xcf\x87\x07\xb8\xf4\xd5\x31\x8a\x63\x92\xb2\xfa\x5a\xd5\x8f\x0c\x3d\xfb\xc0\x37\x88\xff\x02\x00\x00\xff\xff\x56\x04\x46\xf5\x36\x13\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xc4\x98\xcd\x72\x9b\x48\x10\xc7\xef\x3c\x45\x1f\x54\xa5\xa8\x56\xa4\x5c\x7b\x55\xe5\x30\x86\xb6\x4c\x2d\x06\x05\x46\x59\xe7\xa4\x42\xd0\xb6\x89\x11\x68\x67\x06\xcb\xda\xa7\x4f\x0d\x20\x05\xfc\x21\x63\xcb\x4e\x74\x92\xcd\xcc\xbf\xbb\x7f\xd3\xdd\x9a\xc6\x34\x55\xb4\xcc\x08\x54\x01\x32\xba\x23\x88\x8b\xd5\x2a\xca\x13\x09\xb2\x00\x75\x43\x5b\x88\xa3\x1c\x96\x04\xa2\xcc\x61\x73\x43\x39\x44\x49\x92\xe6\xd7\x90\xd3\x06\xf2\x22\x21\x69\x80\x15\x20\xe3\x08\x9c\x9d\xba\x08\xa1\x75\x8e\x17\x6c\x61\x31\xce\x5c\x7f\xfa\x59\xd0\xaa\x50\xb4\xd8\xa9\x7e\x32\x00\x00\x6e\x69\x0b\x1c\x2f\x39\xcc\x02\xe7\x82\x05\xdf\xe1\x1f\xfc\x3e\xae\x9e\x48\xfa\x0f\x42\x0c\x1c\xe6\xd6\x7f\x2b\x11\xe5\x32\x8a\x55\x5a\xe4\x51\x06\xa7\xbe\xef\x22\xf3\xea\x47\x8d\x64\x25\x64\x8c\x26\x86\x61\x07\xfe\x0c\x66\x81\x6f\xa1\x3d\x0f\x10\xe8\x9e\xe2\x52\xd1\x82\xee\x48\x6c\x37\x37\x24\xe8\x93\xa2\x7b\x35\xde\xa9\xe8\x2d\x86\x69\x4a\x55\x2e\x75\xf0\x51\x96\x15\x1b\x28\xa5\xfe\x7e\x95\x66\x19\xa4\x39\xd4\x64\x96\x5b\x88\x8b\xf5\xd6\x5c\x47\x52\x91\xd1\x04\xeb\x07\x10\xe0\xcc\x65\x16\x1e\x36\xd9\x78\xb9\xd0\x21\xd7\xe6\xdb\x7e\x8f\x9f\x0e\x10\xbe\x80\x12\x25\x8d\x0c\x16\xc2\xe0\xaa\xcc\xe3\x81\x71\x8a\x53\xc7\x33\xa0\xf9\x38\x5e\x88\x01\x07\xc7\xe3\xfe\x4b\xc0\x6f\x69\xbb\xb7\xf9\xc0\xdc\x08\xbe\x31\x77\x8e\x61\xdb\xc9\x67\xd7\xee\x6d\xfb\x1e\x58\xbe\x77\xe6\x3a\x16\x07\xad\x3e\x02\xdb\x87\xf9\xcc\xd6\x54\x42\xe4\xfb\xf8\xbe\x00\xdd\xc7\x59\x99\x50\xf2\xf9\x69\xc9\xf6\x8a\xce\x83\x89\x81\x9e\x6d\xd4\x81\x83\xcb\xbc\xe9\x9c\x4d\x11\x66\xee\x6c\x1a\x7e\x75\xf5\xa9\x59\xcc\x75\x9f\x82\x3d\x8c\x05\x45\x8a\x16\x6b\x51\xac\x16\x82\xa2\x84\xc4\x70\x0c\x03\xa2\x81\x01\x00\xda\xcf\xc1\x60\x1f\x47\x97\xa8\xfe\x34\x47\x1b\xf8\x2e\x42\x4b\x62\xb2\x5f\x84\x97\x16\xce\xb8\xe3\x7b\xf0\xef\x39\x7a\x90\x94\xeb\x2c\x8d\xb5\xc1\x62\xf9\x83\x62\x05\xfc\x1c\xbb\x8a\x01\x73\x42\x04\xcf\xe7\x8e\x85\x30\x14\x45\x46\x6d\x61\x88\x32\xfd\x65\x0b\x74\x9f\x4a\x25\xc7\x20\x6f\xd3\xf5\x5a\x17\x57\x1d\xc7\x70\xd2\x15\x43\x3e\x0f\xbc\x5f\xff\xd3\x90\x00\x00\x06\x83\x89\xa1\x63\x1c\x4d\x7a\x92\xd9\x88\x54\x1d\x49\xa6\x96\xf8\x00\x32\xb5\xf0\xef\x24\x73\x2d\xa2\x5c\xb5\x53\xa6\x0b\x69\xcf\x68\x1a\x30\x8f\x77\x8e\x8f\xfb\x5d\x18\x7d\x0f\x41\xc6\x37\xb4\x8a\x64\x73\x00\x35\xfc\x16\xf4\x06\x76\x5d\xd7\xe0\x9c\x69\x4a\x80\x97\x4e\xc8\xc3\x07\xc5\x3e\x01\xd3\x84\x38\x52\x51\x56\x5c\xd7\xcd\x4a\xc2\x5f\x90\xe6\x8a\x84\xae\x2f\x5d\x40\xba\xa2\x64\xcb\xff\x79\xa8\x6b\xc9\xf7\x76\xf2\x5d\xc1\x7d\x48\xed\xcc\xaf\x37\x86\xe8\xa2\xc5\xf5\x4e\x1d\x5d\xd5\xeb\x43\x70\x5e\xa3\xc3\x5c\x8e\x01\xd8\x78\xc6\xe6\x6e\xd5\xfa\xbf\x39\x2e\x4e\x0f\xa9\x3c\xb4\xdc\x58\x7d\xde\xc9\x9e\xd1\xb5\xb3\xb7\x6d\x63\xdc\x34\xd6\x71\xd3\xcf\xc6\x60\xa3\x8b\x1c\x5f\x1b\x75\x5b\xff\xb8\xa8\x0f\x79\xf4\x80\xc6\xce\x68\xdf\x1c\x9a\x05\xfe\x45\x95\x40\xeb\x72\x99\xa5\xf1\x6b\xb2\x45\x6f\x7d\x74\x0a\x7d\xed\xe2\x25\xaf\xcc\x16\x6b\x95\xae\xd2\xff\x29\x81\x3b\x12\x52\x1b\x86\xe2\xea\x97\x17\x4d\xa5\x27\xfa\xe7\x57\xdd\x10\xd0\xbd\xa2\x5c\x2f\x7b\xd9\x3d\x7d\xb3\x78\xab\x77\xfa\xf2\x81\x61\xe5\xa0\x24\x91\x92\x84\xbb\x94\x36\x3d\x98\xd4\x1b\x8f\x2e\xa0\x03\x32\xfd\x33\xa9\x11\xe9\x59\x3e\x7d\xd1\x5c\x20\x0f\x1c\xab\x42\xb3\x22\x25\xd2\xb8\x2f\x9a\x7a\xe3\xd1\x68\x0e\xc8\xf4\x47\xd3\x88\xbc\x33\x1a\x9b\x71\xd6\xa3\x0f\xe9\x65\x47\x63\x78\x56\xa4\x3f\x84\x4a\xe2\xbd\x9a\x6b\xc7\x9f\xf7\xee\xac\xcf\x8a\x1f\x11\xec\x07\xf6\x54\x6d\x6b\xd7\x42\xfa\x91\x7b\xaf\xbe\xf1\x92\xd6\xeb\x78\xbd\xb2\x83\xbc\x29\xd2\x8f\x48\x95\x43\x36\x8e\x27\xf0\x96\xc4\xe9\x9b\x39\x8e\x77\xe6\xf7\x00\xa9\x97\x1d\x9d\x2b\xcf\x8a\xf4\x47\x54\x49\xf4\xcc\x0e\xf4\x6c\x7d\xc1\xdd\xdd\x8e\x4d\x53\x50\xeb\x72\xfa\xe7\x07\x6a\xbc\x44\x6b\xce\x71\xa7\xd3\x54\xfb\x9f\x1a\xb1\x7f\xc7\x78\x6d\x3c\x9e\xf2\xaa\x99\x25\x49\xa5\x12\xe9\xb2\x54\x94\x2c\x34\xfa\x9d\xd7\xa3\x89\xd1\x19\xf2\xf6\xbb\xaa\x61\xaf\xcc\x13\xba\x4a\x73\x4a\x16\xbb\x33\x7d\x3c\xee\x99\x26\x6c\x68\x28\x08\xf2\x42\x81\x5e\x90\xae\x48\xc6\x51\x46\xf0\xf7\x18\x7e\x94\x52\x81\x20\x55\x8a\xfc\xe0\x58\x57\x59\x0b\xbf\xba\x21\xd7\x30\x86\x27\xec\xe4\xe4\x64\xf8\x82\x2d\x7d\x75\x8c\xe2\x98\xa4\xac\xde\x56\xf5\x33\x86\x9e\xfd\xc2\x3b\x88\x9f\x03\x00\x56\x04\x46\xf5\x36\x13\x00\x00"),
 		},
 		"/versions/dev/0.1.5-dev/2-update_autovac_settings.sql": &vfsgen۰CompressedFileInfo{
 			name:             "2-update_autovac_settings.sql",
 			modTime:          time.Time{},
 			uncompressedSize: 522,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xa4\x91\x4d\x4b\xc4\x30\x10\x86\xef\xf9\x15\x73\x88\xb0\x2b\xcb\xd2\x3d\x78\x2a\x1e\xb2\xc9\xec\x07\x64\x37\x92\x46\xf4\x16\x62\x1b\x69\xb1\x1f\x10\xd3\x05\xfd\xf5\xd2\xba\x16\xc1\x4b\xc1\xb9\x84\xbc\x79\xe0\x7d\xc8\x08\x05\xb4\xe8\xaa\x48\x89\x40\x2e\x99\x46\x02\x00\x10\x40\x23\x57\x5a\xa4\x64\x8b\xfb\xe3\x79\xcc\x76\x4a\x43\x80\xeb\x65\x98\x0c\x25\x72\x03\xb7\x53\xb0\xd3\xea\x04\x19\x3f\xe0\x89\x59\xce\x0c\x93\x6a\xbf\x6e\x7c\x0c\x55\x3e\x21\x4f\x07\xd4\x08\x85\x7f\x75\x7d\x1d\x6d\x5e\xf6\xed\x9b\xad\xda\xe8\xc3\xc5\xd5\x23\x24\x95\x7a\x98\x68\x7c\x46\xfe\x68\x70\xa8\x3e\x31\xb3\xa0\x74\x7a\x19\x86\x49\x83\x1a\x0c\xdb\x4a\xfc\x69\x15\xcc\xb0\xf5\xcd\x11\x32\x34\xb0\x70\x7d\xec\x2e\x2e\xef\xfb\xc6\x5e\x8f\x58\x06\xff\x5e\x76\x75\x01\xf7\x70\x97\x24\x49\xb2\x82\x5f\x90\x6b\x5d\xfd\xf1\xe9\xff\x52\xcb\xa9\x96\xd2\x15\x84\x75\x74\x2f\xb5\xb7\xad\x6b\xfc\x32\xfd\x9f\xab\xcd\x50\x1f\x31\x9b\xa9\xbc\x99\x21\xbc\x99\xa1\x8b\x67\x31\xfe\x73\x4a\xf0\x2c\xc8\xf7\xfa\x53\xf2\x15\x00\x00\xff\xff\xb6\x7d\x90\x8e\x0a\x02\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xa4\x91\x4d\x4b\xc4\x30\x10\x86\xef\xf9\x15\x73\x88\xb0\x2b\xcb\xd2\x3d\x78\x2a\x1e\xb2\xc9\xec\x07\x64\x37\x92\x46\xf4\x16\x62\x1b\x69\xb1\x1f\x10\xd3\x05\xfd\xf5\xd2\xba\x16\xc1\x4b\xc1\xb9\x84\xbc\x79\xe0\x7d\xc8\x08\x05\xb4\xe8\xaa\x48\x89\x40\x2e\x99\x46\x02\x00\x10\x40\x23\x57\x5a\xa4\x64\x8b\xfb\xe3\x79\xcc\x76\x4a\x43\x80\xeb\x65\x98\x0c\x25\x72\x03\xb7\x53\xb0\xd3\xea\x04\x19\x3f\xe0\x89\x59\xce\x0c\x93\x6a\xbf\x6e\x7c\x0c\x55\x3e\x21\x4f\x07\xd4\x08\x85\x7f\x75\x7d\x1d\x6d\x5e\xf6\xed\x9b\xad\xda\xe8\xc3\xc5\xd5\x23\x24\x95\x7a\x98\x68\x7c\x46\xfe\x68\x70\xa8\x3e\x31\xb3\xa0\x74\x7a\x19\x86\x49\x83\x1a\x0c\xdb\x4a\xfc\x69\x15\xcc\xb0\xf5\xcd\x11\x32\x34\xb0\x70\x7d\xec\x2e\x2e\xef\xfb\xc6\x5e\x8f\x58\x06\xff\x5e\x76\x75\x01\xf7\x70\x97\x24\x49\xb2\x82\x5f\x90\x6b\x5d\xfd\xf1\xe9\xff\x52\xcb\xa9\x96\xd2\x15\x84\x75\x74\x2f\xb5\xb7\xad\x6b\xfc\x32\xfd\x9f\xab\xcd\x50\x1f\x31\x9b\xa9\xbc\x99\x21\xbc\x99\xa1\x8b\x67\x31\xfe\x73\x4a\xf0\x2c\xc8\xf7\xfa\x53\xf2\x35\x00\xb6\x7d\x90\x8e\x0a\x02\x00\x00"),
 		},
 		"/versions/dev/0.1.5-dev/3-drop_function_metric_view.sql": &vfsgen۰FileInfo{
 			name:    "3-drop_function_metric_view.sql",
@@ -202,7 +251,46 @@ var MigrationFiles = func() http.FileSystem {
 			modTime:          time.Time{},
 			uncompressedSize: 1160,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x8c\x53\xcb\x6e\xdb\x30\x10\xbc\xf3\x2b\xe6\x10\x40\x16\x20\x05\xed\xd9\xe8\x81\x91\xd7\x8e\x5a\x59\x32\x28\x2a\x8f\x5e\x04\x26\xa6\x6d\x21\xb4\xe4\x88\x54\xda\xfc\x7d\x61\xd9\x49\xd5\xa0\x0e\xcc\xe3\xee\xec\xec\xcc\x90\x8c\x04\x71\x49\x90\xfc\x2a\x21\xe4\xd1\x35\xcd\x79\x19\x71\xc9\x93\x6c\x76\xb9\x51\xa5\xd1\xca\x6a\xcb\x46\x0c\x00\x1e\x4d\x67\x9d\x6e\xcb\x5a\x6d\x35\x24\xdd\x49\x2c\x44\x3c\xe7\xe2\x1e\x3f\xe8\x3e\xe8\x21\x46\xab\xe5\x1b\xa2\x87\xbc\x97\xad\x2e\xad\x53\xad\x03\x64\x3c\xa7\x5c\xf2\xf9\x42\xfe\x1c\x76\xbb\xda\x55\xe6\x9f\x2e\xf3\xc7\x8c\x9d\x25\xb0\x34\xcd\xfa\xb4\xca\xe3\x49\x33\x89\xb4\x48\x92\x13\x4a\x4f\xc2\xfe\xab\xfc\x2f\x0c\x61\x88\xaa\xde\x6f\xad\x5e\xf4\xa7\x7e\x02\xbc\x9f\x30\x84\xfe\x3d\x9c\x19\x24\x89\xd1\xd0\x42\x30\x54\x1a\x0c\xf5\xf8\x7d\x3e\x2c\x0c\x91\xcb\xe2\x0a\xab\xa6\xc5\xaa\xab\x1f\x5d\xd5\xd4\x70\x1b\xe5\xe0\xda\x6a\xbd\xd6\x2d\x5c\x03\xd5\xb9\x66\xab\x5c\xf5\xa8\x8c\x79\xc5\x93\xd6\x3b\xb8\x8d\x86\x69\xd6\xd8\x97\x2c\x42\xb4\x5a\x19\x54\xdb\x9d\xd1\x5b\x5d\x3b\xd5\xd3\x54\x35\x36\xea\xd2\x3e\x9b\xb7\x6b\xc8\x04\x04\x2d\x12\x1e\x11\xa6\x45\x1a\xc9\x38\x4b\x4f\x5f\x8a\xea\x96\x95\x2b\x57\xf5\xc8\xef\x2d\x0a\x92\x85\x48\x73\x48\x11\xcf\x66\x24\x18\xcf\xd9\xc5\x5e\xf0\x05\xbb\xa2\x59\x9c\x1e\x30\x3c\xce\x09\xde\xf7\xce\x3a\x28\x58\xd7\x3d\x04\xb0\x9b\xa6\x33\x4b\x3c\x68\x34\x2f\xba\xfd\xd5\x56\xce\xe9\xda\x1b\x0f\x38\x91\xd2\xed\x98\x51\x3a\x19\x1f\x19\x91\xf0\x74\x56\xf0\x19\x61\x67\x76\x6b\xfb\x6c\x70\x93\x25\x5c\xc6\x09\x8d\xfb\xc0\xce\x4a\xe6\xfd\xe9\x1d\xf4\xe2\x83\xb1\x7e\x3f\x9f\x4a\x12\x88\xd3\x9c\x84\xdc\x87\x53\x2c\x26\x5c\x52\xdf\xfa\x24\x99\xbe\x3f\xcd\x04\x88\x47\xd7\x10\xd9\x2d\xa3\x3b\x8a\x0a\x49\x58\x88\x2c\xa2\x49\x21\xe8\x9c\x54\x0f\x5e\x96\x7a\xa5\x3a\xe3\xf0\xa2\x4c\xa7\x6d\xff\x0c\x7a\x24\x3b\xaa\x8a\x53\x99\x7d\x64\x3b\xce\x8c\x9e\xf4\x6b\x70\x18\xf4\xd9\x0d\x4f\x0a\xca\x31\xf2\xde\x56\x95\xae\xda\xea\xa6\x73\x5e\x00\xef\xeb\xd6\xf3\x0f\x1f\x02\x18\x42\x5a\xbd\x6a\xb5\xdd\xf4\x90\x2f\xd6\xf3\x59\x96\x22\xca\xd2\x69\x12\x47\x12\x7b\x7a\x1f\x93\xec\x98\x0a\x72\x92\x87\x65\xf8\x06\xba\x8b\x92\x62\x42\x93\xcb\xbe\x30\x66\x7f\x02\x00\x00\xff\xff\xf6\xc7\x1a\x9e\x88\x04\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x8c\x53\xcb\x6e\xdb\x30\x10\xbc\xf3\x2b\xe6\x10\x40\x16\x20\x05\xed\xd9\xe8\x81\x91\xd7\x8e\x5a\x59\x32\x28\x2a\x8f\x5e\x04\x26\xa6\x6d\x21\xb4\xe4\x88\x54\xda\xfc\x7d\x61\xd9\x49\xd5\xa0\x0e\xcc\xe3\xee\xec\xec\xcc\x90\x8c\x04\x71\x49\x90\xfc\x2a\x21\xe4\xd1\x35\xcd\x79\x19\x71\xc9\x93\x6c\x76\xb9\x51\xa5\xd1\xca\x6a\xcb\x46\x0c\x00\x1e\x4d\x67\x9d\x6e\xcb\x5a\x6d\x35\x24\xdd\x49\x2c\x44\x3c\xe7\xe2\x1e\x3f\xe8\x3e\xe8\x21\x46\xab\xe5\x1b\xa2\x87\xbc\x97\xad\x2e\xad\x53\xad\x03\x64\x3c\xa7\x5c\xf2\xf9\x42\xfe\x1c\x76\xbb\xda\x55\xe6\x9f\x2e\xf3\xc7\x8c\x9d\x25\xb0\x34\xcd\xfa\xb4\xca\xe3\x49\x33\x89\xb4\x48\x92\x13\x4a\x4f\xc2\xfe\xab\xfc\x2f\x0c\x61\x88\xaa\xde\x6f\xad\x5e\xf4\xa7\x7e\x02\xbc\x9f\x30\x84\xfe\x3d\x9c\x19\x24\x89\xd1\xd0\x42\x30\x54\x1a\x0c\xf5\xf8\x7d\x3e\x2c\x0c\x91\xcb\xe2\x0a\xab\xa6\xc5\xaa\xab\x1f\x5d\xd5\xd4\x70\x1b\xe5\xe0\xda\x6a\xbd\xd6\x2d\x5c\x03\xd5\xb9\x66\xab\x5c\xf5\xa8\x8c\x79\xc5\x93\xd6\x3b\xb8\x8d\x86\x69\xd6\xd8\x97\x2c\x42\xb4\x5a\x19\x54\xdb\x9d\xd1\x5b\x5d\x3b\xd5\xd3\x54\x35\x36\xea\xd2\x3e\x9b\xb7\x6b\xc8\x04\x04\x2d\x12\x1e\x11\xa6\x45\x1a\xc9\x38\x4b\x4f\x5f\x8a\xea\x96\x95\x2b\x57\xf5\xc8\xef\x2d\x0a\x92\x85\x48\x73\x48\x11\xcf\x66\x24\x18\xcf\xd9\xc5\x5e\xf0\x05\xbb\xa2\x59\x9c\x1e\x30\x3c\xce\x09\xde\xf7\xce\x3a\x28\x58\xd7\x3d\x04\xb0\x9b\xa6\x33\x4b\x3c\x68\x34\x2f\xba\xfd\xd5\x56\xce\xe9\xda\x1b\x0f\x38\x91\xd2\xed\x98\x51\x3a\x19\x1f\x19\x91\xf0\x74\x56\xf0\x19\x61\x67\x76\x6b\xfb\x6c\x70\x93\x25\x5c\xc6\x09\x8d\xfb\xc0\xce\x4a\xe6\xfd\xe9\x1d\xf4\xe2\x83\xb1\x7e\x3f\x9f\x4a\x12\x88\xd3\x9c\x84\xdc\x87\x53\x2c\x26\x5c\x52\xdf\xfa\x24\x99\xbe\x3f\xcd\x04\x88\x47\xd7\x10\xd9\x2d\xa3\x3b\x8a\x0a\x49\x58\x88\x2c\xa2\x49\x21\xe8\x9c\x54\x0f\x5e\x96\x7a\xa5\x3a\xe3\xf0\xa2\x4c\xa7\x6d\xff\x0c\x7a\x24\x3b\xaa\x8a\x53\x99\x7d\x64\x3b\xce\x8c\x9e\xf4\x6b\x70\x18\xf4\xd9\x0d\x4f\x0a\xca\x31\xf2\xde\x56\x95\xae\xda\xea\xa6\x73\x5e\x00\xef\xeb\xd6\xf3\x0f\x1f\x02\x18\x42\x5a\xbd\x6a\xb5\xdd\xf4\x90\x2f\xd6\xf3\x59\x96\x22\xca\xd2\x69\x12\x47\x12\x7b\x7a\x1f\x93\xec\x98\x0a\x72\x92\x87\x65\xf8\x06\xba\x8b\x92\x62\x42\x93\xcb\xbe\x30\x66\x7f\x06\x00\xf6\xc7\x1a\x9e\x88\x04\x00\x00"),
+		},
+		"/versions/dev/0.3.1-dev": &vfsgen۰DirInfo{
+			name:    "0.3.1-dev",
+			modTime: time.Time{},
+		},
+		"/versions/dev/0.3.1-dev/1-add_downsampling.sql": &vfsgen۰CompressedFileInfo{
+			name:             "1-add_downsampling.sql",
+			modTime:          time.Time{},
+			uncompressedSize: 1008,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xa4\x93\x5f\x6f\xd3\x30\x14\xc5\xdf\xf3\x29\xce\x63\x2b\xa5\x88\xf7\x49\x48\x5e\x7a\x9b\x59\x73\x93\xc8\x76\x10\xe3\x25\x32\x8b\x61\x11\xf9\x53\x39\x2e\x85\x6f\x8f\xdc\x26\xed\x56\x10\x68\x90\x17\x2b\xce\xbd\xbf\x73\xcf\xd5\x49\x22\x89\x69\x82\x4a\xee\x68\xcb\xc0\x37\xc8\x72\x0d\xfa\xc0\x95\x56\xd3\x65\xb5\x66\x9a\x55\x32\x17\xa2\x2c\x6e\xb0\x5a\xa1\x1e\x0e\xfd\x68\xba\x5d\x6b\x6b\x9c\xce\x31\xc6\xd0\x5b\x78\xf3\xa9\xb5\xd8\x59\x87\xce\x7a\xd7\x3c\x46\xa9\x64\x99\x46\xa9\x58\x4a\xc8\xb3\x59\xe4\x57\x2c\x74\x8e\x9d\x1b\xba\xca\x59\x53\x5b\x77\x33\x35\x2a\x12\x94\xe8\xd0\xc9\x84\x80\x66\xb7\x82\x14\xf8\x6b\x38\x4c\x68\x92\x58\xd3\x86\x95\x42\xa3\x90\xfc\x3d\x17\x94\xfe\x8d\x72\xad\x3e\x29\xff\x7e\xc8\x57\xb8\x3b\xb8\xc6\x5f\xbb\x8b\xc1\x33\x45\x52\xc7\x28\x8b\x35\xd3\x14\x63\x4d\x82\x34\xfd\x8b\xeb\x99\xff\xff\xae\xff\x34\xd5\xd5\x36\x66\xd1\x68\xb5\x82\x7f\xb2\x73\x22\x30\x7c\x86\x99\x72\x00\xe3\x2c\xdc\xd0\x86\xc0\xec\x77\x30\x1e\x06\xce\x8e\x43\xbb\xf7\xcd\xd0\x87\x2b\x3f\x1c\x7b\x0f\xc6\x5b\xd7\x19\xf7\x15\x0b\xfb\xfd\xb1\xdd\x8f\xcd\x37\xbb\x8c\x03\xd9\x20\x2b\x85\x78\x56\xd0\x59\xd3\x8f\xe8\x07\xff\xd4\xf4\x5f\x70\x30\xe3\x33\x81\x1f\xd6\x47\x53\xae\x8f\xc3\xce\x6e\x13\xa6\x99\xc8\xd3\x37\x97\x00\x57\x67\x60\xb4\x88\x00\x4c\x03\x57\x4d\x1d\x5e\xc0\x33\x1d\x8e\xf0\x4b\x1c\xe5\x25\x6d\x48\x52\x96\x90\xba\x46\x4e\x46\x17\x4d\xbd\x0c\x3b\x9a\xb6\x95\x30\x95\xb0\x35\xc5\x47\xf4\xc5\x71\xd5\x8d\xb8\xe5\x69\xa0\x9f\xd1\xc9\x1d\x25\xf7\x58\xbc\x2c\x7a\x87\xb7\xcb\x53\xf3\xc5\x78\x78\x34\xdf\x92\xd2\x6c\x5b\xe8\x8f\xa7\xcf\x85\xe4\x5b\x26\x1f\x70\x4f\x0f\x58\x9c\x3d\xc4\x2f\x35\x97\xd1\xf2\x26\xfa\x39\x00\x03\x25\x45\xb5\xf0\x03\x00\x00"),
+		},
+		"/versions/dev/0.3.1-dev/2-add_registered_views.sql": &vfsgen۰CompressedFileInfo{
+			name:             "2-add_registered_views.sql",
+			modTime:          time.Time{},
+			uncompressedSize: 406,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x74\xd0\xdf\x4a\xc3\x30\x14\x06\xf0\xfb\x3c\xc5\x77\xa9\xb0\xee\x05\xbc\x8a\x23\xa8\x98\xfd\xeb\x22\x38\x44\x42\xda\x1d\xdb\x40\xd3\x60\x4e\xaa\xf8\xf6\xd2\x6e\xea\x2e\xdc\x65\xc8\x2f\x5f\xce\xf9\x8a\x02\xbb\xad\xc6\x87\xa7\x4f\x9e\x21\x26\x64\x57\x75\xc4\x70\xfd\x01\x75\xec\xb3\xef\x87\x38\x30\x5c\xd3\x24\x6a\x5c\x26\x9e\xe1\x7d\xa0\xf4\x35\x2a\x38\xc6\x26\xc5\xb0\xd5\x08\x94\x93\xaf\x79\x2e\x8a\x02\xa6\x25\x9f\xd0\xb9\x8a\x3a\xd4\xb1\x1b\x42\xcf\xa8\xa8\x8e\x81\x90\x5b\x3a\x5e\x30\xe2\xdb\x74\x62\x4a\x9e\x78\x2e\x16\xa5\x92\x46\xc1\xc8\x5b\xad\xb0\x5b\xdc\xab\xa5\xb4\x0b\x69\xa4\x5e\xdf\xcd\x13\x35\x9e\x33\x25\x3a\xd8\x71\x4e\x71\x25\x00\x9c\xbe\xb4\xbd\x0b\x04\xc0\xa8\x67\x03\x60\xb5\x36\x58\x3d\x69\x8d\x4d\xf9\xb0\x94\xe5\x1e\x8f\x6a\x3f\x9b\xf8\xf8\xd2\x72\xdd\x52\x70\x00\x56\x72\xa9\xce\xf8\x19\x39\xe5\x5d\x20\xd9\x07\xb2\xc7\xa5\x2e\xa6\xb8\x6e\xf8\x33\xff\x92\xa9\x02\xfb\xd3\xcd\x48\x5e\x5e\x7f\x89\xb8\xbe\x11\xdf\x03\x00\x70\xdb\x46\x9f\x96\x01\x00\x00"),
+		},
+		"/versions/dev/0.3.1-dev/3-add_duplicate_policy.sql": &vfsgen۰CompressedFileInfo{
+			name:             "3-add_duplicate_policy.sql",
+			modTime:          time.Time{},
+			uncompressedSize: 375,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x64\x8f\x41\x6e\xc2\x30\x10\x45\xf7\x3e\xc5\xdf\x25\x91\x08\x07\x28\x2b\x37\xb8\x05\xd5\x04\x09\x9c\xaa\x3b\x64\x25\x43\x71\xeb\xe0\xc8\x76\xa0\xb9\x7d\x95\x06\x75\x01\xcb\x19\xcd\xfc\xf7\x5f\x9e\x43\x9d\x08\x9d\xb3\xa6\x1e\x70\x74\x1e\x41\xb7\x9d\xa5\x80\xeb\xc9\x05\x42\x20\x6f\x28\x40\x9f\x1b\x44\xd3\x12\xb4\xf5\xa4\x9b\x01\xf4\x63\x42\x7c\x82\xf9\x3c\x3b\x4f\x33\xb8\x0b\xf9\xab\x37\x91\xe0\x3c\x3c\x7d\x51\x1d\x11\x4f\xd4\xce\x59\x9e\xa3\xac\xa4\x44\x74\xe8\x03\x8d\x4b\x34\x74\xd4\xbd\x8d\x68\xfa\xce\x9a\x5a\x47\x3a\x4c\xf8\x39\xe3\x52\x89\x1d\x14\x7f\x96\x02\xfb\x62\x25\x36\xfc\x50\x70\xc5\xe5\xf6\x75\xde\x52\xf4\xa6\x06\x5f\x2e\x51\x6c\x65\xb5\x29\x1f\xde\xa1\xc4\x87\xc2\x52\xbc\xf0\x4a\xaa\x3f\x28\x03\x80\x62\x25\x8a\x37\xa4\x0f\xd7\xeb\x12\x69\x32\xf5\x4f\x66\x48\xfe\x0d\xc6\x61\x32\x48\xb2\x6c\xc1\xd8\xba\xdc\x8b\x9d\xc2\xba\x54\xdb\xfb\x4e\x37\x91\xf4\x9b\x86\xd9\x45\xdb\x9e\x32\xbc\x73\x59\x89\x3d\x4b\x93\x7b\xde\x18\x7b\xa3\x65\x0b\xf6\x3b\x00\xba\x3a\x16\x50\x77\x01\x00\x00"),
+		},
+		"/versions/dev/0.3.1-dev/5-reset_rollups.sql": &vfsgen۰CompressedFileInfo{
+			name:             "5-reset_rollups.sql",
+			modTime:          time.Time{},
+			uncompressedSize: 458,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x64\x8e\xc1\x8e\xda\x30\x18\x84\xef\x7e\x8a\x39\x50\xd1\x4a\x0b\x2f\x10\xed\x21\x9b\xfc\xbb\x8b\xe4\x8d\x91\x31\x6a\x6f\xc8\x4b\x7e\xc0\x22\x71\x22\xdb\x34\x7d\xfc\x2a\x5e\x44\x0f\xf5\x69\x3c\xf3\xcf\xa7\x59\xad\x60\x2e\x8c\x30\x74\xdd\x6d\x44\x18\xa6\x08\x1b\x18\x7e\x98\x90\x5c\xcf\x31\xd9\x7e\xe4\x16\x93\x4b\x17\xa4\x0b\x83\x7d\x8b\xe1\x34\x4b\x17\xf0\x79\x3b\x5e\x39\xc1\xfa\x16\x57\xe6\x31\x1f\x9c\x5c\x88\x49\xac\x56\xf8\x6d\xbb\x1b\xe7\x6c\xb6\x9d\x3f\x06\xb6\x91\xef\xe5\x7b\xf5\x09\x71\xf8\xc2\xfe\x71\x31\x39\x7f\xbe\x0f\xf9\x1a\x31\x6b\x6e\x71\x1b\x61\xcf\xd6\xf9\xb5\xa8\x15\x16\x0b\x51\x53\x25\x4b\x4d\x02\x00\x02\x34\x55\x4a\xd7\x85\x78\xa1\xb7\x4d\x93\xbd\x57\xa5\x11\xb0\x69\xb0\x23\x49\x95\x41\xb2\x9f\x1d\x7b\xdb\x33\x5e\xb5\xfa\xc0\x78\x3e\x64\x27\xe2\xe7\x3b\x69\x42\x3c\x5e\xb8\xb7\x39\x7f\xc6\x72\x57\xbd\xd3\x47\x79\xa8\x4b\x53\x1e\xb4\x92\x72\xbf\x5d\x42\x2a\xb5\xcd\xe4\xf9\xd1\x2f\xaa\xf6\x86\x70\x1a\x42\x6f\xd3\xf7\x65\xad\xd5\x16\xa6\x7c\x91\x84\xff\xbb\xeb\x6f\x9b\xe5\x13\xc2\xfa\x31\xe1\x47\x91\x41\xd4\xd4\x99\x5a\x08\x6a\x6a\xb1\x58\x14\x42\xec\xb7\x75\x69\x1e\x8c\xaa\x34\xa5\x54\x6f\xeb\x76\x98\x7c\xb4\xfd\xd8\xf1\x61\xb2\x89\x43\x6f\xc3\x15\x3b\x32\xf8\xf7\x7b\x46\xb3\x97\xb2\x10\x7f\x07\x00\xff\xaf\x2d\x0a\xca\x01\x00\x00"),
+		},
+		"/versions/dev/0.3.1-dev/6-add_backfill_chunks.sql": &vfsgen۰CompressedFileInfo{
+			name:             "6-add_backfill_chunks.sql",
+			modTime:          time.Time{},
+			uncompressedSize: 457,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x74\x8f\xd1\x6a\xc2\x30\x14\x86\xef\xf3\x14\xff\x85\xa0\x42\xeb\x0b\x78\x95\x95\x6e\x93\x55\x1d\xda\xc1\xbc\x2a\x31\xfd\xb7\x16\xdb\x44\x92\x38\xb7\xb7\x1f\x0d\x28\x0a\xdb\xf5\x77\xbe\xef\x9c\x93\xa6\x28\x1b\x42\x37\x27\x73\xf0\xa8\xa9\x6d\x7f\x74\xf4\x9e\x35\x82\xc5\x5e\xe9\xc3\x47\xdb\x75\xf0\xaa\x3f\x76\xf4\x68\x4d\xb0\x08\x0d\xfb\x04\xe7\xa6\xd5\x0d\x94\x23\x6e\x1c\xf5\xa9\x5a\x03\x6b\x34\x87\x29\x91\xa6\x57\xf3\x4c\xc7\x6b\x8f\xf5\x0c\x99\xa3\x0a\xac\x61\x0d\xf8\x45\xf7\x03\x63\x6b\x26\x50\x7e\x30\x2f\x07\x0d\xf9\xbb\xa3\xac\x89\xb8\x56\x41\x45\xc1\xcf\x44\x26\x8b\x02\xfc\xa6\x3e\x05\x56\x31\x75\x6e\xe8\x38\x19\xeb\xb8\xa1\xba\x2c\xad\x62\x73\x9c\x60\x44\x8e\x44\xb6\xc9\x65\x99\xa3\x94\x0f\x45\x8e\xc5\x23\x56\xeb\x12\xf9\xfb\x62\x5b\x6e\xb1\xcd\x9e\xf3\xa5\xac\x32\x59\xca\x62\xfd\x34\xbb\xf7\xc5\x44\x00\x40\xcf\xe0\x5a\x5d\x05\xb5\xef\x88\x95\x5c\xe6\x31\xb0\x7a\x2b\x8a\x24\xf2\x38\x5b\x79\xdd\xb0\x57\xff\x73\xa3\x7a\x02\x7f\xf1\xd7\xcd\x62\x29\x37\x3b\xbc\xe4\x3b\x4c\x6e\x63\xc9\x8d\x3a\x15\xd3\xb9\x18\xbe\x99\xce\xc5\xef\x00\x47\x53\x58\x41\xc9\x01\x00\x00"),
 		},
 	}
 	fs["/"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
@@ -212,8 +300,11 @@ var MigrationFiles = func() http.FileSystem {
 	}
 	fs["/idempotent"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
 		fs["/idempotent/base.sql"].(os.FileInfo),
+		fs["/idempotent/downsampling.sql"].(os.FileInfo),
 		fs["/idempotent/ha.sql"].(os.FileInfo),
 		fs["/idempotent/matcher-functions.sql"].(os.FileInfo),
+		fs["/idempotent/pushdown-functions.sql"].(os.FileInfo),
+		fs["/idempotent/registered-views.sql"].(os.FileInfo),
 	}
 	fs["/preinstall"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
 		fs["/preinstall/000-utils.sql"].(os.FileInfo),
@@ -223,6 +314,10 @@ var MigrationFiles = func() http.FileSystem {
 		fs["/preinstall/004-matcher_operators.sql"].(os.FileInfo),
 		fs["/preinstall/005-install_uda.sql"].(os.FileInfo),
 		fs["/preinstall/006-tables_ha.sql"].(os.FileInfo),
+		fs["/preinstall/007-downsampling.sql"].(os.FileInfo),
+		fs["/preinstall/008-registered_views.sql"].(os.FileInfo),
+		fs["/preinstall/009-duplicate_policy.sql"].(os.FileInfo),
+		fs["/preinstall/010-backfill_chunks.sql"].(os.FileInfo),
 	}
 	fs["/versions"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
 		fs["/versions/dev"].(os.FileInfo),
@@ -236,6 +331,7 @@ var MigrationFiles = func() http.FileSystem {
 		fs["/versions/dev/0.1.4-dev"].(os.FileInfo),
 		fs["/versions/dev/0.1.5-dev"].(os.FileInfo),
 		fs["/versions/dev/0.2.2-dev"].(os.FileInfo),
+		fs["/versions/dev/0.3.1-dev"].(os.FileInfo),
 	}
 	fs["/versions/dev/0.1.0-beta.2.dev"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
 		fs["/versions/dev/0.1.0-beta.2.dev/1-drop_procedure_named_drop_chunks.sql"].(os.FileInfo),
@@ -261,6 +357,13 @@ var MigrationFiles = func() http.FileSystem {
 	fs["/versions/dev/0.2.2-dev"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
 		fs["/versions/dev/0.2.2-dev/1-set_up_ha.sql"].(os.FileInfo),
 	}
+	fs["/versions/dev/0.3.1-dev"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
+		fs["/versions/dev/0.3.1-dev/1-add_downsampling.sql"].(os.FileInfo),
+		fs["/versions/dev/0.3.1-dev/2-add_registered_views.sql"].(os.FileInfo),
+		fs["/versions/dev/0.3.1-dev/3-add_duplicate_policy.sql"].(os.FileInfo),
+		fs["/versions/dev/0.3.1-dev/5-reset_rollups.sql"].(os.FileInfo),
+		fs["/versions/dev/0.3.1-dev/6-add_backfill_chunks.sql"].(os.FileInfo),
+	}
 
 	return fs
 }()
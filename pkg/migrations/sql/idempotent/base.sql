@@ -1099,6 +1099,70 @@ LANGUAGE SQL VOLATILE;
 COMMENT ON FUNCTION SCHEMA_PROM.reset_metric_retention_period(TEXT)
 IS 'resets the retention period for a specific metric to using the default';
 
+CREATE OR REPLACE FUNCTION SCHEMA_CATALOG.get_default_duplicate_policy()
+    RETURNS TEXT
+AS $func$
+    SELECT value FROM SCHEMA_CATALOG.default WHERE key='duplicate_policy';
+$func$
+LANGUAGE SQL STABLE PARALLEL SAFE;
+GRANT EXECUTE ON FUNCTION SCHEMA_CATALOG.get_default_duplicate_policy() TO prom_reader;
+
+CREATE OR REPLACE FUNCTION SCHEMA_CATALOG.get_metric_duplicate_policy(metric_name TEXT)
+RETURNS TEXT
+AS $$
+    SELECT COALESCE(m.duplicate_policy, SCHEMA_CATALOG.get_default_duplicate_policy())
+    FROM SCHEMA_CATALOG.metric m
+    WHERE id IN (SELECT id FROM SCHEMA_CATALOG.get_metric_table_name_if_exists(get_metric_duplicate_policy.metric_name))
+    UNION ALL
+    SELECT SCHEMA_CATALOG.get_default_duplicate_policy()
+    LIMIT 1
+$$
+LANGUAGE SQL STABLE PARALLEL SAFE;
+GRANT EXECUTE ON FUNCTION SCHEMA_CATALOG.get_metric_duplicate_policy(TEXT) TO prom_reader;
+
+CREATE OR REPLACE FUNCTION SCHEMA_PROM.set_default_duplicate_policy(duplicate_policy TEXT)
+RETURNS BOOLEAN
+AS $$
+BEGIN
+    IF duplicate_policy IS NULL OR duplicate_policy NOT IN ('ignore', 'overwrite', 'reject') THEN
+        RAISE EXCEPTION 'invalid duplicate policy %, must be one of ignore, overwrite or reject', duplicate_policy;
+    END IF;
+    INSERT INTO SCHEMA_CATALOG.default(key, value) VALUES('duplicate_policy', duplicate_policy)
+    ON CONFLICT (key) DO UPDATE SET value = EXCLUDED.value;
+    RETURN true;
+END
+$$
+LANGUAGE PLPGSQL VOLATILE;
+COMMENT ON FUNCTION SCHEMA_PROM.set_default_duplicate_policy(TEXT)
+IS 'set the policy for duplicate samples (ignore, overwrite or reject) for any metrics (existing and new) without an explicit override';
+
+CREATE OR REPLACE FUNCTION SCHEMA_PROM.set_metric_duplicate_policy(metric_name TEXT, new_duplicate_policy TEXT)
+RETURNS BOOLEAN
+AS $func$
+    --use get_or_create_metric_table_name because we want to be able to set /before/ any data is ingested
+    --needs to run before update so row exists before update.
+    SELECT SCHEMA_CATALOG.get_or_create_metric_table_name(set_metric_duplicate_policy.metric_name);
+
+    UPDATE SCHEMA_CATALOG.metric SET duplicate_policy = new_duplicate_policy
+    WHERE id IN (SELECT id FROM SCHEMA_CATALOG.get_metric_table_name_if_exists(set_metric_duplicate_policy.metric_name));
+
+    SELECT true;
+$func$
+LANGUAGE SQL VOLATILE;
+COMMENT ON FUNCTION SCHEMA_PROM.set_metric_duplicate_policy(TEXT, TEXT)
+IS 'set the policy for duplicate samples (ignore, overwrite or reject) for a specific metric (this overrides the default)';
+
+CREATE OR REPLACE FUNCTION SCHEMA_PROM.reset_metric_duplicate_policy(metric_name TEXT)
+RETURNS BOOLEAN
+AS $func$
+    UPDATE SCHEMA_CATALOG.metric SET duplicate_policy = NULL
+    WHERE id = (SELECT id FROM SCHEMA_CATALOG.get_metric_table_name_if_exists(reset_metric_duplicate_policy.metric_name));
+    SELECT true;
+$func$
+LANGUAGE SQL VOLATILE;
+COMMENT ON FUNCTION SCHEMA_PROM.reset_metric_duplicate_policy(TEXT)
+IS 'resets the policy for duplicate samples for a specific metric to using the default';
+
 CREATE OR REPLACE FUNCTION SCHEMA_CATALOG.get_metric_compression_setting(metric_name TEXT)
 RETURNS BOOLEAN
 AS $$
@@ -1484,6 +1548,7 @@ BEGIN
         ELSE
             EXECUTE format($$ DELETE FROM SCHEMA_DATA.%I WHERE time < %L $$, metric_table, older_than);
         END IF;
+        PERFORM SCHEMA_CATALOG.drop_metric_rollups(metric_table, older_than);
     COMMIT;
     PERFORM SCHEMA_CATALOG.lock_metric_for_maintenance(metric_id);
 
@@ -2124,6 +2189,158 @@ BEGIN
 END
 $$ LANGUAGE PLPGSQL;
 
+CALL execute_everywhere('SCHEMA_CATALOG.do_decompress_chunks_between', $ee$
+    --Like do_decompress_chunks_after, but only decompresses the chunks overlapping
+    --[min_time, max_time], committing after every chunk. The decompressed chunks are
+    --recorded in backfill_chunk, to compress them again once the samples were backfilled.
+    CREATE OR REPLACE PROCEDURE SCHEMA_CATALOG.do_decompress_chunks_between(metric_table NAME, min_time TIMESTAMPTZ, max_time TIMESTAMPTZ)
+    AS $$
+    DECLARE
+        chunk_row record;
+        dimension_row record;
+        hypertable_row record;
+        min_time_internal bigint;
+        max_time_internal bigint;
+    BEGIN
+        SELECT h.* INTO STRICT hypertable_row FROM _timescaledb_catalog.hypertable h
+        WHERE table_name = metric_table AND schema_name = 'SCHEMA_DATA';
+
+        SELECT d.* INTO STRICT dimension_row FROM _timescaledb_catalog.dimension d WHERE hypertable_id = hypertable_row.id ORDER BY id LIMIT 1;
+
+        SELECT _timescaledb_internal.time_to_internal(min_time) INTO STRICT min_time_internal;
+        SELECT _timescaledb_internal.time_to_internal(max_time) INTO STRICT max_time_internal;
+
+        FOR chunk_row IN
+            SELECT c.*
+            FROM _timescaledb_catalog.dimension_slice ds
+            INNER JOIN _timescaledb_catalog.chunk_constraint cc ON cc.dimension_slice_id = ds.id
+            INNER JOIN _timescaledb_catalog.chunk c ON cc.chunk_id = c.id
+            WHERE dimension_id = dimension_row.id
+            -- the range_ends are non-inclusive
+            AND min_time_internal < ds.range_end
+            AND ds.range_start <= max_time_internal
+            AND c.compressed_chunk_id IS NOT NULL
+            ORDER BY ds.range_start
+        LOOP
+
+            --lock the chunk exclusive.
+            EXECUTE format('LOCK %I.%I;', chunk_row.schema_name, chunk_row.table_name);
+            --double check it's still compressed.
+            PERFORM c.*
+            FROM _timescaledb_catalog.chunk c
+            WHERE c.id = chunk_row.id AND c.compressed_chunk_id IS NOT NULL;
+
+            IF FOUND THEN
+                RAISE NOTICE 'Promscale is decompressing chunk for backfill: %.%', chunk_row.schema_name, chunk_row.table_name;
+                PERFORM decompress_chunk(format('%I.%I', chunk_row.schema_name, chunk_row.table_name)::regclass);
+                INSERT INTO SCHEMA_CATALOG.backfill_chunk(metric_table, chunk_schema, chunk_name)
+                VALUES (metric_table, chunk_row.schema_name, chunk_row.table_name)
+                ON CONFLICT DO NOTHING;
+            END IF;
+
+            COMMIT;
+        END LOOP;
+    END;
+    $$ LANGUAGE PLPGSQL;
+$ee$);
+
+CALL execute_everywhere('SCHEMA_CATALOG.do_compress_backfill_chunks', $ee$
+    --Compresses the chunks of a metric table recorded by do_decompress_chunks_between
+    --again, committing after every chunk. Chunks dropped meanwhile are skipped.
+    CREATE OR REPLACE PROCEDURE SCHEMA_CATALOG.do_compress_backfill_chunks(metric_table NAME)
+    AS $$
+    DECLARE
+        chunk_row record;
+    BEGIN
+        FOR chunk_row IN
+            SELECT b.chunk_schema, b.chunk_name
+            FROM SCHEMA_CATALOG.backfill_chunk b
+            WHERE b.metric_table = do_compress_backfill_chunks.metric_table
+        LOOP
+            IF to_regclass(format('%I.%I', chunk_row.chunk_schema, chunk_row.chunk_name)) IS NOT NULL THEN
+                PERFORM compress_chunk(format('%I.%I', chunk_row.chunk_schema, chunk_row.chunk_name)::regclass, if_not_compressed => true);
+            END IF;
+            DELETE FROM SCHEMA_CATALOG.backfill_chunk b
+            WHERE b.chunk_schema = chunk_row.chunk_schema AND b.chunk_name = chunk_row.chunk_name;
+            COMMIT;
+        END LOOP;
+    END;
+    $$ LANGUAGE PLPGSQL;
+$ee$);
+
+--Prepares a metric for backfilling samples between min_time and max_time: the
+--compression job is delayed and the compressed chunks of the time range are
+--decompressed once, instead of on every insert into a compressed chunk.
+--Must be called outside of a transaction.
+CREATE OR REPLACE PROCEDURE SCHEMA_CATALOG.begin_metric_backfill(metric_name TEXT, min_time TIMESTAMPTZ, max_time TIMESTAMPTZ)
+AS $proc$
+DECLARE
+    metric_table NAME;
+BEGIN
+    SELECT m.table_name
+    INTO metric_table
+    FROM SCHEMA_CATALOG.metric m
+    WHERE m.metric_name = begin_metric_backfill.metric_name;
+
+    --new metrics have no compressed chunks
+    IF NOT FOUND OR NOT SCHEMA_CATALOG.get_metric_compression_setting(metric_name) THEN
+        RETURN;
+    END IF;
+
+    PERFORM SCHEMA_CATALOG.delay_compression_job(metric_table, now() + INTERVAL '1 day');
+    COMMIT;
+
+    IF SCHEMA_CATALOG.is_multinode() THEN
+        CALL distributed_exec(
+            format(
+                $dist$ CALL do_decompress_chunks_between(%L, %L, %L) $dist$,
+                metric_table, min_time, max_time),
+            transactional => false);
+    ELSE
+        CALL SCHEMA_CATALOG.do_decompress_chunks_between(metric_table, min_time, max_time);
+    END IF;
+END
+$proc$ LANGUAGE PLPGSQL;
+COMMENT ON PROCEDURE SCHEMA_CATALOG.begin_metric_backfill(TEXT, TIMESTAMPTZ, TIMESTAMPTZ)
+IS 'delay the compression job and decompress the chunks of a metric overlapping a time range before backfilling it';
+
+--Recompresses the chunks decompressed by begin_metric_backfill once the samples
+--were backfilled, and only those. Must be called outside of a transaction.
+CREATE OR REPLACE PROCEDURE SCHEMA_CATALOG.finish_metric_backfill(metric_name TEXT)
+AS $proc$
+DECLARE
+    metric_table NAME;
+BEGIN
+    SELECT m.table_name
+    INTO metric_table
+    FROM SCHEMA_CATALOG.metric m
+    WHERE m.metric_name = finish_metric_backfill.metric_name;
+
+    IF NOT FOUND OR NOT SCHEMA_CATALOG.get_metric_compression_setting(metric_name) THEN
+        RETURN;
+    END IF;
+
+    PERFORM SCHEMA_CATALOG.delay_compression_job(metric_table, now());
+    COMMIT;
+
+    --in timescaledb 1.x the compression policy recompresses the chunks
+    IF SCHEMA_CATALOG.get_timescale_major_version() < 2 THEN
+        DELETE FROM SCHEMA_CATALOG.backfill_chunk b WHERE b.metric_table = finish_metric_backfill.metric_table;
+        RETURN;
+    END IF;
+
+    IF SCHEMA_CATALOG.is_multinode() THEN
+        CALL distributed_exec(
+            format($dist$ CALL do_compress_backfill_chunks(%L) $dist$, metric_table),
+            transactional => false);
+    ELSE
+        CALL SCHEMA_CATALOG.do_compress_backfill_chunks(metric_table);
+    END IF;
+END
+$proc$ LANGUAGE PLPGSQL;
+COMMENT ON PROCEDURE SCHEMA_CATALOG.finish_metric_backfill(TEXT)
+IS 'recompress the chunks of a metric decompressed to backfill it';
+
 --Order by random with stable marking gives us same order in a statement and different
 -- orderings in different statements
 CREATE OR REPLACE FUNCTION SCHEMA_CATALOG.get_metrics_that_need_compression()
@@ -2220,4 +2437,75 @@ BEGIN
     RETURN num_rows;
 END;
 $$
-LANGUAGE PLPGSQL;
\ No newline at end of file
+LANGUAGE PLPGSQL;
+
+--Inserts the samples of a metric table according to the duplicate policy of the metric, returning the
+--number of samples whose series and time did not exist yet, and the policy. Duplicate samples are
+--ignored, overwritten by the last of them, or rejected by failing the insert. With reject_duplicates
+--false, duplicates are ignored instead of rejected, e.g. for replayed write requests which might have
+--been inserted partially before. Samples older than the downsampling watermarks of the metric get their
+--buckets rolled up again.
+CREATE OR REPLACE FUNCTION SCHEMA_CATALOG.insert_metric_row_with_policy(
+    metric_table name,
+    time_array timestamptz[],
+    value_array DOUBLE PRECISION[],
+    series_id_array bigint[],
+    reject_duplicates BOOLEAN,
+    OUT inserted BIGINT,
+    OUT duplicate_policy TEXT
+) AS
+$$
+BEGIN
+    SELECT m.duplicate_policy INTO duplicate_policy
+    FROM SCHEMA_CATALOG.metric m
+    WHERE m.table_name = metric_table;
+    duplicate_policy := COALESCE(duplicate_policy, SCHEMA_CATALOG.get_default_duplicate_policy(), 'ignore');
+    IF duplicate_policy = 'reject' AND NOT reject_duplicates THEN
+        duplicate_policy := 'ignore';
+    END IF;
+
+    PERFORM SCHEMA_CATALOG.invalidate_rollups(metric_table, (SELECT min(t) FROM unnest(time_array) t));
+
+    IF duplicate_policy = 'overwrite' THEN
+        --the last sample of a series and time wins, as an INSERT cannot update a row twice
+        EXECUTE FORMAT(
+         'WITH upserted AS (
+              INSERT INTO SCHEMA_DATA.%1$I (time, value, series_id)
+                  SELECT DISTINCT ON (s, t) t, v, s FROM unnest($1, $2, $3) WITH ORDINALITY a(t,v,s,o) ORDER BY s, t, o DESC
+              ON CONFLICT (series_id, time) DO UPDATE SET value = EXCLUDED.value
+              RETURNING xmax = 0 AS is_new
+          )
+          SELECT count(*) FILTER (WHERE is_new) FROM upserted',
+            metric_table
+        ) USING time_array, value_array, series_id_array
+        INTO inserted;
+        RETURN;
+    END IF;
+
+    EXECUTE FORMAT(
+     'INSERT INTO  SCHEMA_DATA.%1$I (time, value, series_id)
+          SELECT * FROM unnest($1, $2, $3) a(t,v,s) ORDER BY s,t ON CONFLICT DO NOTHING',
+        metric_table
+    ) USING time_array, value_array, series_id_array;
+    GET DIAGNOSTICS inserted = ROW_COUNT;
+
+    IF duplicate_policy = 'reject' AND inserted <> cardinality(time_array) THEN
+        RAISE EXCEPTION 'rejected % duplicate samples of metric table %', cardinality(time_array) - inserted, metric_table
+        USING ERRCODE = 'unique_violation';
+    END IF;
+END;
+$$
+LANGUAGE PLPGSQL;
+
+CREATE OR REPLACE FUNCTION SCHEMA_CATALOG.insert_metric_row_with_policy(
+    metric_table name,
+    time_array timestamptz[],
+    value_array DOUBLE PRECISION[],
+    series_id_array bigint[],
+    OUT inserted BIGINT,
+    OUT duplicate_policy TEXT
+) AS
+$$
+    SELECT * FROM SCHEMA_CATALOG.insert_metric_row_with_policy(metric_table, time_array, value_array, series_id_array, true)
+$$
+LANGUAGE SQL;
\ No newline at end of file
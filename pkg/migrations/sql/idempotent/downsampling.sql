@@ -0,0 +1,175 @@
+--Downsampling rolls up the samples of a metric into one row per series and
+--bucket of resolution_ms milliseconds, in SCHEMA_DATA_ROLLUP. The rows are
+--timestamped with the last millisecond of their bucket, so that they never
+--hold values of samples after their time, and keep the minimum, maximum, sum,
+--count, first and last value of the samples in the bucket, stale markers
+--excluded, as well as their increase within the bucket adjusted for counter
+--resets like in PromQL.
+
+--Returns the start of the bucket of resolution_ms milliseconds containing t,
+--buckets are aligned to the Unix epoch.
+CREATE OR REPLACE FUNCTION SCHEMA_CATALOG.downsample_bucket(t TIMESTAMPTZ, resolution_ms BIGINT)
+RETURNS TIMESTAMPTZ
+AS $func$
+    SELECT 'epoch'::TIMESTAMPTZ
+        + (floor(EXTRACT(EPOCH FROM t) * 1000 / resolution_ms)::BIGINT * resolution_ms) * INTERVAL '1 millisecond'
+$func$
+LANGUAGE SQL IMMUTABLE PARALLEL SAFE;
+GRANT EXECUTE ON FUNCTION SCHEMA_CATALOG.downsample_bucket(TIMESTAMPTZ, BIGINT) TO prom_reader;
+
+CREATE OR REPLACE FUNCTION SCHEMA_CATALOG.create_rollup_table(metric_table NAME)
+RETURNS VOID
+AS $func$
+BEGIN
+    EXECUTE format($$
+        CREATE TABLE IF NOT EXISTS SCHEMA_DATA_ROLLUP.%I (
+            series_id BIGINT NOT NULL,
+            resolution_ms BIGINT NOT NULL,
+            time TIMESTAMPTZ NOT NULL,
+            min DOUBLE PRECISION NOT NULL,
+            max DOUBLE PRECISION NOT NULL,
+            sum DOUBLE PRECISION NOT NULL,
+            count BIGINT NOT NULL,
+            first DOUBLE PRECISION NOT NULL,
+            last DOUBLE PRECISION NOT NULL,
+            increase DOUBLE PRECISION NOT NULL,
+            PRIMARY KEY (series_id, resolution_ms, time)
+        )
+    $$, metric_table);
+END
+$func$
+LANGUAGE PLPGSQL VOLATILE;
+GRANT EXECUTE ON FUNCTION SCHEMA_CATALOG.create_rollup_table(NAME) TO prom_writer;
+
+--Rolls up the samples of the metric at the resolution, from the watermark up
+--to the last complete bucket older than lag_ms, but at most max_buckets
+--buckets at a time. Samples inserted behind the watermark lower it, see
+--invalidate_rollups, so that their buckets are rolled up again. Connectors
+--running it concurrently skip the metrics being rolled up by another one.
+--Returns the number of rows written.
+--
+--NaN values are ignored by the minimum and maximum like in PromQL, while
+--Postgres orders NaN above all other values.
+CREATE OR REPLACE FUNCTION SCHEMA_CATALOG.downsample_metric(
+    metric_name TEXT, resolution_ms BIGINT, lag_ms BIGINT, max_buckets INT
+) RETURNS BIGINT
+AS $func$
+DECLARE
+    rollup_metric_id INT;
+    metric_table NAME;
+    watermark TIMESTAMPTZ;
+    until TIMESTAMPTZ;
+    rows_written BIGINT;
+BEGIN
+    SELECT m.id, m.table_name
+    INTO rollup_metric_id, metric_table
+    FROM SCHEMA_CATALOG.metric m
+    WHERE m.metric_name = downsample_metric.metric_name;
+    IF NOT FOUND THEN
+        RETURN 0;
+    END IF;
+
+    INSERT INTO SCHEMA_CATALOG.downsample_watermark (metric_id, resolution_ms)
+    VALUES (rollup_metric_id, resolution_ms)
+    ON CONFLICT DO NOTHING;
+
+    SELECT w.watermark
+    INTO watermark
+    FROM SCHEMA_CATALOG.downsample_watermark w
+    WHERE w.metric_id = rollup_metric_id
+        AND w.resolution_ms = downsample_metric.resolution_ms
+    FOR UPDATE SKIP LOCKED;
+    IF NOT FOUND THEN
+        RETURN 0;
+    END IF;
+
+    IF watermark IS NULL THEN
+        EXECUTE format('SELECT min(time) FROM SCHEMA_DATA.%I', metric_table)
+        INTO watermark;
+        IF watermark IS NULL THEN
+            RETURN 0;
+        END IF;
+        watermark := SCHEMA_CATALOG.downsample_bucket(watermark, resolution_ms);
+    END IF;
+
+    until := least(
+        SCHEMA_CATALOG.downsample_bucket(now() - lag_ms * INTERVAL '1 millisecond', resolution_ms),
+        watermark + (max_buckets * resolution_ms) * INTERVAL '1 millisecond'
+    );
+    IF until <= watermark THEN
+        RETURN 0;
+    END IF;
+
+    PERFORM SCHEMA_CATALOG.create_rollup_table(metric_table);
+    EXECUTE format($query$
+        INSERT INTO SCHEMA_DATA_ROLLUP.%1$I (series_id, resolution_ms, time, min, max, sum, count, first, last, increase)
+        SELECT
+            series_id,
+            $1,
+            bucket + ($1 - 1) * INTERVAL '1 millisecond',
+            COALESCE(min(value) FILTER (WHERE value <> 'NaN'), 'NaN'),
+            COALESCE(max(value) FILTER (WHERE value <> 'NaN'), 'NaN'),
+            sum(value),
+            count(*),
+            (array_agg(value ORDER BY time))[1],
+            (array_agg(value ORDER BY time DESC))[1],
+            COALESCE(sum(CASE WHEN value >= prev THEN value - prev ELSE value END), 0)
+        FROM (
+            SELECT series_id, time, value, bucket,
+                lag(value) OVER (PARTITION BY series_id, bucket ORDER BY time) AS prev
+            FROM (
+                SELECT series_id, time, value, SCHEMA_CATALOG.downsample_bucket(time, $1) AS bucket
+                FROM SCHEMA_DATA.%1$I
+                WHERE time >= $2 AND time < $3
+                    AND NOT SCHEMA_PROM.is_stale_marker(value)
+            ) samples
+        ) samples
+        GROUP BY series_id, bucket
+        ON CONFLICT (series_id, resolution_ms, time) DO UPDATE
+        SET (min, max, sum, count, first, last, increase) =
+            (excluded.min, excluded.max, excluded.sum, excluded.count, excluded.first, excluded.last, excluded.increase)
+    $query$, metric_table) USING resolution_ms, watermark, until;
+    GET DIAGNOSTICS rows_written = ROW_COUNT;
+
+    UPDATE SCHEMA_CATALOG.downsample_watermark w
+    SET watermark = until
+    WHERE w.metric_id = rollup_metric_id
+        AND w.resolution_ms = downsample_metric.resolution_ms;
+    RETURN rows_written;
+END
+$func$
+LANGUAGE PLPGSQL VOLATILE;
+COMMENT ON FUNCTION SCHEMA_CATALOG.downsample_metric(TEXT, BIGINT, BIGINT, INT)
+IS 'rolls up the samples of the metric at the resolution, should be run by the connector automatically';
+GRANT EXECUTE ON FUNCTION SCHEMA_CATALOG.downsample_metric(TEXT, BIGINT, BIGINT, INT) TO prom_writer;
+
+--Lowers the watermarks of the metric table to the bucket of min_time, so that
+--the buckets of samples inserted behind the watermarks are rolled up again.
+--The rollups after the lowered watermarks are no longer read until then.
+CREATE OR REPLACE FUNCTION SCHEMA_CATALOG.invalidate_rollups(metric_table NAME, min_time TIMESTAMPTZ)
+RETURNS VOID
+AS $func$
+    UPDATE SCHEMA_CATALOG.downsample_watermark w
+    SET watermark = SCHEMA_CATALOG.downsample_bucket(min_time, w.resolution_ms)
+    FROM SCHEMA_CATALOG.metric m
+    WHERE m.table_name = metric_table
+        AND w.metric_id = m.id
+        AND w.watermark > min_time
+$func$
+LANGUAGE SQL VOLATILE;
+GRANT EXECUTE ON FUNCTION SCHEMA_CATALOG.invalidate_rollups(NAME, TIMESTAMPTZ) TO prom_writer;
+
+--Deletes the rollups older than older_than, which is called by the retention
+--policy so that the rollups do not outlive the series they refer to.
+CREATE OR REPLACE FUNCTION SCHEMA_CATALOG.drop_metric_rollups(metric_table NAME, older_than TIMESTAMPTZ)
+RETURNS VOID
+AS $func$
+BEGIN
+    IF to_regclass(format('SCHEMA_DATA_ROLLUP.%I', metric_table)) IS NULL THEN
+        RETURN;
+    END IF;
+    EXECUTE format($$ DELETE FROM SCHEMA_DATA_ROLLUP.%I WHERE time < %L $$, metric_table, older_than);
+END
+$func$
+LANGUAGE PLPGSQL VOLATILE;
+GRANT EXECUTE ON FUNCTION SCHEMA_CATALOG.drop_metric_rollups(NAME, TIMESTAMPTZ) TO prom_writer;
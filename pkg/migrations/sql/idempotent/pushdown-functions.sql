@@ -0,0 +1,207 @@
+--These functions evaluate PromQL functions in the database so that only the
+--results, and not the raw samples, are sent to the connector. They must
+--return exactly what the PromQL engine would, see promql/functions.go.
+
+--Returns the sample timestamps in milliseconds and the sample values, without
+--the stale markers, which are ignored by range selectors.
+CREATE OR REPLACE FUNCTION SCHEMA_CATALOG.prom_range_samples(
+        sample_times TIMESTAMPTZ[],
+        sample_values DOUBLE PRECISION[],
+        OUT times BIGINT[],
+        OUT vals DOUBLE PRECISION[])
+AS $func$
+    SELECT
+        COALESCE(array_agg((EXTRACT(EPOCH FROM t) * 1000)::BIGINT ORDER BY i), array[]::BIGINT[]),
+        COALESCE(array_agg(v ORDER BY i), array[]::DOUBLE PRECISION[])
+    FROM unnest(sample_times, sample_values) WITH ORDINALITY AS u(t, v, i)
+    WHERE NOT SCHEMA_PROM.is_stale_marker(v)
+$func$
+LANGUAGE SQL IMMUTABLE PARALLEL SAFE;
+COMMENT ON FUNCTION SCHEMA_CATALOG.prom_range_samples(TIMESTAMPTZ[], DOUBLE PRECISION[])
+IS 'returns the samples, without the stale markers, with their timestamps in milliseconds';
+GRANT EXECUTE ON FUNCTION SCHEMA_CATALOG.prom_range_samples(TIMESTAMPTZ[], DOUBLE PRECISION[]) TO prom_reader;
+
+--Divides like Go does: a division by zero does not error out but results in
+--+Inf or -Inf, or in NaN if the dividend is zero or NaN.
+CREATE OR REPLACE FUNCTION SCHEMA_CATALOG.prom_div(dividend DOUBLE PRECISION, divisor DOUBLE PRECISION)
+    RETURNS DOUBLE PRECISION
+AS $func$
+    SELECT CASE
+        WHEN divisor <> 0 THEN dividend / divisor
+        WHEN dividend = 0 OR dividend = 'NaN' THEN 'NaN'::DOUBLE PRECISION
+        WHEN dividend > 0 THEN 'Infinity'::DOUBLE PRECISION
+        ELSE '-Infinity'::DOUBLE PRECISION
+    END
+$func$
+LANGUAGE SQL IMMUTABLE PARALLEL SAFE;
+COMMENT ON FUNCTION SCHEMA_CATALOG.prom_div(DOUBLE PRECISION, DOUBLE PRECISION)
+IS 'divides like Go, returning +Inf, -Inf or NaN on a division by zero';
+GRANT EXECUTE ON FUNCTION SCHEMA_CATALOG.prom_div(DOUBLE PRECISION, DOUBLE PRECISION) TO prom_reader;
+
+--Evaluates rate (is_counter and is_rate), increase (is_counter) or delta at
+--every step between lowest_time and greatest_time. Every step uses the samples
+--in the range [step - range_ms, step], both inclusive, and results in NULL if
+--there are less than two of them.
+--
+--NaN comparisons are guarded since Postgres orders NaN above all other values
+--while Go comparisons involving NaN are always false.
+CREATE OR REPLACE FUNCTION SCHEMA_CATALOG.prom_extrapolated_rate(
+        lowest_time TIMESTAMPTZ,
+        greatest_time TIMESTAMPTZ,
+        step_ms BIGINT,
+        range_ms BIGINT,
+        range_seconds DOUBLE PRECISION,
+        is_counter BOOLEAN,
+        is_rate BOOLEAN,
+        sample_times TIMESTAMPTZ[],
+        sample_values DOUBLE PRECISION[])
+    RETURNS DOUBLE PRECISION[]
+AS $func$
+DECLARE
+    times BIGINT[];
+    vals DOUBLE PRECISION[];
+    num_samples INT;
+    eval_ts BIGINT := (EXTRACT(EPOCH FROM lowest_time) * 1000)::BIGINT;
+    end_ts BIGINT := (EXTRACT(EPOCH FROM greatest_time) * 1000)::BIGINT;
+    first_idx INT := 1;
+    last_idx INT := 0;
+    result DOUBLE PRECISION[] := array[]::DOUBLE PRECISION[];
+    result_value DOUBLE PRECISION;
+    last_value DOUBLE PRECISION;
+    duration_to_start DOUBLE PRECISION;
+    duration_to_end DOUBLE PRECISION;
+    duration_to_zero DOUBLE PRECISION;
+    sampled_interval DOUBLE PRECISION;
+    average_duration DOUBLE PRECISION;
+    extrapolation_threshold DOUBLE PRECISION;
+    extrapolate_to_interval DOUBLE PRECISION;
+BEGIN
+    SELECT s.times, s.vals INTO times, vals FROM SCHEMA_CATALOG.prom_range_samples(sample_times, sample_values) s;
+    num_samples := COALESCE(array_length(times, 1), 0);
+
+    WHILE eval_ts <= end_ts LOOP
+        WHILE first_idx <= num_samples AND times[first_idx] < eval_ts - range_ms LOOP
+            first_idx := first_idx + 1;
+        END LOOP;
+        WHILE last_idx < num_samples AND times[last_idx + 1] <= eval_ts LOOP
+            last_idx := last_idx + 1;
+        END LOOP;
+
+        IF last_idx - first_idx + 1 < 2 THEN
+            result := array_append(result, NULL);
+        ELSE
+            result_value := vals[last_idx] - vals[first_idx];
+            IF is_counter THEN
+                last_value := 0;
+                FOR i IN first_idx..last_idx LOOP
+                    IF vals[i] < last_value AND last_value <> 'NaN' THEN
+                        result_value := result_value + last_value;
+                    END IF;
+                    last_value := vals[i];
+                END LOOP;
+            END IF;
+
+            duration_to_start := (times[first_idx] - (eval_ts - range_ms))::DOUBLE PRECISION / 1000;
+            duration_to_end := (eval_ts - times[last_idx])::DOUBLE PRECISION / 1000;
+            sampled_interval := (times[last_idx] - times[first_idx])::DOUBLE PRECISION / 1000;
+
+            average_duration := sampled_interval / (last_idx - first_idx);
+
+            IF is_counter AND result_value > 0 AND result_value <> 'NaN'
+                AND vals[first_idx] >= 0 AND vals[first_idx] <> 'NaN' THEN
+                duration_to_zero := sampled_interval * (vals[first_idx] / result_value);
+                IF duration_to_zero < duration_to_start THEN
+                    duration_to_start := duration_to_zero;
+                END IF;
+            END IF;
+
+            extrapolation_threshold := average_duration * 1.1;
+            extrapolate_to_interval := sampled_interval;
+            IF duration_to_start < extrapolation_threshold THEN
+                extrapolate_to_interval := extrapolate_to_interval + duration_to_start;
+            ELSE
+                extrapolate_to_interval := extrapolate_to_interval + average_duration / 2;
+            END IF;
+            IF duration_to_end < extrapolation_threshold THEN
+                extrapolate_to_interval := extrapolate_to_interval + duration_to_end;
+            ELSE
+                extrapolate_to_interval := extrapolate_to_interval + average_duration / 2;
+            END IF;
+
+            result_value := result_value * SCHEMA_CATALOG.prom_div(extrapolate_to_interval, sampled_interval);
+            IF is_rate THEN
+                result_value := result_value / range_seconds;
+            END IF;
+            result := array_append(result, result_value);
+        END IF;
+
+        eval_ts := eval_ts + step_ms;
+    END LOOP;
+    RETURN result;
+END
+$func$
+LANGUAGE PLPGSQL IMMUTABLE PARALLEL SAFE;
+COMMENT ON FUNCTION SCHEMA_CATALOG.prom_extrapolated_rate(TIMESTAMPTZ, TIMESTAMPTZ, BIGINT, BIGINT, DOUBLE PRECISION, BOOLEAN, BOOLEAN, TIMESTAMPTZ[], DOUBLE PRECISION[])
+IS 'evaluates the PromQL rate, increase or delta functions at every step, like extrapolatedRate in the PromQL engine';
+GRANT EXECUTE ON FUNCTION SCHEMA_CATALOG.prom_extrapolated_rate(TIMESTAMPTZ, TIMESTAMPTZ, BIGINT, BIGINT, DOUBLE PRECISION, BOOLEAN, BOOLEAN, TIMESTAMPTZ[], DOUBLE PRECISION[]) TO prom_reader;
+
+--Evaluates irate (is_rate) or idelta at every step between lowest_time and
+--greatest_time, using the last two samples in the range [step - range_ms, step].
+CREATE OR REPLACE FUNCTION SCHEMA_CATALOG.prom_instant_value(
+        lowest_time TIMESTAMPTZ,
+        greatest_time TIMESTAMPTZ,
+        step_ms BIGINT,
+        range_ms BIGINT,
+        is_rate BOOLEAN,
+        sample_times TIMESTAMPTZ[],
+        sample_values DOUBLE PRECISION[])
+    RETURNS DOUBLE PRECISION[]
+AS $func$
+DECLARE
+    times BIGINT[];
+    vals DOUBLE PRECISION[];
+    num_samples INT;
+    eval_ts BIGINT := (EXTRACT(EPOCH FROM lowest_time) * 1000)::BIGINT;
+    end_ts BIGINT := (EXTRACT(EPOCH FROM greatest_time) * 1000)::BIGINT;
+    first_idx INT := 1;
+    last_idx INT := 0;
+    result DOUBLE PRECISION[] := array[]::DOUBLE PRECISION[];
+    result_value DOUBLE PRECISION;
+    sampled_interval BIGINT;
+BEGIN
+    SELECT s.times, s.vals INTO times, vals FROM SCHEMA_CATALOG.prom_range_samples(sample_times, sample_values) s;
+    num_samples := COALESCE(array_length(times, 1), 0);
+
+    WHILE eval_ts <= end_ts LOOP
+        WHILE first_idx <= num_samples AND times[first_idx] < eval_ts - range_ms LOOP
+            first_idx := first_idx + 1;
+        END LOOP;
+        WHILE last_idx < num_samples AND times[last_idx + 1] <= eval_ts LOOP
+            last_idx := last_idx + 1;
+        END LOOP;
+
+        sampled_interval := times[last_idx] - times[last_idx - 1];
+        IF last_idx - first_idx + 1 < 2 OR sampled_interval = 0 THEN
+            result := array_append(result, NULL);
+        ELSE
+            IF is_rate AND vals[last_idx] < vals[last_idx - 1] AND vals[last_idx - 1] <> 'NaN' THEN
+                --Counter reset.
+                result_value := vals[last_idx];
+            ELSE
+                result_value := vals[last_idx] - vals[last_idx - 1];
+            END IF;
+            IF is_rate THEN
+                result_value := result_value / (sampled_interval::DOUBLE PRECISION / 1000);
+            END IF;
+            result := array_append(result, result_value);
+        END IF;
+
+        eval_ts := eval_ts + step_ms;
+    END LOOP;
+    RETURN result;
+END
+$func$
+LANGUAGE PLPGSQL IMMUTABLE PARALLEL SAFE;
+COMMENT ON FUNCTION SCHEMA_CATALOG.prom_instant_value(TIMESTAMPTZ, TIMESTAMPTZ, BIGINT, BIGINT, BOOLEAN, TIMESTAMPTZ[], DOUBLE PRECISION[])
+IS 'evaluates the PromQL irate or idelta functions at every step, like instantValue in the PromQL engine';
+GRANT EXECUTE ON FUNCTION SCHEMA_CATALOG.prom_instant_value(TIMESTAMPTZ, TIMESTAMPTZ, BIGINT, BIGINT, BOOLEAN, TIMESTAMPTZ[], DOUBLE PRECISION[]) TO prom_reader;
@@ -0,0 +1,76 @@
+--Returns the type of a column of a relation, or NULL if there is no such column.
+CREATE OR REPLACE FUNCTION SCHEMA_CATALOG.get_column_type(relation REGCLASS, column_name NAME)
+RETURNS REGTYPE
+AS $func$
+    SELECT a.atttypid::REGTYPE
+    FROM pg_catalog.pg_attribute a
+    WHERE a.attrelid = relation
+        AND a.attname = column_name
+        AND a.attnum > 0
+        AND NOT a.attisdropped
+$func$
+LANGUAGE SQL STABLE;
+GRANT EXECUTE ON FUNCTION SCHEMA_CATALOG.get_column_type(REGCLASS, NAME) TO prom_reader;
+
+CREATE OR REPLACE FUNCTION SCHEMA_PROM.register_metric_view(
+    metric_name TEXT, view_schema NAME, view_name NAME, time_column NAME, value_column NAME, label_columns NAME[] DEFAULT '{}'
+) RETURNS BOOLEAN
+AS $func$
+DECLARE
+    relation REGCLASS;
+    column_type REGTYPE;
+    label_column NAME;
+BEGIN
+    IF EXISTS (SELECT 1 FROM SCHEMA_CATALOG.metric m WHERE m.metric_name = register_metric_view.metric_name) THEN
+        RAISE EXCEPTION 'metric % already exists', metric_name;
+    END IF;
+
+    relation := to_regclass(format('%I.%I', view_schema, view_name));
+    IF relation IS NULL THEN
+        RAISE EXCEPTION 'relation %.% does not exist', view_schema, view_name;
+    END IF;
+
+    column_type := SCHEMA_CATALOG.get_column_type(relation, time_column);
+    IF column_type IS DISTINCT FROM 'timestamptz'::REGTYPE THEN
+        RAISE EXCEPTION 'time column % must be of type timestamptz', time_column;
+    END IF;
+
+    column_type := SCHEMA_CATALOG.get_column_type(relation, value_column);
+    IF column_type IS NULL OR column_type NOT IN (
+        'double precision'::REGTYPE, 'real'::REGTYPE, 'numeric'::REGTYPE,
+        'bigint'::REGTYPE, 'integer'::REGTYPE, 'smallint'::REGTYPE) THEN
+        RAISE EXCEPTION 'value column % must be of a numeric type', value_column;
+    END IF;
+
+    FOREACH label_column IN ARRAY label_columns LOOP
+        IF label_column !~ '^[a-zA-Z_][a-zA-Z0-9_]*$' OR label_column LIKE '\_\_%' THEN
+            RAISE EXCEPTION 'label column % is not a valid label name', label_column;
+        END IF;
+        IF SCHEMA_CATALOG.get_column_type(relation, label_column) IS NULL THEN
+            RAISE EXCEPTION 'label column % does not exist', label_column;
+        END IF;
+    END LOOP;
+
+    INSERT INTO SCHEMA_CATALOG.registered_view AS v
+        (metric_name, view_schema, view_name, time_column, value_column, label_columns)
+    VALUES (metric_name, view_schema, view_name, time_column, value_column, label_columns)
+    ON CONFLICT ON CONSTRAINT registered_view_pkey DO UPDATE
+    SET (view_schema, view_name, time_column, value_column, label_columns) =
+        (excluded.view_schema, excluded.view_name, excluded.time_column, excluded.value_column, excluded.label_columns);
+    RETURN true;
+END
+$func$
+LANGUAGE PLPGSQL VOLATILE;
+COMMENT ON FUNCTION SCHEMA_PROM.register_metric_view(TEXT, NAME, NAME, NAME, NAME, NAME[])
+IS 'registers a view, table or continuous aggregate with a time, value and label columns as a metric queryable with PromQL';
+
+CREATE OR REPLACE FUNCTION SCHEMA_PROM.unregister_metric_view(metric_name TEXT)
+RETURNS BOOLEAN
+AS $func$
+    DELETE FROM SCHEMA_CATALOG.registered_view v
+    WHERE v.metric_name = unregister_metric_view.metric_name
+    RETURNING true;
+$func$
+LANGUAGE SQL VOLATILE;
+COMMENT ON FUNCTION SCHEMA_PROM.unregister_metric_view(TEXT)
+IS 'unregisters a metric registered with register_metric_view';
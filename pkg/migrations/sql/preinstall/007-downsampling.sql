@@ -0,0 +1,17 @@
+CREATE SCHEMA IF NOT EXISTS SCHEMA_DATA_ROLLUP; -- downsampled samples, one table per metric
+GRANT USAGE ON SCHEMA SCHEMA_DATA_ROLLUP TO prom_reader;
+GRANT SELECT ON ALL TABLES IN SCHEMA SCHEMA_DATA_ROLLUP TO prom_reader;
+ALTER DEFAULT PRIVILEGES IN SCHEMA SCHEMA_DATA_ROLLUP GRANT SELECT ON TABLES TO prom_reader;
+GRANT USAGE ON SCHEMA SCHEMA_DATA_ROLLUP TO prom_writer;
+GRANT SELECT, INSERT, UPDATE, DELETE ON ALL TABLES IN SCHEMA SCHEMA_DATA_ROLLUP TO prom_writer;
+ALTER DEFAULT PRIVILEGES IN SCHEMA SCHEMA_DATA_ROLLUP GRANT SELECT, INSERT, UPDATE, DELETE ON TABLES TO prom_writer;
+
+-- the samples of a metric are rolled up at a resolution up to the watermark (exclusive),
+-- a NULL watermark means nothing was rolled up yet
+CREATE TABLE SCHEMA_CATALOG.downsample_watermark
+(
+    metric_id     INT    NOT NULL REFERENCES SCHEMA_CATALOG.metric (id) ON DELETE CASCADE,
+    resolution_ms BIGINT NOT NULL CHECK (resolution_ms > 0),
+    watermark     TIMESTAMPTZ,
+    PRIMARY KEY (metric_id, resolution_ms)
+);
@@ -0,0 +1,7 @@
+-- The policy for samples whose series and time already exist: ignore, overwrite or reject them.
+-- NULL to use the default duplicate_policy.
+ALTER TABLE SCHEMA_CATALOG.metric ADD COLUMN duplicate_policy TEXT DEFAULT NULL
+    CHECK (duplicate_policy IN ('ignore', 'overwrite', 'reject'));
+
+INSERT INTO SCHEMA_CATALOG.default(key,value) VALUES
+('duplicate_policy', 'ignore');
@@ -0,0 +1,11 @@
+-- SQL views, or tables and continuous aggregates, queryable as PromQL metrics.
+-- Their label columns become the labels of the series.
+CREATE TABLE SCHEMA_CATALOG.registered_view
+(
+    metric_name   TEXT   NOT NULL PRIMARY KEY,
+    view_schema   NAME   NOT NULL,
+    view_name     NAME   NOT NULL,
+    time_column   NAME   NOT NULL,
+    value_column  NAME   NOT NULL,
+    label_columns NAME[] NOT NULL
+);
@@ -0,0 +1,13 @@
+-- The rollup rows are now timestamped with the end of their bucket and keep the first
+-- value and the increase of the bucket, so the existing rollups are rolled up again.
+DO $$
+DECLARE
+    r RECORD;
+BEGIN
+    FOR r IN SELECT tablename FROM pg_tables WHERE schemaname = 'SCHEMA_DATA_ROLLUP' LOOP
+        EXECUTE format('DROP TABLE SCHEMA_DATA_ROLLUP.%I', r.tablename);
+    END LOOP;
+END
+$$;
+
+UPDATE SCHEMA_CATALOG.downsample_watermark SET watermark = NULL;
@@ -0,0 +1,11 @@
+-- The chunks decompressed to backfill samples into them, which are compressed again once the
+-- samples were backfilled. Created on every node, as the chunks are decompressed on the data nodes.
+CALL execute_everywhere('create_backfill_chunk', $ee$
+CREATE TABLE IF NOT EXISTS SCHEMA_CATALOG.backfill_chunk
+(
+    metric_table NAME NOT NULL,
+    chunk_schema NAME NOT NULL,
+    chunk_name   NAME NOT NULL,
+    PRIMARY KEY (chunk_schema, chunk_name)
+);
+$ee$);
@@ -14,6 +14,8 @@ import (
 	haClient "github.com/timescale/promscale/pkg/ha/client"
 	"github.com/timescale/promscale/pkg/log"
 	"github.com/timescale/promscale/pkg/pgmodel/cache"
+	"github.com/timescale/promscale/pkg/pgmodel/cardinality"
+	"github.com/timescale/promscale/pkg/pgmodel/downsample"
 	"github.com/timescale/promscale/pkg/pgmodel/health"
 	"github.com/timescale/promscale/pkg/pgmodel/ingestor"
 	"github.com/timescale/promscale/pkg/pgmodel/lreader"
@@ -28,10 +30,13 @@ import (
 // Client sends Prometheus samples to TimescaleDB
 type Client struct {
 	Connection    pgxconn.PgxConn
+	pool          *pgxpool.Pool
+	reads         *readConnections
 	ingestor      *ingestor.DBIngestor
 	querier       querier.Querier
 	healthCheck   health.HealthCheckerFn
 	queryable     promql.Queryable
+	cardinality   cardinality.Reader
 	ConnectionStr string
 	metricCache   cache.MetricCache
 	labelsCache   cache.LabelsCache
@@ -39,6 +44,7 @@ type Client struct {
 	closePool     bool
 	sigClose      chan struct{}
 	haService     *ha.Service
+	downsampler   *downsample.Job
 }
 
 // Post connect validation function, useful for things such as acquiring locks
@@ -58,16 +64,129 @@ func NewClient(cfg *Config, schemaLocker LockFunc) (*Client, error) {
 		log.Error("msg", "err creating connection pool for new client", "err", util.MaskPassword(err.Error()))
 		return nil, err
 	}
+	poolStats.add(primaryPool, connectionPool)
 
 	dbConn := pgxconn.NewPgxConn(connectionPool)
-	client, err := NewClientWithPool(cfg, numCopiers, dbConn)
+	reads, err := openReadConnections(cfg, pgConfig, numCopiers, dbConn)
 	if err != nil {
+		poolStats.remove(connectionPool)
+		connectionPool.Close()
+		return nil, err
+	}
+
+	client, err := newClient(cfg, numCopiers, dbConn, reads.conn, reads.labels)
+	if err != nil {
+		reads.close()
 		return client, err
 	}
+	client.pool = connectionPool
+	client.reads = reads
 	client.closePool = true
 	return client, err
 }
 
+const (
+	primaryPool = "primary"
+	readPool    = "read"
+	replicaPool = "replica"
+)
+
+// seriesLabelsSlotsRatio is the share of the read connections reserved for
+// looking up the labels of the series a query is still reading.
+const seriesLabelsSlotsRatio = 8
+
+// minReadConcurrency is the number of read connections needed for a query to
+// look up the labels of its series while reading them.
+const minReadConcurrency = 2
+
+// readConnections holds the connections used to serve reads. Reads are
+// served from a dedicated read pool or the primary pool, or from the read
+// replica if one is configured, and go through the read admission queue.
+// A query still reading its series looks up their labels through labels,
+// which has its own slots, so that it never waits for a slot of the queue
+// while holding one.
+type readConnections struct {
+	conn    pgxconn.PgxConn
+	labels  pgxconn.PgxConn
+	pool    pgxconn.PgxConn
+	replica *readReplicaConn
+	pools   []*pgxpool.Pool
+}
+
+func openReadConnections(cfg *Config, pgConfig *pgxpool.Config, numCopiers int, dbConn pgxconn.PgxConn) (*readConnections, error) {
+	reads := &readConnections{}
+	readConn := dbConn
+	readConfig := pgConfig
+	// Without a dedicated read pool, reads can use the connections of the
+	// primary pool left over by the copiers.
+	concurrency := int(pgConfig.MaxConns) - numCopiers
+	if cfg.ReadConnectionsMax > 0 {
+		readConfig = pgConfig.Copy()
+		readConfig.MaxConns = int32(cfg.ReadConnectionsMax)
+		if readConfig.MinConns > readConfig.MaxConns {
+			readConfig.MinConns = readConfig.MaxConns
+		}
+		pool, err := pgxpool.ConnectConfig(context.Background(), readConfig)
+		if err != nil {
+			log.Error("msg", "err creating read connection pool for new client", "err", util.MaskPassword(err.Error()))
+			return nil, err
+		}
+		log.Info("msg", "Using a dedicated connection pool for reads", "pool_max_conns", readConfig.MaxConns)
+		poolStats.add(readPool, pool)
+		reads.pools = append(reads.pools, pool)
+		reads.pool = pgxconn.NewPgxConn(pool)
+		readConn = reads.pool
+		concurrency = cfg.ReadConnectionsMax
+	}
+
+	if cfg.ReadReplicaDbUri != "" {
+		replicaConfig, err := getReplicaPgConfig(cfg, readConfig)
+		if err != nil {
+			reads.close()
+			return nil, err
+		}
+		pool, err := pgxpool.ConnectConfig(context.Background(), replicaConfig)
+		if err != nil {
+			log.Error("msg", "err creating read replica connection pool for new client", "err", util.MaskPassword(err.Error()))
+			reads.close()
+			return nil, err
+		}
+		poolStats.add(replicaPool, pool)
+		reads.pools = append(reads.pools, pool)
+		reads.replica = newReadReplicaConn(readConn, pgxconn.NewPgxConn(pool), cfg.ReadReplicaMaxLag)
+		readConn = reads.replica
+	}
+
+	// Without a dedicated read pool, the copiers can leave too few
+	// connections for reads in very small pools. Reads then wait for the
+	// connections the copiers don't use.
+	if concurrency < minReadConcurrency {
+		concurrency = minReadConcurrency
+	}
+	// The slots for looking up labels are taken out of the read
+	// concurrency, so that reads never wait for a connection in the pool
+	// instead of in the admission queue.
+	labelsSlots := concurrency / seriesLabelsSlotsRatio
+	if labelsSlots < 1 {
+		labelsSlots = 1
+	}
+	reads.conn = newReadAdmissionConn(readConn, concurrency-labelsSlots, cfg.ReadQueueSize, cfg.ReadQueueTimeout)
+	reads.labels = newReadAdmissionConn(readConn, labelsSlots, 0, cfg.ReadQueueTimeout)
+	return reads, nil
+}
+
+func (r *readConnections) close() {
+	for _, pool := range r.pools {
+		poolStats.remove(pool)
+	}
+	if r.replica != nil {
+		r.replica.Close()
+	}
+	if r.pool != nil {
+		r.pool.Close()
+	}
+}
+
 func getPgConfig(cfg *Config) (*pgxpool.Config, int, error) {
 	connectionStr, err := cfg.GetConnectionStr()
 	if err != nil {
@@ -122,24 +241,61 @@ func getPgConfig(cfg *Config) (*pgxpool.Config, int, error) {
 	return pgConfig, numCopiers, nil
 }
 
+// getReplicaPgConfig returns the connection pool configuration for the read
+// replica. The pool settings are the same as the ones of the pool used for
+// reads on the primary.
+func getReplicaPgConfig(cfg *Config, primaryConfig *pgxpool.Config) (*pgxpool.Config, error) {
+	replicaConfig, err := pgxpool.ParseConfig(cfg.ReadReplicaDbUri)
+	if err != nil {
+		log.Error("msg", "configuring read replica connection", "err", util.MaskPassword(err.Error()))
+		return nil, err
+	}
+	replicaConfig.MaxConns = primaryConfig.MaxConns
+	replicaConfig.MinConns = primaryConfig.MinConns
+	replicaConfig.AfterRelease = primaryConfig.AfterRelease
+	replicaConfig.ConnConfig.BuildStatementCache = primaryConfig.ConnConfig.BuildStatementCache
+	replicaConfig.ConnConfig.PreferSimpleProtocol = primaryConfig.ConnConfig.PreferSimpleProtocol
+
+	log.Info("msg", "Serving reads from read replica "+util.MaskPassword(cfg.ReadReplicaDbUri),
+		"max_lag", cfg.ReadReplicaMaxLag,
+	)
+	return replicaConfig, nil
+}
+
 // NewClientWithPool creates a new PostgreSQL client with an existing connection pool.
 func NewClientWithPool(cfg *Config, numCopiers int, dbConn pgxconn.PgxConn) (*Client, error) {
+	return newClient(cfg, numCopiers, dbConn, dbConn, dbConn)
+}
+
+// newClient creates a new PostgreSQL client which writes using dbConn and
+// serves queries, label reads and remote read using readConn. The labels of
+// the series being read by a query are looked up using labelsConn.
+func newClient(cfg *Config, numCopiers int, dbConn, readConn, labelsConn pgxconn.PgxConn) (*Client, error) {
 	sigClose := make(chan struct{})
 	metricsCache := cache.NewMetricCache(cfg.CacheConfig)
 	labelsCache := cache.NewLabelsCache(cfg.CacheConfig)
 	seriesCache := cache.NewSeriesCache(cfg.CacheConfig, sigClose)
 	c := ingestor.Cfg{
-		AsyncAcks:      cfg.AsyncAcks,
-		ReportInterval: cfg.ReportInterval,
-		NumCopiers:     numCopiers,
+		AsyncAcks:          cfg.AsyncAcks,
+		ReportInterval:     cfg.ReportInterval,
+		NumCopiers:         numCopiers,
+		Spool:              cfg.IngestConfig.Spool,
+		MaxInflightSamples: cfg.IngestConfig.MaxInflightSamples,
+		MaxInflightBytes:   cfg.IngestConfig.MaxInflightBytes,
+		Window:             cfg.IngestConfig.Window,
+		// Backfilled samples are old by definition, and don't come from
+		// the HA Prometheus pairs currently scraping, so neither the
+		// maximum sample age nor the HA leases apply to them.
+		BackfillMaxBufferedSamples: cfg.IngestConfig.BackfillMaxBufferedSamples,
+		BackfillParser:             ingestor.DefaultParser(seriesCache, cfg.IngestConfig.RelabelRules),
 	}
 
 	var parser ingestor.Parser
 	if cfg.HAEnabled {
 		leaseClient := haClient.NewHaLeaseClient(dbConn)
-		parser = ha.NewHAParser(ha.NewHAService(leaseClient), seriesCache)
+		parser = ha.NewHAParser(ha.NewHAService(leaseClient), seriesCache, cfg.IngestConfig.RelabelRules)
 	} else {
-		parser = ingestor.DefaultParser(seriesCache)
+		parser = ingestor.DefaultParser(seriesCache, cfg.IngestConfig.RelabelRules)
 	}
 	dbIngestor, err := ingestor.NewPgxIngestor(dbConn, metricsCache, seriesCache, parser, &c)
 
@@ -147,9 +303,15 @@ func NewClientWithPool(cfg *Config, numCopiers int, dbConn pgxconn.PgxConn) (*Cl
 		log.Error("msg", "err starting ingestor", "err", err)
 		return nil, err
 	}
-	labelsReader := lreader.NewLabelsReader(dbConn, labelsCache)
-	dbQuerier := querier.NewQuerier(dbConn, metricsCache, labelsReader)
-	queryable := query.NewQueryable(dbQuerier, labelsReader)
+	// The querier looks up the labels of the series while it still holds a
+	// read connection for their samples.
+	seriesLabelsReader := lreader.NewLabelsReader(labelsConn, labelsCache)
+	dbQuerier := querier.NewQuerier(readConn, metricsCache, seriesLabelsReader, labelsCache, cfg.QuerierConfig)
+	queryable := query.NewQueryable(dbQuerier)
+	if cfg.FederationConfig.PrometheusReadURL != "" {
+		queryable = query.NewHybridQueryable(queryable, cfg.FederationConfig)
+	}
+	cardinalityReader := cardinality.NewReader(readConn)
 
 	healthChecker := health.NewHealthChecker(dbConn)
 	client := &Client{
@@ -158,12 +320,17 @@ func NewClientWithPool(cfg *Config, numCopiers int, dbConn pgxconn.PgxConn) (*Cl
 		querier:     dbQuerier,
 		healthCheck: healthChecker,
 		queryable:   queryable,
+		cardinality: cardinalityReader,
 		metricCache: metricsCache,
 		labelsCache: labelsCache,
 		seriesCache: seriesCache,
 		sigClose:    sigClose,
 	}
 
+	if len(cfg.DownsampleConfig.Resolutions) > 0 && cfg.DownsampleConfig.Interval > 0 {
+		client.downsampler = downsample.NewJob(dbConn, cfg.DownsampleConfig)
+	}
+
 	InitClientMetrics(client)
 	return client, nil
 }
@@ -171,9 +338,16 @@ func NewClientWithPool(cfg *Config, numCopiers int, dbConn pgxconn.PgxConn) (*Cl
 // Close closes the client and performs cleanup
 func (c *Client) Close() {
 	log.Info("msg", "Shutting down Client")
+	if c.downsampler != nil {
+		c.downsampler.Close()
+	}
 	c.ingestor.Close()
 	close(c.sigClose)
+	if c.reads != nil {
+		c.reads.close()
+	}
 	if c.closePool {
+		poolStats.remove(c.pool)
 		c.Connection.Close()
 	}
 	if c.haService != nil {
@@ -186,6 +360,21 @@ func (c *Client) Ingest(tts []prompb.TimeSeries, req *prompb.WriteRequest) (uint
 	return c.ingestor.Ingest(tts, req)
 }
 
+// Backfill buffers the timeseries object until the backfill is flushed
+func (c *Client) Backfill(tts []prompb.TimeSeries, req *prompb.WriteRequest) (uint64, error) {
+	return c.ingestor.Backfill(tts, req)
+}
+
+// FlushBackfill writes the buffered backfill timeseries into the DB
+func (c *Client) FlushBackfill() error {
+	return c.ingestor.FlushBackfill()
+}
+
+// BackfillProgress returns the progress of the backfill
+func (c *Client) BackfillProgress() ingestor.BackfillProgress {
+	return c.ingestor.BackfillProgress()
+}
+
 // Read returns the promQL query results
 func (c *Client) Read(req *prompb.ReadRequest) (*prompb.ReadResponse, error) {
 	if req == nil {
@@ -236,6 +425,12 @@ func (c *Client) Queryable() promql.Queryable {
 	return c.queryable
 }
 
+// CardinalityReader returns the reader computing the series cardinality
+// statistics.
+func (c *Client) CardinalityReader() cardinality.Reader {
+	return c.cardinality
+}
+
 func observeStatementCacheState(conn *pgx.Conn) bool {
 	// connections have been opened and are released already
 	// but the Client metrics have not been initialized yet
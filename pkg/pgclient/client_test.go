@@ -5,13 +5,17 @@
 package pgclient
 
 import (
+	"context"
 	"fmt"
 	"reflect"
 	"testing"
 
+	"github.com/jackc/pgx/v4/pgxpool"
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/prometheus/pkg/labels"
 	"github.com/prometheus/prometheus/promql/parser"
 	"github.com/prometheus/prometheus/storage"
+	"github.com/timescale/promscale/pkg/pgmodel/model"
 	"github.com/timescale/promscale/pkg/pgmodel/querier"
 	"github.com/timescale/promscale/pkg/prompb"
 )
@@ -25,7 +29,11 @@ type mockQuerier struct {
 
 var _ querier.Querier = (*mockQuerier)(nil)
 
-func (q *mockQuerier) Select(mint int64, maxt int64, sortSeries bool, hints *storage.SelectHints, path []parser.Node, ms ...*labels.Matcher) (storage.SeriesSet, parser.Node) {
+func (q *mockQuerier) Select(_ context.Context, mint int64, maxt int64, sortSeries bool, hints *storage.SelectHints, path []parser.Node, ms ...*labels.Matcher) (storage.SeriesSet, parser.Node) {
+	return nil, nil
+}
+
+func (q *mockQuerier) SelectSeries(int64, int64, int, string, ...[]*labels.Matcher) (*querier.SeriesPage, error) {
 	return nil, nil
 }
 
@@ -33,10 +41,14 @@ func (q *mockQuerier) Query(*prompb.Query) ([]*prompb.TimeSeries, error) {
 	return q.tts, q.err
 }
 
-func (q *mockQuerier) LabelNames() ([]string, error) {
+func (q *mockQuerier) LabelNames(int64, int64, ...*labels.Matcher) ([]string, error) {
 	return q.labelNames, q.labelNamesErr
 }
 
+func (q *mockQuerier) LabelValues(int64, int64, string, ...*labels.Matcher) ([]string, error) {
+	return nil, nil
+}
+
 func (q *mockQuerier) NumCachedLabels() int {
 	return 0
 }
@@ -180,3 +192,91 @@ func TestHealthCheck(t *testing.T) {
 		t.Fatal("health check method not called when expected")
 	}
 }
+
+func TestReadConnectionsReserveLabelsSlots(t *testing.T) {
+	pgConfig, err := pgxpool.ParseConfig("postgres://localhost/promscale?pool_max_conns=20")
+	if err != nil {
+		t.Fatal(err)
+	}
+	reads, err := openReadConnections(&Config{}, pgConfig, 4, model.NewSqlRecorder(nil, t))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer reads.close()
+
+	// 16 connections are left by the copiers, 2 of which are reserved for
+	// looking up series labels.
+	if slots := cap(reads.conn.(*readAdmissionConn).slots); slots != 14 {
+		t.Errorf("unexpected read slots: got %d wanted 14", slots)
+	}
+	if slots := cap(reads.labels.(*readAdmissionConn).slots); slots != 2 {
+		t.Errorf("unexpected series labels slots: got %d wanted 2", slots)
+	}
+}
+
+func TestPoolStatsPerInstance(t *testing.T) {
+	newPool := func(maxConns int) *pgxpool.Pool {
+		config, err := pgxpool.ParseConfig(fmt.Sprintf("postgres://localhost/promscale?pool_max_conns=%d", maxConns))
+		if err != nil {
+			t.Fatal(err)
+		}
+		config.LazyConnect = true
+		pool, err := pgxpool.ConnectConfig(context.Background(), config)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return pool
+	}
+	first, second := newPool(3), newPool(5)
+	defer first.Close()
+	defer second.Close()
+
+	stats := &poolStatsCollector{pools: make(map[*pgxpool.Pool]string)}
+	maxConns := func() float64 {
+		registry := prometheus.NewPedanticRegistry()
+		registry.MustRegister(stats)
+		families, err := registry.Gather()
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, family := range families {
+			if family.GetName() == "promscale_db_pool_max_connections" {
+				return family.GetMetric()[0].GetGauge().GetValue()
+			}
+		}
+		return 0
+	}
+
+	// Pools of two clients with the same name add up.
+	stats.add(primaryPool, first)
+	stats.add(primaryPool, second)
+	if got := maxConns(); got != 8 {
+		t.Errorf("unexpected max connections: got %v wanted 8", got)
+	}
+
+	stats.remove(first)
+	if got := maxConns(); got != 5 {
+		t.Errorf("unexpected max connections after removing a pool: got %v wanted 5", got)
+	}
+}
+
+func TestReadConnectionsSmallPool(t *testing.T) {
+	pgConfig, err := pgxpool.ParseConfig("postgres://localhost/promscale?pool_max_conns=4")
+	if err != nil {
+		t.Fatal(err)
+	}
+	reads, err := openReadConnections(&Config{}, pgConfig, 2, model.NewSqlRecorder(nil, t))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer reads.close()
+
+	// The slot for looking up series labels is taken out of the 2
+	// connections left by the copiers.
+	if slots := cap(reads.conn.(*readAdmissionConn).slots); slots != 1 {
+		t.Errorf("unexpected read slots: got %d wanted 1", slots)
+	}
+	if slots := cap(reads.labels.(*readAdmissionConn).slots); slots != 1 {
+		t.Errorf("unexpected series labels slots: got %d wanted 1", slots)
+	}
+}
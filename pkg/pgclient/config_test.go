@@ -198,3 +198,33 @@ func TestConfig_GetConnectionStr(t *testing.T) {
 		})
 	}
 }
+
+func TestConfig_GetNumConnectionsReadPool(t *testing.T) {
+	tests := []struct {
+		name               string
+		maxConnections     int
+		readConnectionsMax int
+		wantMax            int
+		wantErr            bool
+	}{
+		{name: "shared pool", maxConnections: 40, wantMax: 40},
+		{name: "dedicated read pool", maxConnections: 40, readConnectionsMax: 10, wantMax: 30},
+		{name: "read pool too large", maxConnections: 40, readConnectionsMax: 40, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &Config{
+				WriteConnectionsPerProc: 1,
+				MaxConnections:          tt.maxConnections,
+				ReadConnectionsMax:      tt.readConnectionsMax,
+			}
+			_, max, _, err := cfg.GetNumConnections()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("GetNumConnections() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && max != tt.wantMax {
+				t.Errorf("GetNumConnections() max = %v, want %v", max, tt.wantMax)
+			}
+		})
+	}
+}
@@ -5,9 +5,12 @@
 package pgclient
 
 import (
+	"math"
+	"sync"
+
+	"github.com/jackc/pgx/v4/pgxpool"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/timescale/promscale/pkg/util"
-	"math"
 )
 
 var (
@@ -160,3 +163,96 @@ func createStatementCacheLengthHistogramMetric(client *Client) prometheus.Histog
 		},
 	)
 }
+
+var (
+	poolAcquireCountDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(util.PromNamespace, "db_pool", "acquires_total"),
+		"Total number of connections acquired from the database connection pool.",
+		[]string{"pool"}, nil,
+	)
+	poolEmptyAcquireCountDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(util.PromNamespace, "db_pool", "empty_acquires_total"),
+		"Total number of acquires which had to wait for a connection because the database connection pool was empty.",
+		[]string{"pool"}, nil,
+	)
+	poolAcquireDurationDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(util.PromNamespace, "db_pool", "acquire_wait_seconds_total"),
+		"Total time spent waiting to acquire a connection from the database connection pool.",
+		[]string{"pool"}, nil,
+	)
+	poolAcquiredConnsDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(util.PromNamespace, "db_pool", "acquired_connections"),
+		"Number of currently acquired connections in the database connection pool.",
+		[]string{"pool"}, nil,
+	)
+	poolMaxConnsDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(util.PromNamespace, "db_pool", "max_connections"),
+		"Maximum number of connections in the database connection pool.",
+		[]string{"pool"}, nil,
+	)
+
+	poolStats = &poolStatsCollector{pools: make(map[*pgxpool.Pool]string)}
+)
+
+func init() {
+	prometheus.MustRegister(poolStats)
+}
+
+// poolStatsCollector exports the statistics of the database connection pools
+// in use by the clients. The pools are tracked per instance, and the
+// statistics of the pools sharing a name are summed up.
+type poolStatsCollector struct {
+	lock  sync.Mutex
+	pools map[*pgxpool.Pool]string
+}
+
+func (c *poolStatsCollector) add(name string, pool *pgxpool.Pool) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.pools[pool] = name
+}
+
+func (c *poolStatsCollector) remove(pool *pgxpool.Pool) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	delete(c.pools, pool)
+}
+
+func (c *poolStatsCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- poolAcquireCountDesc
+	ch <- poolEmptyAcquireCountDesc
+	ch <- poolAcquireDurationDesc
+	ch <- poolAcquiredConnsDesc
+	ch <- poolMaxConnsDesc
+}
+
+type poolStatsTotals struct {
+	acquires, emptyAcquires, acquireSeconds, acquiredConns, maxConns float64
+}
+
+func (c *poolStatsCollector) Collect(ch chan<- prometheus.Metric) {
+	c.lock.Lock()
+	totals := make(map[string]*poolStatsTotals)
+	for pool, name := range c.pools {
+		t, ok := totals[name]
+		if !ok {
+			t = &poolStatsTotals{}
+			totals[name] = t
+		}
+		stat := pool.Stat()
+		t.acquires += float64(stat.AcquireCount())
+		t.emptyAcquires += float64(stat.EmptyAcquireCount())
+		t.acquireSeconds += stat.AcquireDuration().Seconds()
+		t.acquiredConns += float64(stat.AcquiredConns())
+		t.maxConns += float64(stat.MaxConns())
+	}
+	c.lock.Unlock()
+
+	for name, t := range totals {
+		ch <- prometheus.MustNewConstMetric(poolAcquireCountDesc, prometheus.CounterValue, t.acquires, name)
+		ch <- prometheus.MustNewConstMetric(poolEmptyAcquireCountDesc, prometheus.CounterValue, t.emptyAcquires, name)
+		ch <- prometheus.MustNewConstMetric(poolAcquireDurationDesc, prometheus.CounterValue, t.acquireSeconds, name)
+		ch <- prometheus.MustNewConstMetric(poolAcquiredConnsDesc, prometheus.GaugeValue, t.acquiredConns, name)
+		ch <- prometheus.MustNewConstMetric(poolMaxConnsDesc, prometheus.GaugeValue, t.maxConns, name)
+	}
+}
@@ -0,0 +1,211 @@
+// This file and its contents are licensed under the Apache License 2.0.
+// Please see the included NOTICE for copyright information and
+// LICENSE for a copy of the license.
+
+package pgclient
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/timescale/promscale/pkg/pgmodel/common/errors"
+	"github.com/timescale/promscale/pkg/pgxconn"
+	"github.com/timescale/promscale/pkg/util"
+)
+
+var (
+	readQueueDepth = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: util.PromNamespace,
+			Name:      "read_queue_depth",
+			Help:      "Number of read queries waiting for a free database connection.",
+		},
+	)
+	readQueueWaitDuration = prometheus.NewHistogram(
+		prometheus.HistogramOpts{
+			Namespace: util.PromNamespace,
+			Name:      "read_queue_wait_duration_seconds",
+			Help:      "Time read queries spent waiting for a free database connection.",
+			Buckets:   prometheus.DefBuckets,
+		},
+	)
+	readQueueRejections = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: util.PromNamespace,
+			Name:      "read_queue_rejections_total",
+			Help:      "Total number of read queries rejected by the read admission queue.",
+		},
+		[]string{"reason"},
+	)
+)
+
+func init() {
+	prometheus.MustRegister(
+		readQueueDepth,
+		readQueueWaitDuration,
+		readQueueRejections,
+	)
+}
+
+// readAdmissionConn is a pgxconn.PgxConn which bounds the number of
+// concurrent read queries. Queries exceeding the concurrency wait in a
+// bounded queue and fail if they cannot be admitted within the timeout.
+// A slot is held until the returned rows, row or batch results are released.
+// A zero queue size or timeout disables the respective bound.
+type readAdmissionConn struct {
+	pgxconn.PgxConn
+	slots     chan struct{}
+	maxQueued int64
+	// queued is accessed atomically.
+	queued  int64
+	timeout time.Duration
+}
+
+func newReadAdmissionConn(conn pgxconn.PgxConn, concurrency, maxQueued int, timeout time.Duration) *readAdmissionConn {
+	if concurrency < 1 {
+		concurrency = 1
+	}
+	return &readAdmissionConn{
+		PgxConn:   conn,
+		slots:     make(chan struct{}, concurrency),
+		maxQueued: int64(maxQueued),
+		timeout:   timeout,
+	}
+}
+
+func (c *readAdmissionConn) acquire(ctx context.Context) error {
+	select {
+	case c.slots <- struct{}{}:
+		return nil
+	default:
+	}
+
+	if queued := atomic.AddInt64(&c.queued, 1); c.maxQueued > 0 && queued > c.maxQueued {
+		atomic.AddInt64(&c.queued, -1)
+		readQueueRejections.WithLabelValues("full").Inc()
+		return fmt.Errorf("%w (max queued: %d)", errors.ErrReadQueueFull, c.maxQueued)
+	}
+	readQueueDepth.Inc()
+	defer func() {
+		atomic.AddInt64(&c.queued, -1)
+		readQueueDepth.Dec()
+	}()
+
+	start := time.Now()
+	var timeout <-chan time.Time
+	if c.timeout > 0 {
+		timer := time.NewTimer(c.timeout)
+		defer timer.Stop()
+		timeout = timer.C
+	}
+	select {
+	case c.slots <- struct{}{}:
+		readQueueWaitDuration.Observe(time.Since(start).Seconds())
+		return nil
+	case <-timeout:
+		readQueueRejections.WithLabelValues("timeout").Inc()
+		return fmt.Errorf("%w (timeout: %s)", errors.ErrReadQueueTimeout, c.timeout)
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (c *readAdmissionConn) releaseFunc() func() {
+	var once sync.Once
+	return func() {
+		once.Do(func() { <-c.slots })
+	}
+}
+
+// Close is a no-op, the underlying connection is owned by the client.
+func (c *readAdmissionConn) Close() {}
+
+func (c *readAdmissionConn) Query(ctx context.Context, sql string, args ...interface{}) (pgx.Rows, error) {
+	if err := c.acquire(ctx); err != nil {
+		return nil, err
+	}
+	release := c.releaseFunc()
+	rows, err := c.PgxConn.Query(ctx, sql, args...)
+	if err != nil {
+		release()
+		return rows, err
+	}
+	return &admittedRows{Rows: rows, release: release}, nil
+}
+
+func (c *readAdmissionConn) QueryRow(ctx context.Context, sql string, args ...interface{}) pgx.Row {
+	if err := c.acquire(ctx); err != nil {
+		return errRow{err: err}
+	}
+	return &admittedRow{Row: c.PgxConn.QueryRow(ctx, sql, args...), release: c.releaseFunc()}
+}
+
+func (c *readAdmissionConn) SendBatch(ctx context.Context, b pgxconn.PgxBatch) (pgx.BatchResults, error) {
+	if err := c.acquire(ctx); err != nil {
+		return nil, err
+	}
+	release := c.releaseFunc()
+	results, err := c.PgxConn.SendBatch(ctx, b)
+	if err != nil {
+		release()
+		return results, err
+	}
+	return &admittedBatchResults{BatchResults: results, release: release}, nil
+}
+
+// admittedRows releases the admission slot once the rows are closed or
+// exhausted.
+type admittedRows struct {
+	pgx.Rows
+	release func()
+}
+
+func (r *admittedRows) Next() bool {
+	if r.Rows.Next() {
+		return true
+	}
+	r.release()
+	return false
+}
+
+func (r *admittedRows) Close() {
+	r.Rows.Close()
+	r.release()
+}
+
+// admittedRow releases the admission slot once the row is scanned.
+type admittedRow struct {
+	pgx.Row
+	release func()
+}
+
+func (r *admittedRow) Scan(dest ...interface{}) error {
+	defer r.release()
+	return r.Row.Scan(dest...)
+}
+
+// admittedBatchResults releases the admission slot once the batch results
+// are closed.
+type admittedBatchResults struct {
+	pgx.BatchResults
+	release func()
+}
+
+func (r *admittedBatchResults) Close() error {
+	defer r.release()
+	return r.BatchResults.Close()
+}
+
+// errRow is a pgx.Row returning an error on scan.
+type errRow struct {
+	err error
+}
+
+func (r errRow) Scan(...interface{}) error {
+	return r.err
+}
@@ -0,0 +1,84 @@
+// This file and its contents are licensed under the Apache License 2.0.
+// Please see the included NOTICE for copyright information and
+// LICENSE for a copy of the license.
+
+package pgclient
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	pgmodelErrs "github.com/timescale/promscale/pkg/pgmodel/common/errors"
+	"github.com/timescale/promscale/pkg/pgmodel/model"
+)
+
+func selectQueries(n int) []model.SqlQuery {
+	queries := make([]model.SqlQuery, n)
+	for i := range queries {
+		queries[i] = model.SqlQuery{Sql: "SELECT 1", Results: model.RowResults{{1}}}
+	}
+	return queries
+}
+
+func TestReadAdmissionConnQueueFull(t *testing.T) {
+	conn := newReadAdmissionConn(model.NewSqlRecorder(selectQueries(2), t), 1, 1, time.Minute)
+
+	rows, err := conn.Query(context.Background(), "SELECT 1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	queued := make(chan error)
+	go func() {
+		rows, err := conn.Query(context.Background(), "SELECT 1")
+		if err == nil {
+			rows.Close()
+		}
+		queued <- err
+	}()
+
+	// Wait for the second query to be queued.
+	for deadline := time.Now().Add(5 * time.Second); readQueueLen(conn) == 0; {
+		if time.Now().After(deadline) {
+			t.Fatal("query was never queued")
+		}
+		time.Sleep(time.Millisecond)
+	}
+
+	if _, err = conn.Query(context.Background(), "SELECT 1"); !errors.Is(err, pgmodelErrs.ErrReadQueueFull) {
+		t.Fatalf("unexpected error: got %v, wanted %v", err, pgmodelErrs.ErrReadQueueFull)
+	}
+
+	rows.Close()
+	if err = <-queued; err != nil {
+		t.Fatalf("unexpected error for queued query: %v", err)
+	}
+}
+
+func TestReadAdmissionConnTimeout(t *testing.T) {
+	conn := newReadAdmissionConn(model.NewSqlRecorder(selectQueries(2), t), 1, 0, 10*time.Millisecond)
+
+	var value int
+	row := conn.QueryRow(context.Background(), "SELECT 1")
+
+	if _, err := conn.Query(context.Background(), "SELECT 1"); !errors.Is(err, pgmodelErrs.ErrReadQueueTimeout) {
+		t.Fatalf("unexpected error: got %v, wanted %v", err, pgmodelErrs.ErrReadQueueTimeout)
+	}
+
+	// Scanning the row releases the slot.
+	if err := row.Scan(&value); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	rows, err := conn.Query(context.Background(), "SELECT 1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	rows.Close()
+}
+
+func readQueueLen(c *readAdmissionConn) int64 {
+	return atomic.LoadInt64(&c.queued)
+}
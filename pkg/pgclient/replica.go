@@ -0,0 +1,175 @@
+// This file and its contents are licensed under the Apache License 2.0.
+// Please see the included NOTICE for copyright information and
+// LICENSE for a copy of the license.
+
+package pgclient
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/timescale/promscale/pkg/log"
+	"github.com/timescale/promscale/pkg/pgxconn"
+	"github.com/timescale/promscale/pkg/util"
+)
+
+const (
+	replicaHealthCheckInterval = 5 * time.Second
+	// The replica is considered to have no lag if it replayed everything it
+	// received, this prevents reporting growing lag while the primary is idle.
+	// A replica whose WAL receiver is not streaming receives nothing, so its
+	// lag is unknown and it is considered to be lagging indefinitely. Reading
+	// the WAL receiver status requires the pg_read_all_stats role.
+	replicaLagSQL = `SELECT CASE
+		WHEN NOT pg_is_in_recovery() THEN 0
+		WHEN NOT EXISTS (SELECT 1 FROM pg_stat_wal_receiver WHERE status = 'streaming') THEN 'Infinity'::float8
+		WHEN pg_last_wal_receive_lsn() = pg_last_wal_replay_lsn() THEN 0
+		ELSE COALESCE(EXTRACT(EPOCH FROM now() - pg_last_xact_replay_timestamp())::float8, 'Infinity')
+	END`
+)
+
+var (
+	readReplicaLag = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: util.PromNamespace,
+			Name:      "read_replica_lag_seconds",
+			Help:      "Replication lag of the read replica as last observed by the connector.",
+		},
+	)
+	readReplicaInUse = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: util.PromNamespace,
+			Name:      "read_replica_in_use",
+			Help:      "Whether reads are currently served by the read replica (1) or by the primary (0).",
+		},
+	)
+)
+
+func init() {
+	prometheus.MustRegister(
+		readReplicaLag,
+		readReplicaInUse,
+	)
+}
+
+// readReplicaConn is a pgxconn.PgxConn which serves queries from a read
+// replica while it is healthy and its replication lag is below the configured
+// threshold, and falls back to the primary otherwise. Writes always go to the
+// primary.
+type readReplicaConn struct {
+	primary pgxconn.PgxConn
+	replica pgxconn.PgxConn
+	maxLag  time.Duration
+	// useReplica is accessed atomically, 1 means reads go to the replica.
+	useReplica int32
+	stop       chan struct{}
+	wg         sync.WaitGroup
+}
+
+var _ pgxconn.PgxConn = (*readReplicaConn)(nil)
+
+func newReadReplicaConn(primary, replica pgxconn.PgxConn, maxLag time.Duration) *readReplicaConn {
+	r := &readReplicaConn{
+		primary: primary,
+		replica: replica,
+		maxLag:  maxLag,
+		stop:    make(chan struct{}),
+	}
+	r.checkHealth()
+	r.wg.Add(1)
+	go r.runHealthChecks()
+	return r
+}
+
+func (r *readReplicaConn) runHealthChecks() {
+	defer r.wg.Done()
+	ticker := time.NewTicker(replicaHealthCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-r.stop:
+			return
+		case <-ticker.C:
+			r.checkHealth()
+		}
+	}
+}
+
+// checkHealth decides whether reads should be served by the replica, based on
+// whether it responds and how far it lags behind the primary.
+func (r *readReplicaConn) checkHealth() {
+	ctx, cancel := context.WithTimeout(context.Background(), replicaHealthCheckInterval)
+	defer cancel()
+
+	var lagSeconds float64
+	healthy := true
+	if err := r.replica.QueryRow(ctx, replicaLagSQL).Scan(&lagSeconds); err != nil {
+		log.Warn("msg", "read replica health check failed, falling back to primary", "err", err)
+		healthy = false
+	} else {
+		readReplicaLag.Set(lagSeconds)
+		if r.maxLag > 0 && lagSeconds > r.maxLag.Seconds() {
+			log.Warn("msg", "read replica is lagging, falling back to primary", "lag_seconds", lagSeconds, "max_lag", r.maxLag)
+			healthy = false
+		}
+	}
+
+	var useReplica int32
+	if healthy {
+		useReplica = 1
+	}
+	if previous := atomic.SwapInt32(&r.useReplica, useReplica); previous != useReplica && healthy {
+		log.Info("msg", "read replica is healthy, serving reads from it")
+	}
+	readReplicaInUse.Set(float64(useReplica))
+}
+
+// reader returns the connection which should currently serve reads.
+func (r *readReplicaConn) reader() pgxconn.PgxConn {
+	if atomic.LoadInt32(&r.useReplica) == 1 {
+		return r.replica
+	}
+	return r.primary
+}
+
+// Close stops the health checks and closes the replica connection pool. The
+// primary is owned by the client and closed separately.
+func (r *readReplicaConn) Close() {
+	close(r.stop)
+	r.wg.Wait()
+	r.replica.Close()
+}
+
+func (r *readReplicaConn) Exec(ctx context.Context, sql string, arguments ...interface{}) (pgconn.CommandTag, error) {
+	return r.primary.Exec(ctx, sql, arguments...)
+}
+
+func (r *readReplicaConn) Query(ctx context.Context, sql string, args ...interface{}) (pgx.Rows, error) {
+	return r.reader().Query(ctx, sql, args...)
+}
+
+func (r *readReplicaConn) QueryRow(ctx context.Context, sql string, args ...interface{}) pgx.Row {
+	return r.reader().QueryRow(ctx, sql, args...)
+}
+
+func (r *readReplicaConn) CopyFrom(ctx context.Context, tableName pgx.Identifier, columnNames []string, rowSrc pgx.CopyFromSource) (int64, error) {
+	return r.primary.CopyFrom(ctx, tableName, columnNames, rowSrc)
+}
+
+func (r *readReplicaConn) CopyFromRows(rows [][]interface{}) pgx.CopyFromSource {
+	return r.primary.CopyFromRows(rows)
+}
+
+func (r *readReplicaConn) NewBatch() pgxconn.PgxBatch {
+	return r.primary.NewBatch()
+}
+
+// SendBatch sends batches to the primary, as they are used for writes.
+func (r *readReplicaConn) SendBatch(ctx context.Context, b pgxconn.PgxBatch) (pgx.BatchResults, error) {
+	return r.primary.SendBatch(ctx, b)
+}
@@ -0,0 +1,74 @@
+// This file and its contents are licensed under the Apache License 2.0.
+// Please see the included NOTICE for copyright information and
+// LICENSE for a copy of the license.
+
+package pgclient
+
+import (
+	"fmt"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/timescale/promscale/pkg/pgmodel/model"
+)
+
+func TestReadReplicaConnRouting(t *testing.T) {
+	testCases := []struct {
+		name       string
+		maxLag     time.Duration
+		lagResult  model.SqlQuery
+		useReplica bool
+	}{
+		{
+			name:       "replica without lag",
+			maxLag:     time.Minute,
+			lagResult:  model.SqlQuery{Sql: replicaLagSQL, Results: model.RowResults{{0.0}}},
+			useReplica: true,
+		},
+		{
+			name:       "replica lagging",
+			maxLag:     time.Minute,
+			lagResult:  model.SqlQuery{Sql: replicaLagSQL, Results: model.RowResults{{120.0}}},
+			useReplica: false,
+		},
+		{
+			name:       "replica with a disconnected WAL receiver",
+			maxLag:     time.Minute,
+			lagResult:  model.SqlQuery{Sql: replicaLagSQL, Results: model.RowResults{{math.Inf(1)}}},
+			useReplica: false,
+		},
+		{
+			name:       "lag check disabled",
+			lagResult:  model.SqlQuery{Sql: replicaLagSQL, Results: model.RowResults{{120.0}}},
+			useReplica: true,
+		},
+		{
+			name:       "replica unhealthy",
+			maxLag:     time.Minute,
+			lagResult:  model.SqlQuery{Sql: replicaLagSQL, Err: fmt.Errorf("connection refused")},
+			useReplica: false,
+		},
+	}
+
+	for _, c := range testCases {
+		t.Run(c.name, func(t *testing.T) {
+			primary := model.NewSqlRecorder(nil, t)
+			replica := model.NewSqlRecorder([]model.SqlQuery{c.lagResult}, t)
+			conn := &readReplicaConn{
+				primary: primary,
+				replica: replica,
+				maxLag:  c.maxLag,
+			}
+			conn.checkHealth()
+
+			expected := interface{}(primary)
+			if c.useReplica {
+				expected = replica
+			}
+			if conn.reader() != expected {
+				t.Errorf("unexpected connection used for reads, wanted replica: %v", c.useReplica)
+			}
+		})
+	}
+}
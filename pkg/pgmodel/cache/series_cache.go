@@ -15,6 +15,7 @@ import (
 	"github.com/prometheus/prometheus/pkg/labels"
 	"github.com/timescale/promscale/pkg/clockcache"
 	"github.com/timescale/promscale/pkg/log"
+	"github.com/timescale/promscale/pkg/pgmodel/common/errors"
 	"github.com/timescale/promscale/pkg/pgmodel/model"
 	"github.com/timescale/promscale/pkg/prompb"
 )
@@ -157,6 +158,12 @@ func generateKey(labels []prompb.Label) (key string, metricName string, error er
 	expectedStrLen := len(labels) * 4 // 2 for the length of each key, and 2 for the length of each value
 	for i := range labels {
 		l := labels[i]
+		if l.Name == "" {
+			return "", metricName, errors.NewNonRetryableError(errors.ReasonInvalidLabels, fmt.Errorf("empty label name"))
+		}
+		if i > 0 && labels[i-1].Name == l.Name {
+			return "", metricName, errors.NewNonRetryableError(errors.ReasonInvalidLabels, fmt.Errorf("duplicate label name %q", l.Name))
+		}
 		expectedStrLen += len(l.Name) + len(l.Value)
 	}
 
@@ -165,7 +172,8 @@ func generateKey(labels []prompb.Label) (key string, metricName string, error er
 	// total length anyway, we only use 16bits to store the legth of each substring
 	// in our string encoding
 	if expectedStrLen > math.MaxUint16 {
-		return "", metricName, fmt.Errorf("series too long, combined series has length %d, max length %d", expectedStrLen, ^uint16(0))
+		return "", metricName, errors.NewNonRetryableError(errors.ReasonInvalidLabels,
+			fmt.Errorf("series too long, combined series has length %d, max length %d", expectedStrLen, ^uint16(0)))
 	}
 
 	// the string representation is
@@ -6,6 +6,8 @@ import (
 	"testing"
 
 	promLabels "github.com/prometheus/prometheus/pkg/labels"
+	"github.com/timescale/promscale/pkg/pgmodel/common/errors"
+	"github.com/timescale/promscale/pkg/prompb"
 )
 
 func TestBigLables(t *testing.T) {
@@ -30,3 +32,16 @@ func TestBigLables(t *testing.T) {
 		t.Errorf("expected error")
 	}
 }
+
+func TestInvalidLabels(t *testing.T) {
+	cache := NewSeriesCache(DefaultConfig, nil)
+	for _, l := range [][]prompb.Label{
+		{{Name: "__name__", Value: "test"}, {Name: "", Value: "a"}},
+		{{Name: "__name__", Value: "test"}, {Name: "job", Value: "a"}, {Name: "job", Value: "b"}},
+	} {
+		_, _, err := cache.GetSeriesFromProtos(l)
+		if reason, _ := errors.NonRetryableReason(err); reason != errors.ReasonInvalidLabels {
+			t.Errorf("unexpected error for %v: %v", l, err)
+		}
+	}
+}
@@ -0,0 +1,154 @@
+// This file and its contents are licensed under the Apache License 2.0.
+// Please see the included NOTICE for copyright information and
+// LICENSE for a copy of the license.
+
+package cardinality
+
+import (
+	"context"
+
+	"github.com/timescale/promscale/pkg/pgxconn"
+)
+
+// Series marked for deletion have no data left and do not count towards the
+// cardinality, neither do the labels only used by them.
+const (
+	liveLabelCondition = `EXISTS (
+		SELECT 1
+		FROM _prom_catalog.series s
+		WHERE s.labels && ARRAY[l.id]
+		AND s.delete_epoch IS NULL
+	)`
+
+	totalsSQL = `SELECT
+		(SELECT count(*) FROM _prom_catalog.series WHERE delete_epoch IS NULL),
+		(SELECT count(*) FROM _prom_catalog.label l WHERE ` + liveLabelCondition + `)`
+
+	seriesCountByMetricNameSQL = `SELECT m.metric_name, count(*)
+	FROM _prom_catalog.series s
+	INNER JOIN _prom_catalog.metric m
+	ON (m.id = s.metric_id)
+	WHERE s.delete_epoch IS NULL
+	GROUP BY m.metric_name
+	ORDER BY count(*) DESC, m.metric_name
+	LIMIT $1`
+
+	labelValueCountByLabelNameSQL = `SELECT l.key, count(*)
+	FROM _prom_catalog.label l
+	WHERE ` + liveLabelCondition + `
+	GROUP BY l.key
+	ORDER BY count(*) DESC, l.key
+	LIMIT $1`
+
+	memoryInBytesByLabelNameSQL = `SELECT l.key, sum(octet_length(l.value))
+	FROM _prom_catalog.label l
+	WHERE ` + liveLabelCondition + `
+	GROUP BY l.key
+	ORDER BY sum(octet_length(l.value)) DESC, l.key
+	LIMIT $1`
+
+	seriesCountByLabelValuePairSQL = `SELECT l.key || '=' || l.value, c.series_count
+	FROM (
+		SELECT label_id, count(*) AS series_count
+		FROM _prom_catalog.series s, unnest(s.labels) AS label_id
+		WHERE s.delete_epoch IS NULL
+		GROUP BY label_id
+		ORDER BY count(*) DESC
+		LIMIT $1
+	) c
+	INNER JOIN _prom_catalog.label l
+	ON (l.id = c.label_id)
+	ORDER BY c.series_count DESC, l.key, l.value`
+)
+
+// Stat is a name with its associated count.
+type Stat struct {
+	Name  string
+	Value int64
+}
+
+// Stats holds the cardinality statistics of the stored series.
+type Stats struct {
+	// NumSeries is the total number of series.
+	NumSeries int64
+	// NumLabelPairs is the total number of label name/value pairs.
+	NumLabelPairs int64
+	// SeriesCountByMetricName lists the metrics with the most series.
+	SeriesCountByMetricName []Stat
+	// LabelValueCountByLabelName lists the label names with the most values.
+	LabelValueCountByLabelName []Stat
+	// MemoryInBytesByLabelName lists the label names whose values take the
+	// most space.
+	MemoryInBytesByLabelName []Stat
+	// SeriesCountByLabelValuePair lists the label name=value pairs used by
+	// the most series.
+	SeriesCountByLabelValuePair []Stat
+}
+
+// Reader computes cardinality statistics.
+type Reader interface {
+	// Stats returns the cardinality statistics, each top list containing at
+	// most limit entries.
+	Stats(limit int) (*Stats, error)
+}
+
+// NewReader returns a Reader computing the statistics from the series, label
+// and metric catalog tables.
+func NewReader(conn pgxconn.PgxConn) Reader {
+	return &pgxReader{conn: conn}
+}
+
+type pgxReader struct {
+	conn pgxconn.PgxConn
+}
+
+// Stats implements the Reader interface. All the statistics are fetched in
+// a single batch.
+func (r *pgxReader) Stats(limit int) (*Stats, error) {
+	stats := &Stats{}
+	topLists := []*[]Stat{
+		&stats.SeriesCountByMetricName,
+		&stats.LabelValueCountByLabelName,
+		&stats.MemoryInBytesByLabelName,
+		&stats.SeriesCountByLabelValuePair,
+	}
+
+	batch := r.conn.NewBatch()
+	batch.Queue(totalsSQL)
+	batch.Queue(seriesCountByMetricNameSQL, limit)
+	batch.Queue(labelValueCountByLabelNameSQL, limit)
+	batch.Queue(memoryInBytesByLabelNameSQL, limit)
+	batch.Queue(seriesCountByLabelValuePairSQL, limit)
+
+	results, err := r.conn.SendBatch(context.Background(), batch)
+	if err != nil {
+		return nil, err
+	}
+	defer results.Close()
+
+	if err = results.QueryRow().Scan(&stats.NumSeries, &stats.NumLabelPairs); err != nil {
+		return nil, err
+	}
+
+	for _, list := range topLists {
+		rows, err := results.Query()
+		if err != nil {
+			return nil, err
+		}
+		*list = make([]Stat, 0, limit)
+		for rows.Next() {
+			var s Stat
+			if err = rows.Scan(&s.Name, &s.Value); err != nil {
+				rows.Close()
+				return nil, err
+			}
+			*list = append(*list, s)
+		}
+		err = rows.Err()
+		rows.Close()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return stats, nil
+}
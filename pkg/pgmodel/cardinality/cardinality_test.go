@@ -0,0 +1,87 @@
+// This file and its contents are licensed under the Apache License 2.0.
+// Please see the included NOTICE for copyright information and
+// LICENSE for a copy of the license.
+
+package cardinality
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/timescale/promscale/pkg/pgmodel/model"
+)
+
+func TestReaderStats(t *testing.T) {
+	mock := model.NewSqlRecorder([]model.SqlQuery{
+		{
+			Sql:     totalsSQL,
+			Results: model.RowResults{{int64(30), int64(12)}},
+		},
+		{
+			Sql:     seriesCountByMetricNameSQL,
+			Args:    []interface{}{2},
+			Results: model.RowResults{{"up", int64(20)}, {"go_goroutines", int64(10)}},
+		},
+		{
+			Sql:     labelValueCountByLabelNameSQL,
+			Args:    []interface{}{2},
+			Results: model.RowResults{{"instance", int64(5)}, {"__name__", int64(2)}},
+		},
+		{
+			Sql:     memoryInBytesByLabelNameSQL,
+			Args:    []interface{}{2},
+			Results: model.RowResults{{"instance", int64(70)}, {"job", int64(12)}},
+		},
+		{
+			Sql:     seriesCountByLabelValuePairSQL,
+			Args:    []interface{}{2},
+			Results: model.RowResults{{"job=node", int64(30)}},
+		},
+	}, t)
+
+	stats, err := NewReader(mock).Stats(2)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := &Stats{
+		NumSeries:     30,
+		NumLabelPairs: 12,
+		SeriesCountByMetricName: []Stat{
+			{Name: "up", Value: 20},
+			{Name: "go_goroutines", Value: 10},
+		},
+		LabelValueCountByLabelName: []Stat{
+			{Name: "instance", Value: 5},
+			{Name: "__name__", Value: 2},
+		},
+		MemoryInBytesByLabelName: []Stat{
+			{Name: "instance", Value: 70},
+			{Name: "job", Value: 12},
+		},
+		SeriesCountByLabelValuePair: []Stat{
+			{Name: "job=node", Value: 30},
+		},
+	}
+	if !reflect.DeepEqual(stats, expected) {
+		t.Errorf("unexpected stats:\ngot\n%+v\nwanted\n%+v", stats, expected)
+	}
+}
+
+func TestStatsExcludeDeletedSeries(t *testing.T) {
+	for _, sql := range []string{
+		totalsSQL,
+		seriesCountByMetricNameSQL,
+		labelValueCountByLabelNameSQL,
+		memoryInBytesByLabelNameSQL,
+		seriesCountByLabelValuePairSQL,
+	} {
+		if !strings.Contains(sql, "delete_epoch IS NULL") {
+			t.Errorf("statistics query does not exclude the series marked for deletion:\n%s", sql)
+		}
+	}
+	if strings.Count(totalsSQL, "delete_epoch IS NULL") != 2 {
+		t.Errorf("label pairs count does not exclude the labels of series marked for deletion:\n%s", totalsSQL)
+	}
+}
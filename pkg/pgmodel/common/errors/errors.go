@@ -4,7 +4,10 @@
 
 package errors
 
-import "fmt"
+import (
+	goErrors "errors"
+	"fmt"
+)
 
 var (
 	ErrNoMetricName                = fmt.Errorf("metric name missing")
@@ -16,4 +19,59 @@ var (
 	ErrTimeBasedDeletion           = fmt.Errorf("time based series deletion is unsupported")
 	ErrInvalidSemverFormat         = fmt.Errorf("app version is not semver format, aborting migration")
 	ErrQueryMismatchTimestampValue = fmt.Errorf("query returned a mismatch in timestamps and values")
+	ErrQueryMaxSeries              = fmt.Errorf("query processing would load too many series into memory")
+	ErrQueryMaxSamples             = fmt.Errorf("query processing would load too many samples into memory")
+	ErrQueryMaxRange               = fmt.Errorf("query time range exceeds the maximum allowed range")
+	ErrReadQueueFull               = fmt.Errorf("too many read queries waiting for a database connection")
+	ErrReadQueueTimeout            = fmt.Errorf("timed out waiting for a database connection for reading")
+	ErrInvalidSeriesToken          = fmt.Errorf("invalid series continuation token")
+	ErrCrossMetricMaxMetrics       = fmt.Errorf("query without a selective matcher would touch too many metrics")
+	ErrCrossMetricMaxSeries        = fmt.Errorf("query without a selective matcher would touch too many series")
+	ErrSpoolFull                   = fmt.Errorf("the write-ahead spool is full")
+	ErrIngestBudgetExceeded        = fmt.Errorf("too many samples are being ingested")
+	ErrSampleOutOfWindow           = fmt.Errorf("sample timestamps outside the accepted time window")
+	ErrBackfillWithoutSpool        = fmt.Errorf("backfilling requires the write-ahead spool to be enabled")
+	ErrViewNotSelectable           = fmt.Errorf("registered views can only be selected by an equality matcher on their metric name")
+)
+
+// Reasons of the non-retryable ingest errors, by which the dropped samples are
+// counted.
+const (
+	ReasonNoMetricName     = "no_metric_name"
+	ReasonInvalidLabels    = "invalid_labels"
+	ReasonHAValidation     = "ha_validation"
+	ReasonInvalidSamples   = "invalid_samples"
+	ReasonDuplicateSamples = "duplicate_samples"
+	ReasonOutOfWindow      = "out_of_window"
 )
+
+// NonRetryableError is an error ingesting a write request which retrying the
+// request does not resolve, e.g. because its samples are invalid. Errors not
+// wrapped in a NonRetryableError are considered retryable.
+type NonRetryableError struct {
+	Reason string
+	Err    error
+}
+
+// NewNonRetryableError marks err as non-retryable for the given reason.
+func NewNonRetryableError(reason string, err error) error {
+	return &NonRetryableError{Reason: reason, Err: err}
+}
+
+func (e *NonRetryableError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *NonRetryableError) Unwrap() error {
+	return e.Err
+}
+
+// NonRetryableReason returns the reason of err, and true, if err is a
+// non-retryable ingest error.
+func NonRetryableReason(err error) (string, bool) {
+	var nre *NonRetryableError
+	if goErrors.As(err, &nre) {
+		return nre.Reason, true
+	}
+	return "", false
+}
@@ -16,4 +16,5 @@ const (
 	SeriesView = "prom_series"
 	MetricView = "prom_metric"
 	DataSeries = "prom_data_series"
+	DataRollup = "prom_data_rollup"
 )
@@ -0,0 +1,66 @@
+// This file and its contents are licensed under the Apache License 2.0.
+// Please see the included NOTICE for copyright information and
+// LICENSE for a copy of the license.
+
+package downsample
+
+import (
+	"flag"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Config holds the downsampling policy: the resolutions the samples are
+// rolled up at, and how the connector maintains the rollups.
+type Config struct {
+	ResolutionsList string
+	// Resolutions are the rollup resolutions parsed from ResolutionsList, in
+	// ascending order. Downsampling is disabled without resolutions.
+	Resolutions []time.Duration
+	// Interval is how often the rollups are updated. A zero interval only
+	// reads the rollups maintained by other connectors.
+	Interval time.Duration
+	// Lag is how long the samples are waited for before they are rolled up.
+	Lag time.Duration
+}
+
+// ParseFlags parses the configuration flags specific to downsampling.
+func ParseFlags(fs *flag.FlagSet, cfg *Config) *Config {
+	fs.StringVar(&cfg.ResolutionsList, "downsample-resolutions", "", "Resolutions the samples are rolled up at, separated by commas, e.g. '5m,1h'. The rollups keep the minimum, maximum, sum, count and last value of every series per resolution, "+
+		"and queries read from the coarsest rollup fitting their step and functions. Downsampling is disabled by default.")
+	fs.DurationVar(&cfg.Interval, "downsample-interval", time.Minute, "How often the connector updates the rollups. A value of 0 stops this connector from updating the rollups, while its queries still read the rollups updated by other connectors.")
+	fs.DurationVar(&cfg.Lag, "downsample-lag", 5*time.Minute, "How long the samples are waited for before they are rolled up. Samples older than this that arrive after their rollup was computed are only visible in the raw data.")
+	return cfg
+}
+
+// Validate parses the resolutions and validates the configuration.
+func Validate(cfg *Config) error {
+	cfg.Resolutions = nil
+	if cfg.ResolutionsList != "" {
+		seen := make(map[time.Duration]bool)
+		for _, s := range strings.Split(cfg.ResolutionsList, ",") {
+			resolution, err := time.ParseDuration(strings.TrimSpace(s))
+			if err != nil {
+				return fmt.Errorf("invalid downsample-resolutions: %w", err)
+			}
+			if resolution < time.Second || resolution%time.Millisecond != 0 {
+				return fmt.Errorf("invalid downsample-resolutions: %s must be a whole number of milliseconds of at least 1s", resolution)
+			}
+			if seen[resolution] {
+				return fmt.Errorf("invalid downsample-resolutions: %s is repeated", resolution)
+			}
+			seen[resolution] = true
+			cfg.Resolutions = append(cfg.Resolutions, resolution)
+		}
+		sort.Slice(cfg.Resolutions, func(i, j int) bool { return cfg.Resolutions[i] < cfg.Resolutions[j] })
+	}
+	if cfg.Interval < 0 {
+		return fmt.Errorf("downsample-interval must be non-negative")
+	}
+	if cfg.Lag < 0 {
+		return fmt.Errorf("downsample-lag must be non-negative")
+	}
+	return nil
+}
@@ -0,0 +1,145 @@
+// This file and its contents are licensed under the Apache License 2.0.
+// Please see the included NOTICE for copyright information and
+// LICENSE for a copy of the license.
+
+package downsample
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/timescale/promscale/pkg/log"
+	"github.com/timescale/promscale/pkg/pgmodel/common/schema"
+	"github.com/timescale/promscale/pkg/pgxconn"
+	"github.com/timescale/promscale/pkg/util"
+)
+
+const (
+	getMetricNamesSQL   = "SELECT metric_name FROM " + schema.Catalog + ".metric ORDER BY metric_name"
+	downsampleMetricSQL = "SELECT " + schema.Catalog + ".downsample_metric($1, $2, $3, $4)"
+
+	// maxBucketsPerRun bounds the work of a single update of a metric, so
+	// that rolling up a long history is spread over several runs instead
+	// of holding a long transaction.
+	maxBucketsPerRun = 1000
+)
+
+var (
+	rollupRows = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: util.PromNamespace,
+			Name:      "downsample_rollup_rows_total",
+			Help:      "Number of rollup rows written by the downsampling job, per resolution.",
+		},
+		[]string{"resolution"},
+	)
+	rollupErrors = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: util.PromNamespace,
+			Name:      "downsample_errors_total",
+			Help:      "Number of metrics the downsampling job failed to roll up, per resolution.",
+		},
+		[]string{"resolution"},
+	)
+)
+
+func init() {
+	prometheus.MustRegister(
+		rollupRows,
+		rollupErrors,
+	)
+}
+
+// Job periodically rolls up the samples of every metric at the configured
+// resolutions.
+type Job struct {
+	conn pgxconn.PgxConn
+	cfg  Config
+	stop chan struct{}
+	wg   sync.WaitGroup
+}
+
+// NewJob starts the downsampling job, which runs every cfg.Interval until it
+// is closed.
+func NewJob(conn pgxconn.PgxConn, cfg Config) *Job {
+	j := &Job{
+		conn: conn,
+		cfg:  cfg,
+		stop: make(chan struct{}),
+	}
+	j.wg.Add(1)
+	go j.run()
+	log.Info("msg", "Downsampling job started", "resolutions", cfg.ResolutionsList, "interval", cfg.Interval, "lag", cfg.Lag)
+	return j
+}
+
+func (j *Job) run() {
+	defer j.wg.Done()
+	ticker := time.NewTicker(j.cfg.Interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-j.stop:
+			return
+		case <-ticker.C:
+			if err := j.runOnce(context.Background()); err != nil {
+				log.Warn("msg", "downsampling failed", "err", err)
+			}
+		}
+	}
+}
+
+// runOnce rolls up every metric at every resolution, the finer resolutions
+// first. A metric failing to roll up does not stop the others.
+func (j *Job) runOnce(ctx context.Context) error {
+	metrics, err := j.metricNames(ctx)
+	if err != nil {
+		return err
+	}
+	for _, resolution := range j.cfg.Resolutions {
+		label := resolution.String()
+		for _, metric := range metrics {
+			select {
+			case <-j.stop:
+				return nil
+			default:
+			}
+			var rows int64
+			err := j.conn.QueryRow(ctx, downsampleMetricSQL, metric, resolution.Milliseconds(), j.cfg.Lag.Milliseconds(), maxBucketsPerRun).Scan(&rows)
+			if err != nil {
+				rollupErrors.WithLabelValues(label).Inc()
+				log.Warn("msg", "failed to roll up metric", "metric", metric, "resolution", resolution, "err", err)
+				continue
+			}
+			rollupRows.WithLabelValues(label).Add(float64(rows))
+		}
+	}
+	return nil
+}
+
+func (j *Job) metricNames(ctx context.Context) ([]string, error) {
+	rows, err := j.conn.Query(ctx, getMetricNamesSQL)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var metrics []string
+	for rows.Next() {
+		var metric string
+		if err := rows.Scan(&metric); err != nil {
+			return nil, err
+		}
+		metrics = append(metrics, metric)
+	}
+	return metrics, rows.Err()
+}
+
+// Close stops the job, waiting for the current run to finish the metric it
+// is rolling up.
+func (j *Job) Close() {
+	close(j.stop)
+	j.wg.Wait()
+}
@@ -0,0 +1,42 @@
+// This file and its contents are licensed under the Apache License 2.0.
+// Please see the included NOTICE for copyright information and
+// LICENSE for a copy of the license.
+
+package downsample
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/timescale/promscale/pkg/pgmodel/model"
+)
+
+func TestJobRunOnce(t *testing.T) {
+	cfg := Config{Resolutions: []time.Duration{5 * time.Minute, time.Hour}, Lag: time.Minute}
+	downsample := func(metric string, resolution time.Duration, rows int64, err error) model.SqlQuery {
+		return model.SqlQuery{
+			Sql:     downsampleMetricSQL,
+			Args:    []interface{}{metric, resolution.Milliseconds(), int64(60000), maxBucketsPerRun},
+			Results: model.RowResults{{rows}},
+			Err:     err,
+		}
+	}
+	conn := model.NewSqlRecorder([]model.SqlQuery{
+		{
+			Sql:     getMetricNamesSQL,
+			Results: model.RowResults{{"bar"}, {"foo"}},
+		},
+		// A metric failing to roll up doesn't stop the others.
+		downsample("bar", 5*time.Minute, 0, fmt.Errorf("some error")),
+		downsample("foo", 5*time.Minute, 10, nil),
+		downsample("bar", time.Hour, 1, nil),
+		downsample("foo", time.Hour, 2, nil),
+	}, t)
+
+	j := &Job{conn: conn, cfg: cfg, stop: make(chan struct{})}
+	if err := j.runOnce(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
@@ -0,0 +1,102 @@
+// This file and its contents are licensed under the Apache License 2.0.
+// Please see the included NOTICE for copyright information and
+// LICENSE for a copy of the license.
+
+package ingestor
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/timescale/promscale/pkg/pgmodel/common/errors"
+	"github.com/timescale/promscale/pkg/prompb"
+)
+
+const (
+	// sampleBytes is the estimated memory of a sample being ingested.
+	sampleBytes = 32
+	// labelBytes is the estimated memory of a label being ingested, in
+	// addition to its name and value.
+	labelBytes = 32
+)
+
+// admission bounds the samples, and the estimated memory, of the write
+// requests being ingested at once. Their samples are in flight until they
+// are inserted, also if they were acknowledged asynchronously before.
+type admission struct {
+	maxSamples uint64
+	maxBytes   uint64
+
+	lock    sync.Mutex
+	samples uint64
+	bytes   uint64
+}
+
+// newAdmission returns the admission control of the budget, or nil if the
+// budget is unlimited. A limit of 0 is unlimited.
+func newAdmission(maxSamples, maxBytes uint64) *admission {
+	IngestMaxInflightSamples.Set(float64(maxSamples))
+	IngestMaxInflightBytes.Set(float64(maxBytes))
+	if maxSamples == 0 && maxBytes == 0 {
+		return nil
+	}
+	return &admission{maxSamples: maxSamples, maxBytes: maxBytes}
+}
+
+// admit admits a write request, unless the budget would be exceeded. A write
+// request is always admitted if nothing is in flight, so that write requests
+// larger than the budget are ingested one at a time.
+func (a *admission) admit(samples, bytes uint64) error {
+	if a == nil {
+		return nil
+	}
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	if a.samples > 0 || a.bytes > 0 {
+		if a.maxSamples > 0 && a.samples+samples > a.maxSamples {
+			IngestRejectedRequests.WithLabelValues("samples").Inc()
+			return fmt.Errorf("%w (in flight: %d samples, limit: %d samples)", errors.ErrIngestBudgetExceeded, a.samples, a.maxSamples)
+		}
+		if a.maxBytes > 0 && a.bytes+bytes > a.maxBytes {
+			IngestRejectedRequests.WithLabelValues("bytes").Inc()
+			return fmt.Errorf("%w (in flight: %d bytes, limit: %d bytes)", errors.ErrIngestBudgetExceeded, a.bytes, a.maxBytes)
+		}
+	}
+	a.samples += samples
+	a.bytes += bytes
+	a.updateMetrics()
+	return nil
+}
+
+// release returns the budget of an admitted write request once it was
+// inserted.
+func (a *admission) release(samples, bytes uint64) {
+	if a == nil {
+		return
+	}
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	a.samples -= samples
+	a.bytes -= bytes
+	a.updateMetrics()
+}
+
+// updateMetrics updates the in-flight metrics. The caller must hold the lock.
+func (a *admission) updateMetrics() {
+	IngestInflightSamples.Set(float64(a.samples))
+	IngestInflightBytes.Set(float64(a.bytes))
+}
+
+// writeRequestSize returns the number of samples of the time series, and an
+// estimate of their memory while they are ingested.
+func writeRequestSize(tts []prompb.TimeSeries) (samples, bytes uint64) {
+	for i := range tts {
+		t := &tts[i]
+		samples += uint64(len(t.Samples))
+		bytes += uint64(len(t.Samples)) * sampleBytes
+		for _, l := range t.Labels {
+			bytes += uint64(len(l.Name)+len(l.Value)) + labelBytes
+		}
+	}
+	return samples, bytes
+}
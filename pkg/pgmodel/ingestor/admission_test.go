@@ -0,0 +1,87 @@
+// This file and its contents are licensed under the Apache License 2.0.
+// Please see the included NOTICE for copyright information and
+// LICENSE for a copy of the license.
+
+package ingestor
+
+import (
+	goErrors "errors"
+	"testing"
+
+	"github.com/timescale/promscale/pkg/pgmodel/common/errors"
+	"github.com/timescale/promscale/pkg/prompb"
+)
+
+func TestAdmission(t *testing.T) {
+	type request struct {
+		samples, bytes uint64
+		rejected       bool
+	}
+	testCases := []struct {
+		name       string
+		maxSamples uint64
+		maxBytes   uint64
+		requests   []request
+	}{
+		{
+			name:     "unlimited",
+			requests: []request{{samples: 1000, bytes: 1 << 20}, {samples: 1000, bytes: 1 << 20}},
+		},
+		{
+			name:       "samples",
+			maxSamples: 100,
+			requests:   []request{{samples: 60}, {samples: 40}, {samples: 1, rejected: true}},
+		},
+		{
+			name:     "bytes",
+			maxBytes: 1000,
+			requests: []request{{bytes: 600}, {bytes: 500, rejected: true}, {bytes: 400}},
+		},
+		{
+			name:       "larger than the budget",
+			maxSamples: 100,
+			requests:   []request{{samples: 500}, {samples: 1, rejected: true}},
+		},
+	}
+
+	for _, c := range testCases {
+		t.Run(c.name, func(t *testing.T) {
+			a := newAdmission(c.maxSamples, c.maxBytes)
+			var admitted []request
+			for i, r := range c.requests {
+				err := a.admit(r.samples, r.bytes)
+				if r.rejected != goErrors.Is(err, errors.ErrIngestBudgetExceeded) {
+					t.Fatalf("unexpected result of request %d: %v", i, err)
+				}
+				if err == nil {
+					admitted = append(admitted, r)
+				}
+			}
+			for _, r := range admitted {
+				a.release(r.samples, r.bytes)
+			}
+			if a != nil && (a.samples != 0 || a.bytes != 0) {
+				t.Errorf("budget was not released: %d samples %d bytes in flight", a.samples, a.bytes)
+			}
+		})
+	}
+}
+
+func TestWriteRequestSize(t *testing.T) {
+	tts := []prompb.TimeSeries{
+		{
+			Labels:  []prompb.Label{{Name: "__name__", Value: "foo"}},
+			Samples: []prompb.Sample{{Timestamp: 1, Value: 1}, {Timestamp: 2, Value: 2}},
+		},
+		{
+			Labels: []prompb.Label{{Name: "__name__", Value: "bar"}, {Name: "job", Value: "api"}},
+		},
+	}
+	samples, bytes := writeRequestSize(tts)
+	if samples != 2 {
+		t.Errorf("unexpected samples: got %d wanted 2", samples)
+	}
+	if expected := uint64(2*sampleBytes + 11 + labelBytes + 11 + 6 + 2*labelBytes); bytes != expected {
+		t.Errorf("unexpected bytes: got %d wanted %d", bytes, expected)
+	}
+}
@@ -0,0 +1,328 @@
+// This file and its contents are licensed under the Apache License 2.0.
+// Please see the included NOTICE for copyright information and
+// LICENSE for a copy of the license.
+
+package ingestor
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/timescale/promscale/pkg/log"
+	"github.com/timescale/promscale/pkg/pgmodel/common/errors"
+	"github.com/timescale/promscale/pkg/pgmodel/common/schema"
+	"github.com/timescale/promscale/pkg/pgmodel/model"
+	"github.com/timescale/promscale/pkg/pgxconn"
+)
+
+const (
+	beginMetricBackfillSQL  = "CALL " + schema.Catalog + ".begin_metric_backfill($1, $2, $3)"
+	finishMetricBackfillSQL = "CALL " + schema.Catalog + ".finish_metric_backfill($1)"
+)
+
+// BackfillProgress reports the progress of the backfill.
+type BackfillProgress struct {
+	// BufferedSamples and BufferedMetrics are the samples waiting for the
+	// next flush.
+	BufferedSamples int
+	BufferedMetrics int
+	// Flushing is set while the buffered samples are being inserted.
+	Flushing bool
+	Flushes  uint64
+	// FlushedMetrics counts the metrics flushed, each of them decompressing
+	// and recompressing the chunks of the backfilled time range once.
+	FlushedMetrics  uint64
+	InsertedSamples uint64
+	FailedSamples   uint64
+	LastFlush       time.Time
+	LastError       string
+}
+
+// backfillRangeGap is the gap between backfilled samples from which the
+// chunks between them are not decompressed.
+const backfillRangeGap = time.Hour
+
+// backfiller buffers the samples of backfill write requests per metric. On a
+// flush, the compressed chunks of the time ranges of each metric are
+// decompressed once, all of its buffered samples are inserted and the chunks
+// are recompressed, instead of decompressing them for every batch which
+// inserts into a compressed chunk.
+type backfiller struct {
+	conn       pgxconn.PgxConn
+	inserter   *pgxInserter
+	maxSamples int
+
+	// flushLock serializes the flushes, lock guards the buffer and progress.
+	flushLock sync.Mutex
+	lock      sync.Mutex
+	buffer    map[string]*backfillMetric
+	progress  BackfillProgress
+	// background is set while a flush started by a full buffer runs.
+	background bool
+	wg         sync.WaitGroup
+}
+
+// backfillMetric holds the buffered samples of a metric, and the requests they
+// come from.
+type backfillMetric struct {
+	data     []model.Samples
+	samples  int
+	requests []*backfillRequest
+}
+
+// backfillRequest is a buffered backfill write request.
+type backfillRequest struct {
+	// pending is the number of metrics of the request which were not
+	// flushed yet.
+	pending int
+	// done is called once the samples of all the metrics of the request
+	// were inserted, or dropped because of an error retrying does not
+	// resolve.
+	done func()
+}
+
+func newBackfiller(conn pgxconn.PgxConn, inserter *pgxInserter, maxSamples int) *backfiller {
+	return &backfiller{
+		conn:       conn,
+		inserter:   inserter,
+		maxSamples: maxSamples,
+		buffer:     make(map[string]*backfillMetric),
+	}
+}
+
+// admit returns errors.ErrIngestBudgetExceeded while the buffer is full, e.g.
+// because the samples of a failed flush are buffered again, starting a flush
+// if none is running.
+func (b *backfiller) admit() error {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	if b.progress.BufferedSamples < b.maxSamples {
+		return nil
+	}
+	b.startFlush()
+	return fmt.Errorf("%w (backfill buffer: %d samples, limit: %d samples)", errors.ErrIngestBudgetExceeded, b.progress.BufferedSamples, b.maxSamples)
+}
+
+// requestFlush starts a flush in the background if samples are buffered.
+func (b *backfiller) requestFlush() {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	if b.progress.BufferedSamples > 0 {
+		b.startFlush()
+	}
+}
+
+// add buffers the samples of the request, and starts a flush in the background
+// once the buffer holds the maximum number of samples.
+func (b *backfiller) add(data map[string][]model.Samples, req *backfillRequest) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	req.pending = len(data)
+	for metric, d := range data {
+		m := &backfillMetric{data: d, requests: []*backfillRequest{req}}
+		for _, s := range d {
+			m.samples += s.CountSamples()
+		}
+		b.bufferMetric(metric, m)
+	}
+	if b.progress.BufferedSamples >= b.maxSamples {
+		b.startFlush()
+	}
+}
+
+// bufferMetric adds the samples to the buffer, before the samples already
+// buffered for the metric. The caller must hold the lock.
+func (b *backfiller) bufferMetric(metric string, m *backfillMetric) {
+	b.progress.BufferedSamples += m.samples
+	BackfillBufferedSamples.Set(float64(b.progress.BufferedSamples))
+	buffered, ok := b.buffer[metric]
+	if !ok {
+		b.progress.BufferedMetrics++
+		b.buffer[metric] = m
+		return
+	}
+	buffered.data = append(m.data, buffered.data...)
+	buffered.samples += m.samples
+	buffered.requests = append(m.requests, buffered.requests...)
+}
+
+// startFlush flushes the buffer in the background, unless such a flush is
+// running already. The caller must hold the lock.
+func (b *backfiller) startFlush() {
+	if b.background {
+		return
+	}
+	b.background = true
+	b.wg.Add(1)
+	go func() {
+		defer b.wg.Done()
+		// The errors are logged and reported by the progress.
+		_ = b.flush()
+		b.lock.Lock()
+		b.background = false
+		b.lock.Unlock()
+	}()
+}
+
+// flush inserts the buffered samples metric by metric, returning the first
+// error. The samples of the metrics which failed with an error worth retrying,
+// e.g. while the database is unavailable, are buffered again for the next
+// flush.
+func (b *backfiller) flush() error {
+	b.flushLock.Lock()
+	defer b.flushLock.Unlock()
+
+	b.lock.Lock()
+	buffer := b.buffer
+	b.buffer = make(map[string]*backfillMetric)
+	b.progress.BufferedSamples = 0
+	b.progress.BufferedMetrics = 0
+	b.progress.Flushing = true
+	BackfillBufferedSamples.Set(0)
+	b.lock.Unlock()
+
+	var firstErr error
+	for metric, m := range buffer {
+		samples, err := b.flushMetric(metric, m.data)
+		if err != nil && firstErr == nil {
+			firstErr = err
+		}
+		if b.report(metric, samples, err) {
+			b.lock.Lock()
+			b.bufferMetric(metric, m)
+			b.lock.Unlock()
+			continue
+		}
+		for _, req := range m.requests {
+			b.lock.Lock()
+			req.pending--
+			done := req.pending == 0
+			b.lock.Unlock()
+			if done {
+				req.done()
+			}
+		}
+	}
+
+	b.lock.Lock()
+	b.progress.Flushing = false
+	b.progress.Flushes++
+	b.progress.LastFlush = time.Now()
+	if firstErr == nil {
+		b.progress.LastError = ""
+	}
+	b.lock.Unlock()
+	return firstErr
+}
+
+// report updates the progress with the result of backfilling the samples of a
+// metric, and returns true if they failed with an error worth retrying.
+func (b *backfiller) report(metric string, samples uint64, err error) (retry bool) {
+	_, dropped := errors.NonRetryableReason(classifyInsertError(err))
+	retry = err != nil && !dropped
+
+	b.lock.Lock()
+	b.progress.FlushedMetrics++
+	switch {
+	case err == nil:
+		b.progress.InsertedSamples += samples
+	case dropped:
+		b.progress.FailedSamples += samples
+	}
+	if err != nil {
+		b.progress.LastError = err.Error()
+	}
+	b.lock.Unlock()
+
+	switch {
+	case err == nil:
+		BackfillSamples.WithLabelValues("inserted").Add(float64(samples))
+	case dropped:
+		BackfillSamples.WithLabelValues("failed").Add(float64(samples))
+		log.Error("msg", fmt.Sprintf("error backfilling metric %s, dropping %d samples", metric, samples), "err", err)
+	default:
+		log.Warn("msg", fmt.Sprintf("error backfilling metric %s, retrying %d samples with the next flush", metric, samples), "err", err)
+	}
+	return retry
+}
+
+// flushMetric decompresses the chunks of the time ranges of the samples,
+// inserts them and recompresses the chunks it decompressed. Only the chunks
+// overlapping the samples are decompressed, as sparse backfills would
+// decompress every chunk between them otherwise. The chunks are recompressed
+// even if the insert failed, so that they don't stay decompressed. The inserts
+// lower the downsampling watermarks of the metric, so that the backfilled
+// range is rolled up again.
+func (b *backfiller) flushMetric(metric string, data []model.Samples) (samples uint64, err error) {
+	for _, d := range data {
+		samples += uint64(d.CountSamples())
+	}
+	ranges := model.SamplesTimeRanges(data, backfillRangeGap)
+	if len(ranges) == 0 {
+		return 0, nil
+	}
+	log.Info("msg", fmt.Sprintf("Backfilling %d samples of metric %s", samples, metric), "ranges", len(ranges),
+		"min-time", ranges[0].Start, "max-time", ranges[len(ranges)-1].End)
+
+	for _, r := range ranges {
+		if _, err = b.conn.Exec(context.Background(), beginMetricBackfillSQL, metric, r.Start, r.End); err != nil {
+			err = fmt.Errorf("decompressing chunks: %w", err)
+			break
+		}
+	}
+	if err == nil {
+		err = b.insertMetric(metric, data)
+	}
+	if _, finishErr := b.conn.Exec(context.Background(), finishMetricBackfillSQL, metric); finishErr != nil && err == nil {
+		err = fmt.Errorf("recompressing chunks: %w", finishErr)
+	}
+	return samples, err
+}
+
+// insertMetric inserts the samples of a metric on the connection of the
+// backfiller, bypassing the inserters of the metrics so that backfills don't
+// hold up live traffic. The samples are inserted in batches of flushSize
+// series.
+func (b *backfiller) insertMetric(metric string, data []model.Samples) error {
+	table, err := initializeInserterRoutine(b.conn, metric, b.inserter.completeMetricCreation, b.inserter.metricTableNames)
+	if err != nil {
+		return fmt.Errorf("initializing the metric table: %w", err)
+	}
+	handler := insertHandler{conn: b.conn, metricTableName: table, labelArrayOID: b.inserter.labelArrayOID}
+	for len(data) > 0 {
+		n := flushSize
+		if len(data) < n {
+			n = len(data)
+		}
+		if err = handler.setSeriesIds(data[:n]); err != nil {
+			return labelsError(err)
+		}
+		req := copyRequest{data: NewPendingBuffer(), table: table, backfill: true}
+		req.data.batch.AppendSlice(data[:n])
+		err = doInsert(b.conn, req)
+		if err != nil {
+			err = insertErrorFallback(b.conn, err, req)
+		}
+		req.data.release()
+		if err != nil {
+			return err
+		}
+		data = data[n:]
+	}
+	return nil
+}
+
+// close waits for the flush running in the background, and flushes the
+// buffer. The samples failing to be flushed stay in the spool.
+func (b *backfiller) close() error {
+	b.wg.Wait()
+	return b.flush()
+}
+
+func (b *backfiller) getProgress() BackfillProgress {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	return b.progress
+}
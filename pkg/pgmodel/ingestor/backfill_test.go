@@ -0,0 +1,121 @@
+// This file and its contents are licensed under the Apache License 2.0.
+// Please see the included NOTICE for copyright information and
+// LICENSE for a copy of the license.
+
+package ingestor
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/timescale/promscale/pkg/pgmodel/cache"
+	pgmodelErrs "github.com/timescale/promscale/pkg/pgmodel/common/errors"
+	"github.com/timescale/promscale/pkg/pgmodel/model"
+	"github.com/timescale/promscale/pkg/prompb"
+)
+
+func newTestBackfillIngestor(s *spool, maxSamples int) *DBIngestor {
+	return &DBIngestor{
+		backfill:       newBackfiller(nil, nil, maxSamples),
+		backfillParser: &dataParser{scache: cache.NewSeriesCache(cache.DefaultConfig, nil)},
+		admission:      newAdmission(1000, 1<<20),
+		spool:          s,
+	}
+}
+
+func TestBackfillBuffer(t *testing.T) {
+	dir := t.TempDir()
+	s := newTestSpool(t, dir, 1<<20)
+	ingestor := newTestBackfillIngestor(s, 100)
+	requests := [][]prompb.TimeSeries{
+		{
+			{
+				Labels:  []prompb.Label{{Name: "__name__", Value: "foo"}, {Name: "job", Value: "a"}},
+				Samples: []prompb.Sample{{Timestamp: 1, Value: 1}, {Timestamp: 2, Value: 2}},
+			},
+		},
+		{
+			{
+				Labels:  []prompb.Label{{Name: "__name__", Value: "foo"}, {Name: "job", Value: "b"}},
+				Samples: []prompb.Sample{{Timestamp: 1, Value: 1}},
+			},
+			{
+				Labels:  []prompb.Label{{Name: "__name__", Value: "bar"}},
+				Samples: []prompb.Sample{{Timestamp: 1, Value: 1}},
+			},
+		},
+	}
+	for _, tts := range requests {
+		if _, err := ingestor.Backfill(tts, NewWriteRequest()); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	progress := ingestor.BackfillProgress()
+	if progress.BufferedSamples != 4 || progress.BufferedMetrics != 2 {
+		t.Errorf("unexpected buffer: got %d samples of %d metrics wanted 4 samples of 2 metrics", progress.BufferedSamples, progress.BufferedMetrics)
+	}
+	if len(ingestor.backfill.buffer["foo"].data) != 2 || len(ingestor.backfill.buffer["bar"].data) != 1 {
+		t.Errorf("unexpected buffered series: %v", ingestor.backfill.buffer)
+	}
+	if progress.Flushes != 0 {
+		t.Errorf("unexpected flush before the buffer is full")
+	}
+	if ingestor.admission.samples != 0 || ingestor.admission.bytes != 0 {
+		t.Errorf("buffered samples still count towards the in-flight budget: %d samples, %d bytes", ingestor.admission.samples, ingestor.admission.bytes)
+	}
+
+	// The buffered requests are spooled, and buffered again after a restart.
+	s.close()
+	s = newTestSpool(t, dir, 1<<20)
+	defer s.close()
+	restarted := newTestBackfillIngestor(s, 100)
+	for _, record := range s.takeBackfill() {
+		restarted.rebufferBackfill(record)
+	}
+	progress = restarted.BackfillProgress()
+	if progress.BufferedSamples != 4 || progress.BufferedMetrics != 2 {
+		t.Errorf("unexpected buffer after a restart: got %d samples of %d metrics wanted 4 samples of 2 metrics", progress.BufferedSamples, progress.BufferedMetrics)
+	}
+}
+
+func TestBackfillBufferFull(t *testing.T) {
+	s := newTestSpool(t, t.TempDir(), 1<<20)
+	defer s.close()
+	ingestor := newTestBackfillIngestor(s, 1)
+	// Keep the buffer full by pretending that a flush is running.
+	ingestor.backfill.background = true
+	tts := []prompb.TimeSeries{{
+		Labels:  []prompb.Label{{Name: "__name__", Value: "foo"}},
+		Samples: []prompb.Sample{{Timestamp: 1, Value: 1}},
+	}}
+	if _, err := ingestor.Backfill(tts, NewWriteRequest()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := ingestor.Backfill(tts, NewWriteRequest()); !errors.Is(err, pgmodelErrs.ErrIngestBudgetExceeded) {
+		t.Errorf("unexpected error: got %v wanted %v", err, pgmodelErrs.ErrIngestBudgetExceeded)
+	}
+}
+
+func TestSamplesTimeRanges(t *testing.T) {
+	hour := time.Hour.Milliseconds()
+	series := &model.Series{}
+	data := []model.Samples{
+		model.NewPromSample(series, []prompb.Sample{{Timestamp: 10}, {Timestamp: hour + 10}, {Timestamp: 5 * hour}}),
+		model.NewPromSample(series, []prompb.Sample{{Timestamp: 2*hour - 1}, {Timestamp: 5*hour + 20}}),
+	}
+	expected := []model.TimeRange{
+		{Start: time.Unix(0, 10*int64(time.Millisecond)).UTC(), End: time.Unix(0, (2*hour-1)*int64(time.Millisecond)).UTC()},
+		{Start: time.Unix(0, 5*hour*int64(time.Millisecond)).UTC(), End: time.Unix(0, (5*hour+20)*int64(time.Millisecond)).UTC()},
+	}
+	ranges := model.SamplesTimeRanges(data, time.Hour)
+	if len(ranges) != len(expected) {
+		t.Fatalf("unexpected ranges: got %v wanted %v", ranges, expected)
+	}
+	for i := range ranges {
+		if !ranges[i].Start.Equal(expected[i].Start) || !ranges[i].End.Equal(expected[i].End) {
+			t.Errorf("unexpected range %d: got %v wanted %v", i, ranges[i], expected[i])
+		}
+	}
+}
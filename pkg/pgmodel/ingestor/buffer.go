@@ -68,8 +68,27 @@ func (p *pendingBuffer) release() {
 }
 
 func (p *pendingBuffer) addReq(req *insertDataRequest) {
-	p.needsResponse = append(p.needsResponse, insertDataTask{finished: req.finished, errChan: req.errChan})
-	p.batch.AppendSlice(req.data)
+	samples := 0
+	for _, s := range req.data {
+		samples += s.CountSamples()
+	}
+	p.addTask(insertDataTask{finished: req.finished, errChan: req.errChan, samples: samples, data: req.data, replay: req.replay})
+}
+
+func (p *pendingBuffer) addTask(task insertDataTask) {
+	p.needsResponse = append(p.needsResponse, task)
+	p.batch.AppendSlice(task.data)
+}
+
+// isReplay returns true if the buffer only holds the samples of requests
+// replayed from the spool, whose duplicates are not rejected.
+func (p *pendingBuffer) isReplay() bool {
+	for i := range p.needsResponse {
+		if !p.needsResponse[i].replay {
+			return false
+		}
+	}
+	return len(p.needsResponse) > 0
 }
 
 func (p *pendingBuffer) absorb(other *pendingBuffer) {
@@ -0,0 +1,143 @@
+// This file and its contents are licensed under the Apache License 2.0.
+// Please see the included NOTICE for copyright information and
+// LICENSE for a copy of the license.
+
+package ingestor
+
+import (
+	"flag"
+	"fmt"
+	"time"
+
+	"github.com/timescale/promscale/pkg/limits"
+	"github.com/timescale/promscale/pkg/pgmodel/relabel"
+	"github.com/timescale/promscale/pkg/pgmodel/timewindow"
+)
+
+const (
+	defaultSpoolMaxBytes        = 1 << 30
+	defaultSpoolSegmentBytes    = 64 << 20
+	defaultSpoolReplayInterval  = 10 * time.Second
+	defaultInflightBytesPercent = 25
+	defaultBackfillMaxSamples   = 5000000
+)
+
+// Config configures the ingestion of the write requests.
+type Config struct {
+	Spool SpoolConfig
+	// MaxInflightSamples is the maximum number of samples being ingested
+	// at once. A value of 0 does not limit the samples.
+	MaxInflightSamples uint64
+	// MaxInflightBytes is the maximum estimated memory of the write
+	// requests being ingested at once.
+	MaxInflightBytes  uint64
+	inflightBytesFlag limits.PercentageAbsoluteBytesFlag
+	// RelabelConfigFile is the YAML file of the relabeling rules applied to
+	// the ingested series, loaded into RelabelRules on validation.
+	RelabelConfigFile string
+	RelabelRules      *relabel.Rules
+	// MaxSampleAge and MaxSampleFutureSkew bound the sample timestamps around
+	// the current time, applied by Window according to OutOfWindowPolicy. A
+	// bound of 0 is disabled.
+	MaxSampleAge        time.Duration
+	MaxSampleFutureSkew time.Duration
+	OutOfWindowPolicy   string
+	Window              *timewindow.Window
+	// BackfillMaxBufferedSamples is the number of samples of the backfill
+	// write requests buffered before they are flushed.
+	BackfillMaxBufferedSamples int
+}
+
+// SpoolConfig configures the on-disk write-ahead spool of the accepted write
+// requests.
+type SpoolConfig struct {
+	// Dir is the directory of the spool. The spool is disabled without it.
+	Dir string
+	// MaxBytes is the maximum size of the spool. Write requests are
+	// rejected while the spool is full.
+	MaxBytes uint64
+	// SegmentBytes is the size of the segment files of the spool, which are
+	// removed once all their write requests were inserted.
+	SegmentBytes uint64
+	// ReplayInterval is the interval at which the write requests that
+	// failed to be inserted are replayed.
+	ReplayInterval time.Duration
+}
+
+// ParseFlags parses the configuration flags specific to ingestion.
+func ParseFlags(fs *flag.FlagSet, cfg *Config) *Config {
+	/* set defaults */
+	cfg.inflightBytesFlag.SetPercent(defaultInflightBytesPercent)
+
+	fs.Var(&cfg.inflightBytesFlag, "ingest-max-inflight-bytes", "Maximum estimated memory of the write requests being ingested at once, after which write requests are rejected with "+
+		"HTTP status 429 until the ingested ones are inserted. Specified in bytes or as a percentage of the memory-target (e.g. 25%).")
+	fs.Uint64Var(&cfg.MaxInflightSamples, "ingest-max-inflight-samples", 0, "Maximum number of samples being ingested at once, after which write requests are rejected with HTTP status 429 "+
+		"until the ingested ones are inserted. A value of 0 does not limit the samples.")
+	fs.StringVar(&cfg.RelabelConfigFile, "ingest-relabel-config-file", "", "YAML file with a relabel_configs list of Prometheus relabeling rules applied to the series of the write requests "+
+		"before they are ingested, e.g. to drop noisy labels or whole metrics. Relabeling is disabled by default.")
+	fs.DurationVar(&cfg.MaxSampleAge, "ingest-max-sample-age", 0, "Maximum age of the ingested samples, relative to the current time. Older samples are dropped or rejected according to "+
+		"ingest-out-of-window-policy. A value of 0 does not limit the age.")
+	fs.DurationVar(&cfg.MaxSampleFutureSkew, "ingest-max-sample-future-skew", 0, "Maximum time the ingested samples can be ahead of the current time. Samples further ahead are dropped or rejected according to "+
+		"ingest-out-of-window-policy. A value of 0 does not limit the skew.")
+	fs.StringVar(&cfg.OutOfWindowPolicy, "ingest-out-of-window-policy", timewindow.PolicyDrop, "Policy for the samples outside the window of ingest-max-sample-age and ingest-max-sample-future-skew. "+
+		"Valid options are: [drop, reject]. Dropping ingests the other samples of the write request, rejecting fails the whole write request with HTTP status 400.")
+	fs.IntVar(&cfg.BackfillMaxBufferedSamples, "ingest-backfill-max-buffered-samples", defaultBackfillMaxSamples, "Maximum number of samples of the /backfill endpoint buffered in memory. "+
+		"Once reached, the buffered samples are flushed in the background, and /backfill requests are rejected with HTTP status 429 until the flush completed: the compressed chunks they fall into are decompressed once per metric, the samples inserted and the chunks recompressed. The /backfill endpoint requires the spool to be enabled.")
+
+	fs.StringVar(&cfg.Spool.Dir, "spool-dir", "", "Directory of an on-disk write-ahead spool of the accepted write requests. If set, write requests are appended to the spool before they are "+
+		"acknowledged, replayed after a restart or once the database is available again, and removed once inserted. Every Promscale instance needs its own directory. The spool is disabled by default.")
+	fs.Uint64Var(&cfg.Spool.MaxBytes, "spool-max-bytes", defaultSpoolMaxBytes, "Maximum size of the spool in bytes. Write requests are rejected while the spool is full.")
+	fs.Uint64Var(&cfg.Spool.SegmentBytes, "spool-segment-bytes", defaultSpoolSegmentBytes, "Size of the segment files of the spool in bytes. A segment file is removed once all its write requests were inserted.")
+	fs.DurationVar(&cfg.Spool.ReplayInterval, "spool-replay-interval", defaultSpoolReplayInterval, "Interval at which the spooled write requests that failed to be inserted are replayed.")
+	return cfg
+}
+
+// Validate validates the ingestion configuration, computes the in-flight
+// memory budget from the memory target, loads the relabeling rules and sets up
+// the acceptance window of the sample timestamps.
+func Validate(cfg *Config, lcfg limits.Config) error {
+	kind, value := cfg.inflightBytesFlag.Get()
+	switch kind {
+	case limits.Percentage:
+		cfg.MaxInflightBytes = uint64(float64(lcfg.TargetMemoryBytes) * (float64(value) / 100.0))
+	case limits.Absolute:
+		cfg.MaxInflightBytes = value
+	default:
+		return fmt.Errorf("ingest-max-inflight-bytes flag has unknown kind")
+	}
+	if cfg.MaxInflightBytes > lcfg.TargetMemoryBytes {
+		return fmt.Errorf("ingest-max-inflight-bytes must be smaller than the memory-target")
+	}
+	if cfg.RelabelConfigFile != "" {
+		rules, err := relabel.LoadFile(cfg.RelabelConfigFile)
+		if err != nil {
+			return fmt.Errorf("ingest-relabel-config-file: %w", err)
+		}
+		cfg.RelabelRules = rules
+	}
+	window, err := timewindow.New(cfg.MaxSampleAge, cfg.MaxSampleFutureSkew, cfg.OutOfWindowPolicy)
+	if err != nil {
+		return fmt.Errorf("invalid sample time window: %w", err)
+	}
+	cfg.Window = window
+	if cfg.BackfillMaxBufferedSamples <= 0 {
+		return fmt.Errorf("ingest-backfill-max-buffered-samples must be positive")
+	}
+	return validateSpool(&cfg.Spool)
+}
+
+func validateSpool(cfg *SpoolConfig) error {
+	if cfg.Dir == "" {
+		return nil
+	}
+	if cfg.MaxBytes == 0 {
+		return fmt.Errorf("spool-max-bytes must be positive")
+	}
+	if cfg.SegmentBytes == 0 || cfg.SegmentBytes > cfg.MaxBytes {
+		return fmt.Errorf("spool-segment-bytes must be positive and not greater than spool-max-bytes")
+	}
+	if cfg.ReplayInterval <= 0 {
+		return fmt.Errorf("spool-replay-interval must be positive")
+	}
+	return nil
+}
@@ -1,16 +1,35 @@
 package ingestor
 
 import (
+	goErrors "errors"
 	"sync"
 	"sync/atomic"
 	"time"
 
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgerrcode"
 	"github.com/timescale/promscale/pkg/log"
 	"github.com/timescale/promscale/pkg/pgmodel/metrics"
 )
 
 const reportDuplicatesInterval = time.Minute
 
+// The policies for samples whose series and time already exist, set per
+// metric in the database.
+const (
+	duplicatePolicyIgnore    = "ignore"
+	duplicatePolicyOverwrite = "overwrite"
+	duplicatePolicyReject    = "reject"
+)
+
+// duplicateOutcomes maps the duplicate policies to the outcome label of their
+// samples.
+var duplicateOutcomes = map[string]string{
+	duplicatePolicyIgnore:    "ignored",
+	duplicatePolicyOverwrite: "overwritten",
+	duplicatePolicyReject:    "rejected",
+}
+
 var (
 	launchReporterOnce    sync.Once
 	duplicateMetricsTotal uint64
@@ -25,6 +44,22 @@ func registerDuplicates(duplicateSamples int64) {
 	metrics.DuplicateWrites.Inc()
 }
 
+// registerDuplicateOutcome counts the duplicate samples handled by the
+// duplicate policy. Rejected inserts count all their samples, as none of them
+// are inserted.
+func registerDuplicateOutcome(policy string, samples int64) {
+	if outcome, ok := duplicateOutcomes[policy]; ok {
+		metrics.DuplicateSampleOutcomes.WithLabelValues(outcome).Add(float64(samples))
+	}
+}
+
+// isDuplicateRejection returns true if the insert failed because its samples
+// contained duplicates under the reject policy.
+func isDuplicateRejection(err error) bool {
+	var pgErr *pgconn.PgError
+	return goErrors.As(err, &pgErr) && pgErr.Code == pgerrcode.UniqueViolation
+}
+
 func reportDuplicates(duplicateMetrics uint64) {
 	atomic.AddUint64(&duplicateMetricsTotal, duplicateMetrics)
 	metrics.DuplicateMetrics.Add(float64(duplicateMetrics))
@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"github.com/jackc/pgtype"
 	"github.com/prometheus/prometheus/pkg/labels"
+	"github.com/timescale/promscale/pkg/pgmodel/common/errors"
 	"github.com/timescale/promscale/pkg/pgmodel/common/schema"
 	"github.com/timescale/promscale/pkg/pgmodel/model"
 	"github.com/timescale/promscale/pkg/pgmodel/model/pgutf8str"
@@ -66,18 +67,52 @@ func (h *insertHandler) flush() {
 // Set all unset SeriesIds and flush to the next layer
 func (h *insertHandler) flushPending() {
 	err := h.setSeriesIds(h.pending.batch.GetSeriesSamples())
+	if err != nil && isDataException(err) && len(h.pending.needsResponse) > 1 {
+		// The labels of one of the write requests are at fault, set the
+		// series IDs of each of them on their own.
+		h.pending, err = h.setSeriesIdsPerRequest(h.pending), nil
+	}
 	if err != nil {
-		h.pending.reportResults(err)
+		h.pending.reportResults(labelsError(err))
 		h.pending.release()
 		h.pending = NewPendingBuffer()
 		return
 	}
+	if h.pending.IsEmpty() {
+		return
+	}
 
 	MetricBatcherFlushSeries.Observe(float64(h.pending.batch.CountSeries()))
-	h.toCopiers <- copyRequest{h.pending, h.metricTableName}
+	h.toCopiers <- copyRequest{data: h.pending, table: h.metricTableName}
 	h.pending = NewPendingBuffer()
 }
 
+// setSeriesIdsPerRequest sets the series IDs of every write request of the
+// pending buffer on its own, so that labels the database rejects only fail the
+// request they belong to. It returns a pending buffer holding the requests
+// whose series IDs are set.
+func (h *insertHandler) setSeriesIdsPerRequest(pending *pendingBuffer) *pendingBuffer {
+	succeeded := NewPendingBuffer()
+	for _, task := range pending.needsResponse {
+		if err := h.setSeriesIds(task.data); err != nil {
+			task.reportResult(labelsError(err))
+			continue
+		}
+		succeeded.addTask(task)
+	}
+	pending.release()
+	return succeeded
+}
+
+// labelsError marks the error of labels which the database rejects, e.g.
+// because of an invalid encoding, as non-retryable.
+func labelsError(err error) error {
+	if isDataException(err) {
+		return errors.NewNonRetryableError(errors.ReasonInvalidLabels, err)
+	}
+	return err
+}
+
 type labelInfo struct {
 	labelID int32
 	Pos     int32
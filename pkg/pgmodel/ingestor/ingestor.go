@@ -6,10 +6,14 @@ package ingestor
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/timescale/promscale/pkg/clockcache"
+	"github.com/timescale/promscale/pkg/log"
 	"github.com/timescale/promscale/pkg/pgmodel/cache"
+	"github.com/timescale/promscale/pkg/pgmodel/common/errors"
 	"github.com/timescale/promscale/pkg/pgmodel/model"
+	"github.com/timescale/promscale/pkg/pgmodel/timewindow"
 	"github.com/timescale/promscale/pkg/pgxconn"
 	"github.com/timescale/promscale/pkg/prompb"
 )
@@ -19,6 +23,20 @@ type Cfg struct {
 	ReportInterval   int
 	NumCopiers       int
 	DisableEpochSync bool
+	Spool            SpoolConfig
+	// MaxInflightSamples and MaxInflightBytes bound the samples being
+	// ingested at once. A value of 0 does not limit them.
+	MaxInflightSamples uint64
+	MaxInflightBytes   uint64
+	// Window is the acceptance window of the sample timestamps, if any.
+	Window *timewindow.Window
+	// BackfillMaxBufferedSamples is the number of backfilled samples after
+	// which they are flushed, and further backfill write requests are
+	// rejected until the flush drained them. BackfillParser parses the
+	// backfill write requests, the parser of the write requests is used if
+	// it is nil.
+	BackfillMaxBufferedSamples int
+	BackfillParser             Parser
 }
 
 // DBIngestor ingest the TimeSeries data into Timescale database.
@@ -26,6 +44,17 @@ type DBIngestor struct {
 	db     model.Inserter
 	scache cache.SeriesCache
 	parser Parser
+	// spool holds the accepted write requests until they are inserted, if
+	// it is enabled.
+	spool     *spool
+	admission *admission
+	window    *timewindow.Window
+	inserter  *pgxInserter
+	// backfill buffers the samples of backfill write requests until they
+	// are flushed.
+	backfill       *backfiller
+	backfillParser Parser
+	backfillWindow *timewindow.Window
 }
 
 // NewPgxIngestor returns a new Ingestor that uses connection pool and a metrics cache
@@ -36,7 +65,31 @@ func NewPgxIngestor(conn pgxconn.PgxConn, cache cache.MetricCache, scache cache.
 		return nil, err
 	}
 
-	return &DBIngestor{db: pi, scache: scache, parser: parser}, nil
+	ingestor := &DBIngestor{
+		db:             pi,
+		scache:         scache,
+		parser:         parser,
+		admission:      newAdmission(cfg.MaxInflightSamples, cfg.MaxInflightBytes),
+		window:         cfg.Window,
+		inserter:       pi,
+		backfill:       newBackfiller(conn, pi, cfg.BackfillMaxBufferedSamples),
+		backfillParser: cfg.BackfillParser,
+		backfillWindow: cfg.Window.WithoutMaxAge(),
+	}
+	if ingestor.backfillParser == nil {
+		ingestor.backfillParser = parser
+	}
+	if cfg.Spool.Dir != "" {
+		if ingestor.spool, err = openSpool(cfg.Spool); err != nil {
+			pi.Close()
+			return nil, err
+		}
+		for _, record := range ingestor.spool.takeBackfill() {
+			ingestor.rebufferBackfill(record)
+		}
+		ingestor.spool.start(ingestor.replay, cfg.Spool.ReplayInterval)
+	}
+	return ingestor, nil
 }
 
 // NewPgxIngestorForTests returns a new Ingestor that write to PostgreSQL using PGX
@@ -52,7 +105,34 @@ func NewPgxIngestorForTests(conn pgxconn.PgxConn) (*DBIngestor, error) {
 //     tts the []Timeseries to insert
 //     req the WriteRequest backing tts. It will be added to our WriteRequest
 //         pool when it is no longer needed.
+// Write requests exceeding the in-flight budget are rejected with
+// errors.ErrIngestBudgetExceeded. The acceptance window of the sample
+// timestamps only applies here, so that the samples of spooled requests are
+// not dropped when they are replayed after an outage. Errors which retrying the write request does
+// not resolve are errors.NonRetryableError. The samples of a request failing
+// to be parsed are counted as dropped, the samples failing to be inserted are
+// counted by the inserters, since only some metrics of a request might fail.
 func (ingestor *DBIngestor) Ingest(tts []prompb.TimeSeries, req *prompb.WriteRequest) (uint64, error) {
+	samples, bytes := writeRequestSize(tts)
+	if err := ingestor.admission.admit(samples, bytes); err != nil {
+		FinishWriteRequest(req)
+		return 0, err
+	}
+	release := func(error) {
+		ingestor.admission.release(samples, bytes)
+	}
+	tts, err := filterWindow(ingestor.window, tts)
+	if err != nil || len(tts) == 0 {
+		countDroppedSamples(err, int(samples))
+		FinishWriteRequest(req)
+		release(err)
+		return 0, err
+	}
+
+	if ingestor.spool != nil {
+		return ingestor.ingestSpooled(tts, req, samples, release)
+	}
+
 	data, totalRows, err := ingestor.parser.ParseData(tts)
 	// WriteRequests can contain pointers into the original buffer we deserialized
 	// them out of, and can be quite large in and of themselves. In order to prevent
@@ -65,16 +145,221 @@ func (ingestor *DBIngestor) Ingest(tts []prompb.TimeSeries, req *prompb.WriteReq
 	// Note data == nil case is to handle samples from non-leader
 	// prometheus instance or when len(tts) == 0
 	if err != nil || data == nil {
+		countDroppedSamples(err, int(samples))
+		release(err)
 		return 0, err
 	}
 
-	rowsInserted, err := ingestor.db.InsertNewData(data)
+	rowsInserted, err := ingestor.insertData(data, totalRows, release)
 	if err == nil && int(rowsInserted) != totalRows {
 		return rowsInserted, fmt.Errorf("failed to insert all the data! Expected: %d, Got: %d", totalRows, rowsInserted)
 	}
 	return rowsInserted, err
 }
 
+// filterWindow drops the samples outside the window, and the series left
+// without samples, returning the remaining series. The samples are
+// dropped first, so that they don't extend the HA leases either. The dropped
+// series are swapped to the end of tts, so that their buffers are still
+// recycled along with the write request.
+func filterWindow(window *timewindow.Window, tts []prompb.TimeSeries) ([]prompb.TimeSeries, error) {
+	now := time.Now()
+	kept := 0
+	for i := range tts {
+		var err error
+		if tts[i].Samples, err = window.Filter(tts[i].Samples, now); err != nil {
+			return nil, err
+		}
+		if len(tts[i].Samples) > 0 {
+			tts[kept], tts[i] = tts[i], tts[kept]
+			kept++
+		}
+	}
+	return tts[:kept], nil
+}
+
+// ingestSpooled appends the write request to the spool before ingesting it.
+// The request is removed from the spool once it was inserted, or replayed
+// later if inserting it failed while the database was unavailable. In that
+// case, the request is acknowledged as it will be inserted eventually.
+func (ingestor *DBIngestor) ingestSpooled(tts []prompb.TimeSeries, req *prompb.WriteRequest, samples uint64, onCommit func(error)) (uint64, error) {
+	buf, err := (&prompb.WriteRequest{Timeseries: tts}).Marshal()
+	if err == nil {
+		var record *spoolRecord
+		if record, err = ingestor.spool.append(buf); err == nil {
+			return ingestor.ingestRecord(tts, req, record, samples, onCommit)
+		}
+	}
+	FinishWriteRequest(req)
+	onCommit(err)
+	return 0, err
+}
+
+func (ingestor *DBIngestor) ingestRecord(tts []prompb.TimeSeries, req *prompb.WriteRequest, record *spoolRecord, samples uint64, onCommit func(error)) (uint64, error) {
+	data, totalRows, err := ingestor.parser.ParseData(tts)
+	FinishWriteRequest(req)
+	if err != nil || data == nil {
+		countDroppedSamples(err, int(samples))
+		ingestor.spool.commit(record)
+		onCommit(err)
+		return 0, err
+	}
+
+	async := ingestor.inserter.asyncAcks
+	rowsInserted, err := ingestor.inserter.insertData(data, async, func(err error) {
+		onCommit(err)
+		if !async {
+			return
+		}
+		if ingestor.spool.done(record, err) {
+			log.Warn("msg", "error on async send, the spooled samples will be replayed", "err", err)
+		} else if err != nil {
+			log.Error("msg", fmt.Sprintf("error on async send, dropping %d datapoints", totalRows), "err", err)
+		}
+	})
+	if async {
+		return rowsInserted, err
+	}
+	if ingestor.spool.done(record, err) {
+		log.Warn("msg", "error inserting samples, the spooled samples will be replayed", "err", err)
+		return uint64(totalRows), nil
+	}
+	if err == nil && int(rowsInserted) != totalRows {
+		return rowsInserted, fmt.Errorf("failed to insert all the data! Expected: %d, Got: %d", totalRows, rowsInserted)
+	}
+	return rowsInserted, err
+}
+
+// insertData inserts the data, calling onCommit with the result once the
+// inserts completed, which is after insertData returned if they are
+// acknowledged asynchronously. The errors of asynchronous inserts are logged,
+// as they are not reported to the client.
+func (ingestor *DBIngestor) insertData(data map[string][]model.Samples, totalRows int, onCommit func(error)) (uint64, error) {
+	if ingestor.inserter == nil {
+		rowsInserted, err := ingestor.db.InsertNewData(data)
+		onCommit(err)
+		return rowsInserted, err
+	}
+	async := ingestor.inserter.asyncAcks
+	return ingestor.inserter.insertData(data, async, func(err error) {
+		onCommit(err)
+		if async && err != nil {
+			log.Error("msg", fmt.Sprintf("error on async send, dropping %d datapoints", totalRows), "err", err)
+		}
+	})
+}
+
+// replay inserts a write request of the spool, waiting for the insert.
+func (ingestor *DBIngestor) replay(buf []byte) error {
+	req := NewWriteRequest()
+	if err := req.Unmarshal(buf); err != nil {
+		FinishWriteRequest(req)
+		return errors.NewNonRetryableError(errors.ReasonInvalidSamples, fmt.Errorf("unmarshalling a spooled write request: %w", err))
+	}
+	data, _, err := ingestor.parser.ParseData(req.Timeseries)
+	FinishWriteRequest(req)
+	if err != nil || data == nil {
+		return err
+	}
+	return ingestor.inserter.insertReplay(data)
+}
+
+// Backfill buffers the samples of a backfill write request until the next
+// flush, which starts in the background once the buffer is full. The request
+// is acknowledged once it is appended to the spool, and its samples only count
+// towards the in-flight budget until then, as the buffer has its own limit.
+// Without the spool, requests are rejected with
+// errors.ErrBackfillWithoutSpool, as the buffered samples would be lost on a
+// restart. Requests are rejected with
+// errors.ErrIngestBudgetExceeded while the buffer is full or the in-flight
+// budget is exceeded, starting a flush in both cases. Only the bound of the
+// acceptance window on the future skew applies, as backfilled samples are old
+// by definition.
+func (ingestor *DBIngestor) Backfill(tts []prompb.TimeSeries, req *prompb.WriteRequest) (uint64, error) {
+	if ingestor.spool == nil {
+		FinishWriteRequest(req)
+		return 0, errors.ErrBackfillWithoutSpool
+	}
+
+	if err := ingestor.backfill.admit(); err != nil {
+		FinishWriteRequest(req)
+		return 0, err
+	}
+	samples, bytes := writeRequestSize(tts)
+	if err := ingestor.admission.admit(samples, bytes); err != nil {
+		// The buffered samples might hold the memory the live writes need.
+		ingestor.backfill.requestFlush()
+		FinishWriteRequest(req)
+		return 0, err
+	}
+	defer ingestor.admission.release(samples, bytes)
+	tts, err := filterWindow(ingestor.backfillWindow, tts)
+	if err != nil || len(tts) == 0 {
+		countDroppedSamples(err, int(samples))
+		FinishWriteRequest(req)
+		return 0, err
+	}
+	buf, err := (&prompb.WriteRequest{Timeseries: tts}).Marshal()
+	var record *spoolRecord
+	if err == nil {
+		record, err = ingestor.spool.appendBackfill(buf)
+	}
+	if err != nil {
+		FinishWriteRequest(req)
+		return 0, err
+	}
+	return ingestor.bufferBackfill(tts, req, record)
+}
+
+// bufferBackfill buffers the samples of a spooled backfill write request. The
+// record is committed once they were flushed.
+func (ingestor *DBIngestor) bufferBackfill(tts []prompb.TimeSeries, req *prompb.WriteRequest, record *spoolRecord) (uint64, error) {
+	data, totalRows, err := ingestor.backfillParser.ParseData(tts)
+	FinishWriteRequest(req)
+	if err != nil || data == nil {
+		ingestor.spool.commit(record)
+		return 0, err
+	}
+	ingestor.backfill.add(data, &backfillRequest{done: func() {
+		ingestor.spool.commit(record)
+	}})
+	return uint64(totalRows), nil
+}
+
+// rebufferBackfill buffers the samples of a backfill write request of the
+// spool again after a restart. They were admitted before the restart already,
+// so they are not subject to the in-flight budget.
+func (ingestor *DBIngestor) rebufferBackfill(record *spoolRecord) {
+	buf, err := ingestor.spool.read(record)
+	if err != nil {
+		log.Error("msg", "Error reading a spooled backfill write request, dropping it", "err", err)
+		ingestor.spool.commit(record)
+		return
+	}
+	req := NewWriteRequest()
+	if err := req.Unmarshal(buf); err != nil {
+		FinishWriteRequest(req)
+		log.Error("msg", "Error unmarshalling a spooled backfill write request, dropping it", "err", err)
+		ingestor.spool.commit(record)
+		return
+	}
+	if _, err := ingestor.bufferBackfill(req.Timeseries, req, record); err != nil {
+		log.Error("msg", "Error parsing a spooled backfill write request, dropping it", "err", err)
+	}
+}
+
+// FlushBackfill inserts the buffered backfilled samples, decompressing and
+// recompressing the chunks of every metric overlapping them once. The samples
+// of metrics failing with a retryable error stay buffered.
+func (ingestor *DBIngestor) FlushBackfill() error {
+	return ingestor.backfill.flush()
+}
+
+// BackfillProgress returns the progress of the backfill.
+func (ingestor *DBIngestor) BackfillProgress() BackfillProgress {
+	return ingestor.backfill.getProgress()
+}
+
 // Parts of metric creation not needed to insert data
 func (ingestor *DBIngestor) CompleteMetricCreation() error {
 	return ingestor.db.CompleteMetricCreation()
@@ -82,5 +367,13 @@ func (ingestor *DBIngestor) CompleteMetricCreation() error {
 
 // Close closes the ingestor
 func (ingestor *DBIngestor) Close() {
+	if ingestor.backfill != nil {
+		if err := ingestor.backfill.close(); err != nil {
+			log.Error("msg", "error flushing the backfilled samples on close", "err", err)
+		}
+	}
+	if ingestor.spool != nil {
+		ingestor.spool.close()
+	}
 	ingestor.db.Close()
 }
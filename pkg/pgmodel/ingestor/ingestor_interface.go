@@ -13,3 +13,17 @@ type DBInserter interface {
 	// Returns the number of metrics ingested and any error encountered before finishing.
 	Ingest([]prompb.TimeSeries, *prompb.WriteRequest) (uint64, error)
 }
+
+// DBBackfiller is responsible for backfilling old TimeSeries protobuf structs
+// in bulk into the database.
+type DBBackfiller interface {
+	// Backfill buffers an array of TimeSeries until the next flush, which
+	// starts in the background once the buffer is full.
+	// Returns the number of samples buffered and any error encountered.
+	Backfill([]prompb.TimeSeries, *prompb.WriteRequest) (uint64, error)
+	// FlushBackfill inserts the buffered TimeSeries, keeping the ones which
+	// failed with a retryable error buffered.
+	FlushBackfill() error
+	// BackfillProgress returns the progress of the backfill.
+	BackfillProgress() BackfillProgress
+}
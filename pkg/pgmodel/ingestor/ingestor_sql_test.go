@@ -6,9 +6,11 @@ package ingestor
 
 import (
 	"fmt"
+	"sync"
 	"testing"
 	"time"
 
+	"github.com/jackc/pgconn"
 	"github.com/jackc/pgtype"
 	"github.com/pkg/errors"
 	"github.com/prometheus/prometheus/pkg/labels"
@@ -494,14 +496,14 @@ func TestPGXInserterInsertData(t *testing.T) {
 					Err:     error(nil),
 				},
 				{
-					Sql: "SELECT _prom_catalog.insert_metric_row($1, $2::TIMESTAMPTZ[], $3::DOUBLE PRECISION[], $4::BIGINT[])",
+					Sql: "SELECT inserted, duplicate_policy FROM _prom_catalog.insert_metric_row_with_policy($1, $2::TIMESTAMPTZ[], $3::DOUBLE PRECISION[], $4::BIGINT[])",
 					Args: []interface{}{
 						"metric_0",
 						[]time.Time{time.Unix(0, 0)},
 						[]float64{0},
 						[]int64{1},
 					},
-					Results: model.RowResults{{int64(1)}},
+					Results: model.RowResults{{int64(1), "ignore"}},
 					Err:     error(nil),
 				},
 				{
@@ -531,14 +533,14 @@ func TestPGXInserterInsertData(t *testing.T) {
 				},
 
 				{
-					Sql: "SELECT _prom_catalog.insert_metric_row($1, $2::TIMESTAMPTZ[], $3::DOUBLE PRECISION[], $4::BIGINT[])",
+					Sql: "SELECT inserted, duplicate_policy FROM _prom_catalog.insert_metric_row_with_policy($1, $2::TIMESTAMPTZ[], $3::DOUBLE PRECISION[], $4::BIGINT[])",
 					Args: []interface{}{
 						"metric_0",
 						[]time.Time{time.Unix(0, 0), time.Unix(0, 0)},
 						[]float64{0, 0},
 						[]int64{1, 1},
 					},
-					Results: model.RowResults{{int64(1)}},
+					Results: model.RowResults{{int64(1), "ignore"}},
 					Err:     error(nil),
 				},
 				{
@@ -589,14 +591,14 @@ func TestPGXInserterInsertData(t *testing.T) {
 				},
 
 				{
-					Sql: "SELECT _prom_catalog.insert_metric_row($1, $2::TIMESTAMPTZ[], $3::DOUBLE PRECISION[], $4::BIGINT[])",
+					Sql: "SELECT inserted, duplicate_policy FROM _prom_catalog.insert_metric_row_with_policy($1, $2::TIMESTAMPTZ[], $3::DOUBLE PRECISION[], $4::BIGINT[])",
 					Args: []interface{}{
 						"metric_0",
 						[]time.Time{time.Unix(0, 0)},
 						[]float64{0},
 						[]int64{1},
 					},
-					Results: model.RowResults{{int64(1)}},
+					Results: model.RowResults{{int64(1), "ignore"}},
 					Err:     error(nil),
 				},
 				{
@@ -608,14 +610,14 @@ func TestPGXInserterInsertData(t *testing.T) {
 				},
 
 				{
-					Sql: "SELECT _prom_catalog.insert_metric_row($1, $2::TIMESTAMPTZ[], $3::DOUBLE PRECISION[], $4::BIGINT[])",
+					Sql: "SELECT inserted, duplicate_policy FROM _prom_catalog.insert_metric_row_with_policy($1, $2::TIMESTAMPTZ[], $3::DOUBLE PRECISION[], $4::BIGINT[])",
 					Args: []interface{}{
 						"metric_0",
 						[]time.Time{time.Unix(0, 0)},
 						[]float64{0},
 						[]int64{1},
 					},
-					Results: model.RowResults{{int64(1)}},
+					Results: model.RowResults{{int64(1), "ignore"}},
 					Err:     error(nil),
 				},
 				{
@@ -650,14 +652,14 @@ func TestPGXInserterInsertData(t *testing.T) {
 				},
 
 				{
-					Sql: "SELECT _prom_catalog.insert_metric_row($1, $2::TIMESTAMPTZ[], $3::DOUBLE PRECISION[], $4::BIGINT[])",
+					Sql: "SELECT inserted, duplicate_policy FROM _prom_catalog.insert_metric_row_with_policy($1, $2::TIMESTAMPTZ[], $3::DOUBLE PRECISION[], $4::BIGINT[])",
 					Args: []interface{}{
 						"metric_0",
 						[]time.Time{time.Unix(0, 0), time.Unix(0, 0), time.Unix(0, 0), time.Unix(0, 0), time.Unix(0, 0)},
 						make([]float64, 5),
 						[]int64{1, 1, 1, 1, 1},
 					},
-					Results: model.RowResults{{int64(1)}},
+					Results: model.RowResults{{int64(1), "ignore"}},
 					Err:     fmt.Errorf("some INSERT error"),
 				},
 				{
@@ -669,14 +671,14 @@ func TestPGXInserterInsertData(t *testing.T) {
 				},
 
 				{
-					Sql: "SELECT _prom_catalog.insert_metric_row($1, $2::TIMESTAMPTZ[], $3::DOUBLE PRECISION[], $4::BIGINT[])",
+					Sql: "SELECT inserted, duplicate_policy FROM _prom_catalog.insert_metric_row_with_policy($1, $2::TIMESTAMPTZ[], $3::DOUBLE PRECISION[], $4::BIGINT[])",
 					Args: []interface{}{
 						"metric_0",
 						[]time.Time{time.Unix(0, 0), time.Unix(0, 0), time.Unix(0, 0), time.Unix(0, 0), time.Unix(0, 0)},
 						make([]float64, 5),
 						[]int64{1, 1, 1, 1, 1},
 					},
-					Results: model.RowResults{{int64(1)}},
+					Results: model.RowResults{{int64(1), "ignore"}},
 					Err:     fmt.Errorf("some INSERT error"),
 				},
 				{
@@ -778,3 +780,104 @@ func TestPGXInserterInsertData(t *testing.T) {
 		})
 	}
 }
+
+func TestInsertBatchErrorFallbackPerRequest(t *testing.T) {
+	makeSamples := func(seriesID int64, value float64) []model.Samples {
+		series := &model.Series{}
+		series.SetSeriesID(model.SeriesID(seriesID), 1)
+		return []model.Samples{model.NewPromSample(series, []prompb.Sample{{Value: value}})}
+	}
+	insertSQL := "SELECT inserted, duplicate_policy FROM _prom_catalog.insert_metric_row_with_policy($1, $2::TIMESTAMPTZ[], $3::DOUBLE PRECISION[], $4::BIGINT[])"
+	epochSQL := "SELECT CASE current_epoch > $1::BIGINT + 1 WHEN true THEN _prom_catalog.epoch_abort($1) END FROM _prom_catalog.ids_epoch LIMIT 1"
+	invalidErr := &pgconn.PgError{Code: "22003"}
+
+	batchInsert := model.SqlQuery{
+		Sql:  insertSQL,
+		Args: []interface{}{"metric_0", []time.Time{time.Unix(0, 0), time.Unix(0, 0)}, []float64{1, 2}, []int64{1, 2}},
+		Err:  invalidErr,
+	}
+	mock := model.NewSqlRecorder([]model.SqlQuery{
+		// the entire batch insert
+		batchInsert,
+		{Sql: epochSQL, Args: []interface{}{int64(1)}, Results: model.RowResults{{[]byte{}}}},
+		// the retry on the individual copy request
+		batchInsert,
+		{Sql: epochSQL, Args: []interface{}{int64(1)}, Results: model.RowResults{{[]byte{}}}},
+		{
+			// the first request on its own
+			Sql:     insertSQL,
+			Args:    []interface{}{"metric_0", []time.Time{time.Unix(0, 0)}, []float64{1}, []int64{1}},
+			Results: model.RowResults{{int64(1), "ignore"}},
+		},
+		{Sql: epochSQL, Args: []interface{}{int64(1)}, Results: model.RowResults{{[]byte{}}}},
+		{
+			// the second request on its own
+			Sql:  insertSQL,
+			Args: []interface{}{"metric_0", []time.Time{time.Unix(0, 0)}, []float64{2}, []int64{2}},
+			Err:  invalidErr,
+		},
+		{Sql: epochSQL, Args: []interface{}{int64(1)}, Results: model.RowResults{{[]byte{}}}},
+	}, t)
+
+	finished := &sync.WaitGroup{}
+	finished.Add(2)
+	errChans := []chan error{make(chan error, 1), make(chan error, 1)}
+	pending := NewPendingBuffer()
+	pending.addReq(&insertDataRequest{metric: "metric_0", data: makeSamples(1, 1), finished: finished, errChan: errChans[0]})
+	pending.addReq(&insertDataRequest{metric: "metric_0", data: makeSamples(2, 2), finished: finished, errChan: errChans[1]})
+
+	doInsertOrFallback(mock, copyRequest{data: pending, table: "metric_0"})
+	finished.Wait()
+
+	select {
+	case err := <-errChans[0]:
+		t.Errorf("unexpected error for the valid request: %v", err)
+	default:
+	}
+	select {
+	case err := <-errChans[1]:
+		if reason, _ := pgmodelErrs.NonRetryableReason(err); reason != pgmodelErrs.ReasonInvalidSamples {
+			t.Errorf("unexpected reason for the invalid request: got %q wanted %q", reason, pgmodelErrs.ReasonInvalidSamples)
+		}
+	default:
+		t.Errorf("expected an error for the invalid request")
+	}
+}
+
+func TestInsertReplayIgnoresDuplicates(t *testing.T) {
+	series := &model.Series{}
+	series.SetSeriesID(1, 1)
+	mock := model.NewSqlRecorder([]model.SqlQuery{
+		{
+			Sql:     "SELECT inserted, duplicate_policy FROM _prom_catalog.insert_metric_row_with_policy($1, $2::TIMESTAMPTZ[], $3::DOUBLE PRECISION[], $4::BIGINT[], false)",
+			Args:    []interface{}{"metric_0", []time.Time{time.Unix(0, 0)}, []float64{1}, []int64{1}},
+			Results: model.RowResults{{int64(0), "ignore"}},
+		},
+		{
+			Sql:     "SELECT CASE current_epoch > $1::BIGINT + 1 WHEN true THEN _prom_catalog.epoch_abort($1) END FROM _prom_catalog.ids_epoch LIMIT 1",
+			Args:    []interface{}{int64(1)},
+			Results: model.RowResults{{[]byte{}}},
+		},
+	}, t)
+
+	finished := &sync.WaitGroup{}
+	finished.Add(1)
+	errChan := make(chan error, 1)
+	pending := NewPendingBuffer()
+	pending.addReq(&insertDataRequest{
+		metric:   "metric_0",
+		data:     []model.Samples{model.NewPromSample(series, []prompb.Sample{{Value: 1}})},
+		finished: finished,
+		errChan:  errChan,
+		replay:   true,
+	})
+
+	doInsertOrFallback(mock, copyRequest{data: pending, table: "metric_0"})
+	finished.Wait()
+
+	select {
+	case err := <-errChan:
+		t.Errorf("unexpected error: %v", err)
+	default:
+	}
+}
@@ -5,14 +5,17 @@
 package ingestor
 
 import (
+	goErrors "errors"
 	"fmt"
 	"testing"
 	"time"
 
+	"github.com/jackc/pgconn"
 	"github.com/timescale/promscale/pkg/ha"
 	"github.com/timescale/promscale/pkg/pgmodel/cache"
 	"github.com/timescale/promscale/pkg/pgmodel/common/errors"
 	"github.com/timescale/promscale/pkg/pgmodel/model"
+	"github.com/timescale/promscale/pkg/pgmodel/timewindow"
 	"github.com/timescale/promscale/pkg/prompb"
 )
 
@@ -32,6 +35,8 @@ func TestDBIngestorIngest(t *testing.T) {
 		setSeriesErr    error
 		ha              bool
 		haSetLeader     *leaderInfo
+		// nonRetryable is the reason of the expected non-retryable error.
+		nonRetryable string
 	}{
 		{
 			name:    "Zero metrics",
@@ -173,8 +178,9 @@ func TestDBIngestorIngest(t *testing.T) {
 					},
 				},
 			},
-			count:       0,
-			countSeries: 0,
+			count:        0,
+			countSeries:  0,
+			nonRetryable: errors.ReasonNoMetricName,
 		},
 		{
 			name: "Insert data in HA for no leader prom",
@@ -242,6 +248,25 @@ func TestDBIngestorIngest(t *testing.T) {
 			countSeries:   0,
 			insertDataErr: fmt.Errorf("HA enabled, but both cluster and __replica__ labels are empty"),
 			ha:            true,
+			nonRetryable:  errors.ReasonHAValidation,
+		},
+		{
+			name: "Duplicate label names",
+			metrics: []prompb.TimeSeries{
+				{
+					Labels: []prompb.Label{
+						{Name: model.MetricNameLabelName, Value: "test"},
+						{Name: "test", Value: "a"},
+						{Name: "test", Value: "b"},
+					},
+					Samples: []prompb.Sample{
+						{Timestamp: 1, Value: 0.1},
+					},
+				},
+			},
+			count:        0,
+			countSeries:  0,
+			nonRetryable: errors.ReasonInvalidLabels,
 		},
 	}
 
@@ -265,7 +290,7 @@ func TestDBIngestorIngest(t *testing.T) {
 					info := c.haSetLeader
 					ha.SetLeaderInMockService(mock, info.cluster, info.leader, info.minT, info.maxT)
 				}
-				i.parser = ha.NewHAParser(mock, scache)
+				i.parser = ha.NewHAParser(mock, scache, nil)
 			}
 
 			count, err := i.Ingest(c.metrics, NewWriteRequest())
@@ -283,7 +308,7 @@ func TestDBIngestorIngest(t *testing.T) {
 				if c.setSeriesErr != nil && err != c.setSeriesErr {
 					t.Errorf("wrong error returned: got\n%s\nwant\n%s\n", err, c.setSeriesErr)
 				}
-				if err == errors.ErrNoMetricName {
+				if goErrors.Is(err, errors.ErrNoMetricName) {
 					for _, ts := range c.metrics {
 						for _, label := range ts.Labels {
 							if label.Name == model.MetricNameLabelName {
@@ -293,6 +318,9 @@ func TestDBIngestorIngest(t *testing.T) {
 					}
 				}
 			}
+			if reason, _ := errors.NonRetryableReason(err); reason != c.nonRetryable {
+				t.Errorf("wrong non-retryable reason: got %q want %q", reason, c.nonRetryable)
+			}
 
 			if count != c.count {
 				t.Errorf("invalid number of metrics inserted: got %d, want %d\n", count, c.count)
@@ -308,3 +336,96 @@ func TestDBIngestorIngest(t *testing.T) {
 		})
 	}
 }
+
+func TestIngestWindow(t *testing.T) {
+	now := time.Now().UnixNano() / int64(time.Millisecond)
+	newRequest := func() []prompb.TimeSeries {
+		return []prompb.TimeSeries{{
+			Labels: []prompb.Label{{Name: model.MetricNameLabelName, Value: "test"}},
+			Samples: []prompb.Sample{
+				{Timestamp: now - 2*time.Hour.Milliseconds(), Value: 1},
+				{Timestamp: now, Value: 2},
+			},
+		}}
+	}
+	testCases := []struct {
+		policy string
+		count  uint64
+		reason string
+	}{
+		{policy: timewindow.PolicyDrop, count: 1},
+		{policy: timewindow.PolicyReject, reason: errors.ReasonOutOfWindow},
+	}
+	for _, c := range testCases {
+		window, err := timewindow.New(time.Hour, 0, c.policy)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		scache := cache.NewSeriesCache(cache.DefaultConfig, nil)
+		i := DBIngestor{
+			db:     &model.MockInserter{InsertedSeries: make(map[string]model.SeriesID)},
+			scache: scache,
+			parser: &dataParser{scache: scache},
+			window: window,
+		}
+		count, err := i.Ingest(newRequest(), NewWriteRequest())
+		if reason, _ := errors.NonRetryableReason(err); reason != c.reason {
+			t.Errorf("unexpected error with the %s policy: %v", c.policy, err)
+		}
+		if count != c.count {
+			t.Errorf("unexpected number of samples inserted with the %s policy: got %d wanted %d", c.policy, count, c.count)
+		}
+	}
+}
+
+func TestFilterWindowDropsEmptySeries(t *testing.T) {
+	now := time.Now().UnixNano() / int64(time.Millisecond)
+	window, err := timewindow.New(time.Hour, 0, timewindow.PolicyDrop)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	tts := []prompb.TimeSeries{
+		{
+			Labels:  []prompb.Label{{Name: model.MetricNameLabelName, Value: "old"}},
+			Samples: []prompb.Sample{{Timestamp: now - 2*time.Hour.Milliseconds(), Value: 1}},
+		},
+		{
+			Labels:  []prompb.Label{{Name: model.MetricNameLabelName, Value: "recent"}},
+			Samples: []prompb.Sample{{Timestamp: now, Value: 2}},
+		},
+	}
+	kept, err := filterWindow(window, tts)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(kept) != 1 || kept[0].Labels[0].Value != "recent" {
+		t.Errorf("unexpected series kept: %+v", kept)
+	}
+	if tts[1].Labels[0].Value != "old" {
+		t.Errorf("dropped series was not swapped to the end: %+v", tts)
+	}
+}
+
+func TestClassifyInsertError(t *testing.T) {
+	testCases := []struct {
+		err    error
+		reason string
+	}{
+		{err: &pgconn.PgError{Code: "22003"}, reason: errors.ReasonInvalidSamples},
+		{err: fmt.Errorf("wrapped: %w", &pgconn.PgError{Code: "22021"}), reason: errors.ReasonInvalidSamples},
+		{err: &pgconn.PgError{Code: "23505"}, reason: errors.ReasonDuplicateSamples},
+		{err: errors.NewNonRetryableError(errors.ReasonInvalidLabels, &pgconn.PgError{Code: "22021"}), reason: errors.ReasonInvalidLabels},
+		{err: &pgconn.PgError{Code: "08006"}},
+		{err: &pgconn.PgError{Code: "42P01"}},
+		{err: fmt.Errorf("some error")},
+	}
+	for _, c := range testCases {
+		err := classifyInsertError(c.err)
+		if reason, _ := errors.NonRetryableReason(err); reason != c.reason {
+			t.Errorf("unexpected reason for %v: got %q wanted %q", c.err, reason, c.reason)
+		}
+		if !goErrors.Is(err, c.err) {
+			t.Errorf("classified error does not wrap %v", c.err)
+		}
+	}
+}
@@ -24,9 +24,19 @@ import (
 	"github.com/timescale/promscale/pkg/pgxconn"
 )
 
+const (
+	insertMetricRowsSQL = "SELECT inserted, duplicate_policy FROM " + schema.Catalog + ".insert_metric_row_with_policy($1, $2::TIMESTAMPTZ[], $3::DOUBLE PRECISION[], $4::BIGINT[])"
+	// replayMetricRowsSQL ignores duplicates instead of rejecting them, as
+	// replayed requests might have been inserted partially before.
+	replayMetricRowsSQL = "SELECT inserted, duplicate_policy FROM " + schema.Catalog + ".insert_metric_row_with_policy($1, $2::TIMESTAMPTZ[], $3::DOUBLE PRECISION[], $4::BIGINT[], false)"
+)
+
 type copyRequest struct {
 	data  *pendingBuffer
 	table string
+	// backfill is set for the samples flushed by the backfiller, whose
+	// duplicates are ignored as a failed flush is retried as a whole.
+	backfill bool
 }
 
 var getBatchMutex = &sync.Mutex{}
@@ -223,12 +233,46 @@ func insertBatchErrorFallback(conn pgxconn.PgxConn, reqs ...copyRequest) {
 		if err != nil {
 			err = insertErrorFallback(conn, err, reqs[i])
 		}
-
-		reqs[i].data.reportResults(err)
+		if isRejectedData(err) && len(reqs[i].data.needsResponse) > 1 {
+			// The samples of one of the write requests are at fault, insert
+			// the samples of each of them on their own.
+			insertPerRequestFallback(conn, reqs[i])
+		} else {
+			reportInsertResult(reqs[i], err)
+		}
 		reqs[i].data.release()
 	}
 }
 
+// insertPerRequestFallback inserts the samples of every write request of the
+// copyRequest in a batch of their own, so that samples the database rejects
+// only fail the request they belong to.
+func insertPerRequestFallback(conn pgxconn.PgxConn, req copyRequest) {
+	for _, task := range req.data.needsResponse {
+		single := copyRequest{data: NewPendingBuffer(), table: req.table, backfill: req.backfill}
+		single.data.addTask(task)
+		err := doInsert(conn, single)
+		if err != nil {
+			err = insertErrorFallback(conn, err, single)
+		}
+		reportInsertResult(single, err)
+		single.data.release()
+	}
+}
+
+// reportInsertResult reports the result of inserting the samples of a
+// copyRequest. Rejected samples can only be tied to a write request, and
+// thus reported as non-retryable, if the copyRequest holds a single one.
+func reportInsertResult(req copyRequest, err error) {
+	if isDuplicateRejection(err) {
+		registerDuplicateOutcome(duplicatePolicyReject, int64(req.data.batch.CountSamples()))
+	}
+	if len(req.data.needsResponse) == 1 {
+		err = classifyInsertError(err)
+	}
+	req.data.reportResults(err)
+}
+
 // certain errors are recoverable, handle those we can
 //   1. if the table is compressed, decompress and retry the insertion
 func insertErrorFallback(conn pgxconn.PgxConn, err error, req copyRequest) error {
@@ -362,7 +406,11 @@ func doInsert(conn pgxconn.PgxConn, reqs ...copyRequest) (err error) {
 		}
 		numRowsTotal += numRows
 		numRowsPerInsert = append(numRowsPerInsert, numRows)
-		batch.Queue("SELECT "+schema.Catalog+".insert_metric_row($1, $2::TIMESTAMPTZ[], $3::DOUBLE PRECISION[], $4::BIGINT[])", req.table, times, vals, series)
+		if req.backfill || req.data.isReplay() {
+			batch.Queue(replayMetricRowsSQL, req.table, times, vals, series)
+		} else {
+			batch.Queue(insertMetricRowsSQL, req.table, times, vals, series)
+		}
 	}
 
 	//note the epoch increment takes an access exclusive on the table before incrementing.
@@ -383,8 +431,11 @@ func doInsert(conn pgxconn.PgxConn, reqs ...copyRequest) (err error) {
 
 	var affectedMetrics uint64
 	for _, numRows := range numRowsPerInsert {
-		var insertedRows int64
-		err := results.QueryRow().Scan(&insertedRows)
+		var (
+			insertedRows    int64
+			duplicatePolicy string
+		)
+		err := results.QueryRow().Scan(&insertedRows, &duplicatePolicy)
 		if err != nil {
 			return err
 		}
@@ -392,6 +443,7 @@ func doInsert(conn pgxconn.PgxConn, reqs ...copyRequest) (err error) {
 		if numRowsExpected != insertedRows {
 			affectedMetrics++
 			registerDuplicates(numRowsExpected - insertedRows)
+			registerDuplicateOutcome(duplicatePolicy, numRowsExpected-insertedRows)
 		}
 	}
 
@@ -6,11 +6,14 @@ package ingestor
 
 import (
 	"context"
+	goErrors "errors"
 	"fmt"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"time"
 
+	"github.com/jackc/pgconn"
 	"github.com/timescale/promscale/pkg/log"
 	"github.com/timescale/promscale/pkg/pgmodel/cache"
 	"github.com/timescale/promscale/pkg/pgmodel/common/errors"
@@ -191,6 +194,26 @@ func (p *pgxInserter) InsertNewData(rows map[string][]model.Samples) (uint64, er
 // Though we may insert data to multiple tables concurrently, if asyncAcks is
 // unset this function will wait until _all_ the insert attempts have completed.
 func (p *pgxInserter) InsertData(rows map[string][]model.Samples) (uint64, error) {
+	return p.insertData(rows, p.asyncAcks, nil)
+}
+
+// insertData inserts a batch of data like InsertData, waiting for the insert
+// attempts to complete unless async is set. If onCommit is not nil, it is
+// called with the result once they completed, which is after insertData
+// returned if async is set.
+func (p *pgxInserter) insertData(rows map[string][]model.Samples, async bool, onCommit func(error)) (uint64, error) {
+	return p.insert(rows, async, false, onCommit)
+}
+
+// insertReplay inserts the data of a replayed write request, waiting for the
+// insert attempts to complete. Duplicates of its samples are ignored instead
+// of rejected, since the request might have been inserted partially before.
+func (p *pgxInserter) insertReplay(rows map[string][]model.Samples) error {
+	_, err := p.insert(rows, false, true, nil)
+	return err
+}
+
+func (p *pgxInserter) insert(rows map[string][]model.Samples, async, replay bool, onCommit func(error)) (uint64, error) {
 	var numRows uint64
 	workFinished := &sync.WaitGroup{}
 	workFinished.Add(len(rows))
@@ -203,17 +226,20 @@ func (p *pgxInserter) InsertData(rows map[string][]model.Samples) (uint64, error
 			numRows += uint64(si.CountSamples())
 		}
 		// the following is usually non-blocking, just a channel insert
-		p.getMetricInserter(metricName) <- &insertDataRequest{metric: metricName, data: data, finished: workFinished, errChan: errChan}
+		p.getMetricInserter(metricName) <- &insertDataRequest{metric: metricName, data: data, finished: workFinished, errChan: errChan, replay: replay}
 	}
 
 	var err error
-	if !p.asyncAcks {
+	if !async {
 		workFinished.Wait()
 		select {
 		case err = <-errChan:
 		default:
 		}
 		close(errChan)
+		if onCommit != nil {
+			onCommit(err)
+		}
 	} else {
 		go func() {
 			workFinished.Wait()
@@ -222,17 +248,38 @@ func (p *pgxInserter) InsertData(rows map[string][]model.Samples) (uint64, error
 			default:
 			}
 			close(errChan)
-			if err != nil {
-				log.Error("msg", fmt.Sprintf("error on async send, dropping %d datapoints", numRows), "err", err)
-			} else if p.insertedDatapoints != nil {
+			if err == nil && p.insertedDatapoints != nil {
 				atomic.AddInt64(p.insertedDatapoints, int64(numRows))
 			}
+			if onCommit != nil {
+				onCommit(err)
+			} else if err != nil {
+				log.Error("msg", fmt.Sprintf("error on async send, dropping %d datapoints", numRows), "err", err)
+			}
 		}()
 	}
 
 	return numRows, err
 }
 
+// classifyInsertError marks the errors of samples which the database rejects
+// as invalid, e.g. because of out of range values, or as duplicates under the
+// reject policy, as non-retryable. It must only be applied to the errors of
+// inserts holding the samples of a single write request, since the error
+// can't be tied to one of the requests otherwise.
+func classifyInsertError(err error) error {
+	if _, ok := errors.NonRetryableReason(err); ok {
+		return err
+	}
+	if isDataException(err) {
+		return errors.NewNonRetryableError(errors.ReasonInvalidSamples, err)
+	}
+	if isDuplicateRejection(err) {
+		return errors.NewNonRetryableError(errors.ReasonDuplicateSamples, err)
+	}
+	return err
+}
+
 // Get the handler for a given metric name, creating a new one if none exists
 func (p *pgxInserter) getMetricInserter(metric string) chan *insertDataRequest {
 	inserter, ok := p.inserters.Load(metric)
@@ -314,10 +361,17 @@ type insertDataRequest struct {
 	data     []model.Samples
 	finished *sync.WaitGroup
 	errChan  chan error
+	// replay is set for the requests replayed from the spool.
+	replay bool
 }
 
 func (idr *insertDataRequest) reportResult(err error) {
 	if err != nil {
+		samples := 0
+		for _, s := range idr.data {
+			samples += s.CountSamples()
+		}
+		countDroppedSamples(err, samples)
 		select {
 		case idr.errChan <- err:
 		default:
@@ -326,9 +380,36 @@ func (idr *insertDataRequest) reportResult(err error) {
 	idr.finished.Done()
 }
 
+// isDataException returns true if the database rejected the data as invalid.
+func isDataException(err error) bool {
+	var pgErr *pgconn.PgError
+	return goErrors.As(err, &pgErr) && strings.HasPrefix(pgErr.Code, "22")
+}
+
+// isRejectedData returns true if the database rejected the samples themselves,
+// as invalid or as duplicates under the reject policy.
+func isRejectedData(err error) bool {
+	return isDataException(err) || isDuplicateRejection(err)
+}
+
+// countDroppedSamples counts the samples failing to be inserted with an error
+// which retrying does not resolve as dropped.
+func countDroppedSamples(err error, samples int) {
+	if reason, ok := errors.NonRetryableReason(err); ok {
+		IngestDroppedSamples.WithLabelValues(reason).Add(float64(samples))
+	}
+}
+
 type insertDataTask struct {
 	finished *sync.WaitGroup
 	errChan  chan error
+	// samples is the number of samples of the task.
+	samples int
+	// data holds the samples of the task, to insert them on their own if
+	// the database rejects the batch they are part of.
+	data []model.Samples
+	// replay is set for the tasks of requests replayed from the spool.
+	replay bool
 }
 
 // Report that this task is completed, along with any error that may have
@@ -336,6 +417,7 @@ type insertDataTask struct {
 // _must never block_: blocking here will cause deadlocks.
 func (idt *insertDataTask) reportResult(err error) {
 	if err != nil {
+		countDroppedSamples(err, idt.samples)
 		select {
 		case idt.errChan <- err:
 		default:
@@ -89,6 +89,116 @@ var (
 		},
 		func() float64 { return float64(len(CopierChannelToMonitor)) },
 	)
+
+	SpoolSizeBytes = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: util.PromNamespace,
+			Name:      "spool_size_bytes",
+			Help:      "Size of the segment files of the write-ahead spool.",
+		},
+	)
+	SpoolMaxBytes = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: util.PromNamespace,
+			Name:      "spool_max_bytes",
+			Help:      "Maximum size of the write-ahead spool.",
+		},
+	)
+	SpoolPendingRequests = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: util.PromNamespace,
+			Name:      "spool_pending_requests",
+			Help:      "Number of spooled write requests which were not inserted yet.",
+		},
+	)
+	SpoolReplayQueueRequests = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: util.PromNamespace,
+			Name:      "spool_replay_queue_requests",
+			Help:      "Number of spooled write requests waiting to be replayed.",
+		},
+	)
+	SpoolReplayedRequests = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: util.PromNamespace,
+			Name:      "spool_replayed_requests_total",
+			Help:      "Number of spooled write requests which were replayed.",
+		},
+	)
+	SpoolReplayFailures = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: util.PromNamespace,
+			Name:      "spool_replay_failures_total",
+			Help:      "Number of times replaying the spooled write requests failed, to be retried later.",
+		},
+	)
+	SpoolRejectedRequests = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: util.PromNamespace,
+			Name:      "spool_rejected_requests_total",
+			Help:      "Number of write requests rejected because the write-ahead spool was full.",
+		},
+	)
+
+	IngestInflightSamples = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: util.PromNamespace,
+			Name:      "ingest_inflight_samples",
+			Help:      "Number of samples being ingested, which were not inserted yet.",
+		},
+	)
+	IngestInflightBytes = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: util.PromNamespace,
+			Name:      "ingest_inflight_bytes",
+			Help:      "Estimated memory of the samples being ingested, which were not inserted yet.",
+		},
+	)
+	IngestMaxInflightSamples = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: util.PromNamespace,
+			Name:      "ingest_max_inflight_samples",
+			Help:      "Maximum number of samples being ingested at once, 0 if unlimited.",
+		},
+	)
+	IngestMaxInflightBytes = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: util.PromNamespace,
+			Name:      "ingest_max_inflight_bytes",
+			Help:      "Maximum estimated memory of the samples being ingested at once, 0 if unlimited.",
+		},
+	)
+	IngestRejectedRequests = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: util.PromNamespace,
+			Name:      "ingest_rejected_requests_total",
+			Help:      "Number of write requests rejected because too many samples were being ingested, by the exceeded limit.",
+		},
+		[]string{"limit"},
+	)
+	IngestDroppedSamples = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: util.PromNamespace,
+			Name:      "ingest_dropped_samples_total",
+			Help:      "Number of samples dropped because of errors which retrying the write request does not resolve, by reason.",
+		},
+		[]string{"reason"},
+	)
+	BackfillBufferedSamples = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: util.PromNamespace,
+			Name:      "backfill_buffered_samples",
+			Help:      "Number of backfilled samples buffered until the next flush.",
+		},
+	)
+	BackfillSamples = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: util.PromNamespace,
+			Name:      "backfill_samples_total",
+			Help:      "Number of backfilled samples flushed, by status: inserted or failed.",
+		},
+		[]string{"status"},
+	)
 )
 
 func setCopierChannelToMonitor(toCopiers chan copyRequest) {
@@ -109,6 +219,21 @@ func init() {
 		DbBatchInsertDuration,
 		CopierChCap,
 		CopierChLen,
+		SpoolSizeBytes,
+		SpoolMaxBytes,
+		SpoolPendingRequests,
+		SpoolReplayQueueRequests,
+		SpoolReplayedRequests,
+		SpoolReplayFailures,
+		SpoolRejectedRequests,
+		IngestInflightSamples,
+		IngestInflightBytes,
+		IngestMaxInflightSamples,
+		IngestMaxInflightBytes,
+		IngestRejectedRequests,
+		IngestDroppedSamples,
+		BackfillBufferedSamples,
+		BackfillSamples,
 	)
 
 	MetricBatcherChCap.Set(MetricBatcherChannelCap)
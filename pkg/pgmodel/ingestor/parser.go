@@ -8,6 +8,7 @@ import (
 	"github.com/timescale/promscale/pkg/pgmodel/cache"
 	"github.com/timescale/promscale/pkg/pgmodel/common/errors"
 	"github.com/timescale/promscale/pkg/pgmodel/model"
+	"github.com/timescale/promscale/pkg/pgmodel/relabel"
 	"github.com/timescale/promscale/pkg/prompb"
 )
 
@@ -16,11 +17,14 @@ type Parser interface {
 }
 
 type dataParser struct {
-	scache cache.SeriesCache
+	scache  cache.SeriesCache
+	relabel *relabel.Rules
 }
 
-func DefaultParser(seriesCache cache.SeriesCache) Parser {
-	return &dataParser{scache: seriesCache}
+// DefaultParser returns a parser applying the relabeling rules, if any, to the
+// series.
+func DefaultParser(seriesCache cache.SeriesCache, relabelRules *relabel.Rules) Parser {
+	return &dataParser{scache: seriesCache, relabel: relabelRules}
 }
 
 // Parse data into a set of samplesInfo infos per-metric.
@@ -31,7 +35,7 @@ func (d *dataParser) ParseData(tts []prompb.TimeSeries) (map[string][]model.Samp
 
 	for i := range tts {
 		t := &tts[i]
-		if len(t.Samples) == 0 {
+		if len(t.Samples) == 0 || !d.relabel.Process(t) {
 			continue
 		}
 
@@ -42,7 +46,7 @@ func (d *dataParser) ParseData(tts []prompb.TimeSeries) (map[string][]model.Samp
 			return nil, rows, err
 		}
 		if metricName == "" {
-			return nil, rows, errors.ErrNoMetricName
+			return nil, rows, errors.NewNonRetryableError(errors.ReasonNoMetricName, errors.ErrNoMetricName)
 		}
 		sample := model.NewPromSample(seriesLabels, t.Samples)
 		rows += len(t.Samples)
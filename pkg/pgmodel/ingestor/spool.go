@@ -0,0 +1,573 @@
+// This file and its contents are licensed under the Apache License 2.0.
+// Please see the included NOTICE for copyright information and
+// LICENSE for a copy of the license.
+
+package ingestor
+
+import (
+	"encoding/binary"
+	"fmt"
+	"hash/crc32"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/timescale/promscale/pkg/log"
+	"github.com/timescale/promscale/pkg/pgmodel/common/errors"
+)
+
+const (
+	spoolSegmentSuffix = ".spool"
+	// spoolRecordHeaderSize is the size of the length, the checksum and the
+	// kind preceding the data of every record.
+	spoolRecordHeaderSize = 9
+	// spoolCommitSize is the size of the data of a commit record: the index
+	// of the segment and the offset of the committed record.
+	spoolCommitSize = 16
+)
+
+const (
+	// spoolRecordRequest records hold a write request.
+	spoolRecordRequest byte = iota
+	// spoolRecordCommit records mark a request record as committed.
+	spoolRecordCommit
+	// spoolRecordBackfill records hold a backfill write request, which is
+	// buffered again instead of being replayed.
+	spoolRecordBackfill
+)
+
+var spoolCRCTable = crc32.MakeTable(crc32.Castagnoli)
+
+// spool is an on-disk write-ahead log of the accepted write requests. A
+// request is appended before it is acknowledged, and committed once its
+// samples were inserted, by appending a commit record. The requests which
+// failed to be inserted, or were not committed before a restart, are
+// replayed. The oldest segment files are removed once all their requests were
+// committed.
+type spool struct {
+	dir          string
+	maxBytes     int64
+	segmentBytes int64
+
+	// writeLock serializes the writes to the active segment. It is taken
+	// before the lock.
+	writeLock sync.Mutex
+
+	lock     sync.Mutex
+	segments []*spoolSegment
+	// active is the file of the last segment, which the records are
+	// appended to. It is only changed while holding both locks.
+	active  *os.File
+	size    int64
+	pending int
+	// queue holds the records to write with the next flush.
+	queue []*spoolWrite
+	// replayQueue holds the records to replay, in the order they were
+	// appended in.
+	replayQueue []*spoolRecord
+	// backfill holds the recovered backfill records, until they are
+	// buffered again.
+	backfill []*spoolRecord
+	closed   bool
+
+	stop chan struct{}
+	wg   sync.WaitGroup
+}
+
+type spoolSegment struct {
+	index   int
+	size    int64
+	pending int
+	removed bool
+}
+
+type spoolRecord struct {
+	segment  *spoolSegment
+	offset   int64
+	length   int64
+	backfill bool
+}
+
+// spoolPosition identifies a record by its segment and offset.
+type spoolPosition struct {
+	index  int
+	offset int64
+}
+
+// spoolWrite is a record queued to be written.
+type spoolWrite struct {
+	buf []byte
+	// commit is the record committed by a commit record.
+	commit *spoolRecord
+	// record is the written request record, err the error writing it.
+	record   *spoolRecord
+	err      error
+	backfill bool
+}
+
+// openSpool opens the spool in the configured directory. The request records
+// of the existing segments, which were not committed before, are queued for
+// replay.
+func openSpool(cfg SpoolConfig) (*spool, error) {
+	if err := os.MkdirAll(cfg.Dir, 0750); err != nil {
+		return nil, fmt.Errorf("creating the spool directory: %w", err)
+	}
+	s := &spool{
+		dir:          cfg.Dir,
+		maxBytes:     int64(cfg.MaxBytes),
+		segmentBytes: int64(cfg.SegmentBytes),
+		stop:         make(chan struct{}),
+	}
+
+	indexes, err := s.segmentIndexes()
+	if err != nil {
+		return nil, err
+	}
+	next := 0
+	committed := make(map[spoolPosition]bool)
+	var records []*spoolRecord
+	for _, index := range indexes {
+		next = index + 1
+		seg := &spoolSegment{index: index}
+		segRecords, err := s.recoverSegment(seg, committed)
+		if err != nil {
+			return nil, err
+		}
+		s.segments = append(s.segments, seg)
+		s.size += seg.size
+		records = append(records, segRecords...)
+	}
+	for _, r := range records {
+		if committed[spoolPosition{index: r.segment.index, offset: r.offset}] {
+			continue
+		}
+		r.segment.pending++
+		s.pending++
+		if r.backfill {
+			s.backfill = append(s.backfill, r)
+		} else {
+			s.replayQueue = append(s.replayQueue, r)
+		}
+	}
+	if err := s.createSegment(next); err != nil {
+		return nil, err
+	}
+	s.removeCommitted()
+	if len(s.replayQueue) > 0 || len(s.backfill) > 0 {
+		log.Info("msg", "Replaying the write requests of the spool", "requests", len(s.replayQueue), "backfill-requests", len(s.backfill), "bytes", s.size)
+	}
+	SpoolMaxBytes.Set(float64(cfg.MaxBytes))
+	s.updateMetrics()
+	return s, nil
+}
+
+func (s *spool) segmentPath(index int) string {
+	return filepath.Join(s.dir, fmt.Sprintf("%08d%s", index, spoolSegmentSuffix))
+}
+
+// segmentIndexes returns the indexes of the segment files in ascending order.
+func (s *spool) segmentIndexes() ([]int, error) {
+	files, err := ioutil.ReadDir(s.dir)
+	if err != nil {
+		return nil, fmt.Errorf("reading the spool directory: %w", err)
+	}
+	var indexes []int
+	for _, f := range files {
+		name := f.Name()
+		if f.IsDir() || !strings.HasSuffix(name, spoolSegmentSuffix) {
+			continue
+		}
+		index, err := strconv.Atoi(strings.TrimSuffix(name, spoolSegmentSuffix))
+		if err != nil {
+			continue
+		}
+		indexes = append(indexes, index)
+	}
+	sort.Ints(indexes)
+	return indexes, nil
+}
+
+// recoverSegment reads the request records of an existing segment, adding the
+// positions of the records committed by its commit records to committed. A
+// segment ending with a partially written record, as left behind by a crash,
+// is truncated to its last complete record.
+func (s *spool) recoverSegment(seg *spoolSegment, committed map[spoolPosition]bool) ([]*spoolRecord, error) {
+	path := s.segmentPath(seg.index)
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading a spool segment: %w", err)
+	}
+
+	var records []*spoolRecord
+	offset := int64(0)
+	for offset < int64(len(data)) {
+		kind, length, ok := readSpoolRecord(data[offset:])
+		if ok && kind == spoolRecordCommit && length != spoolCommitSize {
+			ok = false
+		}
+		if !ok {
+			log.Warn("msg", "Truncating a corrupted spool segment", "segment", path, "offset", offset)
+			if err := os.Truncate(path, offset); err != nil {
+				return nil, fmt.Errorf("truncating a spool segment: %w", err)
+			}
+			break
+		}
+		if kind == spoolRecordCommit {
+			commit := data[offset+spoolRecordHeaderSize:]
+			committed[spoolPosition{
+				index:  int(binary.BigEndian.Uint64(commit)),
+				offset: int64(binary.BigEndian.Uint64(commit[8:])),
+			}] = true
+		} else {
+			records = append(records, &spoolRecord{segment: seg, offset: offset, length: length, backfill: kind == spoolRecordBackfill})
+		}
+		offset += spoolRecordHeaderSize + length
+	}
+	seg.size = offset
+	return records, nil
+}
+
+// encodeSpoolRecord returns a record of the kind holding the data.
+func encodeSpoolRecord(kind byte, data []byte) []byte {
+	buf := make([]byte, spoolRecordHeaderSize+len(data))
+	binary.BigEndian.PutUint32(buf, uint32(len(data)))
+	buf[8] = kind
+	copy(buf[spoolRecordHeaderSize:], data)
+	binary.BigEndian.PutUint32(buf[4:], crc32.Checksum(buf[8:], spoolCRCTable))
+	return buf
+}
+
+// readSpoolRecord returns the kind and the length of the data of the record at
+// the start of buf, and false if the record is incomplete or corrupted.
+func readSpoolRecord(buf []byte) (byte, int64, bool) {
+	if len(buf) < spoolRecordHeaderSize {
+		return 0, 0, false
+	}
+	length := int64(binary.BigEndian.Uint32(buf))
+	if int64(len(buf)) < spoolRecordHeaderSize+length {
+		return 0, 0, false
+	}
+	kind := buf[8]
+	if kind > spoolRecordBackfill {
+		return 0, 0, false
+	}
+	return kind, length, crc32.Checksum(buf[8:spoolRecordHeaderSize+length], spoolCRCTable) == binary.BigEndian.Uint32(buf[4:])
+}
+
+// createSegment creates a new active segment. The caller must hold both
+// locks, or own the spool.
+func (s *spool) createSegment(index int) error {
+	f, err := os.OpenFile(s.segmentPath(index), os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0640)
+	if err != nil {
+		return fmt.Errorf("creating a spool segment: %w", err)
+	}
+	s.segments = append(s.segments, &spoolSegment{index: index})
+	s.active = f
+	return nil
+}
+
+// rotate closes the active segment and starts a new one, removing the oldest
+// segments if all their records were committed. The caller must hold both
+// locks.
+func (s *spool) rotate() error {
+	last := s.segments[len(s.segments)-1]
+	if s.active != nil {
+		if err := s.active.Close(); err != nil {
+			log.Warn("msg", "Error closing a spool segment", "err", err)
+		}
+		s.active = nil
+	}
+	if err := s.createSegment(last.index + 1); err != nil {
+		return err
+	}
+	s.removeCommitted()
+	return nil
+}
+
+// append appends the data to the spool, and returns its record once it is on
+// disk. The records appended concurrently are written and synced together.
+func (s *spool) append(data []byte) (*spoolRecord, error) {
+	return s.appendRecord(spoolRecordRequest, data)
+}
+
+// appendBackfill appends the data of a backfill write request to the spool,
+// like append.
+func (s *spool) appendBackfill(data []byte) (*spoolRecord, error) {
+	return s.appendRecord(spoolRecordBackfill, data)
+}
+
+func (s *spool) appendRecord(kind byte, data []byte) (*spoolRecord, error) {
+	w := &spoolWrite{buf: encodeSpoolRecord(kind, data), backfill: kind == spoolRecordBackfill}
+	s.lock.Lock()
+	if s.closed {
+		s.lock.Unlock()
+		return nil, fmt.Errorf("the spool is closed")
+	}
+	s.queue = append(s.queue, w)
+	s.lock.Unlock()
+
+	// Either this flush writes the record, or one which was already running
+	// took it, and finishes before this one starts.
+	s.flush()
+	return w.record, w.err
+}
+
+// flush writes the queued records to the active segment, syncing it once for
+// all of them. The records queued while a flush is running are written by the
+// next one.
+func (s *spool) flush() {
+	s.writeLock.Lock()
+	defer s.writeLock.Unlock()
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	queue := s.queue
+	s.queue = nil
+	var batch []*spoolWrite
+	var batchSize int64
+	for _, w := range queue {
+		if w.commit != nil && w.commit.segment.removed {
+			// The committed record is gone already.
+			continue
+		}
+		if s.closed {
+			w.err = fmt.Errorf("the spool is closed")
+			continue
+		}
+		size := int64(len(w.buf))
+		seg := s.segments[len(s.segments)-1]
+		if s.active == nil || (seg.size+batchSize > 0 && seg.size+batchSize+size > s.segmentBytes) {
+			s.writeBatch(batch)
+			batch, batchSize = nil, 0
+			if err := s.rotate(); err != nil {
+				w.err = err
+				continue
+			}
+		}
+		if w.commit == nil && s.size+batchSize+size > s.maxBytes {
+			SpoolRejectedRequests.Inc()
+			w.err = fmt.Errorf("%w (limit: %d bytes)", errors.ErrSpoolFull, s.maxBytes)
+			continue
+		}
+		batch = append(batch, w)
+		batchSize += size
+	}
+	s.writeBatch(batch)
+	s.updateMetrics()
+}
+
+// writeBatch writes the records to the active segment and syncs it. The
+// caller must hold both locks, the lock is released while writing.
+func (s *spool) writeBatch(batch []*spoolWrite) {
+	if len(batch) == 0 {
+		return
+	}
+	seg := s.segments[len(s.segments)-1]
+	f := s.active
+	var buf []byte
+	for _, w := range batch {
+		buf = append(buf, w.buf...)
+	}
+
+	s.lock.Unlock()
+	_, err := f.Write(buf)
+	if err == nil {
+		err = f.Sync()
+	}
+	s.lock.Lock()
+
+	if err != nil {
+		// The segment might end with a partial record now, which is
+		// truncated when it is recovered. The records are appended to
+		// a new segment from now on.
+		if rErr := s.rotate(); rErr != nil {
+			log.Warn("msg", "Error rotating the spool segment", "err", rErr)
+		}
+		for _, w := range batch {
+			w.err = fmt.Errorf("appending to the spool: %w", err)
+		}
+		return
+	}
+	for _, w := range batch {
+		size := int64(len(w.buf))
+		if w.commit == nil {
+			w.record = &spoolRecord{segment: seg, offset: seg.size, length: size - spoolRecordHeaderSize, backfill: w.backfill}
+			seg.pending++
+			s.pending++
+		}
+		seg.size += size
+		s.size += size
+	}
+}
+
+// commit marks the record as inserted, removing the oldest segments if all
+// their records were committed. The commit record is written with the next
+// flush: a record whose commit record was not written before a restart is
+// replayed again.
+func (s *spool) commit(record *spoolRecord) {
+	var data [spoolCommitSize]byte
+	binary.BigEndian.PutUint64(data[:], uint64(record.segment.index))
+	binary.BigEndian.PutUint64(data[8:], uint64(record.offset))
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	record.segment.pending--
+	s.pending--
+	s.removeCommitted()
+	if !s.closed && !record.segment.removed {
+		s.queue = append(s.queue, &spoolWrite{buf: encodeSpoolRecord(spoolRecordCommit, data[:]), commit: record})
+	}
+	s.updateMetrics()
+}
+
+// done commits the record after its insert finished, unless the insert
+// failed with an error which is not marked as non-retryable. The record is
+// queued for replay then, and true is returned.
+func (s *spool) done(record *spoolRecord, err error) bool {
+	if err == nil || !isRetryableInsertError(err) {
+		s.commit(record)
+		return false
+	}
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.replayQueue = append(s.replayQueue, record)
+	s.updateMetrics()
+	return true
+}
+
+// removeCommitted removes the oldest segments as long as all their records
+// were committed, keeping the active one. Segments are removed in order, as
+// their commit records might commit records of the older ones. The caller
+// must hold the lock.
+func (s *spool) removeCommitted() {
+	if s.closed {
+		return
+	}
+	for len(s.segments) > 1 && s.segments[0].pending == 0 {
+		seg := s.segments[0]
+		if err := os.Remove(s.segmentPath(seg.index)); err != nil {
+			log.Warn("msg", "Error removing a spool segment", "err", err)
+			return
+		}
+		seg.removed = true
+		s.segments = s.segments[1:]
+		s.size -= seg.size
+	}
+}
+
+func (s *spool) read(record *spoolRecord) ([]byte, error) {
+	f, err := os.Open(s.segmentPath(record.segment.index))
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	data := make([]byte, record.length)
+	if _, err := f.ReadAt(data, record.offset+spoolRecordHeaderSize); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// takeBackfill returns the recovered backfill records, which are committed
+// once their samples were flushed.
+func (s *spool) takeBackfill() []*spoolRecord {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	records := s.backfill
+	s.backfill = nil
+	return records
+}
+
+// replay replays the queued records in order, stopping at the first one
+// failing with an error which is not marked as non-retryable, e.g. while the
+// database is still unavailable.
+func (s *spool) replay(insert func([]byte) error) {
+	s.lock.Lock()
+	queue := s.replayQueue
+	s.replayQueue = nil
+	s.lock.Unlock()
+
+	for i, record := range queue {
+		data, err := s.read(record)
+		if err != nil {
+			log.Error("msg", "Error reading a spooled write request, dropping it", "err", err)
+			s.commit(record)
+			continue
+		}
+		if err = insert(data); err != nil {
+			if isRetryableInsertError(err) {
+				SpoolReplayFailures.Inc()
+				log.Warn("msg", "Error replaying the spooled write requests, retrying later", "requests", len(queue)-i, "err", err)
+				s.lock.Lock()
+				s.replayQueue = append(queue[i:len(queue):len(queue)], s.replayQueue...)
+				s.updateMetrics()
+				s.lock.Unlock()
+				return
+			}
+			log.Error("msg", "Error replaying a spooled write request, dropping it", "err", err)
+		}
+		SpoolReplayedRequests.Inc()
+		s.commit(record)
+	}
+}
+
+// start replays the queued records right away, and then at every interval
+// until the spool is closed, writing the commit records queued meanwhile.
+func (s *spool) start(insert func([]byte) error, interval time.Duration) {
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			s.replay(insert)
+			s.flush()
+			select {
+			case <-ticker.C:
+			case <-s.stop:
+				return
+			}
+		}
+	}()
+}
+
+// close stops replaying, writes the queued commit records and closes the
+// active segment. The records which were not committed yet are replayed once
+// the spool is opened again.
+func (s *spool) close() {
+	close(s.stop)
+	s.wg.Wait()
+
+	s.flush()
+	s.writeLock.Lock()
+	defer s.writeLock.Unlock()
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.closed = true
+	if s.active != nil {
+		if err := s.active.Close(); err != nil {
+			log.Warn("msg", "Error closing a spool segment", "err", err)
+		}
+		s.active = nil
+	}
+}
+
+// updateMetrics updates the spool metrics. The caller must hold the lock.
+func (s *spool) updateMetrics() {
+	SpoolSizeBytes.Set(float64(s.size))
+	SpoolPendingRequests.Set(float64(s.pending))
+	SpoolReplayQueueRequests.Set(float64(len(s.replayQueue)))
+}
+
+// isRetryableInsertError returns true if inserting the samples might succeed
+// later, which is the case for every error not marked as non-retryable, like
+// for the write requests which are not spooled.
+func isRetryableInsertError(err error) bool {
+	_, nonRetryable := errors.NonRetryableReason(err)
+	return !nonRetryable
+}
@@ -0,0 +1,251 @@
+// This file and its contents are licensed under the Apache License 2.0.
+// Please see the included NOTICE for copyright information and
+// LICENSE for a copy of the license.
+
+package ingestor
+
+import (
+	goErrors "errors"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"os"
+	"reflect"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgconn"
+	"github.com/timescale/promscale/pkg/pgmodel/common/errors"
+)
+
+func newTestSpool(t *testing.T, dir string, maxBytes uint64) *spool {
+	s, err := openSpool(SpoolConfig{Dir: dir, MaxBytes: maxBytes, SegmentBytes: 32, ReplayInterval: time.Hour})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return s
+}
+
+func spoolSegmentFiles(t *testing.T, dir string) int {
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return len(files)
+}
+
+func TestSpoolCommit(t *testing.T) {
+	dir := t.TempDir()
+	s := newTestSpool(t, dir, 1024)
+	defer s.close()
+
+	// Every record fills a segment of its own.
+	var records []*spoolRecord
+	for i := 0; i < 3; i++ {
+		r, err := s.append([]byte(fmt.Sprintf("request %d with some data", i)))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		records = append(records, r)
+	}
+	if n := spoolSegmentFiles(t, dir); n != 3 {
+		t.Fatalf("unexpected number of segments: got %d wanted 3", n)
+	}
+
+	// The segments are removed in order.
+	s.commit(records[1])
+	if n := spoolSegmentFiles(t, dir); n != 3 {
+		t.Errorf("committed segment was removed before an older one: %d segments", n)
+	}
+	s.commit(records[0])
+	if n := spoolSegmentFiles(t, dir); n != 1 {
+		t.Errorf("committed segments were not removed: %d segments", n)
+	}
+	// The active segment is kept.
+	s.commit(records[2])
+	if n := spoolSegmentFiles(t, dir); n != 1 {
+		t.Errorf("unexpected number of segments: got %d wanted 1", n)
+	}
+	if s.pending != 0 || s.size != records[2].segment.size {
+		t.Errorf("unexpected spool state: pending %d size %d", s.pending, s.size)
+	}
+}
+
+func TestSpoolFull(t *testing.T) {
+	s := newTestSpool(t, t.TempDir(), 64)
+	defer s.close()
+
+	r, err := s.append(make([]byte, 40))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err = s.append(make([]byte, 40)); !goErrors.Is(err, errors.ErrSpoolFull) {
+		t.Fatalf("unexpected error: got %v wanted %v", err, errors.ErrSpoolFull)
+	}
+	// Committing frees the space once the segment is rotated.
+	s.commit(r)
+	if _, err = s.append(make([]byte, 40)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestSpoolRecover(t *testing.T) {
+	dir := t.TempDir()
+	s := newTestSpool(t, dir, 1024)
+	var expected [][]byte
+	for i := 0; i < 4; i++ {
+		data := []byte(fmt.Sprintf("req %d", i))
+		r, err := s.append(data)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		// Committed records are not replayed, even though their
+		// segment was not removed before the restart.
+		if i == 1 {
+			s.commit(r)
+			continue
+		}
+		expected = append(expected, data)
+	}
+	s.close()
+
+	// A crash left a partially written record behind.
+	last := s.segments[len(s.segments)-1]
+	f, err := os.OpenFile(s.segmentPath(last.index), os.O_WRONLY|os.O_APPEND, 0)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	_, _ = f.Write([]byte{0, 0, 0, 9, 1, 2})
+	f.Close()
+
+	s = newTestSpool(t, dir, 1024)
+	defer s.close()
+	if s.pending != len(expected) || len(s.replayQueue) != len(expected) {
+		t.Errorf("unexpected pending records: got %d wanted %d", s.pending, len(expected))
+	}
+
+	var replayed [][]byte
+	s.replay(func(data []byte) error {
+		replayed = append(replayed, data)
+		return nil
+	})
+	if !reflect.DeepEqual(replayed, expected) {
+		t.Errorf("unexpected replayed records: got %q wanted %q", replayed, expected)
+	}
+	if s.pending != 0 || len(s.replayQueue) != 0 {
+		t.Errorf("unexpected spool state: pending %d queued %d", s.pending, len(s.replayQueue))
+	}
+	if n := spoolSegmentFiles(t, dir); n != 1 {
+		t.Errorf("unexpected number of segments: got %d wanted 1", n)
+	}
+}
+
+func TestSpoolConcurrentAppend(t *testing.T) {
+	dir := t.TempDir()
+	s := newTestSpool(t, dir, 1<<20)
+	const appends = 50
+	var wg sync.WaitGroup
+	errs := make(chan error, appends)
+	for i := 0; i < appends; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			r, err := s.append([]byte{byte(i)})
+			if err == nil && i%2 == 0 {
+				s.commit(r)
+			}
+			errs <- err
+		}(i)
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	s.close()
+
+	s = newTestSpool(t, dir, 1<<20)
+	defer s.close()
+	var replayed []int
+	s.replay(func(data []byte) error {
+		replayed = append(replayed, int(data[0]))
+		return nil
+	})
+	sort.Ints(replayed)
+	var expected []int
+	for i := 1; i < appends; i += 2 {
+		expected = append(expected, i)
+	}
+	if !reflect.DeepEqual(replayed, expected) {
+		t.Errorf("unexpected replayed records: got %v wanted %v", replayed, expected)
+	}
+}
+
+func TestSpoolReplay(t *testing.T) {
+	s := newTestSpool(t, t.TempDir(), 1024)
+	defer s.close()
+
+	unavailable := &pgconn.PgError{Code: "08006"}
+	invalid := errors.NewNonRetryableError(errors.ReasonInvalidSamples, &pgconn.PgError{Code: "22003"})
+
+	var records []*spoolRecord
+	for i := 0; i < 3; i++ {
+		r, err := s.append([]byte{byte(i)})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		records = append(records, r)
+	}
+	if s.done(records[0], invalid) {
+		t.Errorf("record failing with a permanent error was queued for replay")
+	}
+	for _, r := range records[1:] {
+		if !s.done(r, fmt.Errorf("inserting: %w", unavailable)) {
+			t.Errorf("record failing while the database is unavailable was not queued for replay")
+		}
+	}
+
+	// Replaying stops at the first record failing while the database is
+	// unavailable.
+	var replayed []byte
+	s.replay(func(data []byte) error {
+		replayed = append(replayed, data...)
+		return unavailable
+	})
+	if !reflect.DeepEqual(replayed, []byte{1}) || len(s.replayQueue) != 2 {
+		t.Errorf("unexpected replay: replayed %v queued %d", replayed, len(s.replayQueue))
+	}
+
+	replayed = nil
+	s.replay(func(data []byte) error {
+		replayed = append(replayed, data...)
+		return nil
+	})
+	if !reflect.DeepEqual(replayed, []byte{1, 2}) || len(s.replayQueue) != 0 || s.pending != 0 {
+		t.Errorf("unexpected replay: replayed %v queued %d pending %d", replayed, len(s.replayQueue), s.pending)
+	}
+}
+
+func TestIsRetryableInsertError(t *testing.T) {
+	testCases := []struct {
+		err       error
+		retryable bool
+	}{
+		{err: &pgconn.PgError{Code: "08006"}, retryable: true},
+		{err: &pgconn.PgError{Code: "53300"}, retryable: true},
+		{err: &net.OpError{Op: "dial", Net: "tcp", Err: fmt.Errorf("connection refused")}, retryable: true},
+		{err: &pgconn.PgError{Code: "22003"}, retryable: true},
+		{err: &pgconn.PgError{Code: "42P01"}, retryable: true},
+		{err: errors.NewNonRetryableError(errors.ReasonInvalidSamples, &pgconn.PgError{Code: "22003"}), retryable: false},
+		{err: fmt.Errorf("wrapped: %w", errors.NewNonRetryableError(errors.ReasonNoMetricName, errors.ErrNoMetricName)), retryable: false},
+	}
+	for _, c := range testCases {
+		if got := isRetryableInsertError(c.err); got != c.retryable {
+			t.Errorf("unexpected result for %v: got %v wanted %v", c.err, got, c.retryable)
+		}
+	}
+}
@@ -36,6 +36,9 @@ type LabelsReader interface {
 	PrompbLabelsForIds(ids []int64) (lls []prompb.Label, err error)
 	// LabelsForIds returns label names and values for the supplied IDs.
 	LabelsForIds(ids []int64) (lls labels.Labels, err error)
+	// LabelsForIdMap returns the label names and values for the supplied
+	// IDs, keyed by their IDs.
+	LabelsForIdMap(ids []int64) (map[int64]labels.Label, error)
 }
 
 func NewLabelsReader(conn pgxconn.PgxConn, labels cache.LabelsCache) LabelsReader {
@@ -113,8 +116,39 @@ func (lr *labelsReader) PrompbLabelsForIds(ids []int64) (lls []prompb.Label, err
 
 // LabelsForIds returns label names and values for the supplied IDs.
 func (lr *labelsReader) LabelsForIds(ids []int64) (lls labels.Labels, err error) {
-	keys := make([]interface{}, len(ids))
-	values := make([]interface{}, len(ids))
+	_, values, err := lr.labelsForIds(ids)
+	if err != nil {
+		return nil, err
+	}
+
+	lls = make([]labels.Label, 0, len(values))
+	for i := range values {
+		lls = append(lls, values[i].(labels.Label))
+	}
+
+	return
+}
+
+// LabelsForIdMap returns the label names and values for the supplied IDs,
+// keyed by their IDs.
+func (lr *labelsReader) LabelsForIdMap(ids []int64) (map[int64]labels.Label, error) {
+	keys, values, err := lr.labelsForIds(ids)
+	if err != nil {
+		return nil, err
+	}
+
+	lls := make(map[int64]labels.Label, len(values))
+	for i := range values {
+		lls[keys[i].(int64)] = values[i].(labels.Label)
+	}
+	return lls, nil
+}
+
+// labelsForIds returns the labels found for the supplied IDs, and their IDs
+// in the same order, fetching the ones missing from the cache.
+func (lr *labelsReader) labelsForIds(ids []int64) (keys, values []interface{}, err error) {
+	keys = make([]interface{}, len(ids))
+	values = make([]interface{}, len(ids))
 	for i := range ids {
 		keys[i] = ids[i]
 	}
@@ -122,19 +156,15 @@ func (lr *labelsReader) LabelsForIds(ids []int64) (lls labels.Labels, err error)
 
 	if numHits < len(ids) {
 		var numFetches int
-		numFetches, err = lr.fetchMissingLabels(keys[numHits:], ids[numHits:], values[numHits:])
+		missedIds := make([]int64, len(ids)-numHits)
+		numFetches, err = lr.fetchMissingLabels(keys[numHits:], missedIds, values[numHits:])
 		if err != nil {
-			return
+			return nil, nil, err
 		}
+		keys = keys[:numHits+numFetches]
 		values = values[:numHits+numFetches]
 	}
-
-	lls = make([]labels.Label, 0, len(values))
-	for i := range values {
-		lls = append(lls, values[i].(labels.Label))
-	}
-
-	return
+	return keys, values, nil
 }
 
 // fetchMissingLabels imports the missing label IDs from the database into the
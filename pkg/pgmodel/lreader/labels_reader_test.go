@@ -10,6 +10,8 @@ import (
 	"sort"
 	"testing"
 
+	"github.com/prometheus/prometheus/pkg/labels"
+	"github.com/timescale/promscale/pkg/clockcache"
 	"github.com/timescale/promscale/pkg/pgmodel/model"
 )
 
@@ -186,3 +188,33 @@ func TestLabelsReaderLabelsValues(t *testing.T) {
 		})
 	}
 }
+
+func TestLabelsReaderLabelsForIdMap(t *testing.T) {
+	labelsCache := clockcache.WithMax(100)
+	labelsCache.Insert(int64(1), labels.Label{Name: "__name__", Value: "foo"}, 1)
+	mock := model.NewSqlRecorder([]model.SqlQuery{
+		{
+			Sql:     "SELECT (labels_info($1::int[])).*",
+			Args:    []interface{}{[]int64{3, 2}},
+			Results: model.RowResults{{[]int64{3, 2}, []string{"job", "instance"}, []string{"a", "1"}}},
+		},
+	}, t)
+	reader := labelsReader{conn: mock, labels: labelsCache}
+
+	ids := []int64{2, 1, 3}
+	res, err := reader.LabelsForIdMap(ids)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := map[int64]labels.Label{
+		1: {Name: "__name__", Value: "foo"},
+		2: {Name: "instance", Value: "1"},
+		3: {Name: "job", Value: "a"},
+	}
+	if !reflect.DeepEqual(res, expected) {
+		t.Errorf("expected: %v, got: %v", expected, res)
+	}
+	if !reflect.DeepEqual(ids, []int64{2, 1, 3}) {
+		t.Errorf("the supplied IDs were modified: %v", ids)
+	}
+}
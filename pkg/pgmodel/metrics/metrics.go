@@ -31,6 +31,14 @@ var (
 			Help:      "Total number of affected metrics due to duplicate samples.",
 		},
 	)
+	DuplicateSampleOutcomes = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: util.PromNamespace,
+			Name:      "duplicate_sample_outcomes_total",
+			Help:      "Total number of duplicate samples by the outcome of the duplicate policy: ignored, overwritten or rejected.",
+		},
+		[]string{"outcome"},
+	)
 	DecompressCalls = prometheus.NewCounter(
 		prometheus.CounterOpts{
 			Namespace: util.PromNamespace,
@@ -67,6 +75,7 @@ func init() {
 		DecompressCalls,
 		DecompressEarliest,
 		DuplicateMetrics,
+		DuplicateSampleOutcomes,
 		HAClusterLeaderDetails,
 		NumOfHAClusterLeaderChanges,
 	)
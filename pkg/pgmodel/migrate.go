@@ -44,7 +44,10 @@ var (
 		"idempotent": {
 			"base.sql",
 			"matcher-functions.sql",
+			"pushdown-functions.sql",
 			"ha.sql",
+			"downsampling.sql",
+			"registered-views.sql",
 		},
 	}
 	migrateMutex = &sync.Mutex{}
@@ -375,6 +378,7 @@ func replaceSchemaNames(r io.ReadCloser) (string, error) {
 	s = strings.ReplaceAll(s, "SCHEMA_SERIES", schema.SeriesView)
 	s = strings.ReplaceAll(s, "SCHEMA_METRIC", schema.MetricView)
 	s = strings.ReplaceAll(s, "SCHEMA_DATA_SERIES", schema.DataSeries)
+	s = strings.ReplaceAll(s, "SCHEMA_DATA_ROLLUP", schema.DataRollup)
 	s = strings.ReplaceAll(s, "SCHEMA_DATA", schema.Data)
 	s = strings.ReplaceAll(s, "SCHEMA_INFO", schema.Info)
 	return s, err
@@ -6,6 +6,7 @@ package model
 
 import (
 	"math"
+	"sort"
 	"time"
 
 	"github.com/prometheus/common/model"
@@ -39,6 +40,55 @@ func (t *promSample) getSample(index int) *prompb.Sample {
 	return &t.samples[index]
 }
 
+// TimeRange is a time range, including its start and end.
+type TimeRange struct {
+	Start, End time.Time
+}
+
+// SamplesTimeRanges returns the sorted time ranges spanning the samples, such
+// that samples less than gap apart are always in the same range.
+func SamplesTimeRanges(data []Samples, gap time.Duration) []TimeRange {
+	gapMs := gap.Milliseconds()
+	buckets := make(map[int64]*[2]int64)
+	for _, s := range data {
+		for i := 0; i < s.CountSamples(); i++ {
+			ts := s.getSample(i).Timestamp
+			bucket := ts / gapMs
+			if ts < 0 && ts%gapMs != 0 {
+				bucket--
+			}
+			b, ok := buckets[bucket]
+			if !ok {
+				buckets[bucket] = &[2]int64{ts, ts}
+				continue
+			}
+			if ts < b[0] {
+				b[0] = ts
+			}
+			if ts > b[1] {
+				b[1] = ts
+			}
+		}
+	}
+
+	keys := make([]int64, 0, len(buckets))
+	for k := range buckets {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+
+	var ranges []TimeRange
+	for i, k := range keys {
+		b := buckets[k]
+		if i > 0 && keys[i-1] == k-1 {
+			ranges[len(ranges)-1].End = model.Time(b[1]).Time()
+			continue
+		}
+		ranges = append(ranges, TimeRange{Start: model.Time(b[0]).Time(), End: model.Time(b[1]).Time()})
+	}
+	return ranges
+}
+
 // SamplesBatch is an iterator over a collection of sampleInfos that returns
 // data in the format expected for the data table row.
 type SamplesBatch struct {
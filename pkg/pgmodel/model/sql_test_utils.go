@@ -335,8 +335,8 @@ func (m *MockRows) Scan(dest ...interface{}) error {
 				*d = s
 			}
 		case float64:
-			if _, ok := dest[i].(float64); !ok {
-				return fmt.Errorf("wrong value type float64")
+			if _, ok := dest[i].(*float64); !ok {
+				return fmt.Errorf("wrong value type float64 for scan of %T", dest[i])
 			}
 			dv := reflect.ValueOf(dest[i])
 			dvp := reflect.Indirect(dv)
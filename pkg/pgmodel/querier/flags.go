@@ -0,0 +1,75 @@
+// This file and its contents are licensed under the Apache License 2.0.
+// Please see the included NOTICE for copyright information and
+// LICENSE for a copy of the license.
+
+package querier
+
+import (
+	"flag"
+	"fmt"
+	"time"
+)
+
+// Config holds the settings of the querier.
+type Config struct {
+	Limits Limits
+	// MultiMetricParallelism is the maximum number of metric tables queried
+	// at the same time by a selector matching several metrics.
+	MultiMetricParallelism int
+	// MaxResolvedRegexValues is the maximum number of label values a regex
+	// matcher can select to have them resolved to label IDs before querying.
+	MaxResolvedRegexValues int
+	// RollupResolutions are the resolutions of the downsampled rollups the
+	// queries can read from, in ascending order. They are set from the
+	// downsampling configuration.
+	RollupResolutions []time.Duration
+}
+
+// Limits are the per-query resource limits enforced by the querier.
+// A zero value for any of the limits disables it. The cross metric limits
+// only apply to the queries without a selective matcher, which touch all
+// metrics.
+type Limits struct {
+	MaxSeries             int
+	MaxSamples            int
+	MaxRange              time.Duration
+	MaxCrossMetricMetrics int
+	MaxCrossMetricSeries  int
+}
+
+// ParseFlags parses the configuration flags specific to the querier.
+func ParseFlags(fs *flag.FlagSet, cfg *Config) *Config {
+	fs.IntVar(&cfg.Limits.MaxSeries, "query-max-series", 0, "Maximum number of series a single query can load from the database, across all its selectors. A value of 0 disables the limit.")
+	fs.IntVar(&cfg.Limits.MaxSamples, "query-max-samples", 0, "Maximum number of samples a single query can load from the database, across all its selectors. A value of 0 disables the limit.")
+	fs.DurationVar(&cfg.Limits.MaxRange, "query-max-range", 0, "Maximum time range a single query can select data for, including the lookback and range selectors. A value of 0 disables the limit.")
+	fs.IntVar(&cfg.Limits.MaxCrossMetricMetrics, "query-max-cross-metric-metrics", 100, "Maximum number of metrics a query without a selective matcher, like {__name__=~\".+\"} or {job!=\"\"}, can touch. Such queries fail once they match more metrics. A value of 0 disables the limit.")
+	fs.IntVar(&cfg.Limits.MaxCrossMetricSeries, "query-max-cross-metric-series", 10000, "Maximum number of series a query without a selective matcher can touch. A value of 0 disables the limit.")
+	fs.IntVar(&cfg.MultiMetricParallelism, "query-multi-metric-parallelism", 4, "Maximum number of metric tables queried concurrently by a single selector matching several metrics.")
+	fs.IntVar(&cfg.MaxResolvedRegexValues, "query-max-resolved-regex-values", 16, "Maximum number of label values a regex matcher can select, as a set of literal alternatives, to have their label IDs cached and resolved before querying. A value of 0 keeps all regex matchers in SQL.")
+	return cfg
+}
+
+func Validate(cfg *Config) error {
+	if cfg.Limits.MaxSeries < 0 {
+		return fmt.Errorf("query-max-series must be non-negative")
+	}
+	if cfg.Limits.MaxSamples < 0 {
+		return fmt.Errorf("query-max-samples must be non-negative")
+	}
+	if cfg.Limits.MaxRange < 0 {
+		return fmt.Errorf("query-max-range must be non-negative")
+	}
+	if cfg.Limits.MaxCrossMetricMetrics < 0 {
+		return fmt.Errorf("query-max-cross-metric-metrics must be non-negative")
+	}
+	if cfg.Limits.MaxCrossMetricSeries < 0 {
+		return fmt.Errorf("query-max-cross-metric-series must be non-negative")
+	}
+	if cfg.MultiMetricParallelism < 1 {
+		return fmt.Errorf("query-multi-metric-parallelism must be positive")
+	}
+	if cfg.MaxResolvedRegexValues < 0 {
+		return fmt.Errorf("query-max-resolved-regex-values must be non-negative")
+	}
+	return nil
+}
@@ -0,0 +1,221 @@
+// This file and its contents are licensed under the Apache License 2.0.
+// Please see the included NOTICE for copyright information and
+// LICENSE for a copy of the license.
+
+package querier
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/prometheus/prometheus/pkg/labels"
+	"github.com/timescale/promscale/pkg/log"
+	"github.com/timescale/promscale/pkg/pgmodel/cache"
+	"github.com/timescale/promscale/pkg/pgmodel/common/schema"
+	pgmodel "github.com/timescale/promscale/pkg/pgmodel/model"
+	"github.com/timescale/promscale/pkg/pgxconn"
+)
+
+const (
+	getLabelIDsSQL     = "SELECT l.key, l.value, l.id FROM " + schema.Catalog + ".label l WHERE (l.key, l.value) IN (SELECT * FROM unnest($1::text[], $2::text[]))"
+	getCurrentEpochSQL = "SELECT current_epoch FROM " + schema.Catalog + ".ids_epoch LIMIT 1"
+
+	// idsEpochCheckInterval is how often the IDs epoch is read again. The
+	// epoch moves at most once an hour, and the labels deleted when it moves
+	// were unused for several epochs, so a label deleted and created again
+	// within the interval is the only one whose series might be missed.
+	idsEpochCheckInterval = time.Minute
+)
+
+// labelIDKey is the labels cache key of the ID of a label, which does not
+// collide with the label IDs cached by the labels reader. The key includes the
+// IDs epoch it was looked up in, so that the IDs cached in previous epochs are
+// no longer used and eventually evicted. Unused labels are only deleted once
+// the epoch moved past the one they were marked in, so the IDs of the current
+// epoch stay valid until the epoch is read again.
+type labelIDKey struct {
+	key   string
+	value string
+	epoch int64
+}
+
+// labelIDResolver resolves the label matchers selecting a few label values to
+// the IDs of those labels, so that they are passed to the queries instead of
+// being looked up by every query.
+type labelIDResolver struct {
+	conn      pgxconn.PgxConn
+	cache     cache.LabelsCache
+	maxValues int
+	now       func() time.Time
+
+	lock      sync.Mutex
+	epoch     int64
+	checkedAt time.Time
+}
+
+func newLabelIDResolver(conn pgxconn.PgxConn, labelsCache cache.LabelsCache, maxRegexValues int) *labelIDResolver {
+	return &labelIDResolver{
+		conn:      conn,
+		cache:     labelsCache,
+		maxValues: maxRegexValues,
+		now:       time.Now,
+	}
+}
+
+// resolve returns the IDs of the labels selected by the matchers which can be
+// resolved: the equality matchers and the regex matchers only matching a few
+// literal values, which do not match the empty value. The labels which do not
+// exist are left out, so an empty slice means that no series match. The IDs
+// epoch is read again every idsEpochCheckInterval, so cached IDs are not used
+// for long after the labels they refer to might have been deleted.
+func (r *labelIDResolver) resolve(ctx context.Context, ms []*labels.Matcher) (map[*labels.Matcher][]int64, error) {
+	if r == nil {
+		return nil, nil
+	}
+
+	var (
+		epoch       int64
+		err         error
+		matcherKeys = make(map[*labels.Matcher][]labelIDKey)
+	)
+	for _, m := range ms {
+		if m.Matches("") {
+			continue
+		}
+		var values []string
+		switch m.Type {
+		case labels.MatchEqual:
+			// The metric name selects the metric table instead.
+			if m.Name == pgmodel.MetricNameLabelName {
+				continue
+			}
+			values = []string{m.Value}
+		case labels.MatchRegexp:
+			var ok bool
+			values, ok = regexValues(m.Value, r.maxValues)
+			if !ok {
+				continue
+			}
+		default:
+			continue
+		}
+		if len(matcherKeys) == 0 {
+			if epoch, err = r.currentEpoch(ctx); err != nil {
+				return nil, err
+			}
+		}
+		keys := make([]labelIDKey, len(values))
+		for i, v := range values {
+			keys[i] = labelIDKey{key: m.Name, value: v, epoch: epoch}
+		}
+		matcherKeys[m] = keys
+	}
+	if len(matcherKeys) == 0 {
+		return nil, nil
+	}
+
+	ids := make(map[labelIDKey]int64)
+	var missing []labelIDKey
+	for _, m := range ms {
+		for _, k := range matcherKeys[m] {
+			if _, ok := ids[k]; ok {
+				continue
+			}
+			if id, ok := r.get(k); ok {
+				ids[k] = id
+				continue
+			}
+			// Labels which do not exist are not cached, they might be
+			// created at any time.
+			ids[k] = 0
+			missing = append(missing, k)
+		}
+	}
+	if len(missing) > 0 {
+		if err := r.fetch(ctx, missing, ids); err != nil {
+			return nil, err
+		}
+	}
+
+	result := make(map[*labels.Matcher][]int64, len(matcherKeys))
+	for m, keys := range matcherKeys {
+		matched := make([]int64, 0, len(keys))
+		for _, k := range keys {
+			if id := ids[k]; id != 0 {
+				matched = append(matched, id)
+			}
+		}
+		result[m] = matched
+	}
+	return result, nil
+}
+
+func (r *labelIDResolver) get(k labelIDKey) (int64, bool) {
+	keys := []interface{}{k}
+	values := make([]interface{}, 1)
+	if r.cache.GetValues(keys, values) == 0 {
+		return 0, false
+	}
+	return values[0].(int64), true
+}
+
+// fetch looks up the IDs of the missing labels, caching the ones found.
+func (r *labelIDResolver) fetch(ctx context.Context, missing []labelIDKey, ids map[labelIDKey]int64) error {
+	names := make([]string, len(missing))
+	values := make([]string, len(missing))
+	for i, k := range missing {
+		names[i] = k.key
+		values[i] = k.value
+	}
+	rows, err := r.conn.Query(ctx, getLabelIDsSQL, names, values)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	var (
+		keys    []interface{}
+		entries []interface{}
+		sizes   []uint64
+	)
+	for rows.Next() {
+		k := labelIDKey{epoch: missing[0].epoch}
+		var id int64
+		if err := rows.Scan(&k.key, &k.value, &id); err != nil {
+			return err
+		}
+		ids[k] = id
+		keys = append(keys, k)
+		entries = append(entries, id)
+		sizes = append(sizes, uint64(len(k.key)+len(k.value)+56))
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	if len(keys) > 0 && r.cache.InsertBatch(keys, entries, sizes) < len(keys) {
+		log.Warn("msg", "labels cache starving, may need to increase size")
+	}
+	return nil
+}
+
+// currentEpoch returns the current IDs epoch, as read at most
+// idsEpochCheckInterval ago.
+func (r *labelIDResolver) currentEpoch(ctx context.Context) (int64, error) {
+	now := r.now()
+	r.lock.Lock()
+	epoch, checkedAt := r.epoch, r.checkedAt
+	r.lock.Unlock()
+	if !checkedAt.IsZero() && now.Sub(checkedAt) < idsEpochCheckInterval {
+		return epoch, nil
+	}
+
+	if err := r.conn.QueryRow(ctx, getCurrentEpochSQL).Scan(&epoch); err != nil {
+		return 0, err
+	}
+	r.lock.Lock()
+	r.epoch, r.checkedAt = epoch, now
+	r.lock.Unlock()
+	return epoch, nil
+}
@@ -0,0 +1,122 @@
+// This file and its contents are licensed under the Apache License 2.0.
+// Please see the included NOTICE for copyright information and
+// LICENSE for a copy of the license.
+
+package querier
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/prometheus/prometheus/pkg/labels"
+	"github.com/timescale/promscale/pkg/clockcache"
+	"github.com/timescale/promscale/pkg/pgmodel/model"
+)
+
+func TestLabelIDResolver(t *testing.T) {
+	var (
+		job      = labels.MustNewMatcher(labels.MatchEqual, "job", "api")
+		instance = labels.MustNewMatcher(labels.MatchRegexp, "instance", "a|b|c")
+		name     = labels.MustNewMatcher(labels.MatchEqual, model.MetricNameLabelName, "foo")
+		empty    = labels.MustNewMatcher(labels.MatchEqual, "env", "")
+		notEqual = labels.MustNewMatcher(labels.MatchNotEqual, "env", "dev")
+		prefix   = labels.MustNewMatcher(labels.MatchRegexp, "env", "prod.*")
+		ms       = []*labels.Matcher{name, job, instance, empty, notEqual, prefix}
+	)
+	epochQuery := func(epoch int64) model.SqlQuery {
+		return model.SqlQuery{
+			Sql:     getCurrentEpochSQL,
+			Results: model.RowResults{{epoch}},
+		}
+	}
+	labelIDsQuery := model.SqlQuery{
+		Sql: getLabelIDsSQL,
+		Args: []interface{}{
+			[]string{"job", "instance", "instance", "instance"},
+			[]string{"api", "a", "b", "c"},
+		},
+		Results: model.RowResults{{"job", "api", int64(1)}, {"instance", "a", int64(2)}, {"instance", "c", int64(3)}},
+	}
+	expected := map[*labels.Matcher][]int64{
+		job:      {1},
+		instance: {2, 3},
+	}
+
+	conn := model.NewSqlRecorder([]model.SqlQuery{
+		epochQuery(1),
+		labelIDsQuery,
+		// The epoch is only read again after the check interval, and
+		// the label which does not exist is looked up again.
+		{
+			Sql:  getLabelIDsSQL,
+			Args: []interface{}{[]string{"instance"}, []string{"b"}},
+		},
+		// The IDs are looked up again as soon as the epoch changes.
+		epochQuery(2),
+		labelIDsQuery,
+	}, t)
+	now := time.Unix(0, 0)
+	r := newLabelIDResolver(conn, clockcache.WithMax(100), 4)
+	r.now = func() time.Time { return now }
+
+	for i := 0; i < 3; i++ {
+		if i == 2 {
+			now = now.Add(idsEpochCheckInterval)
+		}
+		ids, err := r.resolve(context.Background(), ms)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !reflect.DeepEqual(ids, expected) {
+			t.Errorf("unexpected label IDs: got %v wanted %v", ids, expected)
+		}
+	}
+
+	var nilResolver *labelIDResolver
+	if ids, err := nilResolver.resolve(context.Background(), ms); ids != nil || err != nil {
+		t.Errorf("unexpected resolution without a resolver: %v %v", ids, err)
+	}
+}
+
+func TestBuildSubQueriesWithLabelIDs(t *testing.T) {
+	var (
+		job      = labels.MustNewMatcher(labels.MatchEqual, "job", "api")
+		instance = labels.MustNewMatcher(labels.MatchRegexp, "instance", "a|b")
+		env      = labels.MustNewMatcher(labels.MatchRegexp, "env", "(prod|dev)-.*")
+	)
+
+	cb, err := buildSubQueries([]*labels.Matcher{job, instance, env}, map[*labels.Matcher][]int64{job: {1}, instance: {2, 3}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	clauses, values, err := cb.Build(false)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expectedClauses := []string{
+		"labels && $1::int[]",
+		"labels && $2::int[]",
+		"labels && (SELECT COALESCE(array_agg(l.id), array[]::int[]) FROM _prom_catalog.label l WHERE l.key = $3 and l.value ~ $4)",
+	}
+	if !reflect.DeepEqual(clauses, expectedClauses) {
+		t.Errorf("unexpected clauses: got %v wanted %v", clauses, expectedClauses)
+	}
+	expectedValues := []interface{}{[]int64{1}, []int64{2, 3}, "env", "^(prod|dev)-.*$"}
+	if !reflect.DeepEqual(values, expectedValues) {
+		t.Errorf("unexpected values: got %v wanted %v", values, expectedValues)
+	}
+
+	cb, err = buildSubQueries([]*labels.Matcher{job, env}, map[*labels.Matcher][]int64{job: {}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	clauses, _, err = cb.Build(false)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(clauses, []string{"FALSE"}) {
+		t.Errorf("unexpected clauses for a missing label: %v", clauses)
+	}
+}
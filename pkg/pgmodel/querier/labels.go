@@ -0,0 +1,202 @@
+// This file and its contents are licensed under the Apache License 2.0.
+// Please see the included NOTICE for copyright information and
+// LICENSE for a copy of the license.
+
+package querier
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/prometheus/prometheus/pkg/labels"
+	"github.com/timescale/promscale/pkg/pgmodel/common/errors"
+	"github.com/timescale/promscale/pkg/pgmodel/common/schema"
+)
+
+const (
+	// Series marked for deletion have no data left, so their labels are
+	// excluded whenever the label queries are scoped.
+	liveLabelNamesSQL = `SELECT DISTINCT l.key
+	FROM _prom_catalog.label l
+	WHERE EXISTS (
+		SELECT 1
+		FROM _prom_catalog.series s
+		WHERE s.labels && ARRAY[l.id]
+		AND s.delete_epoch IS NULL
+	)`
+
+	liveLabelValuesSQL = `SELECT l.value
+	FROM _prom_catalog.label l
+	WHERE l.key = $1
+	AND EXISTS (
+		SELECT 1
+		FROM _prom_catalog.series s
+		WHERE s.labels && ARRAY[l.id]
+		AND s.delete_epoch IS NULL
+	)`
+
+	seriesLabelIDsSQLFormat = `SELECT COALESCE(array_agg(DISTINCT label_id), array[]::int[])
+	FROM _prom_catalog.series s, unnest(s.labels) AS label_id
+	WHERE %s
+	AND s.delete_epoch IS NULL`
+
+	seriesIDsPerTableSQLFormat = `SELECT m.table_name, array_agg(s.id)
+	FROM _prom_catalog.series s
+	INNER JOIN _prom_catalog.metric m
+	ON (m.id = s.metric_id)
+	WHERE %s
+	AND s.delete_epoch IS NULL
+	GROUP BY m.table_name`
+
+	labelIDsWithDataSQLFormat = `SELECT COALESCE(array_agg(DISTINCT label_id), array[]::int[])
+	FROM %[1]s s, unnest(s.labels) AS label_id
+	WHERE s.id = ANY($1)
+	AND EXISTS (
+		SELECT 1
+		FROM %[2]s m
+		WHERE m.series_id = s.id
+		AND time >= '%[3]s'
+		AND time <= '%[4]s'
+	)`
+
+	labelNamesForIDsSQL  = "SELECT DISTINCT key FROM " + schema.Catalog + ".label WHERE id = ANY($1)"
+	labelValuesForIDsSQL = "SELECT value FROM " + schema.Catalog + ".label WHERE key = $1 AND id = ANY($2)"
+)
+
+// LabelNames implements the Querier interface. Without matchers, the label
+// names of all the series which are not marked for deletion are returned.
+func (q *pgxQuerier) LabelNames(mint, maxt int64, ms ...*labels.Matcher) ([]string, error) {
+	ids, scoped, err := q.matchingLabelIDs(mint, maxt, ms)
+	if err != nil {
+		return nil, err
+	}
+	if !scoped {
+		return q.queryStrings(liveLabelNamesSQL)
+	}
+	return q.queryStrings(labelNamesForIDsSQL, ids)
+}
+
+// LabelValues implements the Querier interface. Without matchers, the label
+// values of all the series which are not marked for deletion are returned.
+func (q *pgxQuerier) LabelValues(mint, maxt int64, name string, ms ...*labels.Matcher) ([]string, error) {
+	ids, scoped, err := q.matchingLabelIDs(mint, maxt, ms)
+	if err != nil {
+		return nil, err
+	}
+	if !scoped {
+		return q.queryStrings(liveLabelValuesSQL, name)
+	}
+	return q.queryStrings(labelValuesForIDsSQL, name, ids)
+}
+
+// matchingLabelIDs returns the IDs of the labels used by the series matching
+// the matchers. If the time range is bounded, only series with data in the
+// range are considered. scoped is false if there are no matchers: checking
+// every series for data in the time range would scan all the metric tables,
+// so the time range is ignored then.
+func (q *pgxQuerier) matchingLabelIDs(mint, maxt int64, ms []*labels.Matcher) (ids []int64, scoped bool, err error) {
+	if err = q.rejectViews(context.Background(), ms); err != nil {
+		return nil, false, err
+	}
+	clauses, values, err := q.labelIDsClauses(ms)
+	if err == errors.ErrNoClausesGen {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+	cases := strings.Join(clauses, " AND ")
+
+	if mint <= minTime && maxt >= maxTime {
+		err = q.conn.QueryRow(context.Background(), fmt.Sprintf(seriesLabelIDsSQLFormat, cases), values...).Scan(&ids)
+		return ids, true, err
+	}
+
+	rows, err := q.conn.Query(context.Background(), fmt.Sprintf(seriesIDsPerTableSQLFormat, cases), values...)
+	if err != nil {
+		return nil, false, err
+	}
+	tables, series, err := GetSeriesPerMetric(rows)
+	// Release the connection before querying the metric tables.
+	rows.Close()
+	if err != nil || len(tables) == 0 {
+		return nil, true, err
+	}
+
+	batch := q.conn.NewBatch()
+	for i, table := range tables {
+		seriesIDs := make([]int64, len(series[i]))
+		for j := range series[i] {
+			seriesIDs[j] = int64(series[i][j])
+		}
+		batch.Queue(
+			fmt.Sprintf(
+				labelIDsWithDataSQLFormat,
+				pgx.Identifier{schema.DataSeries, table}.Sanitize(),
+				pgx.Identifier{schema.Data, table}.Sanitize(),
+				toRFC3339Nano(mint),
+				toRFC3339Nano(maxt),
+			),
+			seriesIDs,
+		)
+	}
+	results, err := q.conn.SendBatch(context.Background(), batch)
+	if err != nil {
+		return nil, false, err
+	}
+	defer results.Close()
+
+	for range tables {
+		var tableIDs []int64
+		if err = results.QueryRow().Scan(&tableIDs); err != nil {
+			return nil, false, err
+		}
+		ids = append(ids, tableIDs...)
+	}
+	return ids, true, nil
+}
+
+// labelIDsClauses returns the clauses selecting the series matching the
+// matchers, or errors.ErrNoClausesGen if they select all series.
+func (q *pgxQuerier) labelIDsClauses(ms []*labels.Matcher) ([]string, []interface{}, error) {
+	if len(ms) == 0 {
+		return nil, nil, errors.ErrNoClausesGen
+	}
+	builder, err := q.buildSubQueries(context.Background(), ms)
+	if err != nil {
+		return nil, nil, err
+	}
+	return builder.Build(true)
+}
+
+// queryStrings returns the sorted, distinct strings returned by the query.
+func (q *pgxQuerier) queryStrings(sql string, args ...interface{}) ([]string, error) {
+	rows, err := q.conn.Query(context.Background(), sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	seen := make(map[string]struct{})
+	result := make([]string, 0)
+	for rows.Next() {
+		var s string
+		if err := rows.Scan(&s); err != nil {
+			return nil, err
+		}
+		if _, ok := seen[s]; ok {
+			continue
+		}
+		seen[s] = struct{}{}
+		result = append(result, s)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	sort.Strings(result)
+	return result, nil
+}
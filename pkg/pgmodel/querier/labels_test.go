@@ -0,0 +1,169 @@
+// This file and its contents are licensed under the Apache License 2.0.
+// Please see the included NOTICE for copyright information and
+// LICENSE for a copy of the license.
+
+package querier
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/prometheus/prometheus/pkg/labels"
+	"github.com/timescale/promscale/pkg/pgmodel/model"
+)
+
+func TestPGXQuerierLabelNames(t *testing.T) {
+	fooMatcher := labels.MustNewMatcher(labels.MatchEqual, model.MetricNameLabelName, "foo")
+	fooClause := "labels && (SELECT COALESCE(array_agg(l.id), array[]::int[]) FROM _prom_catalog.label l WHERE l.key = $1 and l.value = $2)"
+
+	testCases := []struct {
+		name       string
+		mint       int64
+		maxt       int64
+		matchers   []*labels.Matcher
+		result     []string
+		sqlQueries []model.SqlQuery
+	}{
+		{
+			name: "no matchers",
+			mint: minTime,
+			maxt: maxTime,
+			sqlQueries: []model.SqlQuery{
+				{
+					Sql: "SELECT DISTINCT l.key\n\t" +
+						"FROM _prom_catalog.label l\n\t" +
+						"WHERE EXISTS (\n\t\t" +
+						"SELECT 1\n\t\t" +
+						"FROM _prom_catalog.series s\n\t\t" +
+						"WHERE s.labels && ARRAY[l.id]\n\t\t" +
+						"AND s.delete_epoch IS NULL\n\t" +
+						")",
+					Results: model.RowResults{{"job"}, {"__name__"}},
+				},
+			},
+			result: []string{"__name__", "job"},
+		},
+		{
+			name: "no matchers with time range",
+			mint: 1000,
+			maxt: 2000,
+			sqlQueries: []model.SqlQuery{
+				{
+					Sql: "SELECT DISTINCT l.key\n\t" +
+						"FROM _prom_catalog.label l\n\t" +
+						"WHERE EXISTS (\n\t\t" +
+						"SELECT 1\n\t\t" +
+						"FROM _prom_catalog.series s\n\t\t" +
+						"WHERE s.labels && ARRAY[l.id]\n\t\t" +
+						"AND s.delete_epoch IS NULL\n\t" +
+						")",
+					Results: model.RowResults{{"job"}, {"__name__"}},
+				},
+			},
+			result: []string{"__name__", "job"},
+		},
+		{
+			name:     "matchers without time range",
+			mint:     minTime,
+			maxt:     maxTime,
+			matchers: []*labels.Matcher{fooMatcher},
+			sqlQueries: []model.SqlQuery{
+				{
+					Sql: "SELECT COALESCE(array_agg(DISTINCT label_id), array[]::int[])\n\t" +
+						"FROM _prom_catalog.series s, unnest(s.labels) AS label_id\n\t" +
+						"WHERE " + fooClause + "\n\t" +
+						"AND s.delete_epoch IS NULL",
+					Args:    []interface{}{model.MetricNameLabelName, "foo"},
+					Results: model.RowResults{{[]int64{1, 2}}},
+				},
+				{
+					Sql:     "SELECT DISTINCT key FROM _prom_catalog.label WHERE id = ANY($1)",
+					Args:    []interface{}{[]int64{1, 2}},
+					Results: model.RowResults{{"job"}, {"__name__"}},
+				},
+			},
+			result: []string{"__name__", "job"},
+		},
+		{
+			name:     "matchers with time range",
+			mint:     1000,
+			maxt:     2000,
+			matchers: []*labels.Matcher{fooMatcher},
+			sqlQueries: []model.SqlQuery{
+				{
+					Sql: "SELECT m.table_name, array_agg(s.id)\n\t" +
+						"FROM _prom_catalog.series s\n\t" +
+						"INNER JOIN _prom_catalog.metric m\n\t" +
+						"ON (m.id = s.metric_id)\n\t" +
+						"WHERE " + fooClause + "\n\t" +
+						"AND s.delete_epoch IS NULL\n\t" +
+						"GROUP BY m.table_name",
+					Args:    []interface{}{model.MetricNameLabelName, "foo"},
+					Results: model.RowResults{{"foo", []int64{3, 4}}},
+				},
+				{
+					Sql: "SELECT COALESCE(array_agg(DISTINCT label_id), array[]::int[])\n\t" +
+						"FROM \"prom_data_series\".\"foo\" s, unnest(s.labels) AS label_id\n\t" +
+						"WHERE s.id = ANY($1)\n\t" +
+						"AND EXISTS (\n\t\t" +
+						"SELECT 1\n\t\t" +
+						"FROM \"prom_data\".\"foo\" m\n\t\t" +
+						"WHERE m.series_id = s.id\n\t\t" +
+						"AND time >= '1970-01-01T00:00:01Z'\n\t\t" +
+						"AND time <= '1970-01-01T00:00:02Z'\n\t" +
+						")",
+					Args:    []interface{}{[]int64{3, 4}},
+					Results: model.RowResults{{[]int64{1}}},
+				},
+				{
+					Sql:     "SELECT DISTINCT key FROM _prom_catalog.label WHERE id = ANY($1)",
+					Args:    []interface{}{[]int64{1}},
+					Results: model.RowResults{{"__name__"}},
+				},
+			},
+			result: []string{"__name__"},
+		},
+	}
+
+	for _, c := range testCases {
+		t.Run(c.name, func(t *testing.T) {
+			mock := model.NewSqlRecorder(c.sqlQueries, t)
+			querier := pgxQuerier{conn: mock}
+
+			result, err := querier.LabelNames(c.mint, c.maxt, c.matchers...)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(result, c.result) {
+				t.Errorf("unexpected result: got %v, wanted %v", result, c.result)
+			}
+		})
+	}
+}
+
+func TestPGXQuerierLabelValues(t *testing.T) {
+	mock := model.NewSqlRecorder([]model.SqlQuery{
+		{
+			Sql: "SELECT COALESCE(array_agg(DISTINCT label_id), array[]::int[])\n\t" +
+				"FROM _prom_catalog.series s, unnest(s.labels) AS label_id\n\t" +
+				"WHERE labels && (SELECT COALESCE(array_agg(l.id), array[]::int[]) FROM _prom_catalog.label l WHERE l.key = $1 and l.value = $2)\n\t" +
+				"AND s.delete_epoch IS NULL",
+			Args:    []interface{}{model.MetricNameLabelName, "foo"},
+			Results: model.RowResults{{[]int64{1, 2, 3}}},
+		},
+		{
+			Sql:     "SELECT value FROM _prom_catalog.label WHERE key = $1 AND id = ANY($2)",
+			Args:    []interface{}{"job", []int64{1, 2, 3}},
+			Results: model.RowResults{{"b"}, {"a"}},
+		},
+	}, t)
+	querier := pgxQuerier{conn: mock}
+
+	result, err := querier.LabelValues(minTime, maxTime, "job", labels.MustNewMatcher(labels.MatchEqual, model.MetricNameLabelName, "foo"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if expected := []string{"a", "b"}; !reflect.DeepEqual(result, expected) {
+		t.Errorf("unexpected result: got %v, wanted %v", result, expected)
+	}
+}
@@ -0,0 +1,84 @@
+// This file and its contents are licensed under the Apache License 2.0.
+// Please see the included NOTICE for copyright information and
+// LICENSE for a copy of the license.
+
+package querier
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/timescale/promscale/pkg/pgmodel/common/errors"
+)
+
+// limitTracker keeps count of the series and samples loaded by a single query
+// and errors out as soon as one of the configured limits is exceeded. It is
+// shared by the concurrent sub-queries of a query, and by all the selects of a
+// query run with a context from WithLimitTracker.
+type limitTracker struct {
+	lock    sync.Mutex
+	limits  Limits
+	series  int
+	samples int
+}
+
+func newLimitTracker(limits Limits) *limitTracker {
+	return &limitTracker{limits: limits}
+}
+
+// checkRange verifies that the queried time range is within the limits.
+func (t *limitTracker) checkRange(mint, maxt int64) error {
+	if t.limits.MaxRange <= 0 {
+		return nil
+	}
+	if time.Duration(maxt-mint)*time.Millisecond > t.limits.MaxRange {
+		return fmt.Errorf("%w (limit: %s)", errors.ErrQueryMaxRange, t.limits.MaxRange)
+	}
+	return nil
+}
+
+// add accounts for a new series with the supplied number of samples.
+func (t *limitTracker) add(samples int) error {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	t.series++
+	t.samples += samples
+	if t.limits.MaxSeries > 0 && t.series > t.limits.MaxSeries {
+		return fmt.Errorf("%w (limit: %d)", errors.ErrQueryMaxSeries, t.limits.MaxSeries)
+	}
+	if t.limits.MaxSamples > 0 && t.samples > t.limits.MaxSamples {
+		return fmt.Errorf("%w (limit: %d)", errors.ErrQueryMaxSamples, t.limits.MaxSamples)
+	}
+	return nil
+}
+
+type limitTrackerKey struct{}
+
+// sharedLimitTracker is the limit tracker of a query, created by its first
+// select.
+type sharedLimitTracker struct {
+	once    sync.Once
+	tracker *limitTracker
+}
+
+// WithLimitTracker returns a context making all the selects run with it share
+// a single limit tracker, so that the series and samples limits apply to the
+// whole query instead of to each of its selectors.
+func WithLimitTracker(ctx context.Context) context.Context {
+	return context.WithValue(ctx, limitTrackerKey{}, &sharedLimitTracker{})
+}
+
+// limitTracker returns the limit tracker shared by the selects of the query,
+// or a new one if there is none.
+func (q *pgxQuerier) limitTracker(ctx context.Context) *limitTracker {
+	shared, ok := ctx.Value(limitTrackerKey{}).(*sharedLimitTracker)
+	if !ok {
+		return newLimitTracker(q.limits)
+	}
+	shared.once.Do(func() {
+		shared.tracker = newLimitTracker(q.limits)
+	})
+	return shared.tracker
+}
@@ -0,0 +1,99 @@
+// This file and its contents are licensed under the Apache License 2.0.
+// Please see the included NOTICE for copyright information and
+// LICENSE for a copy of the license.
+
+package querier
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	pgmodelErrs "github.com/timescale/promscale/pkg/pgmodel/common/errors"
+)
+
+func TestLimitTracker(t *testing.T) {
+	testCases := []struct {
+		name     string
+		limits   Limits
+		samples  []int
+		mint     int64
+		maxt     int64
+		expected error
+	}{
+		{
+			name:    "no limits",
+			samples: []int{100, 100, 100},
+			maxt:    time.Hour.Milliseconds(),
+		},
+		{
+			name:    "within limits",
+			limits:  Limits{MaxSeries: 3, MaxSamples: 300, MaxRange: time.Hour},
+			samples: []int{100, 100, 100},
+			maxt:    time.Hour.Milliseconds(),
+		},
+		{
+			name:     "too many series",
+			limits:   Limits{MaxSeries: 2},
+			samples:  []int{1, 1, 1},
+			expected: pgmodelErrs.ErrQueryMaxSeries,
+		},
+		{
+			name:     "too many samples",
+			limits:   Limits{MaxSamples: 150},
+			samples:  []int{100, 100},
+			expected: pgmodelErrs.ErrQueryMaxSamples,
+		},
+		{
+			name:     "range too long",
+			limits:   Limits{MaxRange: time.Hour},
+			mint:     0,
+			maxt:     time.Hour.Milliseconds() + 1,
+			expected: pgmodelErrs.ErrQueryMaxRange,
+		},
+	}
+
+	for _, c := range testCases {
+		t.Run(c.name, func(t *testing.T) {
+			tracker := newLimitTracker(c.limits)
+			err := tracker.checkRange(c.mint, c.maxt)
+			for _, s := range c.samples {
+				if err != nil {
+					break
+				}
+				err = tracker.add(s)
+			}
+			if c.expected == nil && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if c.expected != nil && !errors.Is(err, c.expected) {
+				t.Fatalf("unexpected error: got %v, wanted %v", err, c.expected)
+			}
+		})
+	}
+}
+
+func TestSharedLimitTracker(t *testing.T) {
+	q := &pgxQuerier{limits: Limits{MaxSeries: 2}}
+
+	ctx := WithLimitTracker(context.Background())
+	first, second := q.limitTracker(ctx), q.limitTracker(ctx)
+	if first != second {
+		t.Fatal("the selects of a query don't share the limit tracker")
+	}
+	// Two selectors loading a series each, plus a third one, exceed the
+	// limit of the query together.
+	for i := 0; i < 2; i++ {
+		if err := q.limitTracker(ctx).add(1); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if err := q.limitTracker(ctx).add(1); !errors.Is(err, pgmodelErrs.ErrQueryMaxSeries) {
+		t.Fatalf("unexpected error: got %v, wanted %v", err, pgmodelErrs.ErrQueryMaxSeries)
+	}
+
+	if q.limitTracker(context.Background()) == q.limitTracker(context.Background()) {
+		t.Fatal("selects without a query context share the limit tracker")
+	}
+}
@@ -0,0 +1,44 @@
+// This file and its contents are licensed under the Apache License 2.0.
+// Please see the included NOTICE for copyright information and
+// LICENSE for a copy of the license.
+
+package querier
+
+import (
+	"context"
+	goErrors "errors"
+
+	"github.com/timescale/promscale/pkg/pgmodel/common/errors"
+)
+
+type partialResponseKey struct{}
+
+// WithPartialResponse returns a context allowing the queries across multiple
+// metrics to return the results of the metrics that were queried
+// successfully, with a warning for every metric that failed.
+func WithPartialResponse(ctx context.Context) context.Context {
+	return context.WithValue(ctx, partialResponseKey{}, true)
+}
+
+// partialResponse returns true if partial responses are allowed.
+func partialResponse(ctx context.Context) bool {
+	allowed, _ := ctx.Value(partialResponseKey{}).(bool)
+	return allowed
+}
+
+// isMetricFailure returns true if the error of a per-metric query only fails
+// that metric. Exceeding the query limits or the read queue fails the whole
+// query, as partial results would hide that.
+func isMetricFailure(err error) bool {
+	for _, fatal := range []error{
+		errors.ErrQueryMaxSeries,
+		errors.ErrQueryMaxSamples,
+		errors.ErrReadQueueFull,
+		errors.ErrReadQueueTimeout,
+	} {
+		if goErrors.Is(err, fatal) {
+			return false
+		}
+	}
+	return true
+}
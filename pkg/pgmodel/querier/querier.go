@@ -7,6 +7,7 @@ package querier
 import (
 	"context"
 	"fmt"
+	"sync"
 
 	"github.com/jackc/pgconn"
 	"github.com/jackc/pgerrcode"
@@ -20,6 +21,7 @@ import (
 	"github.com/timescale/promscale/pkg/pgmodel/common/errors"
 	"github.com/timescale/promscale/pkg/pgmodel/common/schema"
 	"github.com/timescale/promscale/pkg/pgmodel/lreader"
+	pgmodel "github.com/timescale/promscale/pkg/pgmodel/model"
 	"github.com/timescale/promscale/pkg/pgxconn"
 	"github.com/timescale/promscale/pkg/prompb"
 )
@@ -35,7 +37,17 @@ type Querier interface {
 	// Query returns resulting timeseries for a query.
 	Query(*prompb.Query) ([]*prompb.TimeSeries, error)
 	// Select returns a series set that matches the supplied query parameters.
-	Select(mint int64, maxt int64, sortSeries bool, hints *storage.SelectHints, path []parser.Node, ms ...*labels.Matcher) (storage.SeriesSet, parser.Node)
+	// The context cancels the database queries.
+	Select(ctx context.Context, mint int64, maxt int64, sortSeries bool, hints *storage.SelectHints, path []parser.Node, ms ...*labels.Matcher) (storage.SeriesSet, parser.Node)
+	// SelectSeries returns a page of at most limit series (0 means no limit)
+	// matching any of the matcher sets, continuing after the supplied token.
+	SelectSeries(mint, maxt int64, limit int, token string, matcherSets ...[]*labels.Matcher) (*SeriesPage, error)
+	// LabelNames returns the sorted label names of the series matching the
+	// matchers with data between mint and maxt.
+	LabelNames(mint, maxt int64, ms ...*labels.Matcher) ([]string, error)
+	// LabelValues returns the sorted values of the label name for the series
+	// matching the matchers with data between mint and maxt.
+	LabelValues(mint, maxt int64, name string, ms ...*labels.Matcher) ([]string, error)
 }
 
 const (
@@ -44,11 +56,17 @@ const (
 
 // NewQuerier returns a new pgxQuerier that reads from PostgreSQL using PGX
 // and caches metric table names and label sets using the supplied caches.
-func NewQuerier(conn pgxconn.PgxConn, metricCache cache.MetricCache, labelsReader lreader.LabelsReader) Querier {
+// The labels cache also caches the label IDs the matchers are resolved to.
+func NewQuerier(conn pgxconn.PgxConn, metricCache cache.MetricCache, labelsReader lreader.LabelsReader, labelsCache cache.LabelsCache, cfg Config) Querier {
 	return &pgxQuerier{
 		conn:             conn,
 		labelsReader:     labelsReader,
+		labelIDs:         newLabelIDResolver(conn, labelsCache, cfg.MaxResolvedRegexValues),
+		rollups:          newRollupSelector(conn, cfg.RollupResolutions),
+		views:            newViewRegistry(conn),
 		metricTableNames: metricCache,
+		limits:           cfg.Limits,
+		parallelism:      cfg.MultiMetricParallelism,
 	}
 }
 
@@ -56,25 +74,52 @@ type metricTimeRangeFilter struct {
 	metric    string
 	startTime string
 	endTime   string
+	// rollup is read from instead of the raw samples, if set.
+	rollup *rollup
 }
 
 type pgxQuerier struct {
 	conn             pgxconn.PgxConn
 	metricTableNames cache.MetricCache
 	labelsReader     lreader.LabelsReader
+	labelIDs         *labelIDResolver
+	rollups          *rollupSelector
+	views            *viewRegistry
+	limits           Limits
+	parallelism      int
 }
 
 var _ Querier = (*pgxQuerier)(nil)
 
 // Select implements the Querier interface. It is the entry point for our
 // own version of the Prometheus engine.
-func (q *pgxQuerier) Select(mint int64, maxt int64, sortSeries bool, hints *storage.SelectHints, path []parser.Node, ms ...*labels.Matcher) (storage.SeriesSet, parser.Node) {
-	rows, topNode, err := q.getResultRows(mint, maxt, hints, path, ms)
+func (q *pgxQuerier) Select(ctx context.Context, mint int64, maxt int64, sortSeries bool, hints *storage.SelectHints, path []parser.Node, ms ...*labels.Matcher) (storage.SeriesSet, parser.Node) {
+	sq, err := q.newSelectQuery(ctx, mint, maxt, ms)
 	if err != nil {
 		return errorSeriesSet{err: err}, nil
 	}
 
-	ss := buildSeriesSet(rows, q.labelsReader)
+	// Series of a single metric are decoded as they are iterated, unless they
+	// have to be sorted first.
+	if sq.metric != "" && !sortSeries {
+		ss, topNode, err := q.streamSingleMetric(ctx, sq, hints, path)
+		if err != nil {
+			return errorSeriesSet{err: err}, nil
+		}
+		return ss, topNode
+	}
+
+	rows, topNode, err := q.getResultRows(ctx, sq, hints, path)
+	if err != nil {
+		return errorSeriesSet{err: err}, nil
+	}
+	if sortSeries {
+		if err = sortRows(rows, q.labelsReader); err != nil {
+			return errorSeriesSet{err: err}, nil
+		}
+	}
+
+	ss := buildSeriesSet(rows, q.labelsReader, sq.warnings)
 	return ss, topNode
 }
 
@@ -91,7 +136,28 @@ func (q *pgxQuerier) Query(query *prompb.Query) ([]*prompb.TimeSeries, error) {
 		return nil, err
 	}
 
-	rows, _, err := q.getResultRows(query.StartTimestampMs, query.EndTimestampMs, nil, nil, matchers)
+	sq, err := q.newSelectQuery(context.Background(), query.StartTimestampMs, query.EndTimestampMs, matchers)
+	if err != nil {
+		return nil, err
+	}
+
+	// The client evaluates the PromQL functions on the returned samples
+	// itself, so the hints only select the rollups to read from, and
+	// functions are not pushed down.
+	hints, path := fromReadHints(query.Hints)
+	sq.noPushdown = true
+
+	// Series of a single metric are converted as they are decoded, so that
+	// their rows are not held in memory next to the converted series.
+	if sq.metric != "" {
+		ss, _, err := q.streamSingleMetric(context.Background(), sq, hints, path)
+		if err != nil {
+			return nil, err
+		}
+		return buildTimeSeriesFromSet(ss)
+	}
+
+	rows, _, err := q.getResultRows(context.Background(), sq, hints, path)
 
 	if err != nil {
 		return nil, err
@@ -129,66 +195,167 @@ func fromLabelMatchers(matchers []*prompb.LabelMatcher) ([]*labels.Matcher, erro
 
 type timescaleRow struct {
 	labelIds []int64
-	times    pgtype.TimestamptzArray
-	values   pgtype.Float8Array
-	err      error
+	// labels are the labels of the rows of registered views, which are
+	// used instead of the label IDs if set.
+	labels labels.Labels
+	times  pgtype.TimestamptzArray
+	values pgtype.Float8Array
+	err    error
 }
 
-// getResultRows fetches the result row datasets from the database using the
-// supplied query parameters.
-func (q *pgxQuerier) getResultRows(startTimestamp int64, endTimestamp int64, hints *storage.SelectHints, path []parser.Node, matchers []*labels.Matcher) ([]timescaleRow, parser.Node, error) {
+// selectQuery holds what is needed to query the metric tables for the
+// supplied matchers and time range.
+type selectQuery struct {
+	builder  *clauseBuilder
+	matchers []*labels.Matcher
+	metric   string
+	filter   metricTimeRangeFilter
+	tracker  *limitTracker
+	// noPushdown is set if the samples must not be replaced by the
+	// results of pushed down functions.
+	noPushdown bool
+	// partialResponse is set if the failed queries of single metrics, in a
+	// query across multiple metrics, are returned as warnings.
+	partialResponse bool
+	warnings        storage.Warnings
+}
+
+func (q *pgxQuerier) newSelectQuery(ctx context.Context, startTimestamp int64, endTimestamp int64, matchers []*labels.Matcher) (*selectQuery, error) {
+	tracker := q.limitTracker(ctx)
+	if err := tracker.checkRange(startTimestamp, endTimestamp); err != nil {
+		return nil, err
+	}
+
 	// Build a subquery per metric matcher.
-	builder, err := BuildSubQueries(matchers)
+	builder, err := q.buildSubQueries(ctx, matchers)
 	if err != nil {
-		return nil, nil, err
+		return nil, err
 	}
 
 	metric := builder.GetMetricName()
 
-	filter := metricTimeRangeFilter{
-		metric:    metric,
-		startTime: toRFC3339Nano(startTimestamp),
-		endTime:   toRFC3339Nano(endTimestamp),
+	return &selectQuery{
+		builder:  builder,
+		matchers: matchers,
+		metric:   metric,
+		filter: metricTimeRangeFilter{
+			metric:    metric,
+			startTime: toRFC3339Nano(startTimestamp),
+			endTime:   toRFC3339Nano(endTimestamp),
+		},
+		tracker:         tracker,
+		partialResponse: partialResponse(ctx),
+	}, nil
+}
+
+// buildSubQueries builds the clauses of the matchers, resolving the label IDs
+// of the matchers selecting a few label values beforehand.
+func (q *pgxQuerier) buildSubQueries(ctx context.Context, matchers []*labels.Matcher) (*clauseBuilder, error) {
+	labelIDs, err := q.labelIDs.resolve(ctx, matchers)
+	if err != nil {
+		return nil, err
 	}
+	return buildSubQueries(matchers, labelIDs)
+}
 
+// getResultRows fetches the result row datasets from the database using the
+// supplied query parameters.
+func (q *pgxQuerier) getResultRows(ctx context.Context, sq *selectQuery, hints *storage.SelectHints, path []parser.Node) ([]timescaleRow, parser.Node, error) {
 	// If all metric matchers match on a single metric (common case),
 	// we query only that single metric.
-	if metric != "" {
-		clauses, values, err := builder.Build(false)
-		if err != nil {
-			return nil, nil, err
+	if sq.metric != "" {
+		return q.querySingleMetric(ctx, sq, hints, path)
+	}
+
+	if err := q.rejectViews(ctx, sq.matchers); err != nil {
+		return nil, nil, err
+	}
+	clauses, values, err := sq.builder.Build(true)
+	crossMetric := !sq.builder.Selective()
+	// Selecting all series is a cross metric query, bounded by the cross
+	// metric limits, if any.
+	if err == errors.ErrNoClausesGen {
+		clauses, values, err = []string{"TRUE"}, nil, nil
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	return q.queryMultipleMetrics(ctx, sq, clauses, values, crossMetric)
+}
+
+// streamSingleMetric returns a series set decoding the rows of a single metric
+// as it is iterated. Like querySingleMetric, it pushes down query functions
+// where possible.
+func (q *pgxQuerier) streamSingleMetric(ctx context.Context, sq *selectQuery, hints *storage.SelectHints, path []parser.Node) (storage.SeriesSet, parser.Node, error) {
+	clauses, values, err := sq.builder.Build(false)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tableName, err := q.getMetricTableName(sq.metric)
+	if err != nil {
+		// If the metric table is missing, the metric might be a registered
+		// view, otherwise there are no results for this query.
+		if err == errors.ErrMissingTableName {
+			rows, err := q.querySingleView(ctx, sq)
+			if err != nil || rows == nil {
+				return storage.EmptySeriesSet(), nil, err
+			}
+			return buildSeriesSet(rows, q.labelsReader, nil), nil, nil
 		}
-		return q.querySingleMetric(metric, filter, clauses, values, hints, path)
+		return nil, nil, err
+	}
+	filter := sq.filter
+	filter.metric = tableName
+	if filter.rollup, err = q.rollups.selectRollup(ctx, sq.metric, hints, path); err != nil {
+		return nil, nil, err
+	}
+	if sq.noPushdown {
+		path = nil
 	}
 
-	clauses, values, err := builder.Build(true)
+	sqlQuery, values, topNode, err := buildTimeseriesByLabelClausesQuery(filter, clauses, values, hints, path)
 	if err != nil {
 		return nil, nil, err
 	}
-	return q.queryMultipleMetrics(filter, clauses, values)
+	return newStreamingSeriesSet(ctx, q.conn, sqlQuery, values, q.labelsReader, sq.tracker), topNode, nil
 }
 
 // querySingleMetric returns all the result rows for a single metric using the
 // supplied query parameters. It uses the hints and node path to try to push
 // down query functions where possible.
-func (q *pgxQuerier) querySingleMetric(metric string, filter metricTimeRangeFilter, cases []string, values []interface{}, hints *storage.SelectHints, path []parser.Node) ([]timescaleRow, parser.Node, error) {
-	tableName, err := q.getMetricTableName(metric)
+func (q *pgxQuerier) querySingleMetric(ctx context.Context, sq *selectQuery, hints *storage.SelectHints, path []parser.Node) ([]timescaleRow, parser.Node, error) {
+	cases, values, err := sq.builder.Build(false)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tableName, err := q.getMetricTableName(sq.metric)
 	if err != nil {
-		// If the metric table is missing, there are no results for this query.
+		// If the metric table is missing, the metric might be a registered
+		// view, otherwise there are no results for this query.
 		if err == errors.ErrMissingTableName {
-			return nil, nil, nil
+			rows, err := q.querySingleView(ctx, sq)
+			return rows, nil, err
 		}
 
 		return nil, nil, err
 	}
+	filter := sq.filter
 	filter.metric = tableName
+	if filter.rollup, err = q.rollups.selectRollup(ctx, sq.metric, hints, path); err != nil {
+		return nil, nil, err
+	}
+	if sq.noPushdown {
+		path = nil
+	}
 
 	sqlQuery, values, topNode, err := buildTimeseriesByLabelClausesQuery(filter, cases, values, hints, path)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	rows, err := q.conn.Query(context.Background(), sqlQuery, values...)
+	rows, err := q.conn.Query(ctx, sqlQuery, values...)
 	if err != nil {
 		// If we are getting undefined table error, it means the query
 		// is looking for a metric which doesn't exist in the system.
@@ -200,33 +367,43 @@ func (q *pgxQuerier) querySingleMetric(metric string, filter metricTimeRangeFilt
 	defer rows.Close()
 
 	// TODO this allocation assumes we usually have 1 row, if not, refactor
-	tsRows, err := appendTsRows(make([]timescaleRow, 0, 1), rows)
+	tsRows, err := appendTsRows(make([]timescaleRow, 0, 1), rows, sq.tracker)
 	return tsRows, topNode, err
 }
 
 // queryMultipleMetrics returns all the result rows for across multiple metrics
-// using the supplied query parameters.
-func (q *pgxQuerier) queryMultipleMetrics(filter metricTimeRangeFilter, cases []string, values []interface{}) ([]timescaleRow, parser.Node, error) {
+// using the supplied query parameters. The metric tables are queried
+// concurrently, and the results are merged in metric name order. Cross metric
+// queries, without a selective matcher, are bounded by the cross metric limits.
+// If partial responses are allowed, the metrics failing to be queried are
+// added to the warnings of the select query.
+func (q *pgxQuerier) queryMultipleMetrics(ctx context.Context, sq *selectQuery, cases []string, values []interface{}, crossMetric bool) ([]timescaleRow, parser.Node, error) {
 	// First fetch series IDs per metric.
 	sqlQuery := BuildMetricNameSeriesIDQuery(cases)
-	rows, err := q.conn.Query(context.Background(), sqlQuery, values...)
+	if crossMetric && q.limits.MaxCrossMetricMetrics > 0 {
+		// One more metric than allowed is enough to know the limit is exceeded.
+		sqlQuery = fmt.Sprintf("%s\n\tLIMIT %d", sqlQuery, q.limits.MaxCrossMetricMetrics+1)
+	}
+	rows, err := q.conn.Query(ctx, sqlQuery, values...)
 	if err != nil {
 		return nil, nil, err
 	}
-	defer rows.Close()
-
 	metrics, series, err := GetSeriesPerMetric(rows)
+	// Release the connection before querying the metric tables.
+	rows.Close()
 	if err != nil {
 		return nil, nil, err
 	}
+	if crossMetric {
+		if err = q.checkCrossMetricLimits(metrics, series); err != nil {
+			return nil, nil, err
+		}
+	}
 
-	// TODO this assume on average on row per-metric. Is this right?
-	results := make([]timescaleRow, 0, len(metrics))
-
-	numQueries := 0
-	batch := q.conn.NewBatch()
-
-	// Generate queries for each metric and send them in a single batch.
+	// Generate queries for each metric.
+	filter := sq.filter
+	queried := make([]string, 0, len(metrics))
+	queries := make([]string, 0, len(metrics))
 	for i, metric := range metrics {
 		//TODO batch getMetricTableName
 		tableName, err := q.getMetricTableName(metric)
@@ -238,34 +415,123 @@ func (q *pgxQuerier) queryMultipleMetrics(filter metricTimeRangeFilter, cases []
 			return nil, nil, err
 		}
 		filter.metric = tableName
-		sqlQuery = buildTimeseriesBySeriesIDQuery(filter, series[i])
-		batch.Queue(sqlQuery)
-		numQueries += 1
+		queried = append(queried, metric)
+		queries = append(queries, buildTimeseriesBySeriesIDQuery(filter, series[i]))
 	}
 
-	batchResults, err := q.conn.SendBatch(context.Background(), batch)
+	perMetric, warnings, err := q.runConcurrently(ctx, queried, queries, sq.tracker, sq.partialResponse)
 	if err != nil {
 		return nil, nil, err
 	}
-	defer batchResults.Close()
+	sq.warnings = append(sq.warnings, warnings...)
 
-	for i := 0; i < numQueries; i++ {
-		rows, err = batchResults.Query()
-		if err != nil {
-			rows.Close()
-			return nil, nil, err
+	// TODO this assume on average on row per-metric. Is this right?
+	results := make([]timescaleRow, 0, len(queries))
+	for _, r := range perMetric {
+		results = append(results, r...)
+	}
+	return results, nil, nil
+}
+
+// checkCrossMetricLimits verifies that a cross metric query only touches as
+// many metrics and series as allowed.
+func (q *pgxQuerier) checkCrossMetricLimits(metrics []string, series [][]pgmodel.SeriesID) error {
+	if q.limits.MaxCrossMetricMetrics > 0 && len(metrics) > q.limits.MaxCrossMetricMetrics {
+		return fmt.Errorf("%w (limit: %d)", errors.ErrCrossMetricMaxMetrics, q.limits.MaxCrossMetricMetrics)
+	}
+	if q.limits.MaxCrossMetricSeries <= 0 {
+		return nil
+	}
+
+	numSeries := 0
+	for _, s := range series {
+		numSeries += len(s)
+	}
+	if numSeries > q.limits.MaxCrossMetricSeries {
+		return fmt.Errorf("%w (limit: %d)", errors.ErrCrossMetricMaxSeries, q.limits.MaxCrossMetricSeries)
+	}
+	return nil
+}
+
+// runConcurrently runs the queries with at most q.parallelism of them at the
+// same time, and returns their result rows in the order of the queries. The
+// first error cancels the queries still running. If partial is set, the
+// errors failing only the query of their metric are returned as warnings
+// instead, and the other queries carry on.
+func (q *pgxQuerier) runConcurrently(ctx context.Context, metrics []string, queries []string, tracker *limitTracker, partial bool) ([][]timescaleRow, storage.Warnings, error) {
+	parallelism := q.parallelism
+	if parallelism < 1 {
+		parallelism = 1
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		wg       sync.WaitGroup
+		errOnce  sync.Once
+		firstErr error
+		sem      = make(chan struct{}, parallelism)
+		results  = make([][]timescaleRow, len(queries))
+		failed   = make([]error, len(queries))
+	)
+	setErr := func(i int, err error) {
+		if partial && isMetricFailure(err) {
+			results[i] = nil
+			failed[i] = fmt.Errorf("querying metric %s failed, its series are missing: %w", metrics[i], err)
+			return
 		}
-		// Append all rows into results.
-		results, err = appendTsRows(results, rows)
-		// Can't defer because we need to Close before the next loop iteration.
-		rows.Close()
-		if err != nil {
-			rows.Close()
-			return nil, nil, err
+		errOnce.Do(func() {
+			firstErr = err
+			cancel()
+		})
+	}
+
+queryLoop:
+	for i, sqlQuery := range queries {
+		if ctx.Err() != nil {
+			break
+		}
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			break queryLoop
 		}
+		wg.Add(1)
+		go func(i int, sqlQuery string) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			rows, err := q.conn.Query(ctx, sqlQuery)
+			if err != nil {
+				setErr(i, err)
+				return
+			}
+			results[i], err = appendTsRows(nil, rows, tracker)
+			rows.Close()
+			if err != nil {
+				setErr(i, err)
+			}
+		}(i, sqlQuery)
 	}
+	wg.Wait()
 
-	return results, nil, nil
+	if firstErr != nil {
+		return nil, nil, firstErr
+	}
+	// The parent context was cancelled.
+	if err := ctx.Err(); err != nil {
+		return nil, nil, err
+	}
+
+	var warnings storage.Warnings
+	for _, err := range failed {
+		if err != nil {
+			warnings = append(warnings, err)
+		}
+	}
+	return results, warnings, nil
 }
 
 // getMetricTableName gets the table name for a specific metric from internal
@@ -320,8 +586,9 @@ func (q *pgxQuerier) queryMetricTableName(metric string) (string, error) {
 }
 
 // appendTsRows adds new results rows to already existing result rows and
-// returns the as a result.
-func appendTsRows(out []timescaleRow, in pgx.Rows) ([]timescaleRow, error) {
+// returns the as a result. It stops reading as soon as the query limits
+// tracked by the supplied tracker are exceeded.
+func appendTsRows(out []timescaleRow, in pgx.Rows, tracker *limitTracker) ([]timescaleRow, error) {
 	if in.Err() != nil {
 		return out, in.Err()
 	}
@@ -333,6 +600,9 @@ func appendTsRows(out []timescaleRow, in pgx.Rows) ([]timescaleRow, error) {
 			log.Error("err", row.err)
 			return out, row.err
 		}
+		if err := tracker.add(len(row.times.Elements)); err != nil {
+			return out, err
+		}
 	}
 	return out, in.Err()
 }
@@ -349,5 +619,5 @@ func (e errorSeriesSet) Err() error                 { return e.err }
 func (e errorSeriesSet) Warnings() storage.Warnings { return nil }
 
 type labelQuerier interface {
-	LabelsForIds(ids []int64) (lls labels.Labels, err error)
+	LabelsForIdMap(ids []int64) (map[int64]labels.Label, error)
 }
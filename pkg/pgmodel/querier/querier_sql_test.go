@@ -347,10 +347,10 @@ func TestPGXQuerierQuery(t *testing.T) {
 						"FROM _prom_catalog.series s\n\t" +
 						"INNER JOIN _prom_catalog.metric m\n\t" +
 						"ON (m.id = s.metric_id)\n\t" +
-						"WHERE NOT labels && (SELECT COALESCE(array_agg(l.id), array[]::int[]) FROM _prom_catalog.label l WHERE l.key = $1 and l.value !~ $2)\n\t" +
+						"WHERE NOT labels && (SELECT COALESCE(array_agg(l.id), array[]::int[]) FROM _prom_catalog.label l WHERE l.key = $1 and NOT (l.value = ANY($2::text[])))\n\t" +
 						"GROUP BY m.metric_name\n\t" +
 						"ORDER BY m.metric_name",
-					Args:    []interface{}{"__name__", "^$"},
+					Args:    []interface{}{"__name__", []string{""}},
 					Results: model.RowResults{{"foo", []int64{1}}, {"bar", []int64{1}}},
 					Err:     error(nil),
 				},
@@ -394,14 +394,8 @@ func TestPGXQuerierQuery(t *testing.T) {
 				},
 				{
 					Sql:     "SELECT (labels_info($1::int[])).*",
-					Args:    []interface{}{[]int64{3}},
-					Results: model.RowResults{{[]int64{3}, []string{"__name__"}, []string{"foo"}}},
-					Err:     error(nil),
-				},
-				{
-					Sql:     "SELECT (labels_info($1::int[])).*",
-					Args:    []interface{}{[]int64{4}},
-					Results: model.RowResults{{[]int64{4}, []string{"__name__"}, []string{"bar"}}},
+					Args:    []interface{}{[]int64{4, 3}},
+					Results: model.RowResults{{[]int64{4, 3}, []string{"__name__", "__name__"}, []string{"bar", "foo"}}},
 					Err:     error(nil),
 				},
 			},
@@ -577,10 +571,10 @@ func TestPGXQuerierQuery(t *testing.T) {
 						"FROM _prom_catalog.series s\n\t" +
 						"INNER JOIN _prom_catalog.metric m\n\t" +
 						"ON (m.id = s.metric_id)\n\t" +
-						"WHERE NOT labels && (SELECT COALESCE(array_agg(l.id), array[]::int[]) FROM _prom_catalog.label l WHERE l.key = $1 and l.value != $2) AND NOT labels && (SELECT COALESCE(array_agg(l.id), array[]::int[]) FROM _prom_catalog.label l WHERE l.key = $3 and l.value = $4) AND labels && (SELECT COALESCE(array_agg(l.id), array[]::int[]) FROM _prom_catalog.label l WHERE l.key = $5 and l.value ~ $6) AND NOT labels && (SELECT COALESCE(array_agg(l.id), array[]::int[]) FROM _prom_catalog.label l WHERE l.key = $7 and l.value ~ $8)\n\t" +
+						"WHERE NOT labels && (SELECT COALESCE(array_agg(l.id), array[]::int[]) FROM _prom_catalog.label l WHERE l.key = $1 and l.value != $2) AND NOT labels && (SELECT COALESCE(array_agg(l.id), array[]::int[]) FROM _prom_catalog.label l WHERE l.key = $3 and l.value = $4) AND labels && (SELECT COALESCE(array_agg(l.id), array[]::int[]) FROM _prom_catalog.label l WHERE l.key = $5 and l.value ~ $6) AND NOT labels && (SELECT COALESCE(array_agg(l.id), array[]::int[]) FROM _prom_catalog.label l WHERE l.key = $7 and l.value = ANY($8::text[]))\n\t" +
 						"GROUP BY m.metric_name\n\t" +
 						"ORDER BY m.metric_name",
-					Args:    []interface{}{"foo", "", "foo1", "bar1", "foo2", "^bar2$", "foo3", "^bar3$"},
+					Args:    []interface{}{"foo", "", "foo1", "bar1", "foo2", "^bar2$", "foo3", []string{"bar3"}},
 					Results: model.RowResults{{"metric", []int64{1, 2}}},
 					Err:     error(nil),
 				},
@@ -0,0 +1,363 @@
+// This file and its contents are licensed under the Apache License 2.0.
+// Please see the included NOTICE for copyright information and
+// LICENSE for a copy of the license.
+
+package querier
+
+import (
+	"context"
+	goErrors "errors"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/prometheus/prometheus/pkg/labels"
+	"github.com/timescale/promscale/pkg/clockcache"
+	"github.com/timescale/promscale/pkg/pgmodel/common/errors"
+	"github.com/timescale/promscale/pkg/pgmodel/lreader"
+	"github.com/timescale/promscale/pkg/pgmodel/model"
+)
+
+// concurrentConn answers the per-metric queries concurrently, from one
+// recorder per metric table, and records how many of them ran at once.
+type concurrentConn struct {
+	*model.SqlRecorder
+	tables map[string]*model.SqlRecorder
+
+	lock       sync.Mutex
+	running    int
+	maxRunning int
+}
+
+func (c *concurrentConn) Query(ctx context.Context, sql string, args ...interface{}) (pgx.Rows, error) {
+	for table, recorder := range c.tables {
+		if !strings.Contains(sql, pgx.Identifier{"prom_data", table}.Sanitize()) {
+			continue
+		}
+		c.lock.Lock()
+		c.running++
+		if c.running > c.maxRunning {
+			c.maxRunning = c.running
+		}
+		c.lock.Unlock()
+
+		time.Sleep(10 * time.Millisecond)
+
+		c.lock.Lock()
+		c.running--
+		c.lock.Unlock()
+		return recorder.Query(ctx, sql, args...)
+	}
+	return c.SqlRecorder.Query(ctx, sql, args...)
+}
+
+func TestQueryMultipleMetricsConcurrently(t *testing.T) {
+	metricNameQuery := model.SqlQuery{
+		Sql: "SELECT m.metric_name, array_agg(s.id)\n\t" +
+			"FROM _prom_catalog.series s\n\t" +
+			"INNER JOIN _prom_catalog.metric m\n\t" +
+			"ON (m.id = s.metric_id)\n\t" +
+			"WHERE labels && (SELECT COALESCE(array_agg(l.id), array[]::int[]) FROM _prom_catalog.label l WHERE l.key = $1 and l.value = $2)\n\t" +
+			"GROUP BY m.metric_name\n\t" +
+			"ORDER BY m.metric_name",
+		Args:    []interface{}{"job", "api"},
+		Results: model.RowResults{{"a", []int64{1}}, {"b", []int64{2}}, {"c", []int64{3, 4}}, {"d", []int64{5}}},
+	}
+	tableQuery := func(table string, ids string, err error, results ...[]interface{}) model.SqlQuery {
+		return model.SqlQuery{
+			Sql: fmt.Sprintf(timeseriesBySeriesIDsSQLFormat,
+				pgx.Identifier{"prom_data", table}.Sanitize(),
+				pgx.Identifier{"prom_data_series", table}.Sanitize(),
+				ids, "1970-01-01T00:00:01Z", "1970-01-01T00:00:02Z"),
+			Results: results,
+			Err:     err,
+		}
+	}
+	row := func(id int64) []interface{} {
+		return []interface{}{[]int64{id}, []time.Time{time.Unix(1, 0)}, []float64{1}}
+	}
+	someErr := fmt.Errorf("some error")
+
+	testCases := []struct {
+		name        string
+		parallelism int
+		limits      Limits
+		cancel      bool
+		partial     bool
+		tables      map[string]model.SqlQuery
+		expectIDs   []int64
+		expectErr   error
+		warnings    int
+	}{
+		{
+			name:        "results in metric order",
+			parallelism: 2,
+			tables: map[string]model.SqlQuery{
+				"a": tableQuery("a", "1", nil, row(1)),
+				"b": tableQuery("b", "2", nil, row(2)),
+				"c": tableQuery("c", "3,4", nil, row(3), row(4)),
+				"d": tableQuery("d", "5", nil, row(5)),
+			},
+			expectIDs: []int64{1, 2, 3, 4, 5},
+		},
+		{
+			name:        "sequential",
+			parallelism: 1,
+			tables: map[string]model.SqlQuery{
+				"a": tableQuery("a", "1", nil, row(1)),
+				"b": tableQuery("b", "2", nil, row(2)),
+				"c": tableQuery("c", "3,4", nil, row(3), row(4)),
+				"d": tableQuery("d", "5", nil, row(5)),
+			},
+			expectIDs: []int64{1, 2, 3, 4, 5},
+		},
+		{
+			name:        "error",
+			parallelism: 4,
+			tables: map[string]model.SqlQuery{
+				"a": tableQuery("a", "1", nil, row(1)),
+				"b": tableQuery("b", "2", someErr),
+				"c": tableQuery("c", "3,4", nil, row(3), row(4)),
+				"d": tableQuery("d", "5", nil, row(5)),
+			},
+			expectErr: someErr,
+		},
+		{
+			name:        "partial response",
+			parallelism: 4,
+			partial:     true,
+			tables: map[string]model.SqlQuery{
+				"a": tableQuery("a", "1", nil, row(1)),
+				"b": tableQuery("b", "2", someErr),
+				"c": tableQuery("c", "3,4", nil, row(3), row(4)),
+				"d": tableQuery("d", "5", someErr),
+			},
+			expectIDs: []int64{1, 3, 4},
+			warnings:  2,
+		},
+		{
+			name:        "partial response exceeding the limits",
+			parallelism: 1,
+			partial:     true,
+			limits:      Limits{MaxSeries: 2},
+			tables: map[string]model.SqlQuery{
+				"a": tableQuery("a", "1", nil, row(1)),
+				"b": tableQuery("b", "2", someErr),
+				"c": tableQuery("c", "3,4", nil, row(3), row(4)),
+			},
+			expectErr: errors.ErrQueryMaxSeries,
+		},
+		{
+			name:        "limits are shared",
+			parallelism: 4,
+			limits:      Limits{MaxSeries: 4},
+			tables: map[string]model.SqlQuery{
+				"a": tableQuery("a", "1", nil, row(1)),
+				"b": tableQuery("b", "2", nil, row(2)),
+				"c": tableQuery("c", "3,4", nil, row(3), row(4)),
+				"d": tableQuery("d", "5", nil, row(5)),
+			},
+			expectErr: errors.ErrQueryMaxSeries,
+		},
+		{
+			name:        "cancelled",
+			parallelism: 1,
+			cancel:      true,
+			tables:      map[string]model.SqlQuery{},
+			expectErr:   context.Canceled,
+		},
+	}
+
+	for _, c := range testCases {
+		t.Run(c.name, func(t *testing.T) {
+			conn := &concurrentConn{
+				SqlRecorder: model.NewSqlRecorder([]model.SqlQuery{metricNameQuery}, t),
+				tables:      make(map[string]*model.SqlRecorder),
+			}
+			tableNames := make(map[string]string)
+			for _, metric := range []string{"a", "b", "c", "d"} {
+				tableNames[metric] = metric
+				if q, ok := c.tables[metric]; ok {
+					conn.tables[metric] = model.NewSqlRecorder([]model.SqlQuery{q}, t)
+				}
+			}
+			querier := pgxQuerier{
+				conn:             conn,
+				metricTableNames: &model.MockMetricCache{MetricCache: tableNames},
+				labelsReader:     lreader.NewLabelsReader(conn, clockcache.WithMax(0)),
+				limits:           c.limits,
+				parallelism:      c.parallelism,
+			}
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			if c.cancel {
+				cancel()
+			}
+			if c.partial {
+				ctx = WithPartialResponse(ctx)
+			}
+
+			sq, err := querier.newSelectQuery(ctx, 1000, 2000, []*labels.Matcher{labels.MustNewMatcher(labels.MatchEqual, "job", "api")})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			rows, _, err := querier.getResultRows(ctx, sq, nil, nil)
+			if c.expectErr != nil {
+				if !goErrors.Is(err, c.expectErr) {
+					t.Fatalf("unexpected error: got %v wanted %v", err, c.expectErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			ids := make([]int64, 0, len(rows))
+			for _, r := range rows {
+				ids = append(ids, r.labelIds...)
+			}
+			if fmt.Sprint(ids) != fmt.Sprint(c.expectIDs) {
+				t.Errorf("unexpected rows: got %v wanted %v", ids, c.expectIDs)
+			}
+			if conn.maxRunning > c.parallelism {
+				t.Errorf("too many concurrent queries: got %d wanted at most %d", conn.maxRunning, c.parallelism)
+			}
+			if len(sq.warnings) != c.warnings {
+				t.Errorf("unexpected warnings: got %v wanted %d", sq.warnings, c.warnings)
+			}
+		})
+	}
+}
+
+func TestCrossMetricLimits(t *testing.T) {
+	metricNameQuery := func(clause string, args []interface{}, limit int, results model.RowResults) model.SqlQuery {
+		sql := BuildMetricNameSeriesIDQuery([]string{clause})
+		if limit > 0 {
+			sql = fmt.Sprintf("%s\n\tLIMIT %d", sql, limit)
+		}
+		return model.SqlQuery{Sql: sql, Args: args, Results: results}
+	}
+	tableQuery := func(table string, ids string) model.SqlQuery {
+		return model.SqlQuery{
+			Sql: fmt.Sprintf(timeseriesBySeriesIDsSQLFormat,
+				pgx.Identifier{"prom_data", table}.Sanitize(),
+				pgx.Identifier{"prom_data_series", table}.Sanitize(),
+				ids, "1970-01-01T00:00:01Z", "1970-01-01T00:00:02Z"),
+		}
+	}
+	var (
+		presenceClause = "labels && (SELECT COALESCE(array_agg(l.id), array[]::int[]) FROM _prom_catalog.label l WHERE l.key = $1 and l.value != $2)"
+		presence       = labels.MustNewMatcher(labels.MatchNotEqual, "job", "")
+		selective      = labels.MustNewMatcher(labels.MatchNotRegexp, "job", "api|")
+		twoMetrics     = model.RowResults{{"a", []int64{1}}, {"b", []int64{2, 3}}}
+	)
+
+	testCases := []struct {
+		name      string
+		limits    Limits
+		matcher   *labels.Matcher
+		queries   []model.SqlQuery
+		expectErr error
+	}{
+		{
+			name:    "within the limits",
+			limits:  Limits{MaxCrossMetricMetrics: 2, MaxCrossMetricSeries: 3},
+			matcher: presence,
+			queries: []model.SqlQuery{
+				metricNameQuery(presenceClause, []interface{}{"job", ""}, 3, twoMetrics),
+				tableQuery("a", "1"),
+				tableQuery("b", "2,3"),
+			},
+		},
+		{
+			name:    "too many metrics",
+			limits:  Limits{MaxCrossMetricMetrics: 1},
+			matcher: presence,
+			queries: []model.SqlQuery{
+				metricNameQuery(presenceClause, []interface{}{"job", ""}, 2, twoMetrics),
+			},
+			expectErr: errors.ErrCrossMetricMaxMetrics,
+		},
+		{
+			name:    "too many series",
+			limits:  Limits{MaxCrossMetricMetrics: 2, MaxCrossMetricSeries: 2},
+			matcher: presence,
+			queries: []model.SqlQuery{
+				metricNameQuery(presenceClause, []interface{}{"job", ""}, 3, twoMetrics),
+			},
+			expectErr: errors.ErrCrossMetricMaxSeries,
+		},
+		{
+			name:    "no limits",
+			matcher: presence,
+			queries: []model.SqlQuery{
+				metricNameQuery(presenceClause, []interface{}{"job", ""}, 0, twoMetrics),
+				tableQuery("a", "1"),
+				tableQuery("b", "2,3"),
+			},
+		},
+		{
+			name:    "selective matcher",
+			limits:  Limits{MaxCrossMetricMetrics: 1, MaxCrossMetricSeries: 1},
+			matcher: selective,
+			queries: []model.SqlQuery{
+				metricNameQuery(
+					"labels && (SELECT COALESCE(array_agg(l.id), array[]::int[]) FROM _prom_catalog.label l WHERE l.key = $1 and NOT (l.value = ANY($2::text[])))",
+					[]interface{}{"job", []string{"api", ""}}, 0, twoMetrics),
+				tableQuery("a", "1"),
+				tableQuery("b", "2,3"),
+			},
+		},
+		{
+			name:    "all series",
+			limits:  Limits{MaxCrossMetricMetrics: 2},
+			matcher: labels.MustNewMatcher(labels.MatchRegexp, "job", "(?s).*"),
+			queries: []model.SqlQuery{
+				metricNameQuery("TRUE", nil, 3, twoMetrics),
+				tableQuery("a", "1"),
+				tableQuery("b", "2,3"),
+			},
+		},
+		{
+			name:    "all series without limits",
+			matcher: labels.MustNewMatcher(labels.MatchRegexp, "job", "(?s).*"),
+			queries: []model.SqlQuery{
+				metricNameQuery("TRUE", nil, 0, twoMetrics),
+				tableQuery("a", "1"),
+				tableQuery("b", "2,3"),
+			},
+		},
+	}
+
+	for _, c := range testCases {
+		t.Run(c.name, func(t *testing.T) {
+			conn := model.NewSqlRecorder(c.queries, t)
+			querier := pgxQuerier{
+				conn:             conn,
+				metricTableNames: &model.MockMetricCache{MetricCache: map[string]string{"a": "a", "b": "b"}},
+				labelsReader:     lreader.NewLabelsReader(conn, clockcache.WithMax(0)),
+				limits:           c.limits,
+				parallelism:      1,
+			}
+
+			sq, err := querier.newSelectQuery(context.Background(), 1000, 2000, []*labels.Matcher{c.matcher})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			_, _, err = querier.getResultRows(context.Background(), sq, nil, nil)
+			if c.expectErr != nil {
+				if !goErrors.Is(err, c.expectErr) {
+					t.Fatalf("unexpected error: got %v wanted %v", err, c.expectErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		})
+	}
+}
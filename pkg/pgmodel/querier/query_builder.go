@@ -11,6 +11,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/jackc/pgtype"
 	"github.com/jackc/pgx/v4"
 	"github.com/prometheus/common/model"
 	"github.com/prometheus/prometheus/pkg/labels"
@@ -34,6 +35,11 @@ const (
 	subQueryREMatchEmpty  = "NOT labels && (SELECT COALESCE(array_agg(l.id), array[]::int[]) FROM _prom_catalog.label l WHERE l.key = $%d and l.value !~ $%d)"
 	subQueryNRE           = "labels && (SELECT COALESCE(array_agg(l.id), array[]::int[]) FROM _prom_catalog.label l WHERE l.key = $%d and l.value !~ $%d)"
 	subQueryNREMatchEmpty = "NOT labels && (SELECT COALESCE(array_agg(l.id), array[]::int[]) FROM _prom_catalog.label l WHERE l.key = $%d and l.value ~ $%d)"
+	subQueryLabelIDs      = "labels && $%d::int[]"
+
+	/* Clause prefixes for the label value predicates of rewritten regex matchers */
+	subQueryPredicate           = "labels && (SELECT COALESCE(array_agg(l.id), array[]::int[]) FROM _prom_catalog.label l WHERE l.key = $%d and "
+	subQueryPredicateMatchEmpty = "NOT labels && (SELECT COALESCE(array_agg(l.id), array[]::int[]) FROM _prom_catalog.label l WHERE l.key = $%d and "
 
 	/* MULTIPLE METRIC PATH (less common case) */
 	/* The following two sql statements are for queries where the metric name is unknown in the query. The first query gets the
@@ -116,22 +122,73 @@ const (
 	) as result ON (result.value_array is not null)
 	WHERE
 	     %[3]s`
+
+	/* Aggregates the series returned by timeseriesByMetricSQLFormat, with a pushed down function, per group of
+	labels. Steps without any value in a group are skipped, like the PromQL engine does. */
+	aggregateByLabelsSQLFormat = `SELECT agg.labels, array_agg(agg.time ORDER BY agg.time), array_agg(agg.value ORDER BY agg.time)
+	FROM (
+		SELECT grp.labels, u.time, %[2]s AS value
+		FROM (%[1]s) AS series_result
+		CROSS JOIN LATERAL (
+			SELECT COALESCE(array_agg(l.id ORDER BY l.id), array[]::int[]) AS labels
+			FROM _prom_catalog.label l
+			WHERE l.id = ANY(series_result.labels) AND l.key <> '__name__' AND %[3]s
+		) AS grp
+		CROSS JOIN LATERAL unnest(series_result.time_array, series_result.value_array) AS u(time, value)
+		WHERE u.value IS NOT NULL
+		GROUP BY grp.labels, u.time
+	) AS agg
+	GROUP BY agg.labels`
 )
 
+// aggregateFunctions maps the PromQL aggregations which can be pushed down
+// to their SQL equivalent. Postgres orders NaN above all other values, so max
+// has to skip them to only return NaN if all the values are NaN, like min does.
+// avg is not pushed down, as the query engine computes an incremental mean
+// which handles infinities and overflows differently than sum/count.
+var aggregateFunctions = map[parser.ItemType]string{
+	parser.SUM:   "sum(u.value)",
+	parser.MIN:   "min(u.value)",
+	parser.MAX:   "COALESCE(max(u.value) FILTER (WHERE u.value <> 'NaN'), 'NaN')",
+	parser.COUNT: "count(u.value)::double precision",
+}
+
 var (
 	minTime = timestamp.FromTime(time.Unix(math.MinInt64/1000+62135596801, 0).UTC())
 	maxTime = timestamp.FromTime(time.Unix(math.MaxInt64/1000-62135596801, 999999999).UTC())
 )
 
 func BuildSubQueries(matchers []*labels.Matcher) (*clauseBuilder, error) {
+	return buildSubQueries(matchers, nil)
+}
+
+// buildSubQueries builds the clauses of the matchers, using the supplied label
+// IDs of the resolved matchers instead of looking them up in the database.
+func buildSubQueries(matchers []*labels.Matcher, labelIDs map[*labels.Matcher][]int64) (*clauseBuilder, error) {
 	var err error
 	cb := &clauseBuilder{}
 
 	for _, m := range matchers {
+		if ids, ok := labelIDs[m]; ok {
+			if len(ids) == 0 {
+				// None of the label values exist.
+				cb.contradiction = true
+				continue
+			}
+			if err = cb.addClause(subQueryLabelIDs, ids); err != nil {
+				return nil, err
+			}
+			cb.selective = true
+			continue
+		}
+
 		// From the PromQL docs: "Label matchers that match
 		// empty label values also select all time series that
 		// do not have the specific label set at all."
 		matchesEmpty := m.Matches("")
+		// Matchers selecting the series with any value for a label, like
+		// label!="" or label=~".+", are not selective either.
+		presence := m.Type == labels.MatchNotEqual && m.Value == ""
 
 		switch m.Type {
 		case labels.MatchEqual:
@@ -151,12 +208,21 @@ func BuildSubQueries(matchers []*labels.Matcher) (*clauseBuilder, error) {
 			}
 			err = cb.addClause(sq, m.Name, m.Value)
 		case labels.MatchRegexp:
+			if p, ok := rewriteRegex(m.Value); ok {
+				presence = len(p.args) == 0
+				err = cb.addPredicateClause(m.Name, p, true, matchesEmpty)
+				break
+			}
 			sq := subQueryRE
 			if matchesEmpty {
 				sq = subQueryREMatchEmpty
 			}
 			err = cb.addClause(sq, m.Name, anchorValue(m.Value))
 		case labels.MatchNotRegexp:
+			if p, ok := rewriteRegex(m.Value); ok {
+				err = cb.addPredicateClause(m.Name, p, false, matchesEmpty)
+				break
+			}
 			sq := subQueryNRE
 			if matchesEmpty {
 				sq = subQueryNREMatchEmpty
@@ -167,6 +233,9 @@ func BuildSubQueries(matchers []*labels.Matcher) (*clauseBuilder, error) {
 		if err != nil {
 			return nil, err
 		}
+		if !matchesEmpty && !presence {
+			cb.selective = true
+		}
 	}
 
 	return cb, err
@@ -197,8 +266,11 @@ func setParameterNumbers(clause string, existingArgs []interface{}, newArgs ...i
 type clauseBuilder struct {
 	metricName    string
 	contradiction bool
-	clauses       []string
-	args          []interface{}
+	// selective is set if a matcher only selects the series with some values
+	// of a label.
+	selective bool
+	clauses   []string
+	args      []interface{}
 }
 
 func (c *clauseBuilder) SetMetricName(name string) {
@@ -217,6 +289,13 @@ func (c *clauseBuilder) GetMetricName() string {
 	return c.metricName
 }
 
+// Selective returns false if the matchers do not narrow down the series to
+// some values of a metric name or label, so that querying them would touch
+// all metrics.
+func (c *clauseBuilder) Selective() bool {
+	return c.metricName != "" || c.selective || c.contradiction
+}
+
 func (c *clauseBuilder) addClause(clause string, args ...interface{}) error {
 	clauseWithParameters, newArgs, err := setParameterNumbers(clause, c.args, args...)
 	if err != nil {
@@ -228,6 +307,29 @@ func (c *clauseBuilder) addClause(clause string, args ...interface{}) error {
 	return nil
 }
 
+// addPredicateClause adds the clause of a regex matcher rewritten into a label
+// value predicate. Like for the regex clauses, the series without the label
+// are selected using the labels which do not match if the matcher matches the
+// empty value.
+func (c *clauseBuilder) addPredicateClause(name string, p *labelValuePredicate, matches bool, matchesEmpty bool) error {
+	if p.matchesAll {
+		if !matches {
+			c.contradiction = true
+		}
+		return nil
+	}
+
+	sq := subQueryPredicate
+	if matchesEmpty {
+		sq = subQueryPredicateMatchEmpty
+	}
+	predicate := p.clause
+	if matches == matchesEmpty {
+		predicate = "NOT (" + predicate + ")"
+	}
+	return c.addClause(sq+predicate+")", append([]interface{}{name}, p.args...)...)
+}
+
 func (c *clauseBuilder) Build(includeMetricName bool) ([]string, []interface{}, error) {
 	if c.contradiction {
 		return []string{"FALSE"}, nil, nil
@@ -252,10 +354,16 @@ func (c *clauseBuilder) Build(includeMetricName bool) ([]string, []interface{},
 	return c.clauses, c.args, nil
 }
 
+// buildTimeSeries converts the rows into protobuf time series, releasing the
+// samples of every row once converted.
 func buildTimeSeries(rows []timescaleRow, lr lreader.LabelsReader) ([]*prompb.TimeSeries, error) {
 	results := make([]*prompb.TimeSeries, 0, len(rows))
+	if err := resolveLabels(rows, lr); err != nil {
+		return nil, err
+	}
 
-	for _, row := range rows {
+	for i := range rows {
+		row := rows[i]
 		if row.err != nil {
 			return nil, row.err
 		}
@@ -264,31 +372,50 @@ func buildTimeSeries(rows []timescaleRow, lr lreader.LabelsReader) ([]*prompb.Ti
 			return nil, errors.ErrQueryMismatchTimestampValue
 		}
 
-		promLabels, err := lr.PrompbLabelsForIds(row.labelIds)
-		if err != nil {
-			return nil, err
-		}
-
-		sort.Slice(promLabels, func(i, j int) bool {
-			return promLabels[i].Name < promLabels[j].Name
-		})
+		results = append(results, toTimeSeries(row.labels, row.times, row.values))
+		rows[i] = timescaleRow{}
+	}
 
-		result := &prompb.TimeSeries{
-			Labels:  promLabels,
-			Samples: make([]prompb.Sample, 0, len(row.times.Elements)),
-		}
+	return results, nil
+}
 
-		for i := range row.times.Elements {
-			result.Samples = append(result.Samples, prompb.Sample{
-				Timestamp: pgmodel.TimestamptzToMs(row.times.Elements[i]),
-				Value:     row.values.Elements[i].Float,
-			})
+// buildTimeSeriesFromSet converts the series of the set into protobuf time
+// series as it is iterated.
+func buildTimeSeriesFromSet(ss storage.SeriesSet) ([]*prompb.TimeSeries, error) {
+	results := make([]*prompb.TimeSeries, 0)
+	for ss.Next() {
+		s, ok := ss.At().(*pgxSeries)
+		if !ok {
+			break
 		}
+		results = append(results, toTimeSeries(s.labels, s.times, s.values))
+	}
+	if err := ss.Err(); err != nil {
+		return nil, err
+	}
+	return results, nil
+}
 
-		results = append(results, result)
+func toTimeSeries(lls labels.Labels, times pgtype.TimestamptzArray, values pgtype.Float8Array) *prompb.TimeSeries {
+	promLabels := make([]prompb.Label, 0, len(lls))
+	for _, l := range lls {
+		promLabels = append(promLabels, prompb.Label{Name: l.Name, Value: l.Value})
 	}
+	sort.Slice(promLabels, func(i, j int) bool {
+		return promLabels[i].Name < promLabels[j].Name
+	})
 
-	return results, nil
+	result := &prompb.TimeSeries{
+		Labels:  promLabels,
+		Samples: make([]prompb.Sample, 0, len(times.Elements)),
+	}
+	for i := range times.Elements {
+		result.Samples = append(result.Samples, prompb.Sample{
+			Timestamp: pgmodel.TimestamptzToMs(times.Elements[i]),
+			Value:     values.Elements[i].Float,
+		})
+	}
+	return result
 }
 
 func BuildMetricNameSeriesIDQuery(cases []string) string {
@@ -326,8 +453,13 @@ func buildTimeseriesByLabelClausesQuery(filter metricTimeRangeFilter, cases []st
 		return "", nil, nil, err
 	}
 
+	dataTable := pgx.Identifier{schema.Data, filter.metric}.Sanitize()
+	if filter.rollup != nil {
+		dataTable = filter.rollup.source(filter.metric, filter.startTime, filter.endTime)
+	}
+
 	finalSQL := fmt.Sprintf(timeseriesByMetricSQLFormat,
-		pgx.Identifier{schema.Data, filter.metric}.Sanitize(),
+		dataTable,
 		pgx.Identifier{schema.DataSeries, filter.metric}.Sanitize(),
 		strings.Join(cases, " AND "),
 		filter.startTime,
@@ -336,6 +468,19 @@ func buildTimeseriesByLabelClausesQuery(filter metricTimeRangeFilter, cases []st
 		valueClauseBound,
 	)
 
+	if qf.aggregate != nil {
+		groupClause := "l.key = ANY($%d)"
+		if qf.aggregate.without {
+			groupClause = "l.key <> ALL($%d)"
+		}
+		groupClauseBound, groupValues, err := setParameterNumbers(groupClause, values, qf.aggregate.grouping)
+		if err != nil {
+			return "", nil, nil, err
+		}
+		finalSQL = fmt.Sprintf(aggregateByLabelsSQLFormat, finalSQL, qf.aggregate.function, groupClauseBound)
+		values = groupValues
+	}
+
 	return finalSQL, values, node, nil
 }
 
@@ -354,37 +499,45 @@ type aggregators struct {
 	timeParams  []interface{}
 	valueClause string
 	valueParams []interface{}
+	aggregate   *aggregateByLabels
+}
+
+// aggregateByLabels is an aggregation over the results of a pushed down
+// function.
+type aggregateByLabels struct {
+	function string
+	grouping []string
+	without  bool
 }
 
 /* The path is the list of ancestors (direct parent last) returned node is the most-ancestral node processed by the pushdown */
 func getAggregators(hints *storage.SelectHints, path []parser.Node) (*aggregators, parser.Node, error) {
-	if extension.ExtensionIsInstalled && path != nil && hints != nil && len(path) >= 2 && !hasSubquery(path) {
-		var topNode parser.Node
-
+	if path != nil && hints != nil && len(path) >= 2 && !hasSubquery(path) && canPushdownSelector(path) {
 		node := path[len(path)-2]
 		switch n := node.(type) {
 		case *parser.Call:
-			if n.Func.Name == "delta" {
-				topNode = node
-				queryStart := hints.Start + hints.Range
-				queryEnd := hints.End
-				stepDuration := time.Second
-				rangeDuration := time.Duration(hints.Range) * time.Millisecond
-
-				if hints.Step > 0 {
-					stepDuration = time.Duration(hints.Step) * time.Millisecond
-				} else {
-					if queryStart != queryEnd {
-						panic("query start should equal query end")
-					}
+			switch n.Func.Name {
+			case "delta":
+				if extension.ExtensionIsInstalled {
+					qf := pushdownAggregators(hints, "prom_delta($%d, $%d,$%d, $%d, time, value)",
+						func(_, end time.Time, step, rng time.Duration) []interface{} {
+							return []interface{}{model.Time(hints.Start).Time(), end, int64(step.Milliseconds()), int64(rng.Milliseconds())}
+						})
+					return withAggregation(qf, node, path)
 				}
-				qf := aggregators{
-					timeClause:  "ARRAY(SELECT generate_series($%d::timestamptz, $%d::timestamptz, $%d))",
-					timeParams:  []interface{}{model.Time(queryStart).Time(), model.Time(queryEnd).Time(), stepDuration},
-					valueClause: "prom_delta($%d, $%d,$%d, $%d, time, value)",
-					valueParams: []interface{}{model.Time(hints.Start).Time(), model.Time(queryEnd).Time(), int64(stepDuration.Milliseconds()), int64(rangeDuration.Milliseconds())},
-				}
-				return &qf, topNode, nil
+			case "rate", "increase":
+				isRate := n.Func.Name == "rate"
+				qf := pushdownAggregators(hints, "_prom_catalog.prom_extrapolated_rate($%d, $%d, $%d, $%d, $%d, $%d, $%d, array_agg(time), array_agg(value))",
+					func(start, end time.Time, step, rng time.Duration) []interface{} {
+						return []interface{}{start, end, int64(step.Milliseconds()), int64(rng.Milliseconds()), rng.Seconds(), true, isRate}
+					})
+				return withAggregation(qf, node, path)
+			case "irate":
+				qf := pushdownAggregators(hints, "_prom_catalog.prom_instant_value($%d, $%d, $%d, $%d, $%d, array_agg(time), array_agg(value))",
+					func(start, end time.Time, step, rng time.Duration) []interface{} {
+						return []interface{}{start, end, int64(step.Milliseconds()), int64(rng.Milliseconds()), true}
+					})
+				return withAggregation(qf, node, path)
 			}
 		default:
 			//No pushdown optimization by default
@@ -398,6 +551,75 @@ func getAggregators(hints *storage.SelectHints, path []parser.Node) (*aggregator
 	return &qf, nil, nil
 }
 
+// withAggregation also pushes down the aggregation directly above the pushed
+// down function node, if it is supported. Aggregations of raw selectors are not
+// pushed down since they would need the lookback and staleness handling of the
+// PromQL engine.
+func withAggregation(qf *aggregators, node parser.Node, path []parser.Node) (*aggregators, parser.Node, error) {
+	if len(path) < 3 {
+		return qf, node, nil
+	}
+	agg, ok := path[len(path)-3].(*parser.AggregateExpr)
+	if !ok || agg.Param != nil {
+		return qf, node, nil
+	}
+	function, ok := aggregateFunctions[agg.Op]
+	if !ok {
+		return qf, node, nil
+	}
+	grouping := agg.Grouping
+	if grouping == nil {
+		grouping = []string{}
+	}
+	qf.aggregate = &aggregateByLabels{
+		function: function,
+		grouping: grouping,
+		without:  agg.Without,
+	}
+	return qf, agg, nil
+}
+
+// pushdownAggregators returns the aggregators evaluating a range vector
+// function at every step of the query. The value clause must return one value,
+// or NULL, per step.
+func pushdownAggregators(hints *storage.SelectHints, valueClause string,
+	valueParams func(start, end time.Time, step, rng time.Duration) []interface{}) *aggregators {
+	queryStart := hints.Start + hints.Range
+	queryEnd := hints.End
+	stepDuration := time.Second
+	rangeDuration := time.Duration(hints.Range) * time.Millisecond
+
+	if hints.Step > 0 {
+		stepDuration = time.Duration(hints.Step) * time.Millisecond
+	} else {
+		if queryStart != queryEnd {
+			panic("query start should equal query end")
+		}
+	}
+	start, end := model.Time(queryStart).Time(), model.Time(queryEnd).Time()
+	return &aggregators{
+		timeClause:  "ARRAY(SELECT generate_series($%d::timestamptz, $%d::timestamptz, $%d))",
+		timeParams:  []interface{}{start, end, stepDuration},
+		valueClause: valueClause,
+		valueParams: valueParams(start, end, stepDuration, rangeDuration),
+	}
+}
+
+// canPushdownSelector returns false if the selector at the end of the path
+// is evaluated at other times than the query steps: the pushed down functions
+// only know about the query steps.
+func canPushdownSelector(path []parser.Node) bool {
+	ms, ok := path[len(path)-1].(*parser.MatrixSelector)
+	if !ok {
+		return false
+	}
+	vs, ok := ms.VectorSelector.(*parser.VectorSelector)
+	if !ok {
+		return false
+	}
+	return vs.OriginalOffset == 0 && vs.Offset == 0 && vs.Timestamp == nil && vs.StartOrEnd == 0
+}
+
 func GetSeriesPerMetric(rows pgx.Rows) ([]string, [][]pgmodel.SeriesID, error) {
 	metrics := make([]string, 0)
 	series := make([][]pgmodel.SeriesID, 0)
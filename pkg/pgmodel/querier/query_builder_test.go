@@ -0,0 +1,240 @@
+// This file and its contents are licensed under the Apache License 2.0.
+// Please see the included NOTICE for copyright information and
+// LICENSE for a copy of the license.
+
+package querier
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/prometheus/common/model"
+	"github.com/prometheus/prometheus/promql/parser"
+	"github.com/prometheus/prometheus/storage"
+)
+
+func TestGetAggregators(t *testing.T) {
+	var (
+		start = model.Time(100000).Time()
+		end   = model.Time(200000).Time()
+		hints = &storage.SelectHints{Start: 40000, End: 200000, Step: 10000, Range: 60000}
+	)
+
+	testCases := []struct {
+		name        string
+		query       string
+		hints       *storage.SelectHints
+		pushdown    bool
+		valueClause string
+		valueParams []interface{}
+		aggregate   *aggregateByLabels
+	}{
+		{
+			name:  "no hints",
+			query: "rate(foo[1m])",
+		},
+		{
+			name:  "vector selector",
+			query: "abs(foo)",
+			hints: hints,
+		},
+		{
+			name:  "unsupported function",
+			query: "avg_over_time(foo[1m])",
+			hints: hints,
+		},
+		{
+			name:  "delta without the extension",
+			query: "delta(foo[1m])",
+			hints: hints,
+		},
+		{
+			name:  "offset",
+			query: "rate(foo[1m] offset 5m)",
+			hints: hints,
+		},
+		{
+			name:  "at modifier",
+			query: "rate(foo[1m] @ 100)",
+			hints: hints,
+		},
+		{
+			name:  "subquery",
+			query: "max_over_time(rate(foo[1m])[5m:1m])",
+			hints: hints,
+		},
+		{
+			name:        "rate",
+			query:       "rate(foo[1m])",
+			hints:       hints,
+			pushdown:    true,
+			valueClause: "_prom_catalog.prom_extrapolated_rate($%d, $%d, $%d, $%d, $%d, $%d, $%d, array_agg(time), array_agg(value))",
+			valueParams: []interface{}{start, end, int64(10000), int64(60000), float64(60), true, true},
+		},
+		{
+			name:        "increase",
+			query:       "increase(foo[1m])",
+			hints:       hints,
+			pushdown:    true,
+			valueClause: "_prom_catalog.prom_extrapolated_rate($%d, $%d, $%d, $%d, $%d, $%d, $%d, array_agg(time), array_agg(value))",
+			valueParams: []interface{}{start, end, int64(10000), int64(60000), float64(60), true, false},
+		},
+		{
+			name:        "irate",
+			query:       "irate(foo[1m])",
+			hints:       hints,
+			pushdown:    true,
+			valueClause: "_prom_catalog.prom_instant_value($%d, $%d, $%d, $%d, $%d, array_agg(time), array_agg(value))",
+			valueParams: []interface{}{start, end, int64(10000), int64(60000), true},
+		},
+		{
+			name:  "aggregation of a selector",
+			query: "sum by (job) (foo)",
+			hints: hints,
+		},
+		{
+			name:        "sum by",
+			query:       "sum by (job) (rate(foo[1m]))",
+			hints:       hints,
+			pushdown:    true,
+			valueClause: "_prom_catalog.prom_extrapolated_rate($%d, $%d, $%d, $%d, $%d, $%d, $%d, array_agg(time), array_agg(value))",
+			valueParams: []interface{}{start, end, int64(10000), int64(60000), float64(60), true, true},
+			aggregate:   &aggregateByLabels{function: "sum(u.value)", grouping: []string{"job"}},
+		},
+		{
+			name:        "count without grouping",
+			query:       "count(increase(foo[1m]))",
+			hints:       hints,
+			pushdown:    true,
+			valueClause: "_prom_catalog.prom_extrapolated_rate($%d, $%d, $%d, $%d, $%d, $%d, $%d, array_agg(time), array_agg(value))",
+			valueParams: []interface{}{start, end, int64(10000), int64(60000), float64(60), true, false},
+			aggregate:   &aggregateByLabels{function: "count(u.value)::double precision", grouping: []string{}},
+		},
+		{
+			name:        "max without",
+			query:       "max without (instance) (irate(foo[1m]))",
+			hints:       hints,
+			pushdown:    true,
+			valueClause: "_prom_catalog.prom_instant_value($%d, $%d, $%d, $%d, $%d, array_agg(time), array_agg(value))",
+			valueParams: []interface{}{start, end, int64(10000), int64(60000), true},
+			aggregate:   &aggregateByLabels{function: "COALESCE(max(u.value) FILTER (WHERE u.value <> 'NaN'), 'NaN')", grouping: []string{"instance"}, without: true},
+		},
+		{
+			name:        "avg",
+			query:       "avg by (job) (rate(foo[1m]))",
+			hints:       hints,
+			pushdown:    true,
+			valueClause: "_prom_catalog.prom_extrapolated_rate($%d, $%d, $%d, $%d, $%d, $%d, $%d, array_agg(time), array_agg(value))",
+			valueParams: []interface{}{start, end, int64(10000), int64(60000), float64(60), true, true},
+		},
+		{
+			name:        "unsupported aggregation",
+			query:       "topk(3, rate(foo[1m]))",
+			hints:       hints,
+			pushdown:    true,
+			valueClause: "_prom_catalog.prom_extrapolated_rate($%d, $%d, $%d, $%d, $%d, $%d, $%d, array_agg(time), array_agg(value))",
+			valueParams: []interface{}{start, end, int64(10000), int64(60000), float64(60), true, true},
+		},
+		{
+			name:        "instant query",
+			query:       "rate(foo[1m])",
+			hints:       &storage.SelectHints{Start: 140000, End: 200000, Range: 60000},
+			pushdown:    true,
+			valueClause: "_prom_catalog.prom_extrapolated_rate($%d, $%d, $%d, $%d, $%d, $%d, $%d, array_agg(time), array_agg(value))",
+			valueParams: []interface{}{end, end, int64(1000), int64(60000), float64(60), true, true},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			expr, err := parser.ParseExpr(tc.query)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			var path []parser.Node
+			parser.Inspect(expr, func(node parser.Node, p []parser.Node) error {
+				if _, ok := node.(*parser.VectorSelector); ok {
+					path = append([]parser.Node{}, p...)
+				}
+				return nil
+			})
+
+			qf, node, err := getAggregators(tc.hints, path)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if !tc.pushdown {
+				if node != nil {
+					t.Fatalf("unexpected pushdown of %s", node)
+				}
+				if qf.timeClause != "array_agg(time)" || qf.valueClause != "array_agg(value)" {
+					t.Fatalf("unexpected aggregators: %+v", qf)
+				}
+				return
+			}
+
+			expectedNode := path[len(path)-2]
+			if tc.aggregate != nil {
+				expectedNode = path[len(path)-3]
+			}
+			if node != expectedNode {
+				t.Fatalf("unexpected pushed down node: got %v wanted %v", node, expectedNode)
+			}
+			if !reflect.DeepEqual(qf.aggregate, tc.aggregate) {
+				t.Errorf("unexpected aggregate: got %+v wanted %+v", qf.aggregate, tc.aggregate)
+			}
+			step := time.Duration(tc.hints.Step) * time.Millisecond
+			if step == 0 {
+				step = time.Second
+			}
+			expectedTimeParams := []interface{}{tc.valueParams[0], tc.valueParams[1], step}
+			if !reflect.DeepEqual(qf.timeParams, expectedTimeParams) {
+				t.Errorf("unexpected time params: got %v wanted %v", qf.timeParams, expectedTimeParams)
+			}
+			if qf.valueClause != tc.valueClause {
+				t.Errorf("unexpected value clause: got %s wanted %s", qf.valueClause, tc.valueClause)
+			}
+			if !reflect.DeepEqual(qf.valueParams, tc.valueParams) {
+				t.Errorf("unexpected value params: got %v wanted %v", qf.valueParams, tc.valueParams)
+			}
+		})
+	}
+}
+
+func TestBuildAggregatedQuery(t *testing.T) {
+	expr, err := parser.ParseExpr("sum without (instance) (rate(foo[1m]))")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var path []parser.Node
+	parser.Inspect(expr, func(node parser.Node, p []parser.Node) error {
+		if _, ok := node.(*parser.VectorSelector); ok {
+			path = append([]parser.Node{}, p...)
+		}
+		return nil
+	})
+
+	filter := metricTimeRangeFilter{metric: "foo", startTime: "start", endTime: "end"}
+	hints := &storage.SelectHints{Start: 40000, End: 200000, Step: 10000, Range: 60000}
+	sql, values, node, err := buildTimeseriesByLabelClausesQuery(filter, []string{"labels && $1"}, []interface{}{"x"}, hints, path)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if node != expr {
+		t.Errorf("unexpected pushed down node: got %v wanted %v", node, expr)
+	}
+	if len(values) != 12 {
+		t.Fatalf("unexpected number of values: %d", len(values))
+	}
+	if !reflect.DeepEqual(values[11], []string{"instance"}) {
+		t.Errorf("unexpected grouping value: %v", values[11])
+	}
+	for _, expected := range []string{"sum(u.value) AS value", "l.key <> ALL($12)", "_prom_catalog.prom_extrapolated_rate($5, $6, $7, $8, $9, $10, $11"} {
+		if !strings.Contains(sql, expected) {
+			t.Errorf("expected %q in query:\n%s", expected, sql)
+		}
+	}
+}
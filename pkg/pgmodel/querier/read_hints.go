@@ -0,0 +1,66 @@
+// This file and its contents are licensed under the Apache License 2.0.
+// Please see the included NOTICE for copyright information and
+// LICENSE for a copy of the license.
+
+package querier
+
+import (
+	"time"
+
+	"github.com/prometheus/prometheus/promql/parser"
+	"github.com/prometheus/prometheus/storage"
+	"github.com/timescale/promscale/pkg/prompb"
+)
+
+// readHintsAggregations are the aggregations remote read hints can name.
+var readHintsAggregations = map[string]parser.ItemType{
+	"sum":          parser.SUM,
+	"avg":          parser.AVG,
+	"count":        parser.COUNT,
+	"min":          parser.MIN,
+	"max":          parser.MAX,
+	"group":        parser.GROUP,
+	"stddev":       parser.STDDEV,
+	"stdvar":       parser.STDVAR,
+	"topk":         parser.TOPK,
+	"bottomk":      parser.BOTTOMK,
+	"count_values": parser.COUNT_VALUES,
+	"quantile":     parser.QUANTILE,
+}
+
+// fromReadHints translates the hints of a remote read query into the select
+// hints of the PromQL engine, and the node path of the selector they describe.
+// The path only holds the function or aggregation the selector is evaluated
+// in, and its matrix selector, since the rest of the PromQL query is not sent
+// by the client. It is nil if the hints don't name a function or aggregation.
+func fromReadHints(h *prompb.ReadHints) (*storage.SelectHints, []parser.Node) {
+	if h == nil {
+		return nil, nil
+	}
+	hints := &storage.SelectHints{
+		Start:    h.StartMs,
+		End:      h.EndMs,
+		Step:     h.StepMs,
+		Func:     h.Func,
+		Grouping: h.Grouping,
+		By:       h.By,
+		Range:    h.RangeMs,
+	}
+
+	var node parser.Node
+	if f, ok := parser.Functions[h.Func]; ok {
+		node = &parser.Call{Func: f}
+	} else if op, ok := readHintsAggregations[h.Func]; ok {
+		node = &parser.AggregateExpr{Op: op, Grouping: h.Grouping, Without: !h.By && len(h.Grouping) > 0}
+	} else {
+		return hints, nil
+	}
+	if h.RangeMs == 0 {
+		return hints, []parser.Node{node}
+	}
+	matrix := &parser.MatrixSelector{
+		VectorSelector: &parser.VectorSelector{},
+		Range:          time.Duration(h.RangeMs) * time.Millisecond,
+	}
+	return hints, []parser.Node{node, matrix}
+}
@@ -0,0 +1,128 @@
+// This file and its contents are licensed under the Apache License 2.0.
+// Please see the included NOTICE for copyright information and
+// LICENSE for a copy of the license.
+
+package querier
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/prometheus/prometheus/promql/parser"
+	"github.com/prometheus/prometheus/storage"
+	"github.com/timescale/promscale/pkg/clockcache"
+	"github.com/timescale/promscale/pkg/pgmodel/lreader"
+	"github.com/timescale/promscale/pkg/pgmodel/model"
+	"github.com/timescale/promscale/pkg/prompb"
+)
+
+func TestFromReadHints(t *testing.T) {
+	testCases := []struct {
+		name          string
+		readHints     *prompb.ReadHints
+		expectedHints *storage.SelectHints
+		expectedPath  []parser.Node
+	}{
+		{
+			name: "no hints",
+		},
+		{
+			name:          "no function",
+			readHints:     &prompb.ReadHints{StartMs: 1000, EndMs: 2000, StepMs: 10},
+			expectedHints: &storage.SelectHints{Start: 1000, End: 2000, Step: 10},
+		},
+		{
+			name:          "series",
+			readHints:     &prompb.ReadHints{StartMs: 1000, EndMs: 2000, Func: "series"},
+			expectedHints: &storage.SelectHints{Start: 1000, End: 2000, Func: "series"},
+		},
+		{
+			name:          "aggregation",
+			readHints:     &prompb.ReadHints{StartMs: 1000, EndMs: 2000, StepMs: 10, Func: "sum", Grouping: []string{"job"}, By: true},
+			expectedHints: &storage.SelectHints{Start: 1000, End: 2000, Step: 10, Func: "sum", Grouping: []string{"job"}, By: true},
+			expectedPath:  []parser.Node{&parser.AggregateExpr{Op: parser.SUM, Grouping: []string{"job"}}},
+		},
+		{
+			name:          "aggregation without labels",
+			readHints:     &prompb.ReadHints{Func: "max", Grouping: []string{"instance"}},
+			expectedHints: &storage.SelectHints{Func: "max", Grouping: []string{"instance"}},
+			expectedPath:  []parser.Node{&parser.AggregateExpr{Op: parser.MAX, Grouping: []string{"instance"}, Without: true}},
+		},
+		{
+			name:          "range vector function",
+			readHints:     &prompb.ReadHints{StartMs: 1000, EndMs: 2000, StepMs: 10, Func: "rate", RangeMs: 300000},
+			expectedHints: &storage.SelectHints{Start: 1000, End: 2000, Step: 10, Func: "rate", Range: 300000},
+			expectedPath: []parser.Node{
+				&parser.Call{Func: parser.Functions["rate"]},
+				&parser.MatrixSelector{VectorSelector: &parser.VectorSelector{}, Range: 5 * time.Minute},
+			},
+		},
+	}
+
+	for _, c := range testCases {
+		t.Run(c.name, func(t *testing.T) {
+			hints, path := fromReadHints(c.readHints)
+			if !reflect.DeepEqual(hints, c.expectedHints) {
+				t.Errorf("unexpected hints: got %+v wanted %+v", hints, c.expectedHints)
+			}
+			if !reflect.DeepEqual(path, c.expectedPath) {
+				t.Errorf("unexpected path: got %v wanted %v", path, c.expectedPath)
+			}
+		})
+	}
+}
+
+func TestQueryWithReadHints(t *testing.T) {
+	watermark := time.Unix(7200, 0).UTC()
+	filter := metricTimeRangeFilter{
+		metric:    "foo",
+		startTime: toRFC3339Nano(3600 * 1000),
+		endTime:   toRFC3339Nano(14400 * 1000),
+		rollup:    &rollup{resolution: time.Hour, column: rollupCounter, watermark: watermark},
+	}
+	// The rollup is read from, but rate is not pushed down.
+	dataSQL, _, _, err := buildTimeseriesByLabelClausesQuery(filter, []string{"TRUE"}, nil, nil, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	conn := model.NewSqlRecorder([]model.SqlQuery{
+		{
+			Sql:     getRollupWatermarksSQL,
+			Args:    []interface{}{"foo"},
+			Results: model.RowResults{{time.Hour.Milliseconds(), watermark}},
+		},
+		{
+			Sql:     dataSQL,
+			Results: model.RowResults{},
+		},
+	}, t)
+	querier := pgxQuerier{
+		conn:             conn,
+		metricTableNames: &model.MockMetricCache{MetricCache: map[string]string{"foo": "foo"}},
+		labelsReader:     lreader.NewLabelsReader(conn, clockcache.WithMax(0)),
+		rollups:          newRollupSelector(conn, []time.Duration{time.Hour}),
+	}
+
+	result, err := querier.Query(&prompb.Query{
+		StartTimestampMs: 3600 * 1000,
+		EndTimestampMs:   14400 * 1000,
+		Matchers: []*prompb.LabelMatcher{
+			{Type: prompb.LabelMatcher_EQ, Name: model.MetricNameLabelName, Value: "foo"},
+		},
+		Hints: &prompb.ReadHints{
+			StartMs: 3600 * 1000,
+			EndMs:   14400 * 1000,
+			StepMs:  time.Hour.Milliseconds(),
+			Func:    "rate",
+			RangeMs: (2 * time.Hour).Milliseconds(),
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result) != 0 {
+		t.Errorf("unexpected result: %v", result)
+	}
+}
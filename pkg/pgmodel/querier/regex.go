@@ -0,0 +1,168 @@
+// This file and its contents are licensed under the Apache License 2.0.
+// Please see the included NOTICE for copyright information and
+// LICENSE for a copy of the license.
+
+package querier
+
+import (
+	"regexp/syntax"
+	"strings"
+)
+
+// maxRegexSetValues is the maximum number of literal values a regex is
+// rewritten into a set lookup for.
+const maxRegexSetValues = 10000
+
+// labelValuePredicate is a condition on the label values, in SQL, equivalent
+// to fully matching a regex. The clause has a %d placeholder per argument.
+type labelValuePredicate struct {
+	clause string
+	args   []interface{}
+	// matchesAll is set if every value matches, so no condition is needed.
+	matchesAll bool
+}
+
+// rewriteRegex returns a predicate matching the same label values as the
+// regex, fully anchored like PromQL regexes, without using a regex scan. This
+// is possible for sets of literal values ("a|b|c") and literal prefixes
+// ("foo.*", "foo.+", ".*", ".+").
+func rewriteRegex(pattern string) (*labelValuePredicate, bool) {
+	re, err := syntax.Parse(pattern, syntax.Perl)
+	if err != nil {
+		return nil, false
+	}
+	re = re.Simplify()
+
+	if values, ok := expandLiterals(re, maxRegexSetValues); ok {
+		return &labelValuePredicate{clause: "l.value = ANY($%d::text[])", args: []interface{}{values}}, true
+	}
+	return rewritePrefix(re)
+}
+
+// rewritePrefix rewrites a literal prefix followed by .* or .+ into LIKE
+// conditions. Unless the s flag is set, the dot doesn't match a new line.
+func rewritePrefix(re *syntax.Regexp) (*labelValuePredicate, bool) {
+	subs := []*syntax.Regexp{re}
+	if re.Op == syntax.OpConcat {
+		subs = re.Sub
+	}
+	last := subs[len(subs)-1]
+	if last.Op != syntax.OpStar && last.Op != syntax.OpPlus {
+		return nil, false
+	}
+	var dotAll bool
+	switch last.Sub[0].Op {
+	case syntax.OpAnyChar:
+		dotAll = true
+	case syntax.OpAnyCharNotNL:
+	default:
+		return nil, false
+	}
+
+	var prefix strings.Builder
+	for _, sub := range subs[:len(subs)-1] {
+		values, ok := expandLiterals(sub, 1)
+		if !ok || len(values) != 1 {
+			return nil, false
+		}
+		prefix.WriteString(values[0])
+	}
+	// The new line check below applies to the whole value.
+	if !dotAll && strings.Contains(prefix.String(), "\n") {
+		return nil, false
+	}
+
+	var (
+		conditions []string
+		args       []interface{}
+	)
+	switch {
+	case prefix.Len() > 0:
+		pattern := escapeLike(prefix.String()) + "%"
+		if last.Op == syntax.OpPlus {
+			pattern = escapeLike(prefix.String()) + "_%"
+		}
+		conditions = append(conditions, "l.value LIKE $%d")
+		args = append(args, pattern)
+	case last.Op == syntax.OpPlus:
+		conditions = append(conditions, "l.value <> ''")
+	}
+	if !dotAll {
+		conditions = append(conditions, "position(chr(10) in l.value) = 0")
+	}
+	if len(conditions) == 0 {
+		return &labelValuePredicate{matchesAll: true}, true
+	}
+	return &labelValuePredicate{clause: strings.Join(conditions, " AND "), args: args}, true
+}
+
+// escapeLike escapes the LIKE wildcards, and the default escape character.
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(s)
+}
+
+// regexValues returns the values fully matched by the regex if they are a set
+// of at most max literal values, e.g. for "foo|ba[rz]".
+func regexValues(pattern string, max int) ([]string, bool) {
+	if max <= 0 {
+		return nil, false
+	}
+	re, err := syntax.Parse(pattern, syntax.Perl)
+	if err != nil {
+		return nil, false
+	}
+	return expandLiterals(re.Simplify(), max)
+}
+
+func expandLiterals(re *syntax.Regexp, max int) ([]string, bool) {
+	if re.Flags&syntax.FoldCase != 0 {
+		return nil, false
+	}
+	switch re.Op {
+	case syntax.OpEmptyMatch:
+		return []string{""}, true
+	case syntax.OpLiteral:
+		return []string{string(re.Rune)}, true
+	case syntax.OpCapture:
+		return expandLiterals(re.Sub[0], max)
+	case syntax.OpCharClass:
+		var values []string
+		for i := 0; i < len(re.Rune); i += 2 {
+			if int(re.Rune[i+1]-re.Rune[i])+len(values) >= max {
+				return nil, false
+			}
+			for c := re.Rune[i]; c <= re.Rune[i+1]; c++ {
+				values = append(values, string(c))
+			}
+		}
+		return values, true
+	case syntax.OpAlternate:
+		var values []string
+		for _, sub := range re.Sub {
+			subValues, ok := expandLiterals(sub, max)
+			if !ok || len(values)+len(subValues) > max {
+				return nil, false
+			}
+			values = append(values, subValues...)
+		}
+		return values, true
+	case syntax.OpConcat:
+		values := []string{""}
+		for _, sub := range re.Sub {
+			subValues, ok := expandLiterals(sub, max)
+			if !ok || len(values)*len(subValues) > max {
+				return nil, false
+			}
+			concat := make([]string, 0, len(values)*len(subValues))
+			for _, prefix := range values {
+				for _, suffix := range subValues {
+					concat = append(concat, prefix+suffix)
+				}
+			}
+			values = concat
+		}
+		return values, true
+	default:
+		return nil, false
+	}
+}
@@ -0,0 +1,205 @@
+// This file and its contents are licensed under the Apache License 2.0.
+// Please see the included NOTICE for copyright information and
+// LICENSE for a copy of the license.
+
+package querier
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/prometheus/prometheus/pkg/labels"
+)
+
+func TestRegexValues(t *testing.T) {
+	testCases := []struct {
+		pattern string
+		values  []string
+	}{
+		{pattern: "foo", values: []string{"foo"}},
+		{pattern: "foo|bar", values: []string{"foo", "bar"}},
+		{pattern: "(foo|bar)", values: []string{"foo", "bar"}},
+		{pattern: "ba[rz]", values: []string{"bar", "baz"}},
+		{pattern: "api-(eu|us)-[12]", values: []string{"api-eu-1", "api-eu-2", "api-us-1", "api-us-2"}},
+		{pattern: "foo.*"},
+		{pattern: "foo.+"},
+		{pattern: "(?i)foo"},
+		{pattern: "[a-z]"},
+		{pattern: "a|b|c|d|e|f|g|h|i"},
+		{pattern: "[a-d][a-d]"},
+		{pattern: "foo("},
+	}
+
+	for _, c := range testCases {
+		t.Run(c.pattern, func(t *testing.T) {
+			values, ok := regexValues(c.pattern, 8)
+			if ok != (c.values != nil) {
+				t.Fatalf("unexpected resolution: got %v wanted %v", values, c.values)
+			}
+			if !reflect.DeepEqual(values, c.values) {
+				t.Errorf("unexpected values: got %v wanted %v", values, c.values)
+			}
+		})
+	}
+
+	if _, ok := regexValues("foo|bar", 0); ok {
+		t.Errorf("regex resolved with no values allowed")
+	}
+}
+
+func TestRewriteRegex(t *testing.T) {
+	testCases := []struct {
+		pattern   string
+		rewritten bool
+		predicate labelValuePredicate
+	}{
+		{
+			pattern:   "a|b|c",
+			rewritten: true,
+			predicate: labelValuePredicate{clause: "l.value = ANY($%d::text[])", args: []interface{}{[]string{"a", "b", "c"}}},
+		},
+		{
+			pattern:   "api|web|",
+			rewritten: true,
+			predicate: labelValuePredicate{clause: "l.value = ANY($%d::text[])", args: []interface{}{[]string{"api", "web", ""}}},
+		},
+		{
+			pattern:   "",
+			rewritten: true,
+			predicate: labelValuePredicate{clause: "l.value = ANY($%d::text[])", args: []interface{}{[]string{""}}},
+		},
+		{
+			pattern:   "foo.*",
+			rewritten: true,
+			predicate: labelValuePredicate{clause: "l.value LIKE $%d AND position(chr(10) in l.value) = 0", args: []interface{}{"foo%"}},
+		},
+		{
+			pattern:   "f_o%o.+",
+			rewritten: true,
+			predicate: labelValuePredicate{clause: "l.value LIKE $%d AND position(chr(10) in l.value) = 0", args: []interface{}{`f\_o\%o_%`}},
+		},
+		{
+			pattern:   "(?s)(foo)bar.*",
+			rewritten: true,
+			predicate: labelValuePredicate{clause: "l.value LIKE $%d", args: []interface{}{"foobar%"}},
+		},
+		{
+			pattern:   ".*",
+			rewritten: true,
+			predicate: labelValuePredicate{clause: "position(chr(10) in l.value) = 0"},
+		},
+		{
+			pattern:   ".+",
+			rewritten: true,
+			predicate: labelValuePredicate{clause: "l.value <> '' AND position(chr(10) in l.value) = 0"},
+		},
+		{
+			pattern:   "(?s).*",
+			rewritten: true,
+			predicate: labelValuePredicate{matchesAll: true},
+		},
+		{
+			pattern:   "(?s).+",
+			rewritten: true,
+			predicate: labelValuePredicate{clause: "l.value <> ''"},
+		},
+		{pattern: "(?i)foo.*"},
+		{pattern: "foo\n.*"},
+		{pattern: "(foo|bar).*"},
+		{pattern: ".*foo"},
+		{pattern: "foo[0-9]+"},
+		{pattern: "^foo$"},
+	}
+
+	for _, c := range testCases {
+		t.Run(c.pattern, func(t *testing.T) {
+			p, ok := rewriteRegex(c.pattern)
+			if ok != c.rewritten {
+				t.Fatalf("unexpected rewrite: got %v wanted %v", ok, c.rewritten)
+			}
+			if !ok {
+				return
+			}
+			if !reflect.DeepEqual(*p, c.predicate) {
+				t.Errorf("unexpected predicate: got %+v wanted %+v", *p, c.predicate)
+			}
+		})
+	}
+}
+
+func TestBuildSubQueriesRegexRewrite(t *testing.T) {
+	const labelSubQuery = "labels && (SELECT COALESCE(array_agg(l.id), array[]::int[]) FROM _prom_catalog.label l WHERE l.key = $1 and "
+	testCases := []struct {
+		name    string
+		matcher *labels.Matcher
+		clauses []string
+		values  []interface{}
+	}{
+		{
+			name:    "set",
+			matcher: labels.MustNewMatcher(labels.MatchRegexp, "job", "api|web"),
+			clauses: []string{labelSubQuery + "l.value = ANY($2::text[]))"},
+			values:  []interface{}{"job", []string{"api", "web"}},
+		},
+		{
+			name:    "set matching empty",
+			matcher: labels.MustNewMatcher(labels.MatchRegexp, "job", "api|"),
+			clauses: []string{"NOT " + labelSubQuery + "NOT (l.value = ANY($2::text[])))"},
+			values:  []interface{}{"job", []string{"api", ""}},
+		},
+		{
+			name:    "negated set",
+			matcher: labels.MustNewMatcher(labels.MatchNotRegexp, "job", "api|"),
+			clauses: []string{labelSubQuery + "NOT (l.value = ANY($2::text[])))"},
+			values:  []interface{}{"job", []string{"api", ""}},
+		},
+		{
+			name:    "negated set matching empty",
+			matcher: labels.MustNewMatcher(labels.MatchNotRegexp, "job", "api|web"),
+			clauses: []string{"NOT " + labelSubQuery + "l.value = ANY($2::text[]))"},
+			values:  []interface{}{"job", []string{"api", "web"}},
+		},
+		{
+			name:    "presence",
+			matcher: labels.MustNewMatcher(labels.MatchRegexp, "job", ".+"),
+			clauses: []string{labelSubQuery + "l.value <> '' AND position(chr(10) in l.value) = 0)"},
+			values:  []interface{}{"job"},
+		},
+		{
+			name:    "absence",
+			matcher: labels.MustNewMatcher(labels.MatchNotRegexp, "job", ".+"),
+			clauses: []string{"NOT " + labelSubQuery + "l.value <> '' AND position(chr(10) in l.value) = 0)"},
+			values:  []interface{}{"job"},
+		},
+		{
+			name:    "matches everything",
+			matcher: labels.MustNewMatcher(labels.MatchRegexp, "job", "(?s).*"),
+			clauses: []string{"TRUE"},
+		},
+		{
+			name:    "matches nothing",
+			matcher: labels.MustNewMatcher(labels.MatchNotRegexp, "job", "(?s).*"),
+			clauses: []string{"FALSE"},
+		},
+	}
+
+	for _, c := range testCases {
+		t.Run(c.name, func(t *testing.T) {
+			nameMatcher := labels.MustNewMatcher(labels.MatchEqual, "__name__", "foo")
+			cb, err := BuildSubQueries([]*labels.Matcher{nameMatcher, c.matcher})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			clauses, values, err := cb.Build(false)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(clauses, c.clauses) {
+				t.Errorf("unexpected clauses:\ngot\n%v\nwanted\n%v", clauses, c.clauses)
+			}
+			if !reflect.DeepEqual(values, c.values) {
+				t.Errorf("unexpected values: got %v wanted %v", values, c.values)
+			}
+		})
+	}
+}
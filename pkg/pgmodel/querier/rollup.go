@@ -0,0 +1,260 @@
+// This file and its contents are licensed under the Apache License 2.0.
+// Please see the included NOTICE for copyright information and
+// LICENSE for a copy of the license.
+
+package querier
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/prometheus/prometheus/promql/parser"
+	"github.com/prometheus/prometheus/storage"
+	"github.com/timescale/promscale/pkg/pgmodel/common/schema"
+	"github.com/timescale/promscale/pkg/pgxconn"
+)
+
+const (
+	getRollupWatermarksSQL = "SELECT w.resolution_ms, w.watermark FROM " + schema.Catalog + ".downsample_watermark w " +
+		"INNER JOIN " + schema.Catalog + ".metric m ON (m.id = w.metric_id) WHERE m.metric_name = $1 AND w.watermark IS NOT NULL"
+
+	// rollupSourceSQLFormat replaces the data table of a metric with its
+	// rollup rows before the watermark and its raw samples after it.
+	rollupSourceSQLFormat = `(SELECT series_id, time, %[1]s AS value FROM %[2]s
+		WHERE resolution_ms = %[3]d AND time < '%[4]s'
+		UNION ALL
+		SELECT series_id, time, value FROM %[5]s
+		WHERE time >= '%[4]s')`
+
+	// rollupCounterSourceSQLFormat replaces the data table of a metric with
+	// a counter rebuilt from the increases of its rollup rows in the time
+	// range before the watermark, and its raw samples after it. The increase
+	// of a row adds the reset adjusted increase from the last value of the
+	// previous row to the first value of the row to the increase within the
+	// row. The rebuilt counter ends at the last value of the last row, so
+	// that the raw samples continue it.
+	rollupCounterSourceSQLFormat = `(SELECT series_id, time,
+			last_value(last) OVER (PARTITION BY series_id ORDER BY time ROWS BETWEEN UNBOUNDED PRECEDING AND UNBOUNDED FOLLOWING)
+			- COALESCE(sum(increase) OVER (PARTITION BY series_id ORDER BY time ROWS BETWEEN 1 FOLLOWING AND UNBOUNDED FOLLOWING), 0) AS value
+		FROM (
+			SELECT series_id, time, last,
+				increase + CASE WHEN prev IS NULL THEN 0 WHEN first >= prev THEN first - prev ELSE first END AS increase
+			FROM (
+				SELECT series_id, time, first, last, increase, lag(last) OVER (PARTITION BY series_id ORDER BY time) AS prev
+				FROM %[1]s
+				WHERE resolution_ms = %[2]d AND time < '%[3]s' AND time >= '%[5]s' AND time <= '%[6]s'
+			) r
+		) r
+		UNION ALL
+		SELECT series_id, time, value FROM %[4]s
+		WHERE time >= '%[3]s')`
+
+	// rollupCounter is the pseudo column of the counter rebuilt from the
+	// rollup increases.
+	rollupCounter = "counter"
+
+	// rollupWatermarksCheckInterval is how often the watermarks of a metric
+	// are read again. Watermarks mostly move forward, for which older
+	// watermarks just read more raw samples, but samples written behind a
+	// watermark lower it, and are only seen by queries reading from the
+	// rollups once the watermark is read again.
+	rollupWatermarksCheckInterval = time.Minute
+
+	// lookbackDelta is the lookback delta of the PromQL engine, which uses
+	// the default one.
+	lookbackDelta = 5 * time.Minute
+)
+
+// rollupColumns are the rollup columns which replace the raw samples under the
+// range vector functions, by the function they are used in.
+var rollupColumns = map[string]struct {
+	column string
+	// minSamples is the minimum number of rollup rows the range has to
+	// cover.
+	minSamples int64
+	// aligned is set if the range has to be a multiple of the resolution
+	// since the function aggregates the whole range.
+	aligned bool
+}{
+	"rate":           {column: rollupCounter, minSamples: 2},
+	"increase":       {column: rollupCounter, minSamples: 2},
+	"delta":          {column: "last", minSamples: 2},
+	"irate":          {column: rollupCounter, minSamples: 2},
+	"idelta":         {column: "last", minSamples: 2},
+	"last_over_time": {column: "last", minSamples: 1},
+	"max_over_time":  {column: "max", minSamples: 1, aligned: true},
+	"min_over_time":  {column: "min", minSamples: 1, aligned: true},
+	"sum_over_time":  {column: "sum", minSamples: 1, aligned: true},
+}
+
+// rollup is a rollup a selector reads from instead of the raw samples.
+type rollup struct {
+	resolution time.Duration
+	column     string
+	// watermark is the time up to which, exclusive, the rollup is used.
+	watermark time.Time
+}
+
+// source returns the table expression, with the same columns as the data table
+// of the metric, reading the rollup rows before the watermark and the raw
+// samples from the watermark on. The rollup rows of the counter are only read
+// within the time range of the query, since they are rebuilt as a whole.
+func (r *rollup) source(metricTable, startTime, endTime string) string {
+	if r.column == rollupCounter {
+		return fmt.Sprintf(rollupCounterSourceSQLFormat,
+			pgx.Identifier{schema.DataRollup, metricTable}.Sanitize(),
+			r.resolution.Milliseconds(),
+			toRFC3339Nano(toMilis(r.watermark)),
+			pgx.Identifier{schema.Data, metricTable}.Sanitize(),
+			startTime,
+			endTime,
+		)
+	}
+	return fmt.Sprintf(rollupSourceSQLFormat,
+		r.column,
+		pgx.Identifier{schema.DataRollup, metricTable}.Sanitize(),
+		r.resolution.Milliseconds(),
+		toRFC3339Nano(toMilis(r.watermark)),
+		pgx.Identifier{schema.Data, metricTable}.Sanitize(),
+	)
+}
+
+// rollupCandidate is a rollup resolution and column which can serve a
+// selector.
+type rollupCandidate struct {
+	resolution time.Duration
+	column     string
+}
+
+// rollupCandidates returns the rollups which can replace the raw samples of a
+// selector, coarsest first. The rollups are only used for the selector of the
+// pushdown path, outside of subqueries, for which the hints describe how the
+// samples are evaluated:
+//  - plain selectors read the last values of rollups finer than both the query
+//    step and the lookback delta, except in timestamp() which returns the
+//    sample timestamps,
+//  - the range vector functions in rollupColumns read the matching column of
+//    rollups finer than the query step whose rows fill the range, the counter
+//    functions read the counter rebuilt from the rollup increases.
+func rollupCandidates(hints *storage.SelectHints, path []parser.Node, resolutions []time.Duration) []rollupCandidate {
+	if hints == nil || len(path) == 0 || hasSubquery(path) {
+		return nil
+	}
+	step := time.Duration(hints.Step) * time.Millisecond
+	rng := time.Duration(hints.Range) * time.Millisecond
+
+	var candidates []rollupCandidate
+	for i := len(resolutions) - 1; i >= 0; i-- {
+		resolution := resolutions[i]
+		if step > 0 && resolution > step {
+			continue
+		}
+		if hints.Range == 0 {
+			if step == 0 || resolution > lookbackDelta || hints.Func == "timestamp" {
+				continue
+			}
+			candidates = append(candidates, rollupCandidate{resolution: resolution, column: "last"})
+			continue
+		}
+		c, ok := rollupColumns[hints.Func]
+		if !ok || int64(rng/resolution) < c.minSamples || (c.aligned && rng%resolution != 0) {
+			continue
+		}
+		candidates = append(candidates, rollupCandidate{resolution: resolution, column: c.column})
+	}
+	return candidates
+}
+
+type rollupWatermarks struct {
+	byResolution map[time.Duration]time.Time
+	checkedAt    time.Time
+}
+
+// rollupSelector selects the rollup a selector of a metric reads from, among
+// the configured rollup resolutions, based on the watermarks of the metric.
+type rollupSelector struct {
+	conn        pgxconn.PgxConn
+	resolutions []time.Duration
+	now         func() time.Time
+
+	lock       sync.Mutex
+	watermarks map[string]rollupWatermarks
+}
+
+// newRollupSelector returns a rollup selector for the resolutions, in
+// ascending order, or nil if there are none.
+func newRollupSelector(conn pgxconn.PgxConn, resolutions []time.Duration) *rollupSelector {
+	if len(resolutions) == 0 {
+		return nil
+	}
+	return &rollupSelector{
+		conn:        conn,
+		resolutions: resolutions,
+		now:         time.Now,
+		watermarks:  make(map[string]rollupWatermarks),
+	}
+}
+
+// selectRollup returns the coarsest rollup the selector of the metric can read
+// from, or nil if the raw samples have to be read. The rollup must have been
+// computed for the beginning of the selected range at least, the range after
+// its watermark is read from the raw samples.
+func (r *rollupSelector) selectRollup(ctx context.Context, metric string, hints *storage.SelectHints, path []parser.Node) (*rollup, error) {
+	if r == nil {
+		return nil, nil
+	}
+	candidates := rollupCandidates(hints, path, r.resolutions)
+	if len(candidates) == 0 {
+		return nil, nil
+	}
+	watermarks, err := r.getWatermarks(ctx, metric)
+	if err != nil {
+		return nil, err
+	}
+	start := time.Unix(0, hints.Start*int64(time.Millisecond))
+	for _, c := range candidates {
+		if watermark, ok := watermarks[c.resolution]; ok && watermark.After(start) {
+			return &rollup{resolution: c.resolution, column: c.column, watermark: watermark}, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *rollupSelector) getWatermarks(ctx context.Context, metric string) (map[time.Duration]time.Time, error) {
+	now := r.now()
+	r.lock.Lock()
+	cached, ok := r.watermarks[metric]
+	r.lock.Unlock()
+	if ok && now.Sub(cached.checkedAt) < rollupWatermarksCheckInterval {
+		return cached.byResolution, nil
+	}
+
+	rows, err := r.conn.Query(ctx, getRollupWatermarksSQL, metric)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	watermarks := make(map[time.Duration]time.Time)
+	for rows.Next() {
+		var (
+			resolutionMs int64
+			watermark    time.Time
+		)
+		if err := rows.Scan(&resolutionMs, &watermark); err != nil {
+			return nil, err
+		}
+		watermarks[time.Duration(resolutionMs)*time.Millisecond] = watermark
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	r.lock.Lock()
+	r.watermarks[metric] = rollupWatermarks{byResolution: watermarks, checkedAt: now}
+	r.lock.Unlock()
+	return watermarks, nil
+}
@@ -0,0 +1,183 @@
+// This file and its contents are licensed under the Apache License 2.0.
+// Please see the included NOTICE for copyright information and
+// LICENSE for a copy of the license.
+
+package querier
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/prometheus/prometheus/promql/parser"
+	"github.com/prometheus/prometheus/storage"
+	"github.com/timescale/promscale/pkg/pgmodel/model"
+)
+
+func TestRollupCandidates(t *testing.T) {
+	resolutions := []time.Duration{time.Minute, 5 * time.Minute, time.Hour}
+	var (
+		callPath = func(name string) []parser.Node {
+			return []parser.Node{&parser.Call{Func: parser.Functions[name]}, &parser.MatrixSelector{}}
+		}
+		sumPath      = []parser.Node{&parser.AggregateExpr{Op: parser.SUM}}
+		subqueryPath = []parser.Node{&parser.Call{Func: parser.Functions["max_over_time"]}, &parser.SubqueryExpr{}}
+	)
+	hours := func(h int64) int64 { return h * time.Hour.Milliseconds() }
+	minutes := func(m int64) int64 { return m * time.Minute.Milliseconds() }
+
+	testCases := []struct {
+		name     string
+		hints    *storage.SelectHints
+		path     []parser.Node
+		expected []rollupCandidate
+	}{
+		{
+			name: "no hints",
+			path: sumPath,
+		},
+		{
+			name:  "no path",
+			hints: &storage.SelectHints{Step: hours(1), Func: "sum"},
+		},
+		{
+			name:  "plain selector",
+			hints: &storage.SelectHints{Step: hours(1), Func: "sum"},
+			path:  sumPath,
+			expected: []rollupCandidate{
+				{resolution: 5 * time.Minute, column: "last"},
+				{resolution: time.Minute, column: "last"},
+			},
+		},
+		{
+			name:     "plain selector with a fine step",
+			hints:    &storage.SelectHints{Step: minutes(2), Func: "sum"},
+			path:     sumPath,
+			expected: []rollupCandidate{{resolution: time.Minute, column: "last"}},
+		},
+		{
+			name:  "plain selector in an instant query",
+			hints: &storage.SelectHints{Func: "sum"},
+			path:  sumPath,
+		},
+		{
+			name:  "timestamp",
+			hints: &storage.SelectHints{Step: hours(1), Func: "timestamp"},
+			path:  callPath("timestamp"),
+		},
+		{
+			name:  "rate",
+			hints: &storage.SelectHints{Step: hours(1), Range: minutes(10), Func: "rate"},
+			path:  callPath("rate"),
+			expected: []rollupCandidate{
+				{resolution: 5 * time.Minute, column: rollupCounter},
+				{resolution: time.Minute, column: rollupCounter},
+			},
+		},
+		{
+			name:  "rate in an instant query",
+			hints: &storage.SelectHints{Range: hours(24), Func: "rate"},
+			path:  callPath("rate"),
+			expected: []rollupCandidate{
+				{resolution: time.Hour, column: rollupCounter},
+				{resolution: 5 * time.Minute, column: rollupCounter},
+				{resolution: time.Minute, column: rollupCounter},
+			},
+		},
+		{
+			name:  "max_over_time with an unaligned range",
+			hints: &storage.SelectHints{Step: hours(2), Range: minutes(90), Func: "max_over_time"},
+			path:  callPath("max_over_time"),
+			expected: []rollupCandidate{
+				{resolution: 5 * time.Minute, column: "max"},
+				{resolution: time.Minute, column: "max"},
+			},
+		},
+		{
+			name:  "sum_over_time",
+			hints: &storage.SelectHints{Step: hours(1), Range: hours(1), Func: "sum_over_time"},
+			path:  callPath("sum_over_time"),
+			expected: []rollupCandidate{
+				{resolution: time.Hour, column: "sum"},
+				{resolution: 5 * time.Minute, column: "sum"},
+				{resolution: time.Minute, column: "sum"},
+			},
+		},
+		{
+			name:  "unsupported function",
+			hints: &storage.SelectHints{Step: hours(1), Range: hours(1), Func: "count_over_time"},
+			path:  callPath("count_over_time"),
+		},
+		{
+			name:  "subquery",
+			hints: &storage.SelectHints{Step: hours(1), Func: "max_over_time"},
+			path:  subqueryPath,
+		},
+	}
+
+	for _, c := range testCases {
+		t.Run(c.name, func(t *testing.T) {
+			candidates := rollupCandidates(c.hints, c.path, resolutions)
+			if !reflect.DeepEqual(candidates, c.expected) {
+				t.Errorf("unexpected candidates: got %v wanted %v", candidates, c.expected)
+			}
+		})
+	}
+}
+
+func TestRollupSelector(t *testing.T) {
+	watermark := time.Unix(7200, 0).UTC()
+	watermarksQuery := model.SqlQuery{
+		Sql:  getRollupWatermarksSQL,
+		Args: []interface{}{"foo"},
+		Results: model.RowResults{
+			{time.Minute.Milliseconds(), time.Unix(10800, 0).UTC()},
+			{time.Hour.Milliseconds(), watermark},
+		},
+	}
+	conn := model.NewSqlRecorder([]model.SqlQuery{
+		watermarksQuery,
+		// The watermarks are read again after the check interval.
+		watermarksQuery,
+	}, t)
+	now := time.Unix(0, 0)
+	r := newRollupSelector(conn, []time.Duration{time.Minute, time.Hour})
+	r.now = func() time.Time { return now }
+
+	path := []parser.Node{&parser.Call{Func: parser.Functions["rate"]}, &parser.MatrixSelector{}}
+	hints := &storage.SelectHints{Start: 3600 * 1000, End: 14400 * 1000, Step: time.Hour.Milliseconds(), Range: (2 * time.Hour).Milliseconds(), Func: "rate"}
+	expected := &rollup{resolution: time.Hour, column: rollupCounter, watermark: watermark}
+
+	for i := 0; i < 2; i++ {
+		selected, err := r.selectRollup(context.Background(), "foo", hints, path)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !reflect.DeepEqual(selected, expected) {
+			t.Errorf("unexpected rollup: got %+v wanted %+v", selected, expected)
+		}
+	}
+
+	// The coarsest rollup doesn't cover the beginning of the range.
+	now = now.Add(rollupWatermarksCheckInterval)
+	hints.Start = watermark.Unix() * 1000
+	selected, err := r.selectRollup(context.Background(), "foo", hints, path)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if selected == nil || selected.resolution != time.Minute {
+		t.Errorf("unexpected rollup: got %+v wanted the 1m rollup", selected)
+	}
+
+	// Selectors which can't use a rollup don't read the watermarks.
+	selected, err = r.selectRollup(context.Background(), "foo", nil, nil)
+	if selected != nil || err != nil {
+		t.Errorf("unexpected rollup without hints: %v %v", selected, err)
+	}
+
+	var nilSelector *rollupSelector
+	if selected, err := nilSelector.selectRollup(context.Background(), "foo", hints, path); selected != nil || err != nil {
+		t.Errorf("unexpected rollup without a selector: %v %v", selected, err)
+	}
+}
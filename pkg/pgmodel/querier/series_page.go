@@ -0,0 +1,264 @@
+// This file and its contents are licensed under the Apache License 2.0.
+// Please see the included NOTICE for copyright information and
+// LICENSE for a copy of the license.
+
+package querier
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/prometheus/prometheus/pkg/labels"
+	"github.com/timescale/promscale/pkg/pgmodel/common/errors"
+	"github.com/timescale/promscale/pkg/pgmodel/common/schema"
+)
+
+const (
+	// Keyset pagination over the series IDs: every page continues after the
+	// last series ID returned by the previous one.
+	seriesPageSQLFormat = `SELECT s.id, s.labels, m.table_name
+	FROM _prom_catalog.series s
+	INNER JOIN _prom_catalog.metric m
+	ON (m.id = s.metric_id)
+	WHERE %s
+	AND s.id > $%d
+	ORDER BY s.id
+	LIMIT $%d`
+
+	seriesWithDataSQLFormat = `SELECT s.id
+	FROM unnest($1::bigint[]) AS s(id)
+	WHERE EXISTS (
+		SELECT 1
+		FROM %[1]s m
+		WHERE m.series_id = s.id
+		AND time >= '%[2]s'
+		AND time <= '%[3]s'
+	)`
+)
+
+// SeriesPage is a single page of series returned by a paginated series query.
+type SeriesPage struct {
+	Series []labels.Labels
+	// NextToken is the continuation token for fetching the next page. It is
+	// empty if there are no more series to fetch.
+	NextToken string
+}
+
+type seriesCandidate struct {
+	id       int64
+	labelIds []int64
+	table    string
+}
+
+type seriesPageQuery struct {
+	clauses []string
+	values  []interface{}
+}
+
+// SelectSeries implements the Querier interface. Series are ordered by their
+// ID and only series with samples between mint and maxt are returned.
+func (q *pgxQuerier) SelectSeries(mint, maxt int64, limit int, token string, matcherSets ...[]*labels.Matcher) (*SeriesPage, error) {
+	after, err := parseSeriesToken(token)
+	if err != nil {
+		return nil, err
+	}
+
+	queries := make([]seriesPageQuery, 0, len(matcherSets))
+	for _, matchers := range matcherSets {
+		if err := q.rejectViews(context.Background(), matchers); err != nil {
+			return nil, err
+		}
+		builder, err := q.buildSubQueries(context.Background(), matchers)
+		if err != nil {
+			return nil, err
+		}
+		clauses, values, err := builder.Build(true)
+		if err != nil {
+			return nil, err
+		}
+		queries = append(queries, seriesPageQuery{clauses: clauses, values: values})
+	}
+
+	filter := metricTimeRangeFilter{
+		startTime: toRFC3339Nano(mint),
+		endTime:   toRFC3339Nano(maxt),
+	}
+
+	// Fetch one series more than requested to know whether there is a next page.
+	var found []seriesCandidate
+	for {
+		batchSize := 0
+		if limit > 0 {
+			batchSize = limit + 1 - len(found)
+		}
+		candidates, last, exhausted, err := q.seriesCandidates(queries, after, batchSize)
+		if err != nil {
+			return nil, err
+		}
+		found, err = q.appendSeriesWithData(found, candidates, filter)
+		if err != nil {
+			return nil, err
+		}
+		if exhausted || (limit > 0 && len(found) > limit) {
+			break
+		}
+		after = last
+	}
+
+	page := &SeriesPage{}
+	if limit > 0 && len(found) > limit {
+		found = found[:limit]
+		page.NextToken = strconv.FormatInt(found[limit-1].id, 10)
+	}
+
+	ids := make([]int64, 0)
+	seen := make(map[int64]struct{})
+	for _, c := range found {
+		for _, id := range c.labelIds {
+			if _, ok := seen[id]; !ok {
+				seen[id] = struct{}{}
+				ids = append(ids, id)
+			}
+		}
+	}
+	lls, err := q.labelsReader.LabelsForIdMap(ids)
+	if err != nil {
+		return nil, err
+	}
+	page.Series = make([]labels.Labels, 0, len(found))
+	for _, c := range found {
+		page.Series = append(page.Series, labelsForIds(lls, c.labelIds))
+	}
+	return page, nil
+}
+
+// seriesCandidates returns, ordered by ID, the series after the supplied ID
+// that match any of the queries. With a positive batch size, each query
+// returns at most batchSize series, so the merged result is only complete up
+// to the smallest last ID among the queries that filled their batch. Series
+// past that ID are discarded and the ID is returned as the point to continue
+// from. exhausted reports that there are no further series to fetch.
+func (q *pgxQuerier) seriesCandidates(queries []seriesPageQuery, after int64, batchSize int) (candidates []seriesCandidate, last int64, exhausted bool, err error) {
+	var limit interface{}
+	if batchSize > 0 {
+		limit = batchSize
+	}
+
+	merged := make(map[int64]seriesCandidate)
+	exhausted = true
+	for _, query := range queries {
+		sqlQuery := fmt.Sprintf(seriesPageSQLFormat, strings.Join(query.clauses, " AND "), len(query.values)+1, len(query.values)+2)
+		values := append(query.values[:len(query.values):len(query.values)], after, limit)
+
+		rows, err := q.conn.Query(context.Background(), sqlQuery, values...)
+		if err != nil {
+			return nil, 0, false, err
+		}
+		count := 0
+		var lastID int64
+		for rows.Next() {
+			var c seriesCandidate
+			if err = rows.Scan(&c.id, &c.labelIds, &c.table); err != nil {
+				rows.Close()
+				return nil, 0, false, err
+			}
+			merged[c.id] = c
+			lastID = c.id
+			count++
+		}
+		err = rows.Err()
+		rows.Close()
+		if err != nil {
+			return nil, 0, false, err
+		}
+
+		if batchSize > 0 && count == batchSize && (exhausted || lastID < last) {
+			exhausted = false
+			last = lastID
+		}
+	}
+
+	candidates = make([]seriesCandidate, 0, len(merged))
+	for id, c := range merged {
+		if exhausted || id <= last {
+			candidates = append(candidates, c)
+		}
+	}
+	sort.Slice(candidates, func(i, j int) bool {
+		return candidates[i].id < candidates[j].id
+	})
+	return candidates, last, exhausted, nil
+}
+
+// appendSeriesWithData appends the candidates which have samples in the
+// filter's time range to the found series, preserving the ID ordering.
+func (q *pgxQuerier) appendSeriesWithData(found, candidates []seriesCandidate, filter metricTimeRangeFilter) ([]seriesCandidate, error) {
+	if len(candidates) == 0 {
+		return found, nil
+	}
+
+	tables := make([]string, 0)
+	idsPerTable := make(map[string][]int64)
+	for _, c := range candidates {
+		if _, ok := idsPerTable[c.table]; !ok {
+			tables = append(tables, c.table)
+		}
+		idsPerTable[c.table] = append(idsPerTable[c.table], c.id)
+	}
+
+	batch := q.conn.NewBatch()
+	for _, table := range tables {
+		batch.Queue(
+			fmt.Sprintf(seriesWithDataSQLFormat, pgx.Identifier{schema.Data, table}.Sanitize(), filter.startTime, filter.endTime),
+			idsPerTable[table],
+		)
+	}
+	results, err := q.conn.SendBatch(context.Background(), batch)
+	if err != nil {
+		return nil, err
+	}
+	defer results.Close()
+
+	withData := make(map[int64]struct{}, len(candidates))
+	for range tables {
+		rows, err := results.Query()
+		if err != nil {
+			return nil, err
+		}
+		for rows.Next() {
+			var id int64
+			if err = rows.Scan(&id); err != nil {
+				rows.Close()
+				return nil, err
+			}
+			withData[id] = struct{}{}
+		}
+		err = rows.Err()
+		rows.Close()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for _, c := range candidates {
+		if _, ok := withData[c.id]; ok {
+			found = append(found, c)
+		}
+	}
+	return found, nil
+}
+
+func parseSeriesToken(token string) (int64, error) {
+	if token == "" {
+		return 0, nil
+	}
+	id, err := strconv.ParseInt(token, 10, 64)
+	if err != nil || id < 0 {
+		return 0, fmt.Errorf("%w: %q", errors.ErrInvalidSeriesToken, token)
+	}
+	return id, nil
+}
@@ -0,0 +1,166 @@
+// This file and its contents are licensed under the Apache License 2.0.
+// Please see the included NOTICE for copyright information and
+// LICENSE for a copy of the license.
+
+package querier
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/prometheus/prometheus/pkg/labels"
+	"github.com/timescale/promscale/pkg/clockcache"
+	pgmodelErrs "github.com/timescale/promscale/pkg/pgmodel/common/errors"
+	"github.com/timescale/promscale/pkg/pgmodel/lreader"
+	"github.com/timescale/promscale/pkg/pgmodel/model"
+)
+
+func seriesPageSQL(after, limit interface{}, metric string, results model.RowResults) model.SqlQuery {
+	return model.SqlQuery{
+		Sql: "SELECT s.id, s.labels, m.table_name\n\t" +
+			"FROM _prom_catalog.series s\n\t" +
+			"INNER JOIN _prom_catalog.metric m\n\t" +
+			"ON (m.id = s.metric_id)\n\t" +
+			"WHERE labels && (SELECT COALESCE(array_agg(l.id), array[]::int[]) FROM _prom_catalog.label l WHERE l.key = $1 and l.value = $2)\n\t" +
+			"AND s.id > $3\n\t" +
+			"ORDER BY s.id\n\t" +
+			"LIMIT $4",
+		Args:    []interface{}{model.MetricNameLabelName, metric, after, limit},
+		Results: results,
+	}
+}
+
+func seriesWithDataSQL(table string, ids []int64, results model.RowResults) model.SqlQuery {
+	return model.SqlQuery{
+		Sql: "SELECT s.id\n\t" +
+			"FROM unnest($1::bigint[]) AS s(id)\n\t" +
+			"WHERE EXISTS (\n\t\t" +
+			"SELECT 1\n\t\t" +
+			"FROM \"prom_data\".\"" + table + "\" m\n\t\t" +
+			"WHERE m.series_id = s.id\n\t\t" +
+			"AND time >= '1970-01-01T00:00:01Z'\n\t\t" +
+			"AND time <= '1970-01-01T00:00:02Z'\n\t" +
+			")",
+		Args:    []interface{}{ids},
+		Results: results,
+	}
+}
+
+func TestPGXQuerierSelectSeries(t *testing.T) {
+	testCases := []struct {
+		name       string
+		limit      int
+		token      string
+		metrics    []string
+		result     *SeriesPage
+		err        error
+		sqlQueries []model.SqlQuery
+	}{
+		{
+			name:    "invalid token",
+			token:   "foo",
+			metrics: []string{"foo"},
+			err:     pgmodelErrs.ErrInvalidSeriesToken,
+		},
+		{
+			name:    "no limit",
+			metrics: []string{"foo"},
+			sqlQueries: []model.SqlQuery{
+				seriesPageSQL(int64(0), nil, "foo", model.RowResults{
+					{int64(1), []int64{10}, "foo"},
+					{int64(3), []int64{11}, "foo"},
+				}),
+				seriesWithDataSQL("foo", []int64{1, 3}, model.RowResults{{int64(1)}, {int64(3)}}),
+				{
+					Sql:     "SELECT (labels_info($1::int[])).*",
+					Args:    []interface{}{[]int64{11, 10}},
+					Results: model.RowResults{{[]int64{11, 10}, []string{"__name__", "__name__"}, []string{"foo", "foo"}}},
+				},
+			},
+			result: &SeriesPage{
+				Series: []labels.Labels{
+					labels.FromStrings("__name__", "foo"),
+					labels.FromStrings("__name__", "foo"),
+				},
+			},
+		},
+		{
+			name:    "truncated page across matcher sets",
+			limit:   1,
+			token:   "1",
+			metrics: []string{"foo", "bar"},
+			sqlQueries: []model.SqlQuery{
+				seriesPageSQL(int64(1), 2, "foo", model.RowResults{
+					{int64(2), []int64{10}, "foo"},
+					{int64(5), []int64{11}, "foo"},
+				}),
+				seriesPageSQL(int64(1), 2, "bar", model.RowResults{
+					{int64(3), []int64{20}, "bar"},
+				}),
+				// Series 2 has no data in the time range.
+				seriesWithDataSQL("foo", []int64{2, 5}, model.RowResults{{int64(5)}}),
+				seriesWithDataSQL("bar", []int64{3}, model.RowResults{{int64(3)}}),
+				{
+					Sql:     "SELECT (labels_info($1::int[])).*",
+					Args:    []interface{}{[]int64{20}},
+					Results: model.RowResults{{[]int64{20}, []string{"__name__"}, []string{"bar"}}},
+				},
+			},
+			result: &SeriesPage{
+				Series:    []labels.Labels{labels.FromStrings("__name__", "bar")},
+				NextToken: "3",
+			},
+		},
+		{
+			name:    "page filled after several batches",
+			limit:   1,
+			metrics: []string{"foo"},
+			sqlQueries: []model.SqlQuery{
+				seriesPageSQL(int64(0), 2, "foo", model.RowResults{
+					{int64(1), []int64{10}, "foo"},
+					{int64(2), []int64{11}, "foo"},
+				}),
+				seriesWithDataSQL("foo", []int64{1, 2}, model.RowResults{}),
+				seriesPageSQL(int64(2), 2, "foo", model.RowResults{
+					{int64(4), []int64{12}, "foo"},
+				}),
+				seriesWithDataSQL("foo", []int64{4}, model.RowResults{{int64(4)}}),
+				{
+					Sql:     "SELECT (labels_info($1::int[])).*",
+					Args:    []interface{}{[]int64{12}},
+					Results: model.RowResults{{[]int64{12}, []string{"__name__"}, []string{"foo"}}},
+				},
+			},
+			result: &SeriesPage{
+				Series: []labels.Labels{labels.FromStrings("__name__", "foo")},
+			},
+		},
+	}
+
+	for _, c := range testCases {
+		t.Run(c.name, func(t *testing.T) {
+			mock := model.NewSqlRecorder(c.sqlQueries, t)
+			querier := pgxQuerier{conn: mock, labelsReader: lreader.NewLabelsReader(mock, clockcache.WithMax(0))}
+
+			matcherSets := make([][]*labels.Matcher, 0, len(c.metrics))
+			for _, m := range c.metrics {
+				matcherSets = append(matcherSets, []*labels.Matcher{labels.MustNewMatcher(labels.MatchEqual, model.MetricNameLabelName, m)})
+			}
+
+			result, err := querier.SelectSeries(1000, 2000, c.limit, c.token, matcherSets...)
+			if c.err != nil {
+				if !errors.Is(err, c.err) {
+					t.Fatalf("unexpected error: got %v, wanted %v", err, c.err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(result, c.result) {
+				t.Errorf("unexpected result:\ngot\n%#v\nwanted\n%#v", result, c.result)
+			}
+		})
+	}
+}
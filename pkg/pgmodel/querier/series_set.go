@@ -12,7 +12,6 @@ import (
 	"github.com/prometheus/prometheus/pkg/labels"
 	"github.com/prometheus/prometheus/storage"
 	"github.com/prometheus/prometheus/tsdb/chunkenc"
-	"github.com/timescale/promscale/pkg/log"
 	"github.com/timescale/promscale/pkg/pgmodel/common/errors"
 	"github.com/timescale/promscale/pkg/pgmodel/model"
 )
@@ -25,28 +24,102 @@ const (
 
 // pgxSeriesSet implements storage.SeriesSet.
 type pgxSeriesSet struct {
-	rowIdx  int
-	rows    []timescaleRow
-	err     error
-	querier labelQuerier
+	rowIdx   int
+	rows     []timescaleRow
+	err      error
+	warnings storage.Warnings
 }
 
 // pgxSeriesSet must implement storage.SeriesSet
 var _ storage.SeriesSet = (*pgxSeriesSet)(nil)
 
-func buildSeriesSet(rows []timescaleRow, querier labelQuerier) storage.SeriesSet {
+func buildSeriesSet(rows []timescaleRow, querier labelQuerier, warnings storage.Warnings) storage.SeriesSet {
+	if err := resolveLabels(rows, querier); err != nil {
+		return errorSeriesSet{err: err}
+	}
 	return &pgxSeriesSet{
-		rows:    rows,
-		querier: querier,
-		rowIdx:  -1,
+		rows:     rows,
+		rowIdx:   -1,
+		warnings: warnings,
+	}
+}
+
+// resolveLabels sets the sorted label sets of the rows missing them. All the
+// label IDs of the rows are looked up at once, instead of with a round-trip
+// per row.
+func resolveLabels(rows []timescaleRow, querier labelQuerier) error {
+	ids := make([]int64, 0)
+	seen := make(map[int64]struct{})
+	for i := range rows {
+		if rows[i].err != nil || rows[i].labels != nil {
+			continue
+		}
+		for _, id := range rows[i].labelIds {
+			if _, ok := seen[id]; !ok {
+				seen[id] = struct{}{}
+				ids = append(ids, id)
+			}
+		}
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	lls, err := querier.LabelsForIdMap(ids)
+	if err != nil {
+		return err
+	}
+
+	for i := range rows {
+		if rows[i].err != nil || rows[i].labels != nil || len(rows[i].labelIds) == 0 {
+			continue
+		}
+		rows[i].labels = labelsForIds(lls, rows[i].labelIds)
+	}
+	return nil
+}
+
+// labelsForIds returns the sorted label set of the supplied IDs.
+func labelsForIds(lls map[int64]labels.Label, ids []int64) labels.Labels {
+	set := make(labels.Labels, 0, len(ids))
+	for _, id := range ids {
+		if l, ok := lls[id]; ok {
+			set = append(set, l)
+		}
+	}
+	sort.Sort(set)
+	return set
+}
+
+// sortRows sorts the rows by their label sets. Rows with the same label set
+// keep their relative order.
+func sortRows(rows []timescaleRow, querier labelQuerier) error {
+	for i := range rows {
+		if rows[i].err != nil {
+			return rows[i].err
+		}
 	}
+	if err := resolveLabels(rows, querier); err != nil {
+		return err
+	}
+	sort.Stable(rowsByLabels(rows))
+	return nil
 }
 
-// Next forwards the internal cursor to next storage.Series
+type rowsByLabels []timescaleRow
+
+func (r rowsByLabels) Len() int           { return len(r) }
+func (r rowsByLabels) Less(i, j int) bool { return labels.Compare(r[i].labels, r[j].labels) < 0 }
+func (r rowsByLabels) Swap(i, j int)      { r[i], r[j] = r[j], r[i] }
+
+// Next forwards the internal cursor to next storage.Series, releasing the
+// samples of the current one.
 func (p *pgxSeriesSet) Next() bool {
 	if p.rowIdx >= len(p.rows) {
 		return false
 	}
+	if p.rowIdx >= 0 {
+		p.rows[p.rowIdx] = timescaleRow{}
+	}
 	p.rowIdx += 1
 	if p.rowIdx >= len(p.rows) {
 		return false
@@ -73,24 +146,11 @@ func (p *pgxSeriesSet) At() storage.Series {
 		return nil
 	}
 
-	ps := &pgxSeries{
+	return &pgxSeries{
+		labels: row.labels,
 		times:  row.times,
 		values: row.values,
 	}
-
-	// this should pretty much always be non-empty due to __name__, but it
-	// costs little to check here
-	if len(row.labelIds) != 0 {
-		lls, err := p.querier.LabelsForIds(row.labelIds)
-		if err != nil {
-			log.Error("err", err)
-			return nil
-		}
-		sort.Sort(lls)
-		ps.labels = lls
-	}
-
-	return ps
 }
 
 // Err implements storage.SeriesSet.
@@ -101,7 +161,7 @@ func (p *pgxSeriesSet) Err() error {
 	return nil
 }
 
-func (p *pgxSeriesSet) Warnings() storage.Warnings { return nil }
+func (p *pgxSeriesSet) Warnings() storage.Warnings { return p.warnings }
 
 // pgxSeries implements storage.Series.
 type pgxSeries struct {
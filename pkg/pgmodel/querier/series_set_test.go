@@ -248,7 +248,7 @@ func TestPgxSeriesSet(t *testing.T) {
 				c.input = [][]seriesSetRow{{
 					genSeries(labels, c.ts, c.vs)}}
 			}
-			p := buildSeriesSet(genPgxRows(c.input, c.rowErr), mapQuerier{labelMapping})
+			p := buildSeriesSet(genPgxRows(c.input, c.rowErr), mapQuerier{labelMapping}, nil)
 
 			for c.rowCount > 0 {
 				c.rowCount--
@@ -388,6 +388,18 @@ func (m mapQuerier) LabelsForIds(ids []int64) (labels.Labels, error) {
 	return lls, nil
 }
 
+func (m mapQuerier) LabelsForIdMap(ids []int64) (map[int64]labels.Label, error) {
+	lls := make(map[int64]labels.Label, len(ids))
+	for _, id := range ids {
+		kv, ok := m.mapping[id]
+		if !ok {
+			return nil, pgmodelErrs.ErrInvalidRowData
+		}
+		lls[id] = labels.Label{Name: kv.k, Value: kv.v}
+	}
+	return lls, nil
+}
+
 //nolint
 func genRows(count int) [][][]byte {
 	result := make([][][]byte, count)
@@ -456,3 +468,55 @@ func genSeries(labels []int64, ts []pgtype.Timestamptz, vs []pgtype.Float8) seri
 		values:     vs,
 	}
 }
+
+func TestSortRowsResolvesLabelsOnce(t *testing.T) {
+	querier := &countingQuerier{
+		mapQuerier: mapQuerier{mapping: map[int64]struct {
+			k string
+			v string
+		}{
+			1: {k: "__name__", v: "foo"},
+			2: {k: "job", v: "b"},
+			3: {k: "job", v: "a"},
+			4: {k: "instance", v: "1"},
+		}},
+	}
+	row := func(marker float64, ids ...int64) timescaleRow {
+		return timescaleRow{
+			labelIds: ids,
+			times:    toTimestampTzArray([]pgtype.Timestamptz{{Time: time.Unix(0, 0), Status: pgtype.Present}}),
+			values:   toFloat8Array([]pgtype.Float8{{Float: marker, Status: pgtype.Present}}),
+		}
+	}
+	rows := []timescaleRow{row(1, 2, 1), row(2, 1, 3, 4), row(3, 4, 3, 1)}
+	if err := sortRows(rows, querier); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if querier.lookups != 1 {
+		t.Errorf("unexpected number of label lookups: got %d wanted 1", querier.lookups)
+	}
+
+	expected := []labels.Labels{
+		labels.FromStrings("__name__", "foo", "instance", "1", "job", "a"),
+		labels.FromStrings("__name__", "foo", "instance", "1", "job", "a"),
+		labels.FromStrings("__name__", "foo", "job", "b"),
+	}
+	// Rows with the same label set keep their order.
+	expectedMarkers := []float64{2, 3, 1}
+	ss := buildSeriesSet(rows, querier, nil)
+	for i := range expected {
+		if !ss.Next() {
+			t.Fatalf("unexpected end of series set")
+		}
+		s := ss.At()
+		if !reflect.DeepEqual(s.Labels(), expected[i]) {
+			t.Errorf("unexpected labels: got %v wanted %v", s.Labels(), expected[i])
+		}
+		if got := rows[i].values.Elements[0].Float; got != expectedMarkers[i] {
+			t.Errorf("unexpected row order: got row %v wanted row %v", got, expectedMarkers[i])
+		}
+	}
+	if querier.lookups != 1 {
+		t.Errorf("labels were looked up again by the series set: got %d lookups wanted 1", querier.lookups)
+	}
+}
@@ -0,0 +1,160 @@
+// This file and its contents are licensed under the Apache License 2.0.
+// Please see the included NOTICE for copyright information and
+// LICENSE for a copy of the license.
+
+package querier
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v4"
+	"github.com/prometheus/prometheus/storage"
+	"github.com/timescale/promscale/pkg/pgmodel/common/errors"
+	"github.com/timescale/promscale/pkg/pgxconn"
+)
+
+// seriesSetBatchSize is the number of rows decoded ahead of the iteration so
+// that their labels are fetched with a single lookup.
+const seriesSetBatchSize = 32
+
+// streamingSeriesSet implements storage.SeriesSet by decoding the result rows
+// of a query as it is iterated, instead of loading all of them beforehand.
+// The query only runs on the first call to Next, so that selecting several
+// series sets does not hold several connections until they are iterated.
+type streamingSeriesSet struct {
+	ctx     context.Context
+	conn    pgxconn.PgxConn
+	sql     string
+	args    []interface{}
+	querier labelQuerier
+	tracker *limitTracker
+
+	rows  pgx.Rows
+	batch []timescaleRow
+	idx   int
+	done  bool
+	err   error
+}
+
+// streamingSeriesSet must implement storage.SeriesSet
+var _ storage.SeriesSet = (*streamingSeriesSet)(nil)
+
+func newStreamingSeriesSet(ctx context.Context, conn pgxconn.PgxConn, sql string, args []interface{}, querier labelQuerier, tracker *limitTracker) *streamingSeriesSet {
+	return &streamingSeriesSet{
+		ctx:     ctx,
+		conn:    conn,
+		sql:     sql,
+		args:    args,
+		querier: querier,
+		tracker: tracker,
+		idx:     -1,
+	}
+}
+
+// Next forwards the cursor to the next series, decoding the next batch of
+// rows when the current one is exhausted. The set only keeps references to the
+// rows of the current batch which have not been iterated yet.
+func (s *streamingSeriesSet) Next() bool {
+	if s.done {
+		return false
+	}
+	if s.idx >= 0 && s.idx < len(s.batch) {
+		s.batch[s.idx] = timescaleRow{}
+	}
+	s.idx++
+	if s.idx < len(s.batch) {
+		return true
+	}
+	if !s.fetchBatch() {
+		s.Close()
+		return false
+	}
+	s.idx = 0
+	return true
+}
+
+func (s *streamingSeriesSet) fetchBatch() bool {
+	if s.rows == nil {
+		rows, err := s.conn.Query(s.ctx, s.sql, s.args...)
+		if err != nil {
+			// If we are getting undefined table error, it means the query
+			// is looking for a metric which doesn't exist in the system.
+			if e, ok := err.(*pgconn.PgError); !ok || e.Code != pgerrcode.UndefinedTable {
+				s.err = err
+			}
+			return false
+		}
+		s.rows = rows
+	}
+
+	s.batch = s.batch[:0]
+	for len(s.batch) < seriesSetBatchSize && s.rows.Next() {
+		var row timescaleRow
+		if err := s.rows.Scan(&row.labelIds, &row.times, &row.values); err != nil {
+			s.err = err
+			return false
+		}
+		if len(row.times.Elements) != len(row.values.Elements) {
+			s.err = errors.ErrInvalidRowData
+			return false
+		}
+		if err := s.tracker.add(len(row.times.Elements)); err != nil {
+			s.err = err
+			return false
+		}
+		s.batch = append(s.batch, row)
+	}
+	if err := s.rows.Err(); err != nil {
+		s.err = err
+		return false
+	}
+	if len(s.batch) == 0 {
+		return false
+	}
+	return s.fetchLabels()
+}
+
+// fetchLabels resolves the label sets of the current batch.
+func (s *streamingSeriesSet) fetchLabels() bool {
+	if err := resolveLabels(s.batch, s.querier); err != nil {
+		s.err = err
+		return false
+	}
+	return true
+}
+
+// At returns the current storage.Series.
+func (s *streamingSeriesSet) At() storage.Series {
+	if s.idx < 0 || s.idx >= len(s.batch) {
+		return nil
+	}
+	row := s.batch[s.idx]
+	return &pgxSeries{
+		labels: row.labels,
+		times:  row.times,
+		values: row.values,
+	}
+}
+
+// Err implements storage.SeriesSet.
+func (s *streamingSeriesSet) Err() error {
+	if s.err != nil {
+		return fmt.Errorf("Error retrieving series set: %w", s.err)
+	}
+	return nil
+}
+
+func (s *streamingSeriesSet) Warnings() storage.Warnings { return nil }
+
+// Close releases the rows, and with them the database connection, if the set
+// is not iterated until the end.
+func (s *streamingSeriesSet) Close() {
+	if s.rows != nil {
+		s.rows.Close()
+	}
+	s.done = true
+	s.batch = nil
+}
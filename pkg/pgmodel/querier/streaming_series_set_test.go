@@ -0,0 +1,197 @@
+// This file and its contents are licensed under the Apache License 2.0.
+// Please see the included NOTICE for copyright information and
+// LICENSE for a copy of the license.
+
+package querier
+
+import (
+	"context"
+	goErrors "errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgtype"
+	"github.com/jackc/pgx/v4"
+	"github.com/prometheus/prometheus/pkg/labels"
+	"github.com/timescale/promscale/pkg/pgmodel/common/errors"
+	"github.com/timescale/promscale/pkg/pgxconn"
+)
+
+// rowsConn returns the same rows to every query and counts the queries.
+type rowsConn struct {
+	pgxconn.PgxConn
+	rows    *mockPgxRows
+	err     error
+	queries int
+}
+
+func (c *rowsConn) Query(ctx context.Context, sql string, args ...interface{}) (pgx.Rows, error) {
+	c.queries++
+	if c.err != nil {
+		return nil, c.err
+	}
+	return c.rows, nil
+}
+
+// countingQuerier counts the label lookups.
+type countingQuerier struct {
+	mapQuerier
+	lookups int
+}
+
+func (c *countingQuerier) LabelsForIdMap(ids []int64) (map[int64]labels.Label, error) {
+	c.lookups++
+	return c.mapQuerier.LabelsForIdMap(ids)
+}
+
+func TestStreamingSeriesSet(t *testing.T) {
+	numSeries := seriesSetBatchSize + 3
+	results := make([]seriesSetRow, 0, numSeries)
+	mapping := make(map[int64]struct {
+		k string
+		v string
+	})
+	for i := 0; i < numSeries; i++ {
+		id := int64(i + 1)
+		mapping[id] = struct {
+			k string
+			v string
+		}{"instance", fmt.Sprintf("%03d", i)}
+		results = append(results, genSeries(
+			[]int64{id},
+			[]pgtype.Timestamptz{{Time: time.Unix(0, 0)}},
+			[]pgtype.Float8{{Float: float64(i)}},
+		))
+	}
+
+	testCases := []struct {
+		name          string
+		rows          []seriesSetRow
+		queryErr      error
+		scanErr       error
+		limits        Limits
+		expectSeries  int
+		expectLookups int
+		expectErr     error
+	}{
+		{
+			name:          "multiple batches",
+			rows:          results,
+			expectSeries:  numSeries,
+			expectLookups: 2,
+		},
+		{
+			name:         "no rows",
+			expectSeries: 0,
+		},
+		{
+			name:         "undefined table",
+			queryErr:     &pgconn.PgError{Code: pgerrcode.UndefinedTable},
+			expectSeries: 0,
+		},
+		{
+			name:      "query error",
+			queryErr:  arbitraryErr,
+			expectErr: arbitraryErr,
+		},
+		{
+			name:      "scan error",
+			rows:      results,
+			scanErr:   arbitraryErr,
+			expectErr: arbitraryErr,
+		},
+		{
+			name:      "series limit",
+			rows:      results,
+			limits:    Limits{MaxSeries: 10},
+			expectErr: errors.ErrQueryMaxSeries,
+		},
+	}
+
+	for _, c := range testCases {
+		t.Run(c.name, func(t *testing.T) {
+			rows := &mockPgxRows{results: c.rows, err: c.scanErr}
+			conn := &rowsConn{rows: rows, err: c.queryErr}
+			querier := &countingQuerier{mapQuerier: mapQuerier{mapping: mapping}}
+			ss := newStreamingSeriesSet(context.Background(), conn, "SELECT", nil, querier, newLimitTracker(c.limits))
+
+			if conn.queries != 0 {
+				t.Fatalf("query ran before the iteration")
+			}
+
+			count := 0
+			var prev labels.Labels
+			for ss.Next() {
+				s := ss.At()
+				if prev != nil && labels.Compare(prev, s.Labels()) >= 0 {
+					t.Errorf("unexpected series order: %v after %v", s.Labels(), prev)
+				}
+				prev = s.Labels()
+				it := s.Iterator()
+				if !it.Next() {
+					t.Fatalf("series %v has no samples", s.Labels())
+				}
+				if _, v := it.At(); v != float64(count) {
+					t.Errorf("unexpected value: got %v wanted %v", v, count)
+				}
+				count++
+			}
+			if ss.Next() {
+				t.Errorf("set is not exhausted")
+			}
+
+			if c.expectErr != nil {
+				if !goErrors.Is(ss.Err(), c.expectErr) {
+					t.Fatalf("unexpected error: got %v wanted %v", ss.Err(), c.expectErr)
+				}
+			} else if ss.Err() != nil {
+				t.Fatalf("unexpected error: %v", ss.Err())
+			}
+			if c.expectErr == nil && count != c.expectSeries {
+				t.Errorf("unexpected number of series: got %d wanted %d", count, c.expectSeries)
+			}
+			if c.expectLookups != 0 && querier.lookups != c.expectLookups {
+				t.Errorf("unexpected number of label lookups: got %d wanted %d", querier.lookups, c.expectLookups)
+			}
+			if conn.queries != 1 {
+				t.Errorf("unexpected number of queries: %d", conn.queries)
+			}
+			if c.queryErr == nil && !rows.closeCalled {
+				t.Errorf("rows were not closed")
+			}
+		})
+	}
+}
+
+func TestStreamingSeriesSetClose(t *testing.T) {
+	rows := &mockPgxRows{results: []seriesSetRow{
+		genSeries([]int64{1}, []pgtype.Timestamptz{{Time: time.Unix(0, 0)}}, []pgtype.Float8{{Float: 1}}),
+		genSeries([]int64{2}, []pgtype.Timestamptz{{Time: time.Unix(0, 0)}}, []pgtype.Float8{{Float: 2}}),
+	}}
+	conn := &rowsConn{rows: rows}
+	querier := mapQuerier{mapping: map[int64]struct {
+		k string
+		v string
+	}{1: {"a", "1"}, 2: {"a", "2"}}}
+
+	unused := newStreamingSeriesSet(context.Background(), conn, "SELECT", nil, querier, newLimitTracker(Limits{}))
+	unused.Close()
+	if conn.queries != 0 || unused.Next() {
+		t.Fatalf("closed set was iterated")
+	}
+
+	ss := newStreamingSeriesSet(context.Background(), conn, "SELECT", nil, querier, newLimitTracker(Limits{}))
+	if !ss.Next() {
+		t.Fatalf("unexpected end of the set: %v", ss.Err())
+	}
+	ss.Close()
+	if !rows.closeCalled {
+		t.Errorf("rows were not closed")
+	}
+	if ss.Next() {
+		t.Errorf("closed set was iterated")
+	}
+}
@@ -0,0 +1,277 @@
+// This file and its contents are licensed under the Apache License 2.0.
+// Please see the included NOTICE for copyright information and
+// LICENSE for a copy of the license.
+
+package querier
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/prometheus/prometheus/pkg/labels"
+	"github.com/timescale/promscale/pkg/pgmodel/common/errors"
+	"github.com/timescale/promscale/pkg/pgmodel/common/schema"
+	pgmodel "github.com/timescale/promscale/pkg/pgmodel/model"
+	"github.com/timescale/promscale/pkg/pgxconn"
+)
+
+const (
+	getRegisteredViewsSQL = "SELECT metric_name, view_schema::text, view_name::text, time_column::text, value_column::text, label_columns::text[] " +
+		"FROM " + schema.Catalog + ".registered_view"
+
+	// viewSeriesSQLFormat groups the rows of a registered view into series
+	// by the values of its label columns.
+	viewSeriesSQLFormat = `SELECT %[1]s, array_agg(%[2]s ORDER BY %[2]s), array_agg(%[3]s::double precision ORDER BY %[2]s)
+	FROM %[4]s
+	WHERE %[2]s >= '%[5]s' AND %[2]s <= '%[6]s' AND %[3]s IS NOT NULL%[7]s
+	GROUP BY 1`
+
+	// registeredViewsCheckInterval is how often the registered views are
+	// read again.
+	registeredViewsCheckInterval = time.Minute
+)
+
+// registeredView is a SQL view, table or continuous aggregate registered to be
+// queried as a metric. Each distinct set of values of its label columns is a
+// series.
+type registeredView struct {
+	metric       string
+	schema       string
+	name         string
+	timeColumn   string
+	valueColumn  string
+	labelColumns []string
+}
+
+// buildQuery returns the query selecting the series of the view matching the
+// matchers in the time range of the filter. It returns false if the matchers
+// can't match any series of the view.
+func (v *registeredView) buildQuery(filter metricTimeRangeFilter, ms []*labels.Matcher) (string, []interface{}, bool) {
+	columns := make(map[string]string, len(v.labelColumns))
+	labelValues := make([]string, len(v.labelColumns))
+	for i, c := range v.labelColumns {
+		columns[c] = fmt.Sprintf("COALESCE(%s::text, '')", pgx.Identifier{c}.Sanitize())
+		labelValues[i] = columns[c]
+	}
+
+	var (
+		predicates strings.Builder
+		values     []interface{}
+	)
+	for _, m := range ms {
+		if m.Name == pgmodel.MetricNameLabelName {
+			if !m.Matches(v.metric) {
+				return "", nil, false
+			}
+			continue
+		}
+		column, ok := columns[m.Name]
+		if !ok {
+			// The series of the view don't have the label.
+			if !m.Matches("") {
+				return "", nil, false
+			}
+			continue
+		}
+
+		var op, value = "", m.Value
+		switch m.Type {
+		case labels.MatchEqual:
+			op = "="
+		case labels.MatchNotEqual:
+			op = "<>"
+		case labels.MatchRegexp:
+			op, value = "~", anchorValue(m.Value)
+		case labels.MatchNotRegexp:
+			op, value = "!~", anchorValue(m.Value)
+		}
+		values = append(values, value)
+		fmt.Fprintf(&predicates, " AND %s %s $%d", column, op, len(values))
+	}
+
+	labelArray := "ARRAY[]::text[]"
+	if len(labelValues) > 0 {
+		labelArray = "ARRAY[" + strings.Join(labelValues, ", ") + "]"
+	}
+	return fmt.Sprintf(viewSeriesSQLFormat,
+		labelArray,
+		pgx.Identifier{v.timeColumn}.Sanitize(),
+		pgx.Identifier{v.valueColumn}.Sanitize(),
+		pgx.Identifier{v.schema, v.name}.Sanitize(),
+		filter.startTime,
+		filter.endTime,
+		predicates.String(),
+	), values, true
+}
+
+// seriesLabels returns the sorted labels of the series with the label column
+// values. Empty values are left out, like missing labels.
+func (v *registeredView) seriesLabels(values []string) labels.Labels {
+	lls := make(labels.Labels, 0, len(values)+1)
+	lls = append(lls, labels.Label{Name: pgmodel.MetricNameLabelName, Value: v.metric})
+	for i, value := range values {
+		if value != "" && i < len(v.labelColumns) {
+			lls = append(lls, labels.Label{Name: v.labelColumns[i], Value: value})
+		}
+	}
+	sort.Sort(lls)
+	return lls
+}
+
+// viewRegistry caches the registered views by metric name.
+type viewRegistry struct {
+	conn pgxconn.PgxConn
+	now  func() time.Time
+
+	lock      sync.Mutex
+	views     map[string]*registeredView
+	checkedAt time.Time
+}
+
+func newViewRegistry(conn pgxconn.PgxConn) *viewRegistry {
+	return &viewRegistry{
+		conn: conn,
+		now:  time.Now,
+	}
+}
+
+// get returns the view registered as the metric, or nil if there is none.
+func (r *viewRegistry) get(ctx context.Context, metric string) (*registeredView, error) {
+	if r == nil {
+		return nil, nil
+	}
+	views, err := r.all(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return views[metric], nil
+}
+
+// all returns the registered views by metric name.
+func (r *viewRegistry) all(ctx context.Context) (map[string]*registeredView, error) {
+	now := r.now()
+	r.lock.Lock()
+	views, checkedAt := r.views, r.checkedAt
+	r.lock.Unlock()
+	if views != nil && now.Sub(checkedAt) < registeredViewsCheckInterval {
+		return views, nil
+	}
+
+	rows, err := r.conn.Query(ctx, getRegisteredViewsSQL)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	views = make(map[string]*registeredView)
+	for rows.Next() {
+		v := &registeredView{}
+		if err := rows.Scan(&v.metric, &v.schema, &v.name, &v.timeColumn, &v.valueColumn, &v.labelColumns); err != nil {
+			return nil, err
+		}
+		views[v.metric] = v
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	r.lock.Lock()
+	r.views, r.checkedAt = views, now
+	r.lock.Unlock()
+	return views, nil
+}
+
+// rejectViews returns errors.ErrViewNotSelectable if the metric name matchers
+// select a registered view, in a query path which only reads the metric
+// tables: cross metric selectors, and the label and series metadata. Views
+// whose name is also the name of a metric are not selected, the metric takes
+// precedence.
+func (q *pgxQuerier) rejectViews(ctx context.Context, ms []*labels.Matcher) error {
+	if q.views == nil {
+		return nil
+	}
+	var nameMatchers []*labels.Matcher
+	for _, m := range ms {
+		if m.Name == pgmodel.MetricNameLabelName {
+			nameMatchers = append(nameMatchers, m)
+		}
+	}
+	if len(nameMatchers) == 0 {
+		return nil
+	}
+
+	views, err := q.views.all(ctx)
+	if err != nil {
+		return err
+	}
+	metrics := make([]string, 0, len(views))
+	for metric := range views {
+		metrics = append(metrics, metric)
+	}
+	sort.Strings(metrics)
+	for _, metric := range metrics {
+		selected := true
+		for _, m := range nameMatchers {
+			selected = selected && m.Matches(metric)
+		}
+		if !selected {
+			continue
+		}
+		_, err := q.getMetricTableName(metric)
+		if err == nil {
+			continue
+		}
+		if err != errors.ErrMissingTableName {
+			return err
+		}
+		return fmt.Errorf("%w: %s", errors.ErrViewNotSelectable, metric)
+	}
+	return nil
+}
+
+// queryView returns the result rows of the series of a registered view
+// matching the matchers.
+func (q *pgxQuerier) queryView(ctx context.Context, view *registeredView, sq *selectQuery) ([]timescaleRow, error) {
+	sqlQuery, values, ok := view.buildQuery(sq.filter, sq.matchers)
+	if !ok {
+		return nil, nil
+	}
+	rows, err := q.conn.Query(ctx, sqlQuery, values...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var out []timescaleRow
+	for rows.Next() {
+		var (
+			row         timescaleRow
+			labelValues []string
+		)
+		if err := rows.Scan(&labelValues, &row.times, &row.values); err != nil {
+			return nil, err
+		}
+		row.labels = view.seriesLabels(labelValues)
+		out = append(out, row)
+		if err := sq.tracker.add(len(row.times.Elements)); err != nil {
+			return nil, err
+		}
+	}
+	return out, rows.Err()
+}
+
+// querySingleView returns the result rows of the view registered as the
+// metric of the query, or no rows if there is none. Functions are not pushed
+// down to views.
+func (q *pgxQuerier) querySingleView(ctx context.Context, sq *selectQuery) ([]timescaleRow, error) {
+	view, err := q.views.get(ctx, sq.metric)
+	if err != nil || view == nil {
+		return nil, err
+	}
+	return q.queryView(ctx, view, sq)
+}
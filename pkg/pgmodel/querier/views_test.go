@@ -0,0 +1,309 @@
+// This file and its contents are licensed under the Apache License 2.0.
+// Please see the included NOTICE for copyright information and
+// LICENSE for a copy of the license.
+
+package querier
+
+import (
+	"context"
+	goErrors "errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/prometheus/prometheus/pkg/labels"
+	"github.com/timescale/promscale/pkg/clockcache"
+	"github.com/timescale/promscale/pkg/pgmodel/cache"
+	"github.com/timescale/promscale/pkg/pgmodel/common/errors"
+	"github.com/timescale/promscale/pkg/pgmodel/lreader"
+	"github.com/timescale/promscale/pkg/pgmodel/model"
+)
+
+var testView = &registeredView{
+	metric:       "http_requests_5m",
+	schema:       "public",
+	name:         "http_requests_5m",
+	timeColumn:   "bucket",
+	valueColumn:  "requests",
+	labelColumns: []string{"job", "status"},
+}
+
+func TestRegisteredViewBuildQuery(t *testing.T) {
+	filter := metricTimeRangeFilter{
+		metric:    "http_requests_5m",
+		startTime: toRFC3339Nano(1000),
+		endTime:   toRFC3339Nano(2000),
+	}
+	query := func(predicates string) string {
+		return `SELECT ARRAY[COALESCE("job"::text, ''), COALESCE("status"::text, '')], array_agg("bucket" ORDER BY "bucket"), array_agg("requests"::double precision ORDER BY "bucket")
+	FROM "public"."http_requests_5m"
+	WHERE "bucket" >= '1970-01-01T00:00:01Z' AND "bucket" <= '1970-01-01T00:00:02Z' AND "requests" IS NOT NULL` + predicates + `
+	GROUP BY 1`
+	}
+
+	testCases := []struct {
+		name     string
+		matchers []*labels.Matcher
+		query    string
+		values   []interface{}
+		noSeries bool
+	}{
+		{
+			name:     "metric name",
+			matchers: []*labels.Matcher{labels.MustNewMatcher(labels.MatchEqual, "__name__", "http_requests_5m")},
+			query:    query(""),
+		},
+		{
+			name: "label columns",
+			matchers: []*labels.Matcher{
+				labels.MustNewMatcher(labels.MatchEqual, "__name__", "http_requests_5m"),
+				labels.MustNewMatcher(labels.MatchEqual, "job", "api"),
+				labels.MustNewMatcher(labels.MatchNotEqual, "status", "200"),
+				labels.MustNewMatcher(labels.MatchRegexp, "status", "5.."),
+				labels.MustNewMatcher(labels.MatchNotRegexp, "job", "^test"),
+			},
+			query: query(` AND COALESCE("job"::text, '') = $1` +
+				` AND COALESCE("status"::text, '') <> $2` +
+				` AND COALESCE("status"::text, '') ~ $3` +
+				` AND COALESCE("job"::text, '') !~ $4`),
+			values: []interface{}{"api", "200", "^5..$", "^test$"},
+		},
+		{
+			name: "missing label matching the empty value",
+			matchers: []*labels.Matcher{
+				labels.MustNewMatcher(labels.MatchEqual, "__name__", "http_requests_5m"),
+				labels.MustNewMatcher(labels.MatchEqual, "instance", ""),
+			},
+			query: query(""),
+		},
+		{
+			name: "missing label",
+			matchers: []*labels.Matcher{
+				labels.MustNewMatcher(labels.MatchEqual, "__name__", "http_requests_5m"),
+				labels.MustNewMatcher(labels.MatchEqual, "instance", "a"),
+			},
+			noSeries: true,
+		},
+		{
+			name: "conflicting metric names",
+			matchers: []*labels.Matcher{
+				labels.MustNewMatcher(labels.MatchEqual, "__name__", "http_requests_5m"),
+				labels.MustNewMatcher(labels.MatchEqual, "__name__", "foo"),
+			},
+			noSeries: true,
+		},
+	}
+
+	for _, c := range testCases {
+		t.Run(c.name, func(t *testing.T) {
+			sql, values, ok := testView.buildQuery(filter, c.matchers)
+			if ok == c.noSeries {
+				t.Fatalf("unexpected result: got %v wanted %v", ok, !c.noSeries)
+			}
+			if c.noSeries {
+				return
+			}
+			if sql != c.query {
+				t.Errorf("unexpected query:\ngot\n%s\nwanted\n%s", sql, c.query)
+			}
+			if !reflect.DeepEqual(values, c.values) {
+				t.Errorf("unexpected values: got %v wanted %v", values, c.values)
+			}
+		})
+	}
+
+	noLabels := &registeredView{metric: "m", schema: "s", name: "v", timeColumn: "t", valueColumn: "v"}
+	sql, _, _ := noLabels.buildQuery(filter, nil)
+	expected := `SELECT ARRAY[]::text[], array_agg("t" ORDER BY "t"), array_agg("v"::double precision ORDER BY "t")
+	FROM "s"."v"
+	WHERE "t" >= '1970-01-01T00:00:01Z' AND "t" <= '1970-01-01T00:00:02Z' AND "v" IS NOT NULL
+	GROUP BY 1`
+	if sql != expected {
+		t.Errorf("unexpected query without label columns:\n%s", sql)
+	}
+}
+
+func TestRegisteredViewSeriesLabels(t *testing.T) {
+	lls := testView.seriesLabels([]string{"api", ""})
+	expected := labels.FromStrings("__name__", "http_requests_5m", "job", "api")
+	if !reflect.DeepEqual(lls, expected) {
+		t.Errorf("unexpected labels: got %v wanted %v", lls, expected)
+	}
+}
+
+func TestViewRegistry(t *testing.T) {
+	viewsQuery := model.SqlQuery{
+		Sql: getRegisteredViewsSQL,
+		Results: model.RowResults{
+			{"http_requests_5m", "public", "http_requests_5m", "bucket", "requests", []string{"job", "status"}},
+		},
+	}
+	conn := model.NewSqlRecorder([]model.SqlQuery{
+		viewsQuery,
+		// The views are read again after the check interval.
+		viewsQuery,
+	}, t)
+	now := time.Unix(0, 0)
+	r := newViewRegistry(conn)
+	r.now = func() time.Time { return now }
+
+	for _, metric := range []string{"http_requests_5m", "foo", "http_requests_5m"} {
+		view, err := r.get(context.Background(), metric)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if metric == "foo" {
+			if view != nil {
+				t.Errorf("unexpected view for an unregistered metric: %+v", view)
+			}
+			continue
+		}
+		if !reflect.DeepEqual(view, testView) {
+			t.Errorf("unexpected view: got %+v wanted %+v", view, testView)
+		}
+	}
+
+	now = now.Add(registeredViewsCheckInterval)
+	if _, err := r.get(context.Background(), "foo"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var nilRegistry *viewRegistry
+	if view, err := nilRegistry.get(context.Background(), "foo"); view != nil || err != nil {
+		t.Errorf("unexpected view without a registry: %v %v", view, err)
+	}
+}
+
+func TestQueryRegisteredView(t *testing.T) {
+	matchers := []*labels.Matcher{
+		labels.MustNewMatcher(labels.MatchEqual, "__name__", "http_requests_5m"),
+		labels.MustNewMatcher(labels.MatchEqual, "job", "api"),
+	}
+	filter := metricTimeRangeFilter{startTime: toRFC3339Nano(1000), endTime: toRFC3339Nano(2000)}
+	viewSQL, _, _ := testView.buildQuery(filter, matchers)
+
+	conn := model.NewSqlRecorder([]model.SqlQuery{
+		{
+			Sql:     getMetricsTableSQL,
+			Args:    []interface{}{"http_requests_5m"},
+			Results: model.RowResults{},
+		},
+		{
+			Sql: getRegisteredViewsSQL,
+			Results: model.RowResults{
+				{"http_requests_5m", "public", "http_requests_5m", "bucket", "requests", []string{"job", "status"}},
+			},
+		},
+		{
+			Sql:  viewSQL,
+			Args: []interface{}{"api"},
+			Results: model.RowResults{
+				{[]string{"api", "500"}, []time.Time{time.Unix(1, 0)}, []float64{2}},
+				{[]string{"api", "200"}, []time.Time{time.Unix(1, 0), time.Unix(2, 0)}, []float64{5, 7}},
+			},
+		},
+	}, t)
+	querier := pgxQuerier{
+		conn:             conn,
+		metricTableNames: cache.NewMetricCache(cache.Config{MetricsCacheSize: 1}),
+		labelsReader:     lreader.NewLabelsReader(conn, clockcache.WithMax(0)),
+		views:            newViewRegistry(conn),
+	}
+
+	ss, _ := querier.Select(context.Background(), 1000, 2000, true, nil, nil, matchers...)
+	var got []labels.Labels
+	for ss.Next() {
+		got = append(got, ss.At().Labels())
+	}
+	if err := ss.Err(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := []labels.Labels{
+		labels.FromStrings("__name__", "http_requests_5m", "job", "api", "status", "200"),
+		labels.FromStrings("__name__", "http_requests_5m", "job", "api", "status", "500"),
+	}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("unexpected series: got %v wanted %v", got, expected)
+	}
+}
+
+func TestRejectRegisteredViews(t *testing.T) {
+	viewsQuery := model.SqlQuery{
+		Sql: getRegisteredViewsSQL,
+		Results: model.RowResults{
+			{"http_requests_5m", "public", "http_requests_5m", "bucket", "requests", []string{"job", "status"}},
+			{"node_load", "public", "node_load_view", "time", "load", []string{"instance"}},
+		},
+	}
+	newQuerier := func(queries []model.SqlQuery) *pgxQuerier {
+		conn := model.NewSqlRecorder(queries, t)
+		return &pgxQuerier{
+			conn: conn,
+			// The metric takes precedence over the view of the same name.
+			metricTableNames: &model.MockMetricCache{MetricCache: map[string]string{"node_load": "node_load"}},
+			labelsReader:     lreader.NewLabelsReader(conn, clockcache.WithMax(0)),
+			views:            newViewRegistry(conn),
+		}
+	}
+	missingMetric := model.SqlQuery{
+		Sql:     getMetricsTableSQL,
+		Args:    []interface{}{"http_requests_5m"},
+		Results: model.RowResults{},
+	}
+
+	testCases := []struct {
+		name      string
+		matchers  []*labels.Matcher
+		queries   []model.SqlQuery
+		expectErr bool
+	}{
+		{
+			name:     "no metric name",
+			matchers: []*labels.Matcher{labels.MustNewMatcher(labels.MatchEqual, "job", "api")},
+		},
+		{
+			name:      "view",
+			matchers:  []*labels.Matcher{labels.MustNewMatcher(labels.MatchRegexp, "__name__", "http_.*")},
+			queries:   []model.SqlQuery{viewsQuery, missingMetric},
+			expectErr: true,
+		},
+		{
+			name:     "view with a metric of the same name",
+			matchers: []*labels.Matcher{labels.MustNewMatcher(labels.MatchEqual, "__name__", "node_load")},
+			queries:  []model.SqlQuery{viewsQuery},
+		},
+		{
+			name: "no view",
+			matchers: []*labels.Matcher{
+				labels.MustNewMatcher(labels.MatchRegexp, "__name__", "http_.*"),
+				labels.MustNewMatcher(labels.MatchNotEqual, "__name__", "http_requests_5m"),
+			},
+			queries: []model.SqlQuery{viewsQuery},
+		},
+	}
+	for _, c := range testCases {
+		t.Run(c.name, func(t *testing.T) {
+			err := newQuerier(c.queries).rejectViews(context.Background(), c.matchers)
+			if c.expectErr != goErrors.Is(err, errors.ErrViewNotSelectable) {
+				t.Errorf("unexpected error: %v", err)
+			}
+		})
+	}
+
+	// The metadata and cross metric paths reject the views.
+	viewMatcher := labels.MustNewMatcher(labels.MatchEqual, "__name__", "http_requests_5m")
+	if _, err := newQuerier([]model.SqlQuery{viewsQuery, missingMetric}).LabelNames(1000, 2000, viewMatcher); !goErrors.Is(err, errors.ErrViewNotSelectable) {
+		t.Errorf("unexpected label names error: %v", err)
+	}
+	if _, err := newQuerier([]model.SqlQuery{viewsQuery, missingMetric}).LabelValues(1000, 2000, "job", viewMatcher); !goErrors.Is(err, errors.ErrViewNotSelectable) {
+		t.Errorf("unexpected label values error: %v", err)
+	}
+	if _, err := newQuerier([]model.SqlQuery{viewsQuery, missingMetric}).SelectSeries(1000, 2000, 10, "", []*labels.Matcher{viewMatcher}); !goErrors.Is(err, errors.ErrViewNotSelectable) {
+		t.Errorf("unexpected series error: %v", err)
+	}
+	ss, _ := newQuerier([]model.SqlQuery{viewsQuery, missingMetric}).Select(context.Background(), 1000, 2000, false, nil, nil,
+		labels.MustNewMatcher(labels.MatchRegexp, "__name__", "http_.*"))
+	if !goErrors.Is(ss.Err(), errors.ErrViewNotSelectable) {
+		t.Errorf("unexpected cross metric select error: %v", ss.Err())
+	}
+}
@@ -0,0 +1,36 @@
+// This file and its contents are licensed under the Apache License 2.0.
+// Please see the included NOTICE for copyright information and
+// LICENSE for a copy of the license.
+
+package relabel
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/timescale/promscale/pkg/util"
+)
+
+var (
+	DroppedSeries = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: util.PromNamespace,
+			Name:      "ingest_relabel_dropped_series_total",
+			Help:      "Number of series of the write requests dropped by the relabeling rules, by the index of the rule.",
+		},
+		[]string{"rule"},
+	)
+	DroppedSamples = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: util.PromNamespace,
+			Name:      "ingest_relabel_dropped_samples_total",
+			Help:      "Number of samples of the series dropped by the relabeling rules, by the index of the rule.",
+		},
+		[]string{"rule"},
+	)
+)
+
+func init() {
+	prometheus.MustRegister(
+		DroppedSeries,
+		DroppedSamples,
+	)
+}
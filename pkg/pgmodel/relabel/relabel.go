@@ -0,0 +1,81 @@
+// This file and its contents are licensed under the Apache License 2.0.
+// Please see the included NOTICE for copyright information and
+// LICENSE for a copy of the license.
+
+// Package relabel applies relabeling rules to the series of the write requests
+// before they are ingested.
+package relabel
+
+import (
+	"fmt"
+	"io/ioutil"
+	"strconv"
+
+	"github.com/prometheus/prometheus/pkg/labels"
+	promRelabel "github.com/prometheus/prometheus/pkg/relabel"
+	"github.com/timescale/promscale/pkg/prompb"
+	"gopkg.in/yaml.v2"
+)
+
+// Rules are relabeling rules in the format of the Prometheus relabel_config.
+// They can drop series, or drop and rewrite their labels.
+type Rules struct {
+	configs []*promRelabel.Config
+	// names are the label values of the rules in the metrics, which is their
+	// index in the rules file.
+	names []string
+}
+
+type rulesFile struct {
+	RelabelConfigs []*promRelabel.Config `yaml:"relabel_configs"`
+}
+
+// LoadFile loads the rules of a YAML file with a relabel_configs list.
+func LoadFile(path string) (*Rules, error) {
+	buf, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading relabel rules: %w", err)
+	}
+	return Parse(buf)
+}
+
+// Parse parses the rules of a YAML document with a relabel_configs list.
+func Parse(buf []byte) (*Rules, error) {
+	var f rulesFile
+	if err := yaml.UnmarshalStrict(buf, &f); err != nil {
+		return nil, fmt.Errorf("parsing relabel rules: %w", err)
+	}
+	r := &Rules{configs: f.RelabelConfigs, names: make([]string, len(f.RelabelConfigs))}
+	for i, c := range f.RelabelConfigs {
+		if c == nil {
+			return nil, fmt.Errorf("parsing relabel rules: rule %d is empty", i)
+		}
+		r.names[i] = strconv.Itoa(i)
+	}
+	return r, nil
+}
+
+// Process applies the rules to the labels of the series, in place. It returns
+// false if a rule dropped the series, which is counted with its samples as
+// dropped by that rule. Nil rules keep the series as is.
+func (r *Rules) Process(t *prompb.TimeSeries) bool {
+	if r == nil || len(r.configs) == 0 {
+		return true
+	}
+	lset := make(labels.Labels, len(t.Labels))
+	for i, l := range t.Labels {
+		lset[i] = labels.Label{Name: l.Name, Value: l.Value}
+	}
+	for i, c := range r.configs {
+		if lset = promRelabel.Process(lset, c); lset == nil {
+			DroppedSeries.WithLabelValues(r.names[i]).Inc()
+			DroppedSamples.WithLabelValues(r.names[i]).Add(float64(len(t.Samples)))
+			return false
+		}
+	}
+	t.Labels = t.Labels[:0]
+	for _, l := range lset {
+		t.Labels = append(t.Labels, prompb.Label{Name: l.Name, Value: l.Value})
+	}
+	return true
+}
@@ -0,0 +1,111 @@
+// This file and its contents are licensed under the Apache License 2.0.
+// Please see the included NOTICE for copyright information and
+// LICENSE for a copy of the license.
+
+package relabel
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/timescale/promscale/pkg/prompb"
+)
+
+const testRules = `
+relabel_configs:
+- action: labeldrop
+  regex: pod_template_hash
+- source_labels: [__name__]
+  regex: go_gc_.*
+  action: drop
+- source_labels: [env]
+  regex: prd
+  target_label: env
+  replacement: production
+`
+
+func TestParse(t *testing.T) {
+	testCases := []struct {
+		name  string
+		rules string
+		count int
+		err   bool
+	}{
+		{name: "rules", rules: testRules, count: 3},
+		{name: "no rules", rules: "", count: 0},
+		{name: "unknown field", rules: "relabel_config:\n- action: drop\n", err: true},
+		{name: "invalid action", rules: "relabel_configs:\n- action: explode\n", err: true},
+		{name: "missing target label", rules: "relabel_configs:\n- action: replace\n  target_label: ''\n", err: true},
+		{name: "empty rule", rules: "relabel_configs:\n-\n", err: true},
+	}
+	for _, c := range testCases {
+		t.Run(c.name, func(t *testing.T) {
+			r, err := Parse([]byte(c.rules))
+			if (err != nil) != c.err {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if err == nil && len(r.configs) != c.count {
+				t.Errorf("unexpected number of rules: got %d wanted %d", len(r.configs), c.count)
+			}
+		})
+	}
+}
+
+func TestProcess(t *testing.T) {
+	r, err := Parse([]byte(testRules))
+	if err != nil {
+		t.Fatal(err)
+	}
+	testCases := []struct {
+		name    string
+		labels  []prompb.Label
+		kept    bool
+		relabel []prompb.Label
+	}{
+		{
+			name:    "unchanged",
+			labels:  []prompb.Label{{Name: "__name__", Value: "up"}, {Name: "job", Value: "api"}},
+			kept:    true,
+			relabel: []prompb.Label{{Name: "__name__", Value: "up"}, {Name: "job", Value: "api"}},
+		},
+		{
+			name:    "label dropped",
+			labels:  []prompb.Label{{Name: "__name__", Value: "up"}, {Name: "pod_template_hash", Value: "5d4f"}},
+			kept:    true,
+			relabel: []prompb.Label{{Name: "__name__", Value: "up"}},
+		},
+		{
+			name:    "label value rewritten",
+			labels:  []prompb.Label{{Name: "env", Value: "prd"}, {Name: "__name__", Value: "up"}},
+			kept:    true,
+			relabel: []prompb.Label{{Name: "__name__", Value: "up"}, {Name: "env", Value: "production"}},
+		},
+		{
+			name:   "series dropped",
+			labels: []prompb.Label{{Name: "__name__", Value: "go_gc_duration_seconds"}},
+		},
+	}
+	for _, c := range testCases {
+		t.Run(c.name, func(t *testing.T) {
+			ts := prompb.TimeSeries{Labels: c.labels, Samples: []prompb.Sample{{Timestamp: 1}, {Timestamp: 2}}}
+			if kept := r.Process(&ts); kept != c.kept {
+				t.Fatalf("unexpected result: got %v wanted %v", kept, c.kept)
+			}
+			if c.kept && !reflect.DeepEqual(ts.Labels, c.relabel) {
+				t.Errorf("unexpected labels: got %v wanted %v", ts.Labels, c.relabel)
+			}
+		})
+	}
+	if dropped := testutil.ToFloat64(DroppedSamples.WithLabelValues("1")); dropped != 2 {
+		t.Errorf("unexpected dropped samples of rule 1: got %v wanted 2", dropped)
+	}
+	if dropped := testutil.ToFloat64(DroppedSeries.WithLabelValues("1")); dropped != 1 {
+		t.Errorf("unexpected dropped series of rule 1: got %v wanted 1", dropped)
+	}
+
+	var nilRules *Rules
+	if !nilRules.Process(&prompb.TimeSeries{}) {
+		t.Errorf("nil rules dropped a series")
+	}
+}
@@ -0,0 +1,23 @@
+// This file and its contents are licensed under the Apache License 2.0.
+// Please see the included NOTICE for copyright information and
+// LICENSE for a copy of the license.
+
+package timewindow
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/timescale/promscale/pkg/util"
+)
+
+var OutOfWindowSamples = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Namespace: util.PromNamespace,
+		Name:      "ingest_out_of_window_samples_total",
+		Help:      "Number of samples outside the accepted time window, by the exceeded bound (past or future) and the policy (drop or reject).",
+	},
+	[]string{"bound", "policy"},
+)
+
+func init() {
+	prometheus.MustRegister(OutOfWindowSamples)
+}
@@ -0,0 +1,107 @@
+// This file and its contents are licensed under the Apache License 2.0.
+// Please see the included NOTICE for copyright information and
+// LICENSE for a copy of the license.
+
+// Package timewindow validates the timestamps of the ingested samples against
+// an acceptance window around the current time.
+package timewindow
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/prometheus/common/model"
+	"github.com/timescale/promscale/pkg/log"
+	"github.com/timescale/promscale/pkg/pgmodel/common/errors"
+	"github.com/timescale/promscale/pkg/prompb"
+)
+
+// The policies for the samples outside the window.
+const (
+	PolicyDrop   = "drop"
+	PolicyReject = "reject"
+)
+
+const (
+	boundPast   = "past"
+	boundFuture = "future"
+)
+
+// Window is the acceptance window of the sample timestamps.
+type Window struct {
+	maxAge        time.Duration
+	maxFutureSkew time.Duration
+	policy        string
+}
+
+// New returns the window accepting samples at most maxAge old, and at most
+// maxFutureSkew ahead of the current time. A bound of 0 is disabled, and the
+// window is nil if both are. Samples outside the window are dropped or
+// rejected according to the policy.
+func New(maxAge, maxFutureSkew time.Duration, policy string) (*Window, error) {
+	if maxAge < 0 || maxFutureSkew < 0 {
+		return nil, fmt.Errorf("the bounds of the time window must not be negative")
+	}
+	if policy != PolicyDrop && policy != PolicyReject {
+		return nil, fmt.Errorf("invalid out of window policy %q, must be one of %s or %s", policy, PolicyDrop, PolicyReject)
+	}
+	if maxAge == 0 && maxFutureSkew == 0 {
+		return nil, nil
+	}
+	return &Window{maxAge: maxAge, maxFutureSkew: maxFutureSkew, policy: policy}, nil
+}
+
+// Filter returns the samples within the window at the time now, in place. If
+// the policy is to reject samples outside the window, it returns a
+// non-retryable error instead. A nil window accepts all samples.
+func (w *Window) Filter(samples []prompb.Sample, now time.Time) ([]prompb.Sample, error) {
+	if w == nil {
+		return samples, nil
+	}
+	minT, maxT := int64(model.TimeFromUnixNano(now.Add(-w.maxAge).UnixNano())), int64(model.TimeFromUnixNano(now.Add(w.maxFutureSkew).UnixNano()))
+	var past, future int
+	numAccepted := 0
+	for _, sample := range samples {
+		switch {
+		case w.maxAge > 0 && sample.Timestamp < minT:
+			past++
+		case w.maxFutureSkew > 0 && sample.Timestamp > maxT:
+			future++
+		default:
+			samples[numAccepted] = sample
+			numAccepted++
+		}
+	}
+	if past == 0 && future == 0 {
+		return samples, nil
+	}
+
+	w.report(boundPast, past)
+	w.report(boundFuture, future)
+	if w.policy == PolicyReject {
+		return nil, errors.NewNonRetryableError(errors.ReasonOutOfWindow,
+			fmt.Errorf("%w: %d samples older than %s, %d samples more than %s ahead", errors.ErrSampleOutOfWindow, past, w.maxAge, future, w.maxFutureSkew))
+	}
+	for i := numAccepted; i < len(samples); i++ {
+		samples[i] = prompb.Sample{}
+	}
+	return samples[:numAccepted], nil
+}
+
+// WithoutMaxAge returns the window without its bound on the age of the
+// samples, e.g. for backfilled samples, which are old by definition. It is nil
+// if the window does not bound the future skew.
+func (w *Window) WithoutMaxAge() *Window {
+	if w == nil || w.maxFutureSkew == 0 {
+		return nil
+	}
+	return &Window{maxFutureSkew: w.maxFutureSkew, policy: w.policy}
+}
+
+func (w *Window) report(bound string, samples int) {
+	if samples == 0 {
+		return
+	}
+	OutOfWindowSamples.WithLabelValues(bound, w.policy).Add(float64(samples))
+	log.DebugRateLimited("msg", "Samples outside the accepted time window", "bound", bound, "policy", w.policy)
+}
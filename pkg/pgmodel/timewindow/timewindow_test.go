@@ -0,0 +1,131 @@
+// This file and its contents are licensed under the Apache License 2.0.
+// Please see the included NOTICE for copyright information and
+// LICENSE for a copy of the license.
+
+package timewindow
+
+import (
+	goErrors "errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/timescale/promscale/pkg/pgmodel/common/errors"
+	"github.com/timescale/promscale/pkg/prompb"
+)
+
+func TestNew(t *testing.T) {
+	testCases := []struct {
+		name          string
+		maxAge        time.Duration
+		maxFutureSkew time.Duration
+		policy        string
+		disabled      bool
+		err           bool
+	}{
+		{name: "disabled", policy: PolicyDrop, disabled: true},
+		{name: "max age", maxAge: time.Hour, policy: PolicyDrop},
+		{name: "max future skew", maxFutureSkew: time.Hour, policy: PolicyReject},
+		{name: "negative bound", maxAge: -time.Hour, policy: PolicyDrop, err: true},
+		{name: "invalid policy", maxAge: time.Hour, policy: "ignore", err: true},
+	}
+	for _, c := range testCases {
+		t.Run(c.name, func(t *testing.T) {
+			w, err := New(c.maxAge, c.maxFutureSkew, c.policy)
+			if (err != nil) != c.err {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if err == nil && (w == nil) != c.disabled {
+				t.Errorf("unexpected window: %v", w)
+			}
+		})
+	}
+}
+
+func TestFilter(t *testing.T) {
+	now := time.Unix(10000, 0)
+	samples := func() []prompb.Sample {
+		return []prompb.Sample{
+			{Timestamp: 1000, Value: 1},     // 1970, way too old
+			{Timestamp: 9000000, Value: 2},  // 1000s old
+			{Timestamp: 10000000, Value: 3}, // now
+			{Timestamp: 10030000, Value: 4}, // 30s ahead
+			{Timestamp: 99000000, Value: 5}, // way ahead
+		}
+	}
+	testCases := []struct {
+		name          string
+		maxAge        time.Duration
+		maxFutureSkew time.Duration
+		policy        string
+		expected      []float64
+		past, future  float64
+		err           bool
+	}{
+		{name: "disabled", policy: PolicyDrop, expected: []float64{1, 2, 3, 4, 5}},
+		{name: "drop", maxAge: time.Hour, maxFutureSkew: time.Minute, policy: PolicyDrop, expected: []float64{2, 3, 4}, past: 1, future: 1},
+		{name: "only max age", maxAge: time.Minute, policy: PolicyDrop, expected: []float64{3, 4, 5}, past: 2},
+		{name: "only future skew", maxFutureSkew: time.Second, policy: PolicyDrop, expected: []float64{1, 2, 3}, future: 2},
+		{name: "reject", maxAge: time.Hour, maxFutureSkew: time.Minute, policy: PolicyReject, past: 1, future: 1, err: true},
+	}
+	for _, c := range testCases {
+		t.Run(c.name, func(t *testing.T) {
+			w, err := New(c.maxAge, c.maxFutureSkew, c.policy)
+			if err != nil {
+				t.Fatal(err)
+			}
+			pastBefore := testutil.ToFloat64(OutOfWindowSamples.WithLabelValues(boundPast, c.policy))
+			futureBefore := testutil.ToFloat64(OutOfWindowSamples.WithLabelValues(boundFuture, c.policy))
+
+			filtered, err := w.Filter(samples(), now)
+			if c.err {
+				if reason, _ := errors.NonRetryableReason(err); reason != errors.ReasonOutOfWindow || !goErrors.Is(err, errors.ErrSampleOutOfWindow) {
+					t.Fatalf("unexpected error: %v", err)
+				}
+			} else {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				var values []float64
+				for _, s := range filtered {
+					values = append(values, s.Value)
+				}
+				if !reflect.DeepEqual(values, c.expected) {
+					t.Errorf("unexpected samples: got %v wanted %v", values, c.expected)
+				}
+			}
+
+			if past := testutil.ToFloat64(OutOfWindowSamples.WithLabelValues(boundPast, c.policy)) - pastBefore; past != c.past {
+				t.Errorf("unexpected samples in the past: got %v wanted %v", past, c.past)
+			}
+			if future := testutil.ToFloat64(OutOfWindowSamples.WithLabelValues(boundFuture, c.policy)) - futureBefore; future != c.future {
+				t.Errorf("unexpected samples in the future: got %v wanted %v", future, c.future)
+			}
+		})
+	}
+}
+
+func TestWithoutMaxAge(t *testing.T) {
+	now := time.Unix(10000, 0)
+	w, err := New(time.Hour, time.Minute, PolicyDrop)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	samples := []prompb.Sample{
+		{Timestamp: 1000, Value: 1},     // 1970, way too old
+		{Timestamp: 10000000, Value: 2}, // now
+		{Timestamp: 99000000, Value: 3}, // way ahead
+	}
+	accepted, err := w.WithoutMaxAge().Filter(samples, now)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(accepted, samples[:2]) {
+		t.Errorf("unexpected samples: got %v", accepted)
+	}
+
+	if w, _ = New(time.Hour, 0, PolicyDrop); w.WithoutMaxAge() != nil {
+		t.Errorf("window without future skew bound is not nil")
+	}
+}
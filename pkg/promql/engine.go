@@ -56,10 +56,14 @@ type Queryable interface {
 type Querier interface {
 	// LabelValues returns all potential values for a label name.
 	// It is not safe to use the strings beyond the lifefime of the querier.
-	LabelValues(name string) ([]string, storage.Warnings, error)
+	// If matchers are specified the returned result set is reduced
+	// to label values of metrics matching the matchers.
+	LabelValues(name string, matchers ...*labels.Matcher) ([]string, storage.Warnings, error)
 
 	// LabelNames returns all the unique label names present in the block in sorted order.
-	LabelNames() ([]string, storage.Warnings, error)
+	// If matchers are specified the returned result set is reduced
+	// to label names of metrics matching the matchers.
+	LabelNames(matchers ...*labels.Matcher) ([]string, storage.Warnings, error)
 
 	// Close releases the resources of the Querier.
 	Close() error
@@ -805,7 +809,11 @@ func (ng *Engine) populateSeries(querier Querier, s *parser.EvalStmt) parser.Nod
 			hints.By, hints.Grouping = extractGroupsFromPath(path)
 
 			if topNode != nil {
-				return fmt.Errorf("assigning topNode twice")
+				// Only a single node can be pushed down, the other
+				// selectors are evaluated without pushdown.
+				set, _ = querier.Select(false, hints, nil, n.LabelMatchers...)
+				n.UnexpandedSeriesSet = set
+				return nil
 			}
 			set, topNode = querier.Select(false, hints, path, n.LabelMatchers...)
 			n.UnexpandedSeriesSet = set
@@ -112,7 +112,11 @@ func (t *QuerierWrapper) Select(b bool, sh *storage.SelectHints, _ []parser.Node
 	return ss, nil
 }
 
-func (t *QuerierWrapper) LabelValues(n string) ([]string, storage.Warnings, error) {
+func (t *QuerierWrapper) LabelValues(n string, m ...*labels.Matcher) ([]string, storage.Warnings, error) {
+	return nil, nil, nil
+}
+
+func (t *QuerierWrapper) LabelNames(m ...*labels.Matcher) ([]string, storage.Warnings, error) {
 	return nil, nil, nil
 }
 
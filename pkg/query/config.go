@@ -0,0 +1,62 @@
+// This file and its contents are licensed under the Apache License 2.0.
+// Please see the included NOTICE for copyright information and
+// LICENSE for a copy of the license.
+
+package query
+
+import (
+	"flag"
+	"fmt"
+	"net/url"
+	"time"
+)
+
+// FederationConfig configures reading the recent samples from a live
+// Prometheus as well as from the database.
+type FederationConfig struct {
+	// PrometheusReadURL is the remote read endpoint of the Prometheus.
+	// Federation is disabled without it.
+	PrometheusReadURL string
+	// RecentBoundary is how far back from now the samples are read from
+	// Prometheus.
+	RecentBoundary time.Duration
+	// ReadTimeout is the timeout of the remote read requests.
+	ReadTimeout time.Duration
+	// MaxResponseBytes is the maximum decompressed size of the remote read
+	// responses.
+	MaxResponseBytes int64
+}
+
+// ParseFlags parses the configuration flags specific to query federation.
+func ParseFlags(fs *flag.FlagSet, cfg *FederationConfig) *FederationConfig {
+	fs.StringVar(&cfg.PrometheusReadURL, "federation-prometheus-read-url", "", "Remote read URL of a Prometheus serving the recent samples, e.g. 'http://prometheus:9090/api/v1/read'. If set, PromQL queries merge the samples "+
+		"newer than federation-recent-boundary read from Prometheus with the samples of the database, so that they stay fresh while remote write lags. Federation is disabled by default.")
+	fs.DurationVar(&cfg.RecentBoundary, "federation-recent-boundary", 2*time.Hour, "How far back from now the samples are read from Prometheus as well as from the database. It should cover the remote write lag, within the retention of Prometheus.")
+	fs.DurationVar(&cfg.ReadTimeout, "federation-read-timeout", 30*time.Second, "Timeout of the remote read requests to Prometheus. Queries return the samples of the database with a warning when Prometheus fails to answer.")
+	fs.Int64Var(&cfg.MaxResponseBytes, "federation-read-max-response-bytes", 128<<20, "Maximum size in bytes of a remote read response of Prometheus, once decompressed. Larger responses fail like unanswered requests.")
+	return cfg
+}
+
+// Validate validates the federation configuration.
+func Validate(cfg *FederationConfig) error {
+	if cfg.PrometheusReadURL == "" {
+		return nil
+	}
+	u, err := url.Parse(cfg.PrometheusReadURL)
+	if err != nil {
+		return fmt.Errorf("invalid federation-prometheus-read-url: %w", err)
+	}
+	if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("invalid federation-prometheus-read-url: %s is not an HTTP URL", cfg.PrometheusReadURL)
+	}
+	if cfg.RecentBoundary <= 0 {
+		return fmt.Errorf("federation-recent-boundary must be positive")
+	}
+	if cfg.ReadTimeout <= 0 {
+		return fmt.Errorf("federation-read-timeout must be positive")
+	}
+	if cfg.MaxResponseBytes <= 0 {
+		return fmt.Errorf("federation-read-max-response-bytes must be positive")
+	}
+	return nil
+}
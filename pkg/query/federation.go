@@ -0,0 +1,135 @@
+// This file and its contents are licensed under the Apache License 2.0.
+// Please see the included NOTICE for copyright information and
+// LICENSE for a copy of the license.
+
+package query
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/prometheus/pkg/labels"
+	"github.com/prometheus/prometheus/pkg/timestamp"
+	"github.com/prometheus/prometheus/promql/parser"
+	"github.com/prometheus/prometheus/storage"
+	"github.com/timescale/promscale/pkg/log"
+	pgQuerier "github.com/timescale/promscale/pkg/pgmodel/querier"
+	"github.com/timescale/promscale/pkg/promql"
+	"github.com/timescale/promscale/pkg/util"
+)
+
+var federationReadErrors = prometheus.NewCounter(
+	prometheus.CounterOpts{
+		Namespace: util.PromNamespace,
+		Name:      "federation_read_errors_total",
+		Help:      "Number of failed remote reads of the recent samples from Prometheus.",
+	},
+)
+
+func init() {
+	prometheus.MustRegister(federationReadErrors)
+}
+
+// NewHybridQueryable returns a queryable reading the samples from the supplied
+// queryable, and the samples newer than the recent boundary from the remote
+// read endpoint of Prometheus as well.
+func NewHybridQueryable(queryable promql.Queryable, cfg FederationConfig) promql.Queryable {
+	log.Info("msg", "Reading recent samples from Prometheus", "url", cfg.PrometheusReadURL, "boundary", cfg.RecentBoundary)
+	recent := newRemoteReadQueryable(cfg.PrometheusReadURL, cfg.ReadTimeout, cfg.MaxResponseBytes)
+	return newHybridQueryable(queryable, recent, cfg.RecentBoundary)
+}
+
+func newHybridQueryable(queryable promql.Queryable, recent storage.Queryable, boundary time.Duration) *hybridQueryable {
+	return &hybridQueryable{
+		queryable: queryable,
+		recent:    recent,
+		boundary:  boundary,
+		now:       time.Now,
+	}
+}
+
+// hybridQueryable merges the samples of the database with the recent samples
+// of Prometheus, which remote write may not have sent yet.
+type hybridQueryable struct {
+	queryable promql.Queryable
+	recent    storage.Queryable
+	boundary  time.Duration
+	now       func() time.Time
+}
+
+func (h *hybridQueryable) Querier(ctx context.Context, mint, maxt int64) (promql.Querier, error) {
+	q, err := h.queryable.Querier(ctx, mint, maxt)
+	if err != nil {
+		return nil, err
+	}
+	start := timestamp.FromTime(h.now().Add(-h.boundary))
+	if maxt < start {
+		return q, nil
+	}
+	if mint > start {
+		start = mint
+	}
+	recent, err := h.recent.Querier(ctx, start, maxt)
+	if err != nil {
+		_ = q.Close()
+		return nil, err
+	}
+	return &hybridQuerier{Querier: q, recent: recent}, nil
+}
+
+// hybridQuerier reads the label names and values from the database only.
+type hybridQuerier struct {
+	promql.Querier
+	recent storage.Querier
+}
+
+// Select merges the series of the database with the recent series of
+// Prometheus, deduplicating the samples read from both. Functions are not
+// pushed down, since they have to be evaluated on the merged samples. If
+// Prometheus fails, the series of the database are returned with a warning.
+func (q *hybridQuerier) Select(_ bool, hints *storage.SelectHints, _ []parser.Node, matchers ...*labels.Matcher) (storage.SeriesSet, parser.Node) {
+	// The series have to be sorted to be merged.
+	ss, _ := q.Querier.Select(true, hints, nil, matchers...)
+	if ss.Err() != nil {
+		return ss, nil
+	}
+	recent := q.recent.Select(true, hints, matchers...)
+	if err := recent.Err(); err != nil {
+		federationReadErrors.Inc()
+		log.Warn("msg", "failed to read the recent samples from Prometheus", "err", err)
+		warnings := append(storage.Warnings{fmt.Errorf("recent samples are missing, reading them from Prometheus failed: %w", err)}, recent.Warnings()...)
+		return &seriesSetWithWarnings{SeriesSet: ss, warnings: warnings}, nil
+	}
+	return storage.NewMergeSeriesSet([]storage.SeriesSet{ss, recent}, storage.ChainedSeriesMerge), nil
+}
+
+// SelectSeries returns a page of the series of the database only.
+func (q *hybridQuerier) SelectSeries(limit int, token string, matcherSets ...[]*labels.Matcher) (*pgQuerier.SeriesPage, error) {
+	s, ok := q.Querier.(interface {
+		SelectSeries(limit int, token string, matcherSets ...[]*labels.Matcher) (*pgQuerier.SeriesPage, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("series pagination is not supported")
+	}
+	return s.SelectSeries(limit, token, matcherSets...)
+}
+
+func (q *hybridQuerier) Close() error {
+	err := q.Querier.Close()
+	if rErr := q.recent.Close(); err == nil {
+		err = rErr
+	}
+	return err
+}
+
+// seriesSetWithWarnings adds warnings to a series set.
+type seriesSetWithWarnings struct {
+	storage.SeriesSet
+	warnings storage.Warnings
+}
+
+func (s *seriesSetWithWarnings) Warnings() storage.Warnings {
+	return append(s.SeriesSet.Warnings(), s.warnings...)
+}
@@ -0,0 +1,253 @@
+// This file and its contents are licensed under the Apache License 2.0.
+// Please see the included NOTICE for copyright information and
+// LICENSE for a copy of the license.
+
+package query
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/golang/snappy"
+	"github.com/prometheus/prometheus/pkg/labels"
+	"github.com/prometheus/prometheus/pkg/timestamp"
+	"github.com/prometheus/prometheus/promql/parser"
+	"github.com/prometheus/prometheus/storage"
+	"github.com/prometheus/prometheus/tsdb/tsdbutil"
+	"github.com/timescale/promscale/pkg/prompb"
+	"github.com/timescale/promscale/pkg/promql"
+)
+
+type testSeries map[string][]sample
+
+func (ts testSeries) seriesSet() storage.SeriesSet {
+	var series []storage.Series
+	for _, name := range []string{"a", "b", "c"} {
+		samples, ok := ts[name]
+		if !ok {
+			continue
+		}
+		s := make([]tsdbutil.Sample, len(samples))
+		for i := range samples {
+			s[i] = samples[i]
+		}
+		series = append(series, storage.NewListSeries(labels.FromStrings("__name__", name), s))
+	}
+	return &listSeriesSet{series: series, idx: -1}
+}
+
+type testQueryable struct {
+	series     testSeries
+	err        error
+	mint, maxt int64
+}
+
+func (q *testQueryable) Querier(_ context.Context, mint, maxt int64) (promql.Querier, error) {
+	q.mint, q.maxt = mint, maxt
+	return &testQuerier{q}, nil
+}
+
+type testQuerier struct{ *testQueryable }
+
+func (q *testQuerier) LabelValues(string, ...*labels.Matcher) ([]string, storage.Warnings, error) {
+	return nil, nil, nil
+}
+func (q *testQuerier) LabelNames(...*labels.Matcher) ([]string, storage.Warnings, error) {
+	return nil, nil, nil
+}
+func (q *testQuerier) Close() error { return nil }
+func (q *testQuerier) Select(bool, *storage.SelectHints, []parser.Node, ...*labels.Matcher) (storage.SeriesSet, parser.Node) {
+	return q.series.seriesSet(), nil
+}
+
+type testRecentQueryable struct{ *testQueryable }
+
+func (q testRecentQueryable) Querier(_ context.Context, mint, maxt int64) (storage.Querier, error) {
+	q.mint, q.maxt = mint, maxt
+	return &testRecentQuerier{testQuerier{q.testQueryable}}, nil
+}
+
+type testRecentQuerier struct{ testQuerier }
+
+func (q *testRecentQuerier) LabelNames() ([]string, storage.Warnings, error) { return nil, nil, nil }
+func (q *testRecentQuerier) Select(bool, *storage.SelectHints, ...*labels.Matcher) storage.SeriesSet {
+	if q.err != nil {
+		return storage.ErrSeriesSet(q.err)
+	}
+	return q.series.seriesSet()
+}
+
+func TestHybridQueryable(t *testing.T) {
+	now := time.Unix(10000, 0)
+	boundary := time.Hour
+	recentStart := timestamp.FromTime(now.Add(-boundary))
+
+	dbSeries := testSeries{"a": {{1000, 1}, {2000, 2}}, "b": {{recentStart, 3}}}
+
+	testCases := []struct {
+		name         string
+		maxt         int64
+		recentSeries testSeries
+		recentErr    error
+		expected     testSeries
+		warnings     int
+	}{
+		{
+			name:         "older than the boundary",
+			maxt:         recentStart - 1,
+			recentSeries: testSeries{"c": {{recentStart, 5}}},
+			expected:     dbSeries,
+		},
+		{
+			name:         "merged with the recent samples",
+			maxt:         timestamp.FromTime(now),
+			recentSeries: testSeries{"b": {{recentStart, 3}, {recentStart + 1000, 4}}, "c": {{recentStart, 5}}},
+			expected: testSeries{
+				"a": {{1000, 1}, {2000, 2}},
+				"b": {{recentStart, 3}, {recentStart + 1000, 4}},
+				"c": {{recentStart, 5}},
+			},
+		},
+		{
+			name:      "failed to read the recent samples",
+			maxt:      timestamp.FromTime(now),
+			recentErr: fmt.Errorf("connection refused"),
+			expected:  dbSeries,
+			warnings:  1,
+		},
+	}
+
+	for _, c := range testCases {
+		t.Run(c.name, func(t *testing.T) {
+			db := &testQueryable{series: dbSeries}
+			recent := &testQueryable{series: c.recentSeries, err: c.recentErr}
+			h := newHybridQueryable(db, testRecentQueryable{recent}, boundary)
+			h.now = func() time.Time { return now }
+
+			q, err := h.Querier(context.Background(), 0, c.maxt)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			defer q.Close()
+
+			ss, topNode := q.Select(false, nil, nil, labels.MustNewMatcher(labels.MatchRegexp, "__name__", ".+"))
+			if topNode != nil {
+				t.Errorf("unexpected pushdown: %v", topNode)
+			}
+			got := testSeries{}
+			for ss.Next() {
+				s := ss.At()
+				it := s.Iterator()
+				var samples []sample
+				for it.Next() {
+					ts, v := it.At()
+					samples = append(samples, sample{ts, v})
+				}
+				got[s.Labels().Get("__name__")] = samples
+			}
+			if err := ss.Err(); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(got, c.expected) {
+				t.Errorf("unexpected series: got %v wanted %v", got, c.expected)
+			}
+			if len(ss.Warnings()) != c.warnings {
+				t.Errorf("unexpected warnings: %v", ss.Warnings())
+			}
+		})
+	}
+}
+
+func TestHybridQueryableRecentRange(t *testing.T) {
+	now := time.Unix(10000, 0)
+	db := &testQueryable{}
+	recent := &testQueryable{mint: -1, maxt: -1}
+	h := newHybridQueryable(db, testRecentQueryable{recent}, time.Hour)
+	h.now = func() time.Time { return now }
+
+	recentStart := timestamp.FromTime(now.Add(-time.Hour))
+	for _, c := range []struct {
+		mint, maxt, expectedMint int64
+	}{
+		{mint: 0, maxt: recentStart + 1, expectedMint: recentStart},
+		{mint: recentStart + 100, maxt: recentStart + 200, expectedMint: recentStart + 100},
+	} {
+		if _, err := h.Querier(context.Background(), c.mint, c.maxt); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if db.mint != c.mint || db.maxt != c.maxt {
+			t.Errorf("unexpected database range: got [%d, %d] wanted [%d, %d]", db.mint, db.maxt, c.mint, c.maxt)
+		}
+		if recent.mint != c.expectedMint || recent.maxt != c.maxt {
+			t.Errorf("unexpected recent range: got [%d, %d] wanted [%d, %d]", recent.mint, recent.maxt, c.expectedMint, c.maxt)
+		}
+	}
+}
+
+func TestRemoteReadQuerier(t *testing.T) {
+	var received *prompb.ReadRequest
+	fail := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if fail {
+			http.Error(w, "unavailable", http.StatusServiceUnavailable)
+			return
+		}
+		compressed, _ := ioutil.ReadAll(r.Body)
+		data, err := snappy.Decode(nil, compressed)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		received = &prompb.ReadRequest{}
+		if err := proto.Unmarshal(data, received); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		resp := &prompb.ReadResponse{Results: []*prompb.QueryResult{{Timeseries: []*prompb.TimeSeries{
+			{Labels: []prompb.Label{{Name: "__name__", Value: "b"}}, Samples: []prompb.Sample{{Timestamp: 2, Value: 2}}},
+			{Labels: []prompb.Label{{Name: "__name__", Value: "a"}}, Samples: []prompb.Sample{{Timestamp: 1, Value: 1}}},
+		}}}}
+		data, _ = proto.Marshal(resp)
+		_, _ = w.Write(snappy.Encode(nil, data))
+	}))
+	defer server.Close()
+
+	q, _ := newRemoteReadQueryable(server.URL, time.Minute, 1<<20).Querier(context.Background(), 1000, 2000)
+	hints := &storage.SelectHints{Start: 1000, End: 2000, Step: 10, Func: "rate", Range: 300}
+	ss := q.Select(true, hints, labels.MustNewMatcher(labels.MatchRegexp, "__name__", "a|b"))
+
+	expectedQuery := &prompb.Query{
+		StartTimestampMs: 1000,
+		EndTimestampMs:   2000,
+		Matchers:         []*prompb.LabelMatcher{{Type: prompb.LabelMatcher_RE, Name: "__name__", Value: "a|b"}},
+		Hints:            &prompb.ReadHints{StartMs: 1000, EndMs: 2000, StepMs: 10, Func: "rate", RangeMs: 300},
+	}
+	if received == nil || len(received.Queries) != 1 || !reflect.DeepEqual(received.Queries[0], expectedQuery) {
+		t.Errorf("unexpected request: got %v wanted %v", received, expectedQuery)
+	}
+	var names []string
+	for ss.Next() {
+		names = append(names, ss.At().Labels().Get("__name__"))
+	}
+	if err := ss.Err(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(names, []string{"a", "b"}) {
+		t.Errorf("unexpected series, not sorted: %v", names)
+	}
+
+	small, _ := newRemoteReadQueryable(server.URL, time.Minute, 16).Querier(context.Background(), 1000, 2000)
+	if ss := small.Select(true, nil); ss.Err() == nil {
+		t.Errorf("expected an error for a response exceeding the maximum size")
+	}
+
+	fail = true
+	if ss := q.Select(true, nil); ss.Err() == nil {
+		t.Errorf("expected an error for a failed remote read")
+	}
+}
@@ -6,29 +6,29 @@ package query
 
 import (
 	"context"
+	"sync"
 
 	"github.com/prometheus/prometheus/pkg/labels"
 	"github.com/prometheus/prometheus/promql/parser"
 	"github.com/prometheus/prometheus/storage"
-	"github.com/timescale/promscale/pkg/pgmodel/lreader"
 	pgQuerier "github.com/timescale/promscale/pkg/pgmodel/querier"
 	"github.com/timescale/promscale/pkg/promql"
 )
 
-func NewQueryable(q pgQuerier.Querier, labelsReader lreader.LabelsReader) promql.Queryable {
-	return &queryable{querier: q, labelsReader: labelsReader}
+func NewQueryable(q pgQuerier.Querier) promql.Queryable {
+	return &queryable{querier: q}
 }
 
 type queryable struct {
-	querier      pgQuerier.Querier
-	labelsReader lreader.LabelsReader
+	querier pgQuerier.Querier
 }
 
+// Querier returns a querier for a single query. The limits on the series and
+// samples loaded apply to all the selects of the query together.
 func (q queryable) Querier(ctx context.Context, mint, maxt int64) (promql.Querier, error) {
 	return &querier{
-		ctx: ctx, mint: mint, maxt: maxt,
+		ctx: pgQuerier.WithLimitTracker(ctx), mint: mint, maxt: maxt,
 		metricsReader: q.querier,
-		labelsReader:  q.labelsReader,
 	}, nil
 }
 
@@ -36,23 +36,56 @@ type querier struct {
 	ctx           context.Context
 	mint, maxt    int64
 	metricsReader pgQuerier.Querier
-	labelsReader  lreader.LabelsReader
+
+	lock sync.Mutex
+	// sets are the selected series sets holding database resources until
+	// they are iterated to the end or closed.
+	sets []closer
+}
+
+type closer interface {
+	Close()
 }
 
-func (q querier) LabelValues(name string) ([]string, storage.Warnings, error) {
-	lVals, err := q.labelsReader.LabelValues(name)
+// LabelValues returns the values of the label name, of the series matching
+// the matchers within the time range of the querier. The values of the series
+// marked for deletion are never returned.
+func (q *querier) LabelValues(name string, matchers ...*labels.Matcher) ([]string, storage.Warnings, error) {
+	lVals, err := q.metricsReader.LabelValues(q.mint, q.maxt, name, matchers...)
 	return lVals, nil, err
 }
 
-func (q querier) LabelNames() ([]string, storage.Warnings, error) {
-	lNames, err := q.labelsReader.LabelNames()
+// LabelNames returns the label names of the series matching the matchers
+// within the time range of the querier. The names of the series marked for
+// deletion are never returned.
+func (q *querier) LabelNames(matchers ...*labels.Matcher) ([]string, storage.Warnings, error) {
+	lNames, err := q.metricsReader.LabelNames(q.mint, q.maxt, matchers...)
 	return lNames, nil, err
 }
 
-func (q querier) Close() error {
+// Close releases the series sets which were not iterated to the end.
+func (q *querier) Close() error {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	for _, s := range q.sets {
+		s.Close()
+	}
+	q.sets = nil
 	return nil
 }
 
-func (q querier) Select(sortSeries bool, hints *storage.SelectHints, path []parser.Node, matchers ...*labels.Matcher) (storage.SeriesSet, parser.Node) {
-	return q.metricsReader.Select(q.mint, q.maxt, sortSeries, hints, path, matchers...)
+func (q *querier) Select(sortSeries bool, hints *storage.SelectHints, path []parser.Node, matchers ...*labels.Matcher) (storage.SeriesSet, parser.Node) {
+	ss, topNode := q.metricsReader.Select(q.ctx, q.mint, q.maxt, sortSeries, hints, path, matchers...)
+	if c, ok := ss.(closer); ok {
+		q.lock.Lock()
+		q.sets = append(q.sets, c)
+		q.lock.Unlock()
+	}
+	return ss, topNode
+}
+
+// SelectSeries returns a page of the series matching any of the matcher sets
+// within the querier's time range.
+func (q *querier) SelectSeries(limit int, token string, matcherSets ...[]*labels.Matcher) (*pgQuerier.SeriesPage, error) {
+	return q.metricsReader.SelectSeries(q.mint, q.maxt, limit, token, matcherSets...)
 }
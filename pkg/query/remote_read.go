@@ -0,0 +1,219 @@
+// This file and its contents are licensed under the Apache License 2.0.
+// Please see the included NOTICE for copyright information and
+// LICENSE for a copy of the license.
+
+package query
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/golang/snappy"
+	"github.com/prometheus/prometheus/pkg/labels"
+	"github.com/prometheus/prometheus/storage"
+	"github.com/prometheus/prometheus/tsdb/tsdbutil"
+	"github.com/timescale/promscale/pkg/prompb"
+	"github.com/timescale/promscale/pkg/version"
+)
+
+// maxErrMsgLen is the maximum length of the error messages of failed remote
+// reads.
+const maxErrMsgLen = 256
+
+var remoteReadUserAgent = fmt.Sprintf("Promscale/%s", version.Version)
+
+// remoteReadQueryable reads the samples from a remote read endpoint.
+type remoteReadQueryable struct {
+	url     string
+	client  *http.Client
+	timeout time.Duration
+	// maxBytes is the maximum decompressed size of a response.
+	maxBytes int64
+}
+
+func newRemoteReadQueryable(url string, timeout time.Duration, maxBytes int64) *remoteReadQueryable {
+	return &remoteReadQueryable{url: url, client: &http.Client{}, timeout: timeout, maxBytes: maxBytes}
+}
+
+func (r *remoteReadQueryable) Querier(ctx context.Context, mint, maxt int64) (storage.Querier, error) {
+	return &remoteReadQuerier{r: r, ctx: ctx, mint: mint, maxt: maxt}, nil
+}
+
+// remoteReadQuerier only selects series, the labels are not read remotely.
+type remoteReadQuerier struct {
+	r          *remoteReadQueryable
+	ctx        context.Context
+	mint, maxt int64
+}
+
+func (q *remoteReadQuerier) LabelValues(string, ...*labels.Matcher) ([]string, storage.Warnings, error) {
+	return nil, nil, nil
+}
+
+func (q *remoteReadQuerier) LabelNames() ([]string, storage.Warnings, error) {
+	return nil, nil, nil
+}
+
+func (q *remoteReadQuerier) Close() error {
+	return nil
+}
+
+// Select reads the series right away. The series are always sorted.
+func (q *remoteReadQuerier) Select(_ bool, hints *storage.SelectHints, matchers ...*labels.Matcher) storage.SeriesSet {
+	query, err := toQuery(q.mint, q.maxt, hints, matchers)
+	if err != nil {
+		return storage.ErrSeriesSet(err)
+	}
+	result, err := q.r.read(q.ctx, query)
+	if err != nil {
+		return storage.ErrSeriesSet(err)
+	}
+	return newTimeSeriesSet(result.Timeseries)
+}
+
+func (r *remoteReadQueryable) read(ctx context.Context, query *prompb.Query) (*prompb.QueryResult, error) {
+	data, err := proto.Marshal(&prompb.ReadRequest{Queries: []*prompb.Query{query}})
+	if err != nil {
+		return nil, fmt.Errorf("marshalling the remote read request: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, r.url, bytes.NewReader(snappy.Encode(nil, data)))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Add("Content-Encoding", "snappy")
+	req.Header.Add("Accept-Encoding", "snappy")
+	req.Header.Set("Content-Type", "application/x-protobuf")
+	req.Header.Set("User-Agent", remoteReadUserAgent)
+	req.Header.Set("X-Prometheus-Remote-Read-Version", "0.1.0")
+
+	resp, err := r.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("remote read: %w", err)
+	}
+	defer func() {
+		_, _ = io.Copy(ioutil.Discard, resp.Body)
+		_ = resp.Body.Close()
+	}()
+
+	if resp.StatusCode/100 != 2 {
+		msg, _ := ioutil.ReadAll(io.LimitReader(resp.Body, maxErrMsgLen))
+		return nil, fmt.Errorf("remote read: server returned HTTP status %s: %s", resp.Status, bytes.TrimSpace(msg))
+	}
+	// The compressed response is at most the maximum encoded length of a
+	// response of the maximum size.
+	maxCompressed := int64(snappy.MaxEncodedLen(int(r.maxBytes)))
+	compressed, err := ioutil.ReadAll(io.LimitReader(resp.Body, maxCompressed+1))
+	if err != nil {
+		return nil, fmt.Errorf("remote read: reading the response: %w", err)
+	}
+	if int64(len(compressed)) > maxCompressed {
+		return nil, fmt.Errorf("remote read: the response exceeds the maximum size of %d bytes", r.maxBytes)
+	}
+	if n, err := snappy.DecodedLen(compressed); err != nil {
+		return nil, fmt.Errorf("remote read: decoding the response: %w", err)
+	} else if int64(n) > r.maxBytes {
+		return nil, fmt.Errorf("remote read: the response exceeds the maximum size of %d bytes", r.maxBytes)
+	}
+	data, err = snappy.Decode(nil, compressed)
+	if err != nil {
+		return nil, fmt.Errorf("remote read: decoding the response: %w", err)
+	}
+	var readResp prompb.ReadResponse
+	if err = proto.Unmarshal(data, &readResp); err != nil {
+		return nil, fmt.Errorf("remote read: unmarshalling the response: %w", err)
+	}
+	if len(readResp.Results) != 1 {
+		return nil, fmt.Errorf("remote read: expected 1 result, got %d", len(readResp.Results))
+	}
+	return readResp.Results[0], nil
+}
+
+func toQuery(mint, maxt int64, hints *storage.SelectHints, matchers []*labels.Matcher) (*prompb.Query, error) {
+	query := &prompb.Query{
+		StartTimestampMs: mint,
+		EndTimestampMs:   maxt,
+		Matchers:         make([]*prompb.LabelMatcher, 0, len(matchers)),
+	}
+	for _, m := range matchers {
+		var t prompb.LabelMatcher_Type
+		switch m.Type {
+		case labels.MatchEqual:
+			t = prompb.LabelMatcher_EQ
+		case labels.MatchNotEqual:
+			t = prompb.LabelMatcher_NEQ
+		case labels.MatchRegexp:
+			t = prompb.LabelMatcher_RE
+		case labels.MatchNotRegexp:
+			t = prompb.LabelMatcher_NRE
+		default:
+			return nil, fmt.Errorf("invalid matcher type")
+		}
+		query.Matchers = append(query.Matchers, &prompb.LabelMatcher{Type: t, Name: m.Name, Value: m.Value})
+	}
+	if hints != nil {
+		query.Hints = &prompb.ReadHints{
+			StartMs:  hints.Start,
+			EndMs:    hints.End,
+			StepMs:   hints.Step,
+			Func:     hints.Func,
+			Grouping: hints.Grouping,
+			By:       hints.By,
+			RangeMs:  hints.Range,
+		}
+	}
+	return query, nil
+}
+
+// newTimeSeriesSet returns a series set of the time series sorted by their
+// labels.
+func newTimeSeriesSet(ts []*prompb.TimeSeries) storage.SeriesSet {
+	series := make([]storage.Series, 0, len(ts))
+	for _, t := range ts {
+		lls := make(labels.Labels, 0, len(t.Labels))
+		for _, l := range t.Labels {
+			lls = append(lls, labels.Label{Name: l.Name, Value: l.Value})
+		}
+		sort.Sort(lls)
+		samples := make([]tsdbutil.Sample, 0, len(t.Samples))
+		for _, s := range t.Samples {
+			samples = append(samples, sample{t: s.Timestamp, v: s.Value})
+		}
+		series = append(series, storage.NewListSeries(lls, samples))
+	}
+	sort.Slice(series, func(i, j int) bool {
+		return labels.Compare(series[i].Labels(), series[j].Labels()) < 0
+	})
+	return &listSeriesSet{series: series, idx: -1}
+}
+
+type sample struct {
+	t int64
+	v float64
+}
+
+func (s sample) T() int64   { return s.t }
+func (s sample) V() float64 { return s.v }
+
+type listSeriesSet struct {
+	series []storage.Series
+	idx    int
+}
+
+func (s *listSeriesSet) Next() bool {
+	s.idx++
+	return s.idx < len(s.series)
+}
+
+func (s *listSeriesSet) At() storage.Series         { return s.series[s.idx] }
+func (s *listSeriesSet) Err() error                 { return nil }
+func (s *listSeriesSet) Warnings() storage.Warnings { return nil }
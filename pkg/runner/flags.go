@@ -135,6 +135,8 @@ func ParseFlags(cfg *Config, args []string) (*Config, error) {
 		cfg.UseVersionLease = false
 		cfg.InstallExtensions = false
 		cfg.UpgradeExtensions = false
+		// The rollups are still read, but can't be updated.
+		cfg.PgmodelCfg.DownsampleConfig.Interval = 0
 	}
 
 	if cfg.HaGroupLockID != 0 {
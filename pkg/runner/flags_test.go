@@ -5,6 +5,7 @@ import (
 	"os"
 	"reflect"
 	"testing"
+	"time"
 )
 
 func TestParseFlags(t *testing.T) {
@@ -82,9 +83,30 @@ func TestParseFlags(t *testing.T) {
 				c.UseVersionLease = false
 				c.InstallExtensions = false
 				c.UpgradeExtensions = false
+				c.PgmodelCfg.DownsampleConfig.Interval = 0
 				return c
 			},
 		},
+		{
+			name: "Downsampling resolutions",
+			args: []string{"-downsample-resolutions", "1h,5m"},
+			result: func(c Config) Config {
+				c.PgmodelCfg.DownsampleConfig.ResolutionsList = "1h,5m"
+				c.PgmodelCfg.DownsampleConfig.Resolutions = []time.Duration{5 * time.Minute, time.Hour}
+				c.PgmodelCfg.QuerierConfig.RollupResolutions = []time.Duration{5 * time.Minute, time.Hour}
+				return c
+			},
+		},
+		{
+			name:        "Invalid downsampling resolution",
+			args:        []string{"-downsample-resolutions", "5m,100us"},
+			shouldError: true,
+		},
+		{
+			name:        "Repeated downsampling resolution",
+			args:        []string{"-downsample-resolutions", "5m,300s"},
+			shouldError: true,
+		},
 		{
 			name: "Running HA and read-only error",
 			args: []string{
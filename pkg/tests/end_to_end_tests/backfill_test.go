@@ -0,0 +1,128 @@
+// This file and its contents are licensed under the Apache License 2.0.
+// Please see the included NOTICE for copyright information and
+// LICENSE for a copy of the license.
+
+package end_to_end_tests
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4/pgxpool"
+	_ "github.com/jackc/pgx/v4/stdlib"
+	"github.com/timescale/promscale/pkg/clockcache"
+	"github.com/timescale/promscale/pkg/pgmodel/cache"
+	ingstr "github.com/timescale/promscale/pkg/pgmodel/ingestor"
+	"github.com/timescale/promscale/pkg/pgmodel/model"
+	"github.com/timescale/promscale/pkg/pgxconn"
+	"github.com/timescale/promscale/pkg/prompb"
+)
+
+func TestBackfillCompressed(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+	if !*useTimescale2 {
+		t.Skip("backfill recompression requires TimescaleDB 2")
+	}
+	withDB(t, *testDatabase, func(db *pgxpool.Pool, t testing.TB) {
+		labels := []prompb.Label{
+			{Name: model.MetricNameLabelName, Value: "Test"},
+			{Name: "test", Value: "test"},
+		}
+		ts := []prompb.TimeSeries{
+			{
+				Labels: labels,
+				// Two samples that, by default, end up in different chunks.
+				Samples: []prompb.Sample{
+					{Timestamp: 1, Value: 0.1},
+					{Timestamp: 100000000, Value: 0.1},
+				},
+			},
+		}
+		// Backfilled samples are only buffered with the spool.
+		c := &cache.MetricNameCache{Metrics: clockcache.WithMax(cache.DefaultMetricCacheSize)}
+		scache := cache.NewSeriesCache(cache.DefaultConfig, nil)
+		ingestor, err := ingstr.NewPgxIngestor(pgxconn.NewPgxConn(db), c, scache, ingstr.DefaultParser(scache, nil), &ingstr.Cfg{
+			Spool:                      ingstr.SpoolConfig{Dir: t.TempDir(), MaxBytes: 1 << 20, SegmentBytes: 1 << 20, ReplayInterval: time.Minute},
+			BackfillMaxBufferedSamples: 100,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer ingestor.Close()
+		if _, err = ingestor.Ingest(copyMetrics(ts), ingstr.NewWriteRequest()); err != nil {
+			t.Fatal(err)
+		}
+		if err = ingestor.CompleteMetricCreation(); err != nil {
+			t.Fatal(err)
+		}
+
+		var tableName string
+		err = db.QueryRow(context.Background(), "SELECT table_name FROM _prom_catalog.get_metric_table_name_if_exists('Test');").Scan(&tableName)
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, err = db.Exec(context.Background(), fmt.Sprintf(`SELECT compress_chunk(i) from show_chunks('prom_data."%s"') i;`, tableName))
+		if err != nil {
+			if pgErr, ok := err.(*pgconn.PgError); !ok || pgErr.SQLState() != "42710" {
+				t.Fatal(err)
+			}
+		}
+
+		backfill := []prompb.TimeSeries{
+			{
+				Labels: labels,
+				Samples: []prompb.Sample{
+					{Timestamp: 2, Value: 0.2},
+					{Timestamp: 100000001, Value: 0.2},
+				},
+			},
+		}
+		if _, err = ingestor.Backfill(copyMetrics(backfill), ingstr.NewWriteRequest()); err != nil {
+			t.Fatal(err)
+		}
+		if err = ingestor.FlushBackfill(); err != nil {
+			t.Fatal(err)
+		}
+
+		progress := ingestor.BackfillProgress()
+		if progress.InsertedSamples != 2 || progress.FailedSamples != 0 || progress.BufferedSamples != 0 {
+			t.Errorf("unexpected progress: %+v", progress)
+		}
+
+		var count int
+		err = db.QueryRow(context.Background(), `SELECT count(*) FROM prom_data."Test"`).Scan(&count)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if count != 4 {
+			t.Errorf("unexpected row count: got %d wanted 4", count)
+		}
+
+		// The chunks decompressed for the backfill are compressed again.
+		var uncompressed int
+		err = db.QueryRow(context.Background(),
+			`SELECT count(*)
+			FROM timescaledb_information.chunks
+			WHERE hypertable_schema = 'prom_data' AND hypertable_name = $1 AND NOT is_compressed`, tableName).Scan(&uncompressed)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if uncompressed != 0 {
+			t.Errorf("%d backfilled chunks were not recompressed", uncompressed)
+		}
+
+		var recorded int
+		err = db.QueryRow(context.Background(), `SELECT count(*) FROM _prom_catalog.backfill_chunk`).Scan(&recorded)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if recorded != 0 {
+			t.Errorf("unexpected recorded backfill chunks: got %d wanted 0", recorded)
+		}
+	})
+}
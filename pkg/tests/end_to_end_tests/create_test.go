@@ -5,6 +5,7 @@ package end_to_end_tests
 
 import (
 	"context"
+	goErrors "errors"
 	"fmt"
 	"reflect"
 	"testing"
@@ -423,7 +424,7 @@ func TestSQLIngest(t *testing.T) {
 				defer ingestor.Close()
 
 				cnt, err := ingestor.Ingest(copyMetrics(tcase.metrics), ingstr.NewWriteRequest())
-				if err != nil && err != tcase.expectErr {
+				if err != nil && !goErrors.Is(err, tcase.expectErr) {
 					t.Fatalf("got an unexpected error %v", err)
 				}
 
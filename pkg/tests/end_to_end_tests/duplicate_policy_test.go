@@ -0,0 +1,99 @@
+// This file and its contents are licensed under the Apache License 2.0.
+// Please see the included NOTICE for copyright information and
+// LICENSE for a copy of the license.
+
+package end_to_end_tests
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/jackc/pgx/v4/pgxpool"
+	_ "github.com/jackc/pgx/v4/stdlib"
+	"github.com/timescale/promscale/pkg/pgmodel/common/errors"
+	ingstr "github.com/timescale/promscale/pkg/pgmodel/ingestor"
+	"github.com/timescale/promscale/pkg/pgmodel/model"
+	"github.com/timescale/promscale/pkg/pgxconn"
+	"github.com/timescale/promscale/pkg/prompb"
+)
+
+func TestSQLDuplicatePolicy(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+
+	testCases := []struct {
+		policy       string
+		values       []float64
+		nonRetryable string
+	}{
+		{policy: "ignore", values: []float64{1, 3}},
+		{policy: "overwrite", values: []float64{2, 3}},
+		{policy: "reject", values: []float64{1}, nonRetryable: errors.ReasonDuplicateSamples},
+	}
+
+	withDB(t, *testDatabase, func(db *pgxpool.Pool, t testing.TB) {
+		ingestor, err := ingstr.NewPgxIngestorForTests(pgxconn.NewPgxConn(db))
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer ingestor.Close()
+
+		for _, c := range testCases {
+			metricName := "dup_" + c.policy
+			_, err = db.Exec(context.Background(), "SELECT prom_api.set_metric_duplicate_policy($1, $2)", metricName, c.policy)
+			if err != nil {
+				t.Fatal(err)
+			}
+			series := func(samples ...prompb.Sample) []prompb.TimeSeries {
+				return []prompb.TimeSeries{{
+					Labels: []prompb.Label{
+						{Name: model.MetricNameLabelName, Value: metricName},
+						{Name: "foo", Value: "bar"},
+					},
+					Samples: samples,
+				}}
+			}
+
+			if _, err = ingestor.Ingest(series(prompb.Sample{Timestamp: 10, Value: 1}), ingstr.NewWriteRequest()); err != nil {
+				t.Fatal(err)
+			}
+			// The second write request corrects the first sample.
+			_, err = ingestor.Ingest(series(prompb.Sample{Timestamp: 10, Value: 2}, prompb.Sample{Timestamp: 20, Value: 3}), ingstr.NewWriteRequest())
+			if reason, _ := errors.NonRetryableReason(err); reason != c.nonRetryable {
+				t.Fatalf("unexpected error for policy %s: %v", c.policy, err)
+			}
+
+			rows, err := db.Query(context.Background(), fmt.Sprintf(`SELECT value FROM prom_data."%s" ORDER BY time`, metricName))
+			if err != nil {
+				t.Fatal(err)
+			}
+			var values []float64
+			for rows.Next() {
+				var v float64
+				if err = rows.Scan(&v); err != nil {
+					t.Fatal(err)
+				}
+				values = append(values, v)
+			}
+			rows.Close()
+			if !reflect.DeepEqual(values, c.values) {
+				t.Errorf("unexpected values for policy %s: got %v wanted %v", c.policy, values, c.values)
+			}
+		}
+
+		var policy string
+		err = db.QueryRow(context.Background(), "SELECT _prom_catalog.get_metric_duplicate_policy('dup_unset')").Scan(&policy)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if policy != "ignore" {
+			t.Errorf("unexpected default duplicate policy: %s", policy)
+		}
+		if _, err = db.Exec(context.Background(), "SELECT prom_api.set_default_duplicate_policy('newest')"); err == nil {
+			t.Errorf("expected an error setting an invalid duplicate policy")
+		}
+	})
+}
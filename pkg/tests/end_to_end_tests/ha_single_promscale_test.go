@@ -106,7 +106,7 @@ func prepareIngestorWithHa(db *pgxpool.Pool, t testing.TB) (*util.ManualTicker,
 	haService := ha.NewHAServiceWith(leaseClient, ticker, tooFarInTheFutureNowFn)
 	sigClose := make(chan struct{})
 	scach := cache.NewSeriesCache(cache.DefaultConfig, sigClose)
-	haParser := ha.NewHAParser(haService, scach)
+	haParser := ha.NewHAParser(haService, scach, nil)
 	cach := &cache.MetricNameCache{Metrics: clockcache.WithMax(cache.DefaultMetricCacheSize)}
 
 	ing, err := ingestor.NewPgxIngestor(pgxconn.NewPgxConn(db), cach, scach, haParser, &ingestor.Cfg{})
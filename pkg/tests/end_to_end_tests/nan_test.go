@@ -125,7 +125,7 @@ func TestSQLStaleNaN(t *testing.T) {
 			lCache := clockcache.WithMax(100)
 			dbConn := pgxconn.NewPgxConn(db)
 			labelsReader := lreader.NewLabelsReader(dbConn, lCache)
-			r := querier.NewQuerier(dbConn, mCache, labelsReader)
+			r := querier.NewQuerier(dbConn, mCache, labelsReader, lCache, querier.Config{})
 			resp, err := r.Query(c.query)
 			if err != nil {
 				t.Fatalf("unexpected error while ingesting test dataset: %s", err)
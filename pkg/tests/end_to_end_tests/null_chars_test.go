@@ -63,7 +63,7 @@ func TestOperationWithNullChars(t *testing.T) {
 		lCache := clockcache.WithMax(100)
 		dbConn := pgxconn.NewPgxConn(db)
 		labelsReader := lreader.NewLabelsReader(dbConn, lCache)
-		r := querier.NewQuerier(dbConn, mCache, labelsReader)
+		r := querier.NewQuerier(dbConn, mCache, labelsReader, lCache, querier.Config{})
 		resp, err := r.Query(&prompb.Query{
 			Matchers: []*prompb.LabelMatcher{
 				{
@@ -15,6 +15,7 @@ import (
 	"os"
 	"reflect"
 	"runtime"
+	"sort"
 	"testing"
 	"time"
 
@@ -24,6 +25,9 @@ import (
 	"github.com/prometheus/common/model"
 	"github.com/prometheus/prometheus/pkg/labels"
 	"github.com/prometheus/prometheus/pkg/timestamp"
+	"github.com/prometheus/prometheus/pkg/value"
+	"github.com/prometheus/prometheus/promql/parser"
+	"github.com/prometheus/prometheus/storage"
 	"github.com/prometheus/prometheus/tsdb"
 	"github.com/stretchr/testify/require"
 	"github.com/timescale/promscale/pkg/clockcache"
@@ -624,7 +628,7 @@ func TestSQLQuery(t *testing.T) {
 		lCache := clockcache.WithMax(100)
 		dbConn := pgxconn.NewPgxConn(readOnly)
 		labelsReader := lreader.NewLabelsReader(dbConn, lCache)
-		r := querier.NewQuerier(dbConn, mCache, labelsReader)
+		r := querier.NewQuerier(dbConn, mCache, labelsReader, lCache, querier.Config{})
 		for _, c := range testCases {
 			tester.Run(c.name, func(t *testing.T) {
 				resp, err := r.Query(c.query)
@@ -960,7 +964,7 @@ func TestPromQL(t *testing.T) {
 		lCache := clockcache.WithMax(100)
 		dbConn := pgxconn.NewPgxConn(readOnly)
 		labelsReader := lreader.NewLabelsReader(dbConn, lCache)
-		r := querier.NewQuerier(dbConn, mCache, labelsReader)
+		r := querier.NewQuerier(dbConn, mCache, labelsReader, lCache, querier.Config{})
 		for _, c := range testCases {
 			tester.Run(c.name, func(t *testing.T) {
 				connResp, connErr := r.Query(c.query)
@@ -1131,8 +1135,8 @@ func TestPushdown(t *testing.T) {
 		lCache := clockcache.WithMax(100)
 		dbConn := pgxconn.NewPgxConn(readOnly)
 		labelsReader := lreader.NewLabelsReader(dbConn, lCache)
-		r := querier.NewQuerier(dbConn, mCache, labelsReader)
-		queryable := query.NewQueryable(r, labelsReader)
+		r := querier.NewQuerier(dbConn, mCache, labelsReader, lCache, querier.Config{})
+		queryable := query.NewQueryable(r)
 		queryEngine, err := query.NewEngine(log.GetLogger(), time.Minute, time.Minute, []string{})
 		if err != nil {
 			t.Fatal(err)
@@ -1159,3 +1163,229 @@ func TestPushdown(t *testing.T) {
 		}
 	})
 }
+
+// noPushdownQueryable evaluates every selector without pushdown, it is the
+// reference the pushed down results are compared to.
+type noPushdownQueryable struct {
+	promql.Queryable
+}
+
+func (q noPushdownQueryable) Querier(ctx context.Context, mint, maxt int64) (promql.Querier, error) {
+	qr, err := q.Queryable.Querier(ctx, mint, maxt)
+	if err != nil {
+		return nil, err
+	}
+	return noPushdownQuerier{qr}, nil
+}
+
+type noPushdownQuerier struct {
+	promql.Querier
+}
+
+func (q noPushdownQuerier) Select(sortSeries bool, hints *storage.SelectHints, _ []parser.Node, ms ...*labels.Matcher) (storage.SeriesSet, parser.Node) {
+	return q.Querier.Select(sortSeries, hints, nil, ms...)
+}
+
+// generateCounterResets returns counters with resets, irregular scrape
+// intervals and stale markers.
+func generateCounterResets() []prompb.TimeSeries {
+	resets := prompb.TimeSeries{
+		Labels: []prompb.Label{
+			{Name: pgmodel.MetricNameLabelName, Value: "counter_resets"},
+			{Name: "instance", Value: "1"},
+		},
+	}
+	irregular := prompb.TimeSeries{
+		Labels: []prompb.Label{
+			{Name: pgmodel.MetricNameLabelName, Value: "counter_resets"},
+			{Name: "instance", Value: "2"},
+		},
+	}
+	var v float64
+	for i := int64(0); startTime+i*15000 < endTime; i++ {
+		ts := startTime + i*15000
+		switch {
+		case i%97 == 96:
+			resets.Samples = append(resets.Samples, prompb.Sample{Timestamp: ts, Value: math.Float64frombits(value.StaleNaN)})
+			continue
+		case i%41 == 0:
+			v = float64(i % 3)
+		default:
+			v += float64(i%7) + 0.25
+		}
+		resets.Samples = append(resets.Samples, prompb.Sample{Timestamp: ts, Value: v})
+		if i%5 != 0 && i%13 != 0 {
+			irregular.Samples = append(irregular.Samples, prompb.Sample{Timestamp: ts + i%4*1000, Value: float64(i*i%1000) / 3})
+		}
+	}
+	return []prompb.TimeSeries{resets, irregular}
+}
+
+func TestPushdownGolden(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+
+	// Aggregations are not computed in the same order in the database, so
+	// their results are only compared approximately.
+	queries := []struct {
+		query  string
+		approx bool
+	}{
+		{query: `rate(counter_resets[1m])`},
+		{query: `rate(counter_resets[5m])`},
+		{query: `increase(counter_resets[2m])`},
+		{query: `increase(counter_resets[10m])`},
+		{query: `irate(counter_resets[1m])`},
+		{query: `irate(counter_resets[5m])`},
+		{query: `rate(metric_1[5m])`},
+		{query: `increase(metric_2{instance="2"}[1m])`},
+		{query: `irate(metric_3[5m])`},
+		{query: `rate(counter_resets[5m]) / irate(counter_resets[5m])`},
+		{query: `sum by (instance) (rate(counter_resets[5m]))`, approx: true},
+		{query: `sum(rate(metric_2[5m]))`, approx: true},
+		{query: `avg by (foo) (increase(metric_2[5m]))`, approx: true},
+		{query: `min without (instance) (irate(metric_2[1m]))`},
+		{query: `max by (foo) (rate(metric_2[1m]))`},
+		{query: `count by (foo) (rate(metric_2[1m]))`},
+		{query: `sum by (foo) (metric_2)`},
+	}
+	steps := []time.Duration{10 * time.Second, 30 * time.Second, time.Minute, 5 * time.Minute}
+
+	withDB(t, *testDatabase, func(db *pgxpool.Pool, t testing.TB) {
+		ingestQueryTestDataset(db, t, append(generateLargeTimeseries(), generateCounterResets()...))
+		readOnly := testhelpers.GetReadOnlyConnection(t, *testDatabase)
+		defer readOnly.Close()
+
+		var tester *testing.T
+		var ok bool
+		if tester, ok = t.(*testing.T); !ok {
+			t.Fatalf("Cannot run test, not an instance of testing.T")
+			return
+		}
+
+		mCache := &cache.MetricNameCache{Metrics: clockcache.WithMax(cache.DefaultMetricCacheSize)}
+		lCache := clockcache.WithMax(100)
+		dbConn := pgxconn.NewPgxConn(readOnly)
+		labelsReader := lreader.NewLabelsReader(dbConn, lCache)
+		r := querier.NewQuerier(dbConn, mCache, labelsReader, lCache, querier.Config{})
+		queryable := query.NewQueryable(r)
+		queryEngine, err := query.NewEngine(log.GetLogger(), time.Minute, time.Minute, []string{})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		compare := func(t *testing.T, approx bool, newQuery func(q promql.Queryable) (promql.Query, error)) {
+			pushed, err := newQuery(queryable)
+			if err != nil {
+				t.Fatal(err)
+			}
+			reference, err := newQuery(noPushdownQueryable{queryable})
+			if err != nil {
+				t.Fatal(err)
+			}
+			expected := reference.Exec(context.Background())
+			require.NoError(t, expected.Err)
+			actual := pushed.Exec(context.Background())
+			if approx {
+				requireResultInDelta(t, expected, actual)
+				return
+			}
+			require.Equal(t, *expected, *actual)
+		}
+
+		for _, q := range queries {
+			qry, approx := q.query, q.approx
+			for _, ts := range []int64{startTime + 30000, startTime + 317000, endTime, endTime + 200000} {
+				evalTime := model.Time(ts).Time()
+				tester.Run(fmt.Sprintf("%s instant at %d", qry, ts), func(t *testing.T) {
+					compare(t, approx, func(q promql.Queryable) (promql.Query, error) {
+						return queryEngine.NewInstantQuery(q, qry, evalTime)
+					})
+				})
+			}
+			for _, step := range steps {
+				s := step
+				tester.Run(fmt.Sprintf("%s range step %s", qry, s), func(t *testing.T) {
+					compare(t, approx, func(q promql.Queryable) (promql.Query, error) {
+						return queryEngine.NewRangeQuery(q, qry, model.Time(startTime).Time(), model.Time(endTime).Time().Add(10*time.Minute), s)
+					})
+				})
+			}
+		}
+	})
+}
+
+// TestPushdownDivisionByZero checks that the pushed down functions divide by
+// zero like the PromQL engine does, instead of erroring out.
+func TestPushdownDivisionByZero(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+	withDB(t, *testDatabase, func(db *pgxpool.Pool, t testing.TB) {
+		for _, c := range []struct{ dividend, divisor float64 }{
+			{1, 0},
+			{-1, 0},
+			{0, 0},
+			{math.NaN(), 0},
+			{6, 3},
+		} {
+			expected := c.dividend / c.divisor
+			var actual float64
+			err := db.QueryRow(context.Background(), "SELECT _prom_catalog.prom_div($1, $2)", c.dividend, c.divisor).Scan(&actual)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if actual != expected && !(math.IsNaN(actual) && math.IsNaN(expected)) {
+				t.Errorf("unexpected result of %v / %v: got %v wanted %v", c.dividend, c.divisor, actual, expected)
+			}
+		}
+
+		// Two samples with the same timestamp have a sampled interval of
+		// zero, for which the engine extrapolates to NaN.
+		evalTime := time.Unix(10, 0)
+		var result []float64
+		err := db.QueryRow(context.Background(),
+			`SELECT _prom_catalog.prom_extrapolated_rate($1, $1, 1000, 60000, 60, true, true, $2::timestamptz[], $3::float8[])`,
+			evalTime, []time.Time{evalTime, evalTime}, []float64{1, 2},
+		).Scan(&result)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(result) != 1 || !math.IsNaN(result[0]) {
+			t.Errorf("unexpected rate for a sampled interval of zero: got %v wanted [NaN]", result)
+		}
+	})
+}
+
+// requireResultInDelta checks that both results have the same series and
+// points, allowing for rounding differences in the values.
+func requireResultInDelta(t *testing.T, expected, actual *promql.Result) {
+	require.NoError(t, actual.Err)
+	toMatrix := func(v parser.Value) promql.Matrix {
+		switch r := v.(type) {
+		case promql.Matrix:
+			return r
+		case promql.Vector:
+			m := make(promql.Matrix, 0, len(r))
+			for _, s := range r {
+				m = append(m, promql.Series{Metric: s.Metric, Points: []promql.Point{s.Point}})
+			}
+			return m
+		}
+		t.Fatalf("unexpected result type %T", v)
+		return nil
+	}
+	exp, act := toMatrix(expected.Value), toMatrix(actual.Value)
+	sort.Sort(exp)
+	sort.Sort(act)
+	require.Equal(t, len(exp), len(act))
+	for i := range exp {
+		require.Equal(t, exp[i].Metric, act[i].Metric)
+		require.Equal(t, len(exp[i].Points), len(act[i].Points), exp[i].Metric.String())
+		for j, p := range exp[i].Points {
+			require.Equal(t, p.T, act[i].Points[j].T)
+			require.InDelta(t, p.V, act[i].Points[j].V, 1e-9*math.Max(1, math.Abs(p.V)))
+		}
+	}
+}
@@ -0,0 +1,160 @@
+// This file and its contents are licensed under the Apache License 2.0.
+// Please see the included NOTICE for copyright information and
+// LICENSE for a copy of the license.
+
+package end_to_end_tests
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v4/pgxpool"
+	"github.com/stretchr/testify/require"
+	"github.com/timescale/promscale/pkg/clockcache"
+	"github.com/timescale/promscale/pkg/log"
+	"github.com/timescale/promscale/pkg/pgmodel/cache"
+	"github.com/timescale/promscale/pkg/pgmodel/lreader"
+	pgmodel "github.com/timescale/promscale/pkg/pgmodel/model"
+	"github.com/timescale/promscale/pkg/pgmodel/querier"
+	"github.com/timescale/promscale/pkg/pgxconn"
+	"github.com/timescale/promscale/pkg/prompb"
+	"github.com/timescale/promscale/pkg/promql"
+	"github.com/timescale/promscale/pkg/query"
+)
+
+// generateRollupDataset returns 4 hours of samples, scraped every 10 seconds
+// between the 5 minute boundaries, of a counter increasing by 1 per sample and
+// resetting within the rollup buckets, and of two gauges.
+func generateRollupDataset(start int64) []prompb.TimeSeries {
+	counter := prompb.TimeSeries{
+		Labels: []prompb.Label{
+			{Name: pgmodel.MetricNameLabelName, Value: "rollup_counter"},
+			{Name: "instance", Value: "1"},
+		},
+	}
+	gauges := make([]prompb.TimeSeries, 2)
+	for i := range gauges {
+		gauges[i].Labels = []prompb.Label{
+			{Name: pgmodel.MetricNameLabelName, Value: "rollup_gauge"},
+			{Name: "instance", Value: fmt.Sprint(i)},
+		}
+	}
+	for i := int64(0); i < 4*360; i++ {
+		ts := start + 5000 + i*10000
+		counter.Samples = append(counter.Samples, prompb.Sample{Timestamp: ts, Value: float64(i%100 + 1)})
+		for j := range gauges {
+			gauges[j].Samples = append(gauges[j].Samples, prompb.Sample{Timestamp: ts, Value: float64((i*37+int64(j)*11)%101) - 50})
+		}
+	}
+	return append(gauges, counter)
+}
+
+// TestRollupsMatchRawSamples checks that the queries reading from the rollups
+// return the results of the same queries over the raw samples, by deleting
+// the raw samples which were rolled up.
+func TestRollupsMatchRawSamples(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+
+	const resolution = 5 * time.Minute
+	start := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	at := func(d time.Duration) time.Time { return start.Add(d) }
+
+	withDB(t, *testDatabase, func(db *pgxpool.Pool, t testing.TB) {
+		ctx := context.Background()
+		ingestQueryTestDataset(db, t, generateRollupDataset(start.UnixNano()/int64(time.Millisecond)))
+
+		rollUp := func(metric string, maxBuckets int) {
+			var rows int64
+			err := db.QueryRow(ctx, "SELECT _prom_catalog.downsample_metric($1, $2, 0, $3)", metric, resolution.Milliseconds(), maxBuckets).Scan(&rows)
+			require.NoError(t, err)
+		}
+		requireWatermark := func(metric string, expected time.Time) {
+			var watermark time.Time
+			err := db.QueryRow(ctx, `SELECT w.watermark FROM _prom_catalog.downsample_watermark w
+				INNER JOIN _prom_catalog.metric m ON (m.id = w.metric_id)
+				WHERE m.metric_name = $1 AND w.resolution_ms = $2`, metric, resolution.Milliseconds()).Scan(&watermark)
+			require.NoError(t, err)
+			require.True(t, expected.Equal(watermark), "unexpected watermark of %s: got %v wanted %v", metric, watermark, expected)
+		}
+
+		rollUp("rollup_counter", 24)
+		rollUp("rollup_gauge", 24)
+		requireWatermark("rollup_gauge", at(2*time.Hour))
+
+		// A sample written behind the watermark gets its bucket rolled
+		// up again.
+		ingestQueryTestDataset(db, t, []prompb.TimeSeries{{
+			Labels: []prompb.Label{
+				{Name: pgmodel.MetricNameLabelName, Value: "rollup_gauge"},
+				{Name: "instance", Value: "0"},
+			},
+			Samples: []prompb.Sample{{Timestamp: at(time.Hour+7*time.Second).UnixNano() / int64(time.Millisecond), Value: 1000}},
+		}})
+		requireWatermark("rollup_gauge", at(time.Hour))
+		requireWatermark("rollup_counter", at(2*time.Hour))
+		rollUp("rollup_gauge", 24)
+		rollUp("rollup_counter", 12)
+		watermark := at(3 * time.Hour)
+		requireWatermark("rollup_gauge", watermark)
+		requireWatermark("rollup_counter", watermark)
+
+		queryEngine, err := query.NewEngine(log.GetLogger(), time.Minute, time.Minute, []string{})
+		require.NoError(t, err)
+		newQueryable := func(cfg querier.Config) promql.Queryable {
+			mCache := &cache.MetricNameCache{Metrics: clockcache.WithMax(cache.DefaultMetricCacheSize)}
+			lCache := clockcache.WithMax(100)
+			dbConn := pgxconn.NewPgxConn(db)
+			labelsReader := lreader.NewLabelsReader(dbConn, lCache)
+			return query.NewQueryable(querier.NewQuerier(dbConn, mCache, labelsReader, lCache, cfg))
+		}
+
+		// The counter queries only range over the rolled up samples, the
+		// extrapolation of the rates differs where the rollup rows are
+		// followed by raw samples. The gauge queries are exact.
+		queries := []struct {
+			query      string
+			start, end time.Time
+		}{
+			{query: `rate(rollup_counter[30m])`, start: at(time.Hour), end: watermark},
+			{query: `increase(rollup_counter[1h])`, start: at(time.Hour), end: watermark},
+			{query: `irate(rollup_counter[10m])`, start: at(time.Hour), end: watermark},
+			{query: `max_over_time(rollup_gauge[10m])`, start: at(time.Hour), end: at(4 * time.Hour)},
+			{query: `min_over_time(rollup_gauge[10m])`, start: at(time.Hour), end: at(4 * time.Hour)},
+			{query: `sum_over_time(rollup_gauge[30m])`, start: at(time.Hour), end: at(4 * time.Hour)},
+			{query: `last_over_time(rollup_gauge[5m])`, start: at(time.Hour), end: at(4 * time.Hour)},
+			{query: `max by (instance) (rollup_gauge)`, start: at(time.Hour), end: at(4 * time.Hour)},
+		}
+
+		raw := newQueryable(querier.Config{})
+		expected := make([]*promql.Result, len(queries))
+		for i, q := range queries {
+			qry, err := queryEngine.NewRangeQuery(raw, q.query, q.start, q.end, resolution)
+			require.NoError(t, err)
+			expected[i] = qry.Exec(ctx)
+			require.NoError(t, expected[i].Err)
+		}
+
+		// The rollups are the only remaining source of the rolled up range.
+		for _, table := range []string{"rollup_counter", "rollup_gauge"} {
+			_, err = db.Exec(ctx, fmt.Sprintf(`DELETE FROM prom_data.%q WHERE time < $1`, table), watermark)
+			require.NoError(t, err)
+		}
+
+		rollups := newQueryable(querier.Config{RollupResolutions: []time.Duration{resolution}})
+		for i, q := range queries {
+			qry, err := queryEngine.NewRangeQuery(rollups, q.query, q.start, q.end, resolution)
+			require.NoError(t, err)
+			tester, ok := t.(*testing.T)
+			if !ok {
+				t.Fatalf("Cannot run test, not an instance of testing.T")
+			}
+			tester.Run(q.query, func(t *testing.T) {
+				requireResultInDelta(t, expected[i], qry.Exec(ctx))
+			})
+		}
+	})
+}
@@ -39,6 +39,7 @@ var schemas = []string{
 	"pg_toast_temp_1",
 	"prom_api",
 	"prom_data",
+	"prom_data_rollup",
 	"prom_data_series",
 	"prom_info",
 	"prom_metric",
@@ -57,6 +58,7 @@ var schemasWOTimescaleDB = []string{
 	"pg_toast_temp_1",
 	"prom_api",
 	"prom_data",
+	"prom_data_rollup",
 	"prom_data_series",
 	"prom_info",
 	"prom_metric",
@@ -70,6 +72,7 @@ var ourSchemas = []string{
 	"_prom_ext",
 	"prom_api",
 	"prom_data",
+	"prom_data_rollup",
 	"prom_data_series",
 	"prom_info",
 	"prom_metric",